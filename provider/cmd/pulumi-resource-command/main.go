@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	command "github.com/pulumi/pulumi-command/provider/pkg/provider"
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/remote"
 	"github.com/pulumi/pulumi-command/provider/pkg/version"
 )
 
@@ -34,6 +35,8 @@ func main() {
 
 	// This method starts serving requests using the Command provider.
 	err := commandProvider.Run(context.Background(), "command", version)
+	// The engine is done with the provider, so the tunnels opened for the deployment aren't needed anymore.
+	remote.CloseTunnels()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
 		os.Exit(1)
@@ -24,12 +24,23 @@ import (
 type BaseInputs struct {
 	Stdin                  *string            `pulumi:"stdin,optional"`
 	Logging                *Logging           `pulumi:"logging,optional"`
+	StdoutSeverity         *LogSeverity       `pulumi:"stdoutSeverity,optional"`
+	StderrSeverity         *LogSeverity       `pulumi:"stderrSeverity,optional"`
+	LogStreamPrefix        *bool              `pulumi:"logStreamPrefix,optional"`
+	WorkflowCommands       *bool              `pulumi:"workflowCommands,optional"`
+	StripWorkflowCommands  *bool              `pulumi:"stripWorkflowCommands,optional"`
+	HeartbeatInterval      *int               `pulumi:"heartbeatInterval,optional"`
+	ErrorOutputLines       *int               `pulumi:"errorOutputLines,optional"`
+	Redact                 *[]string          `pulumi:"redact,optional"                 provider:"secret"`
+	RedactOutputs          *bool              `pulumi:"redactOutputs,optional"`
 	Interpreter            *[]string          `pulumi:"interpreter,optional"`
 	Dir                    *string            `pulumi:"dir,optional"`
 	Environment            *map[string]string `pulumi:"environment,optional"`
 	AssetPaths             *[]string          `pulumi:"assetPaths,optional"`
 	ArchivePaths           *[]string          `pulumi:"archivePaths,optional"`
 	AddPreviousOutputInEnv *bool              `pulumi:"addPreviousOutputInEnv,optional"`
+	Symlinks               *SymlinkPolicy     `pulumi:"symlinks,optional"`
+	Pty                    *Pty               `pulumi:"pty,optional"`
 }
 
 // Implementing Annotate lets you provide descriptions and default values for fields and they will
@@ -39,6 +50,29 @@ func (c *BaseInputs) Annotate(a infer.Annotator) {
 	a.Describe(&c.Logging, `If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.`)
+	a.Describe(&c.StdoutSeverity, "The severity with which the lines of stdout are logged. Defaults to `info`.")
+	a.Describe(&c.StderrSeverity, "The severity with which the lines of stderr are logged, e.g. `warning` to make "+
+		"errors stand out. Defaults to `info`.")
+	a.Describe(&c.LogStreamPrefix, "If each logged line is prefixed with the name of its stream, `[stdout]` or "+
+		"`[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.")
+	a.Describe(&c.WorkflowCommands, `Run the workflow commands among the lines of stdout, modeled on those of
+GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.`)
+	a.Describe(&c.StripWorkflowCommands, `If the workflow commands are removed from the 'stdout' output. Defaults
+to false.`)
+	a.Describe(&c.HeartbeatInterval, `The number of seconds without output after which the status of the
+resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+Defaults to 60.`)
+	a.Describe(&c.ErrorOutputLines, `The number of lines at the end of stdout and stderr that the error of a
+failed command includes. Defaults to 20.`)
+	a.Describe(&c.Redact, `Additional values to mask as '[secret]' in the logged output and the error of
+the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.`)
+	a.Describe(&c.RedactOutputs, `If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+to false, in which case outputs that might contain secrets can be marked as secret via
+'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.`)
 	a.Describe(&c.Interpreter, "The program and arguments to run the command.\n"+
 		"On Linux and macOS, defaults to: `[\"/bin/sh\", \"-c\"]`. On Windows, defaults to: `[\"cmd\", \"/C\"]`")
 	a.Describe(&c.Dir, "The directory from which to run the command from. If `dir` does not exist, then\n"+
@@ -126,6 +160,16 @@ The following paths will be returned:
 		`If the previous command's stdout and stderr (as generated by the prior create/update) is
 injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
 Defaults to true.`)
+	a.Describe(&c.Symlinks, `How symlinks are handled when matching `+"`assetPaths` and `archivePaths`"+`.
+With `+"`follow`"+`, symlinks to files are read as the files they point to and symlinks to directories are
+searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+Assets can't represent symlinks, so `+"`preserve`"+` reads symlinks to files like `+"`follow`"+` but doesn't
+search symlinked directories. With `+"`skip`"+`, symlinks are ignored. If not set, symlinks are matched like
+files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+a directory isn't searched.`)
+	a.Describe(&c.Pty, `Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+the terminal, without echo, followed by the end of input. Not supported on Windows.`)
 }
 
 type BaseOutputs struct {
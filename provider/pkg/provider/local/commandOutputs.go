@@ -17,19 +17,19 @@ package local
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"io/fs"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"runtime"
 	"strings"
-
-	"github.com/gobwas/glob"
+	"syscall"
+	"time"
 
 	"github.com/pulumi/pulumi-go-provider/infer/types"
-	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 
@@ -51,24 +51,31 @@ func run(ctx context.Context, command string, in BaseInputs, out *BaseOutputs, l
 	args = append(args, command)
 
 	var err error
-	var stdoutbuf, stderrbuf, stdouterrbuf bytes.Buffer // stdouterrbuf is only for error messages
-	stdouterrwriter := util.ConcurrentWriter{Writer: &stdouterrbuf}
-	loggingReader, loggingWriter := io.Pipe()
+	var stdoutbuf, stderrbuf bytes.Buffer
+	// The tails are only for error messages.
+	stdoutTail, stderrTail := util.NewTailWriter(in.ErrorOutputLines), util.NewTailWriter(in.ErrorOutputLines)
+	var redact []string
+	if in.Redact != nil {
+		redact = *in.Redact
+	}
+	redactor := util.NewRedactor(ctx, redact...)
+	logger := util.NewOutputLogger(ctx, redactor, "", in.LogStreamPrefix != nil && *in.LogStreamPrefix)
 
 	//nolint:gosec // G204: This is a command execution provider, running user-specified commands is the intended behavior
 	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
 
-	stdoutWriters := []io.Writer{&stdoutbuf, &stdouterrwriter}
-	if logging.ShouldLogStdout() {
-		stdoutWriters = append(stdoutWriters, loggingWriter)
-	}
-	cmd.Stdout = io.MultiWriter(stdoutWriters...)
-
-	stderrWriters := []io.Writer{&stderrbuf, &stdouterrwriter}
-	if logging.ShouldLogStderr() {
-		stderrWriters = append(stderrWriters, loggingWriter)
-	}
-	cmd.Stderr = io.MultiWriter(stderrWriters...)
+	// The streams that aren't logged still run the workflow commands and count as output for the
+	// heartbeat.
+	workflowCommands := in.WorkflowCommands != nil && *in.WorkflowCommands
+	cmd.Stdout = io.MultiWriter(&stdoutbuf, stdoutTail, logger.Stream("stdout", util.StreamOptions{
+		Severity:         in.StdoutSeverity.diag(),
+		Quiet:            !logging.ShouldLogStdout(),
+		WorkflowCommands: workflowCommands,
+	}))
+	cmd.Stderr = io.MultiWriter(&stderrbuf, stderrTail, logger.Stream("stderr", util.StreamOptions{
+		Severity: in.StderrSeverity.diag(),
+		Quiet:    !logging.ShouldLogStderr(),
+	}))
 
 	if in.Dir != nil {
 		cmd.Dir = *in.Dir
@@ -79,6 +86,9 @@ func run(ctx context.Context, command string, in BaseInputs, out *BaseOutputs, l
 		}
 	}
 	cmd.Env = os.Environ()
+	if in.Pty != nil {
+		cmd.Env = append(cmd.Env, "TERM="+in.Pty.term())
+	}
 	if in.Environment != nil {
 		for k, v := range *in.Environment {
 			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
@@ -98,23 +108,36 @@ func run(ctx context.Context, command string, in BaseInputs, out *BaseOutputs, l
 		cmd.Stdin = strings.NewReader(*in.Stdin)
 	}
 
-	stdouterrch := make(chan struct{})
-	go util.LogOutput(ctx, loggingReader, stdouterrch, diag.Info)
-
-	err = cmd.Start()
+	start := time.Now()
+	logger.StartHeartbeat(util.HeartbeatInterval(in.HeartbeatInterval))
+	wait := cmd.Wait
+	if in.Pty != nil {
+		wait, err = startPty(cmd, in.Pty)
+	} else {
+		err = cmd.Start()
+	}
 	if err == nil {
-		err = cmd.Wait()
+		err = wait()
 	}
 
-	loggingWriter.Close()
-	<-stdouterrch
+	logger.Close()
 
 	if err != nil {
-		return fmt.Errorf("%w: running %q:\n%s", err, command, stdouterrbuf.String())
+		exitCode, signal := exitStatus(err)
+		return &util.CommandError{
+			Command:  command,
+			ExitCode: exitCode,
+			Signal:   signal,
+			Duration: time.Since(start),
+			Stdout:   stdoutTail,
+			Stderr:   stderrTail,
+			Redactor: redactor,
+			Err:      err,
+		}
 	}
 
 	if in.AssetPaths != nil {
-		assets, err := globAssets(cmd.Dir, *in.AssetPaths)
+		assets, err := globAssets(cmd.Dir, *in.AssetPaths, in.Symlinks)
 		if err != nil {
 			return err
 		}
@@ -123,7 +146,7 @@ func run(ctx context.Context, command string, in BaseInputs, out *BaseOutputs, l
 
 	if in.ArchivePaths != nil {
 		archiveAssets := map[string]any{}
-		assets, err := globAssets(cmd.Dir, *in.ArchivePaths)
+		assets, err := globAssets(cmd.Dir, *in.ArchivePaths, in.Symlinks)
 		if err != nil {
 			return err
 		}
@@ -139,49 +162,81 @@ func run(ctx context.Context, command string, in BaseInputs, out *BaseOutputs, l
 		out.Archive = archive
 	}
 
-	out.Stdout = strings.TrimSuffix(stdoutbuf.String(), "\n")
-	out.Stderr = strings.TrimSuffix(stderrbuf.String(), "\n")
+	stdout := stdoutbuf.String()
+	if in.Pty != nil {
+		stdout = util.TerminalOutput(stdout, in.Pty.stripANSI())
+	}
+	if workflowCommands && in.StripWorkflowCommands != nil && *in.StripWorkflowCommands {
+		stdout = util.RemoveWorkflowCommands(stdout)
+	}
+	stderr := stderrbuf.String()
+	if in.RedactOutputs != nil && *in.RedactOutputs {
+		stdout, stderr = redactor.Redact(stdout), redactor.Redact(stderr)
+	}
+	out.Stdout = strings.TrimSuffix(stdout, "\n")
+	out.Stderr = strings.TrimSuffix(stderr, "\n")
 
 	return nil
 }
 
-func globAssets(dir string, globs []string) (map[string]*types.AssetOrArchive, error) {
-	assets := map[string]*types.AssetOrArchive{}
-	compiledGlobs := make([]glob.Glob, len(globs))
-	isGlobExclude := make([]bool, len(globs))
-	for i, g := range globs {
-		isExclude := strings.HasPrefix(g, "!")
-		g = strings.TrimPrefix(g, "!")
-		compiled, err := glob.Compile(g, '/')
-		if err != nil {
-			return nil, err
+// signalNames are the names of the signals that SSH servers report, so that local and remote
+// command errors name signals the same.
+var signalNames = map[syscall.Signal]string{
+	syscall.SIGABRT: "ABRT",
+	syscall.SIGALRM: "ALRM",
+	syscall.SIGFPE:  "FPE",
+	syscall.SIGHUP:  "HUP",
+	syscall.SIGILL:  "ILL",
+	syscall.SIGINT:  "INT",
+	syscall.SIGKILL: "KILL",
+	syscall.SIGPIPE: "PIPE",
+	syscall.SIGQUIT: "QUIT",
+	syscall.SIGSEGV: "SEGV",
+	syscall.SIGTERM: "TERM",
+}
+
+// exitStatus returns the exit code of a command from the error it failed with, or -1 if it didn't
+// exit, and the signal that killed it, if any.
+func exitStatus(err error) (int, string) {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return -1, ""
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		if name, ok := signalNames[status.Signal()]; ok {
+			return -1, name
 		}
-		compiledGlobs[i] = compiled
-		isGlobExclude[i] = isExclude
+		return -1, status.Signal().String()
 	}
+	return exitErr.ExitCode(), ""
+}
 
-	err := fs.WalkDir(os.DirFS(dir), ".", func(p string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-		if d.IsDir() {
+func globAssets(dir string, globs []string, symlinks *SymlinkPolicy) (map[string]*types.AssetOrArchive, error) {
+	assets := map[string]*types.AssetOrArchive{}
+	rules, err := util.CompileGlobRules(globs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.WalkFiles(dir, symlinks.policy(), func(e util.WalkEntry) error {
+		if e.IsDir() {
 			return nil
 		}
-		for i, g := range compiledGlobs {
-			matched := g.Match(p)
-			if !matched {
-				continue
-			}
-			if isGlobExclude[i] {
-				delete(assets, p)
-			} else {
-				asset, err := resource.NewPathAsset(path.Join(dir, p))
-				if err != nil {
-					return err
-				}
-				assets[p] = &types.AssetOrArchive{Asset: asset}
+		if e.IsSymlink() {
+			// Assets can't represent symlinks, so preserved links to files are read through the link
+			// and links to directories are left out.
+			if info, err := os.Stat(filepath.Join(dir, e.Path)); err != nil || info.IsDir() {
+				return nil
 			}
 		}
+		if _, included := rules.Match(e.Path); !included {
+			return nil
+		}
+		asset, err := resource.NewPathAsset(path.Join(dir, e.Path))
+		if err != nil {
+			return err
+		}
+		assets[e.Path] = &types.AssetOrArchive{Asset: asset}
 		return nil
 	})
 	if err != nil {
@@ -0,0 +1,217 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestGlobAssetsSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "file"), []byte("x"), 0o600))
+	require.NoError(t, os.Symlink(filepath.Join("sub", "file"), filepath.Join(dir, "link")))
+	require.NoError(t, os.Symlink("sub", filepath.Join(dir, "linkdir")))
+
+	for policy, want := range map[SymlinkPolicy][]string{
+		SymlinksFollow:   {"link", "linkdir/file", "sub/file"},
+		SymlinksPreserve: {"link", "sub/file"},
+		SymlinksSkip:     {"sub/file"},
+	} {
+		t.Run(string(policy), func(t *testing.T) {
+			assets, err := globAssets(dir, []string{"**"}, &policy)
+			require.NoError(t, err)
+			assert.Equal(t, want, slices.Sorted(maps.Keys(assets)))
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		// Without a policy, links are matched like files, as before the policies existed: links to
+		// files are read through the link and symlinked directories aren't searched.
+		assets, err := globAssets(dir, []string{"**", "!linkdir"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"link", "sub/file"}, slices.Sorted(maps.Keys(assets)))
+
+		_, err = globAssets(dir, []string{"**"}, nil)
+		assert.ErrorContains(t, err, "is a directory")
+	})
+
+	t.Run("loop", func(t *testing.T) {
+		require.NoError(t, os.Symlink("..", filepath.Join(dir, "sub", "up")))
+		follow := SymlinksFollow
+		_, err := globAssets(dir, []string{"**"}, &follow)
+		assert.ErrorContains(t, err, "symlink loop")
+
+		_, err = globAssets(dir, []string{"**", "!linkdir", "!sub/up"}, nil)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRunPty(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("pseudo-terminals aren't supported on Windows")
+	}
+	ctx := &testutil.TestContext{Context: context.Background()}
+	run := func(pty *Pty, stdin *string, command string) (BaseOutputs, error) {
+		var out BaseOutputs
+		err := run(ctx, command, BaseInputs{Pty: pty, Stdin: stdin}, &out, nil)
+		return out, err
+	}
+
+	t.Run("merged output", func(t *testing.T) {
+		out, err := run(&Pty{}, nil, `[ -t 0 ] && [ -t 1 ] && echo "tty $TERM"; echo oops >&2; stty size`)
+		require.NoError(t, err)
+		assert.Equal(t, "tty xterm\noops\n24 80", out.Stdout)
+		assert.Empty(t, out.Stderr)
+	})
+
+	t.Run("settings", func(t *testing.T) {
+		pty := &Pty{Term: pulumi.StringRef("vt100"), Rows: pulumi.IntRef(50), Cols: pulumi.IntRef(132)}
+		out, err := run(pty, nil, `echo $TERM; stty size`)
+		require.NoError(t, err)
+		assert.Equal(t, "vt100\n50 132", out.Stdout)
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		out, err := run(&Pty{}, pulumi.StringRef("one\ntwo"), `cat; echo "|"`)
+		require.NoError(t, err)
+		assert.Equal(t, "one\ntwo|", out.Stdout)
+	})
+
+	t.Run("background child", func(t *testing.T) {
+		// The child ignores the hangup when the command exits, and keeps the terminal open.
+		start := time.Now()
+		out, err := run(&Pty{}, nil, `echo started; (trap "" HUP; sleep 10) &`)
+		require.NoError(t, err)
+		assert.Equal(t, "started", out.Stdout)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("strip ANSI", func(t *testing.T) {
+		const command = `printf '\033[1;31mred\033[0m \033]0;title\007done\n'`
+		out, err := run(&Pty{}, nil, command)
+		require.NoError(t, err)
+		assert.Equal(t, "\x1b[1;31mred\x1b[0m \x1b]0;title\adone", out.Stdout)
+
+		out, err = run(&Pty{StripAnsi: pulumi.BoolRef(true)}, nil, command)
+		require.NoError(t, err)
+		assert.Equal(t, "red done", out.Stdout)
+	})
+}
+
+func TestRunError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands require a POSIX shell")
+	}
+	ctx := &testutil.TestContext{Context: context.Background()}
+
+	t.Run("exit code", func(t *testing.T) {
+		in := BaseInputs{ErrorOutputLines: pulumi.IntRef(2)}
+		err := run(ctx, `for i in 1 2 3; do echo "line $i"; done; echo oops >&2; exit 3`, in, &BaseOutputs{}, nil)
+
+		var cmdErr *util.CommandError
+		require.ErrorAs(t, err, &cmdErr)
+		assert.Equal(t, 3, cmdErr.ExitCode)
+		assert.Empty(t, cmdErr.Signal)
+		assert.Contains(t, err.Error(), "failed with exit code 3 after ")
+		assert.Contains(t, err.Error(), "stdout (last 2 lines, 1 omitted):\nline 2\nline 3\nstderr:\noops")
+	})
+
+	t.Run("signal", func(t *testing.T) {
+		err := run(ctx, `echo started; kill -TERM $$`, BaseInputs{}, &BaseOutputs{}, nil)
+
+		var cmdErr *util.CommandError
+		require.ErrorAs(t, err, &cmdErr)
+		assert.Equal(t, -1, cmdErr.ExitCode)
+		assert.Equal(t, "TERM", cmdErr.Signal)
+		assert.Contains(t, err.Error(), "was killed by signal TERM after ")
+		assert.Contains(t, err.Error(), "stdout:\nstarted")
+	})
+}
+
+func TestRunLogSeverity(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands require a POSIX shell")
+	}
+	ctx := &testutil.TestContext{Context: context.Background()}
+	severity := SeverityWarning
+	in := BaseInputs{
+		StderrSeverity:  &severity,
+		LogStreamPrefix: pulumi.BoolRef(true),
+	}
+	// The sleeps order the lines, since the streams are read concurrently.
+	err := run(ctx, `echo one; sleep 0.1; echo two >&2; sleep 0.1; printf three`, in, &BaseOutputs{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []testutil.LogMessage{
+		{Severity: diag.Info, Msg: "[stdout] one"},
+		{Severity: diag.Warning, Msg: "[stderr] two"},
+		{Severity: diag.Info, Msg: "[stdout] three"},
+	}, ctx.Messages)
+}
+
+func TestRunWorkflowCommands(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands require a POSIX shell")
+	}
+	ctx := &testutil.TestContext{Context: context.Background()}
+	in := BaseInputs{
+		WorkflowCommands:      pulumi.BoolRef(true),
+		StripWorkflowCommands: pulumi.BoolRef(true),
+	}
+	var out BaseOutputs
+	err := run(ctx, `echo "::add-mask::hunter2"; echo "::warning file=app.js::disk 99%25 full"
+echo "password hunter2"; echo "::error::failed%0Aon two lines"; echo "::unknown::kept"`, in, &out, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []testutil.LogMessage{
+		{Severity: diag.Warning, Msg: "disk 99% full"},
+		{Severity: diag.Info, Msg: "password [secret]"},
+		{Severity: diag.Error, Msg: "failed\non two lines"},
+		{Severity: diag.Info, Msg: "::unknown::kept"},
+	}, ctx.Messages)
+	assert.Equal(t, "password hunter2\n::unknown::kept", out.Stdout)
+}
+
+func TestRunHeartbeat(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands require a POSIX shell")
+	}
+	ctx := &testutil.TestContext{Context: context.Background()}
+	in := BaseInputs{HeartbeatInterval: pulumi.IntRef(1)}
+	err := run(ctx, `echo first; sleep 2.5`, in, &BaseOutputs{}, nil)
+	require.NoError(t, err)
+
+	require.GreaterOrEqual(t, len(ctx.Messages), 2)
+	assert.Equal(t, "first", ctx.Messages[0].Msg)
+	for _, m := range ctx.Messages[1:] {
+		assert.Equal(t, diag.Info, m.Severity)
+		assert.Regexp(t, `^still running, \ds elapsed, last output \ds ago: first$`, m.Msg)
+	}
+}
@@ -4,7 +4,10 @@ package local
 // are used by `local`` and `remote`. It's duplicated in `local` and `remote` for the time being due
 // to pulumi/pulumi#16221, and changes need to be made in both copies.
 
-import "github.com/pulumi/pulumi-go-provider/infer"
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+)
 
 type Logging string
 
@@ -30,3 +33,43 @@ func (l *Logging) ShouldLogStdout() bool {
 func (l *Logging) ShouldLogStderr() bool {
 	return l == nil || *l == LogStderr || *l == LogStdoutAndStderr
 }
+
+// LogSeverity is the severity with which the lines of an output stream are logged.
+type LogSeverity string
+
+const (
+	SeverityDebug   LogSeverity = "debug"
+	SeverityInfo    LogSeverity = "info"
+	SeverityWarning LogSeverity = "warning"
+	SeverityError   LogSeverity = "error"
+)
+
+func (LogSeverity) Values() []infer.EnumValue[LogSeverity] {
+	return []infer.EnumValue[LogSeverity]{
+		{Name: string(SeverityDebug), Value: SeverityDebug, Description: "Log the lines as debug messages"},
+		{Name: string(SeverityInfo), Value: SeverityInfo, Description: "Log the lines as info messages"},
+		{Name: string(SeverityWarning), Value: SeverityWarning, Description: "Log the lines as warnings"},
+		{Name: string(SeverityError), Value: SeverityError, Description: "Log the lines as errors"},
+	}
+}
+
+// OrDefault returns the severity, or SeverityInfo if none is set.
+func (s *LogSeverity) OrDefault() LogSeverity {
+	if s == nil || *s == "" {
+		return SeverityInfo
+	}
+	return *s
+}
+
+func (s *LogSeverity) diag() diag.Severity {
+	switch s.OrDefault() {
+	case SeverityDebug:
+		return diag.Debug
+	case SeverityWarning:
+		return diag.Warning
+	case SeverityError:
+		return diag.Error
+	default:
+		return diag.Info
+	}
+}
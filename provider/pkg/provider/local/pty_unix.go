@@ -0,0 +1,104 @@
+//go:build !windows
+
+package local
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/creack/pty"
+	"golang.org/x/sys/unix"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+// startPty starts cmd in a new session with a pseudo-terminal as its controlling terminal. The
+// output of the terminal is copied to cmd.Stdout, and cmd.Stdin, if set, is typed into it followed
+// by the end of input. The returned function waits for the command and the copying of its output.
+func startPty(cmd *exec.Cmd, p *Pty) (wait func() error, err error) {
+	ptmx, tty, err := pty.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a pseudo-terminal: %w", err)
+	}
+	// The command has its own copy once started.
+	defer tty.Close()
+
+	rows, cols := p.size()
+	if err := pty.Setsize(ptmx, &pty.Winsize{Rows: uint16(rows), Cols: uint16(cols)}); err != nil { //nolint:gosec
+		ptmx.Close()
+		return nil, fmt.Errorf("failed to set the size of the pseudo-terminal: %w", err)
+	}
+	if err := disableEcho(tty); err != nil {
+		ptmx.Close()
+		return nil, fmt.Errorf("failed to disable the echo of the pseudo-terminal: %w", err)
+	}
+	if ptmx, err = nonBlocking(ptmx); err != nil {
+		return nil, fmt.Errorf("failed to open a pseudo-terminal: %w", err)
+	}
+
+	out, stdin := cmd.Stdout, cmd.Stdin
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = tty, tty, tty
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	if err := cmd.Start(); err != nil {
+		ptmx.Close()
+		return nil, err
+	}
+
+	copied := make(chan struct{})
+	go func() {
+		// Ends with EIO once the command and its children closed the terminal, or at the deadline.
+		_, _ = io.Copy(out, ptmx)
+		close(copied)
+	}()
+	go func() {
+		if stdin != nil {
+			_, _ = io.Copy(ptmx, stdin)
+		}
+		_, _ = io.WriteString(ptmx, util.TerminalEOF)
+	}()
+
+	return func() error {
+		err := cmd.Wait()
+		// A child left running in the background, e.g. a daemon, keeps the terminal open, so the
+		// output is only copied until it's drained rather than until the end of the terminal.
+		if ptmx.SetReadDeadline(time.Now().Add(ptyDrainTimeout)) != nil {
+			ptmx.Close()
+		}
+		<-copied
+		ptmx.Close()
+		return err
+	}, nil
+}
+
+// ptyDrainTimeout is how long the output of the terminal is copied after the command exited.
+const ptyDrainTimeout = time.Second
+
+// nonBlocking replaces f, which is in blocking mode once its Fd was used, with a copy in
+// non-blocking mode, whose reads can be interrupted by a deadline.
+func nonBlocking(f *os.File) (*os.File, error) {
+	defer f.Close()
+	fd, err := unix.Dup(int(f.Fd())) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	if err := unix.SetNonblock(fd, true); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return os.NewFile(uintptr(fd), f.Name()), nil //nolint:gosec
+}
+
+// disableEcho keeps the terminal from echoing the standard input into the output.
+func disableEcho(tty *os.File) error {
+	fd := int(tty.Fd()) //nolint:gosec
+	termios, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return err
+	}
+	termios.Lflag &^= unix.ECHO
+	return unix.IoctlSetTermios(fd, ioctlWriteTermios, termios)
+}
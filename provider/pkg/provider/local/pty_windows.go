@@ -0,0 +1,12 @@
+//go:build windows
+
+package local
+
+import (
+	"errors"
+	"os/exec"
+)
+
+func startPty(*exec.Cmd, *Pty) (wait func() error, err error) {
+	return nil, errors.New("running a command in a pseudo-terminal isn't supported on Windows")
+}
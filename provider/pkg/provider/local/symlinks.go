@@ -0,0 +1,36 @@
+package local
+
+// TODO Like logging.go, this file should be in the `common` package since its contents are used by
+// `local` and `remote`. It's duplicated in `local` and `remote` for the time being due to
+// pulumi/pulumi#16221, and changes need to be made in both copies.
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+type SymlinkPolicy string
+
+const (
+	SymlinksPreserve SymlinkPolicy = util.SymlinksPreserve
+	SymlinksFollow   SymlinkPolicy = util.SymlinksFollow
+	SymlinksSkip     SymlinkPolicy = util.SymlinksSkip
+)
+
+func (SymlinkPolicy) Values() []infer.EnumValue[SymlinkPolicy] {
+	return []infer.EnumValue[SymlinkPolicy]{
+		{Name: string(SymlinksPreserve), Value: SymlinksPreserve, Description: "Keep symlinks as symlinks"},
+		{Name: string(SymlinksFollow), Value: SymlinksFollow,
+			Description: "Treat symlinks as the files or directories they point to"},
+		{Name: string(SymlinksSkip), Value: SymlinksSkip, Description: "Ignore symlinks"},
+	}
+}
+
+// policy returns the util.Symlinks* policy, or util.SymlinksAsFiles if none is set.
+func (s *SymlinkPolicy) policy() string {
+	if s == nil {
+		return util.SymlinksAsFiles
+	}
+	return string(*s)
+}
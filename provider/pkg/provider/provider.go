@@ -21,6 +21,7 @@ import (
 
 	"github.com/pulumi/pulumi-command/provider/pkg/provider/local"
 	"github.com/pulumi/pulumi-command/provider/pkg/provider/remote"
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
 )
 
 const (
@@ -31,6 +32,13 @@ const (
 
 // This provider uses the `pulumi-go-provider` library to produce a code-first provider definition.
 func NewProvider() p.Provider {
+	// The commands mask the secret inputs in their logs and errors. The connections are secret as a
+	// whole, but their secret fields are masked by the remote commands. Detached jobs are named after
+	// the URN, so that they can be resumed.
+	return util.WithURN(util.RedactSecrets(newInferredProvider(), "connection", "connections"))
+}
+
+func newInferredProvider() p.Provider {
 	return infer.Provider(infer.Options{
 		// This is the metadata for the provider
 		Metadata: schema.Metadata{
@@ -86,13 +94,19 @@ func NewProvider() p.Provider {
 			// The Command resource implementation is commented extensively for new pulumi-go-provider developers.
 			infer.Resource(&local.Command{}),
 			infer.Resource(&remote.Command{}),
+			infer.Resource(&remote.MultiCommand{}),
 			infer.Resource(&remote.CopyToRemote{}),
 			infer.Resource(&remote.CopyFile{}),
+			infer.Resource(&remote.Tunnel{}),
+			infer.Resource(&remote.WaitForReady{}),
 		},
 		// Functions or invokes that are provided by the provider.
 		Functions: []infer.InferredFunction{
 			// The Run function is commented extensively for new pulumi-go-provider developers.
 			infer.Function(&local.Run{}),
+			infer.Function(&remote.GetFacts{}),
+			infer.Function(&remote.TestConnection{}),
+			infer.Function(&remote.GetHostKeys{}),
 		},
 	})
 }
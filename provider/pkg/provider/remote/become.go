@@ -0,0 +1,225 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+type BecomeMethod string
+
+const (
+	BecomeSudo BecomeMethod = "sudo"
+	BecomeSu   BecomeMethod = "su"
+	BecomeDoas BecomeMethod = "doas"
+)
+
+func (BecomeMethod) Values() []infer.EnumValue[BecomeMethod] {
+	return []infer.EnumValue[BecomeMethod]{
+		{Name: string(BecomeSudo), Value: BecomeSudo, Description: "Use `sudo`"},
+		{Name: string(BecomeSu), Value: BecomeSu, Description: "Use `su`"},
+		{Name: string(BecomeDoas), Value: BecomeDoas, Description: "Use `doas`"},
+	}
+}
+
+const (
+	// becomeStartMarker is printed by the shell running as the become user before the command. It
+	// signals that the escalation succeeded and is removed from the output.
+	becomeStartMarker = "PULUMI_COMMAND_BECOME_START"
+	// becomeSudoPrompt replaces sudo's password prompt so that it can be recognized reliably.
+	becomeSudoPrompt = "PULUMI_COMMAND_BECOME_PROMPT:"
+)
+
+var (
+	sudoPromptRegexp = regexp.MustCompile(regexp.QuoteMeta(becomeSudoPrompt))
+	// su and doas don't allow setting the prompt, e.g. "Password: " or "doas (user@host) password: ".
+	ttyPromptRegexp = regexp.MustCompile(`(?im)^[^\n]*password: ?\r?\n?`)
+)
+
+// become describes how a command is run as another user.
+type become struct {
+	method   BecomeMethod
+	user     string
+	password string
+}
+
+// become returns how to run the command as another user, or nil if it runs as the login user.
+func (c *CommandOptions) become() *become {
+	if c.Become == nil || !*c.Become {
+		return nil
+	}
+	b := &become{method: BecomeSudo, user: "root"}
+	if c.BecomeMethod != nil && *c.BecomeMethod != "" {
+		b.method = *c.BecomeMethod
+	}
+	if c.BecomeUser != nil && *c.BecomeUser != "" {
+		b.user = *c.BecomeUser
+	}
+	if c.BecomePassword != nil {
+		b.password = *c.BecomePassword
+	}
+	return b
+}
+
+// becomeEnvironment returns the variables to export for a command run with become: the environment
+// and, unless disabled, the previous output, which are otherwise set on the session.
+func (c *CommandOutputs) becomeEnvironment() map[string]string {
+	env := maps.Clone(c.Environment)
+	if env == nil {
+		env = map[string]string{}
+	}
+	if c.AddPreviousOutputInEnv == nil || *c.AddPreviousOutputInEnv {
+		if c.Stdout != "" {
+			env[util.PulumiCommandStdout] = c.Stdout
+		}
+		if c.Stderr != "" {
+			env[util.PulumiCommandStderr] = c.Stderr
+		}
+	}
+	return env
+}
+
+// usesPty reports whether the password is entered over a PTY, since su and doas only read it from a
+// terminal. sudo reads it from the standard input.
+func (b *become) usesPty() bool {
+	return b.password != "" && b.method != BecomeSudo
+}
+
+// wrap returns the command line that runs cmd as the become user, with env exported by the shell
+// of that user, since the escalation tools don't pass on the environment of the session.
+func (b *become) wrap(cmd string, env map[string]string) (string, error) {
+	var script strings.Builder
+	if b.password != "" {
+		stream := " >&2"
+		if b.usesPty() {
+			stream = ""
+		}
+		fmt.Fprintf(&script, "echo %s%s\n", becomeStartMarker, stream)
+	}
+	exports, err := exportEnvironment(env)
+	if err != nil {
+		return "", err
+	}
+	script.WriteString(exports)
+	script.WriteString(cmd)
+	shell := "sh -c " + shellQuote(script.String())
+	user := shellQuote(b.user)
+
+	switch b.method {
+	case BecomeSu:
+		return fmt.Sprintf("su %s -c %s", user, shellQuote(shell)), nil
+	case BecomeDoas:
+		if b.password == "" {
+			return fmt.Sprintf("doas -n -u %s %s", user, shell), nil
+		}
+		return fmt.Sprintf("doas -u %s %s", user, shell), nil
+	default:
+		if b.password == "" {
+			return fmt.Sprintf("sudo -n -u %s -- %s", user, shell), nil
+		}
+		return fmt.Sprintf("sudo -S -p %s -u %s -- %s", shellQuote(becomeSudoPrompt), user, shell), nil
+	}
+}
+
+// newPrompter returns a becomePrompter for b and the reader to use as the standard input of the
+// session. stdin, if set, is passed on once the command started.
+func (b *become) newPrompter(stdin io.Reader) (*becomePrompter, io.Reader) {
+	r, w := io.Pipe()
+	prompt := sudoPromptRegexp
+	if b.usesPty() {
+		prompt = ttyPromptRegexp
+	}
+	return &becomePrompter{password: b.password, prompt: prompt, stdin: stdin, w: w}, r
+}
+
+// becomePrompter watches the output stream that the escalation tool prompts for the password on.
+// The first prompt is answered with the password, and a repeated prompt closes the standard input
+// since the password was wrong. Output is held back until the command started, and is then passed
+// on to out without the prompts and the start marker, so that the password exchange doesn't show up
+// in the command's output.
+type becomePrompter struct {
+	out      io.Writer
+	password string
+	prompt   *regexp.Regexp
+	// stdin is the command's standard input, copied to w once the command started.
+	stdin io.Reader
+	w     *io.PipeWriter
+
+	buf     strings.Builder
+	prompts int
+	started bool
+}
+
+func (p *becomePrompter) Write(b []byte) (int, error) {
+	if p.started {
+		return p.out.Write(b)
+	}
+	p.buf.Write(b)
+	held := p.buf.String()
+
+	if i := strings.Index(held, becomeStartMarker); i >= 0 {
+		rest, ok := strings.CutPrefix(strings.TrimPrefix(held[i+len(becomeStartMarker):], "\r"), "\n")
+		if !ok {
+			// Wait for the line break that completes the marker.
+			return len(b), nil
+		}
+		p.start()
+		if _, err := io.WriteString(p.out, p.prompt.ReplaceAllString(held[:i], "")+rest); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+
+	if n := len(p.prompt.FindAllStringIndex(held, -1)); n > p.prompts {
+		p.prompts = n
+		if n == 1 {
+			// Best effort: if the command exited, there's no one to answer.
+			_, _ = io.WriteString(p.w, p.password+"\n")
+		} else {
+			p.w.Close()
+		}
+	}
+	return len(b), nil
+}
+
+func (p *becomePrompter) start() {
+	p.started = true
+	p.buf.Reset()
+	go func() {
+		if p.stdin != nil {
+			_, _ = io.Copy(p.w, p.stdin)
+		}
+		p.w.Close()
+	}()
+}
+
+// Close passes on the held back output of a command that never started, e.g. the error of the
+// escalation tool, and closes the standard input.
+func (p *becomePrompter) Close() error {
+	var err error
+	if !p.started {
+		_, err = io.WriteString(p.out, p.prompt.ReplaceAllString(p.buf.String(), ""))
+	}
+	p.w.Close()
+	return err
+}
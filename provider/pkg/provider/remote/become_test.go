@@ -0,0 +1,138 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestBecomeWrap(t *testing.T) {
+	env := map[string]string{"B": "it's", "A": "1"}
+	script := shellQuote("export A='1'\nexport B='it'\\''s'\necho hi")
+
+	for method, want := range map[BecomeMethod]string{
+		BecomeSudo: "sudo -n -u 'root' -- sh -c " + script,
+		BecomeDoas: "doas -n -u 'root' sh -c " + script,
+		BecomeSu:   "su 'root' -c " + shellQuote("sh -c "+script),
+	} {
+		b := &become{method: method, user: "root"}
+		cmd, err := b.wrap("echo hi", env)
+		require.NoError(t, err)
+		assert.Equal(t, want, cmd, method)
+	}
+
+	b := &become{method: BecomeSudo, user: "app", password: "secret"}
+	cmd, err := b.wrap("echo hi", nil)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"sudo -S -p 'PULUMI_COMMAND_BECOME_PROMPT:' -u 'app' -- sh -c "+
+			shellQuote("echo PULUMI_COMMAND_BECOME_START >&2\necho hi"),
+		cmd)
+}
+
+func TestBecomePrompter(t *testing.T) {
+	// start returns a prompter for the password "secret" and a channel receiving what it writes to
+	// the standard input of the session.
+	start := func(method BecomeMethod, stdin io.Reader) (*becomePrompter, *strings.Builder, <-chan string) {
+		b := &become{method: method, password: "secret"}
+		p, r := b.newPrompter(stdin)
+		var out strings.Builder
+		p.out = &out
+		input := make(chan string)
+		go func() {
+			data, _ := io.ReadAll(r)
+			input <- string(data)
+		}()
+		return p, &out, input
+	}
+
+	t.Run("sudo", func(t *testing.T) {
+		p, out, input := start(BecomeSudo, strings.NewReader("data"))
+		for _, chunk := range []string{"PULUMI_COMMAND_", "BECOME_PROMPT:", "PULUMI_COMMAND_BECOME_START", "\nwarn"} {
+			_, err := p.Write([]byte(chunk))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "secret\ndata", <-input)
+		require.NoError(t, p.Close())
+		assert.Equal(t, "warn", out.String())
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		p, out, input := start(BecomeSudo, nil)
+		for _, chunk := range []string{becomeSudoPrompt, "Sorry, try again.\n", becomeSudoPrompt} {
+			_, err := p.Write([]byte(chunk))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "secret\n", <-input)
+		require.NoError(t, p.Close())
+		assert.Equal(t, "Sorry, try again.\n", out.String())
+	})
+
+	t.Run("su on a terminal", func(t *testing.T) {
+		p, out, input := start(BecomeSu, nil)
+		for _, chunk := range []string{"Password: ", "\n", "PULUMI_COMMAND_BECOME_START\r\n", "root\n"} {
+			_, err := p.Write([]byte(chunk))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "secret\n", <-input)
+		require.NoError(t, p.Close())
+		assert.Equal(t, "root\n", out.String())
+	})
+}
+
+func TestBecomeCommand(t *testing.T) {
+	env, _ := fakeSudo(t)
+	server := newExecServer(t, t.TempDir(), env...)
+
+	run := func(t *testing.T, password string) (CommandOutputs, error) {
+		become := true
+		c := CommandOutputs{CommandInputs: CommandInputs{
+			Connection: execConnection(server),
+			CommandOptions: CommandOptions{
+				Environment:    map[string]string{"GREETING": `it's "quoted"`},
+				Stdin:          pulumi.StringRef("input"),
+				Become:         &become,
+				BecomePassword: &password,
+			},
+		}}
+		ctx := &testutil.TestContext{Context: context.Background()}
+		err := c.run(ctx, `cat; echo " $GREETING"; echo oops >&2`, nil)
+		return c, err
+	}
+
+	t.Run("correct password", func(t *testing.T) {
+		c, err := run(t, "secret")
+		require.NoError(t, err)
+		assert.Equal(t, `input it's "quoted"`, c.Stdout)
+		assert.Equal(t, "oops", c.Stderr)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := run(t, "hunter2")
+		require.ErrorContains(t, err, "incorrect password")
+		assert.NotContains(t, err.Error(), "hunter2")
+		assert.NotContains(t, err.Error(), becomeSudoPrompt)
+	})
+}
@@ -41,29 +41,118 @@ type CommandInputs struct {
 	// pulumi:"optional" specifies that a field is optional. This must be a pointer.
 	// provider:"replaceOnChanges" specifies that the resource will be replaced if the field changes.
 	// provider:"secret" specifies that a field should be marked secret.
+	Connection *Connection `pulumi:"connection" provider:"secret"`
+	CommandOptions
+}
+
+// Implementing Annotate lets you provide descriptions and default values for arguments and they will
+// be visible in the provider's schema and the generated SDKs.
+func (c *CommandInputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Connection, "The parameters with which to connect to the remote host.")
+}
+
+// CommandOptions are the inputs of how a command is run, shared by Command and MultiCommand.
+type CommandOptions struct {
 	Stdin                  *string           `pulumi:"stdin,optional"`
 	Logging                *Logging          `pulumi:"logging,optional"`
-	Connection             *Connection       `pulumi:"connection"                      provider:"secret"`
+	StdoutSeverity         *LogSeverity      `pulumi:"stdoutSeverity,optional"`
+	StderrSeverity         *LogSeverity      `pulumi:"stderrSeverity,optional"`
+	LogStreamPrefix        *bool             `pulumi:"logStreamPrefix,optional"`
+	WorkflowCommands       *bool             `pulumi:"workflowCommands,optional"`
+	StripWorkflowCommands  *bool             `pulumi:"stripWorkflowCommands,optional"`
+	HeartbeatInterval      *int              `pulumi:"heartbeatInterval,optional"`
+	ErrorOutputLines       *int              `pulumi:"errorOutputLines,optional"`
+	Redact                 *[]string         `pulumi:"redact,optional"                 provider:"secret"`
+	RedactOutputs          *bool             `pulumi:"redactOutputs,optional"`
+	Pty                    *Pty              `pulumi:"pty,optional"`
+	Timeout                *int              `pulumi:"timeout,optional"`
+	Detached               *Detached         `pulumi:"detached,optional"`
+	Dir                    *string           `pulumi:"dir,optional"`
+	Interpreter            *[]string         `pulumi:"interpreter,optional"`
+	LoginShell             *bool             `pulumi:"loginShell,optional"`
 	Environment            map[string]string `pulumi:"environment,optional"`
+	EnvironmentMode        *EnvironmentMode  `pulumi:"environmentMode,optional"`
 	AddPreviousOutputInEnv *bool             `pulumi:"addPreviousOutputInEnv,optional"`
+	Become                 *bool             `pulumi:"become,optional"`
+	BecomeUser             *string           `pulumi:"becomeUser,optional"`
+	BecomeMethod           *BecomeMethod     `pulumi:"becomeMethod,optional"`
+	BecomePassword         *string           `pulumi:"becomePassword,optional"         provider:"secret"`
 }
 
-// Implementing Annotate lets you provide descriptions and default values for arguments and they will
-// be visible in the provider's schema and the generated SDKs.
-func (c *CommandInputs) Annotate(a infer.Annotator) {
+func (c *CommandOptions) Annotate(a infer.Annotator) {
 	a.Describe(&c.Stdin, "Pass a string to the command's process as standard in")
 	a.Describe(&c.Logging, `If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.`)
-	a.Describe(&c.Connection, "The parameters with which to connect to the remote host.")
+	a.Describe(&c.StdoutSeverity, "The severity with which the lines of stdout are logged. Defaults to `info`.")
+	a.Describe(&c.StderrSeverity, "The severity with which the lines of stderr are logged, e.g. `warning` to make "+
+		"errors stand out. Defaults to `info`.")
+	a.Describe(&c.LogStreamPrefix, "If each logged line is prefixed with the name of its stream, `[stdout]` or "+
+		"`[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.")
+	a.Describe(&c.WorkflowCommands, `Run the workflow commands among the lines of stdout, modeled on those of
+GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.`)
+	a.Describe(&c.StripWorkflowCommands, `If the workflow commands are removed from the 'stdout' output. Defaults
+to false.`)
+	a.Describe(&c.HeartbeatInterval, `The number of seconds without output after which the status of the
+resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+Defaults to 60.`)
+	a.Describe(&c.ErrorOutputLines, `The number of lines at the end of stdout and stderr that the error of a
+failed command includes. Defaults to 20.`)
+	a.Describe(&c.Redact, `Additional values to mask as '[secret]' in the logged output and the error of
+the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+Values shorter than 4 characters aren't masked, and a warning is logged instead.`)
+	a.Describe(&c.RedactOutputs, `If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+to false, in which case outputs that might contain secrets can be marked as secret via
+'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.`)
+	a.Describe(&c.Pty, `Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+the terminal, without echo, followed by the end of input.`)
+	a.Describe(&c.Timeout, `The maximum number of seconds the command may run. When it elapses, or the
+deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+Defaults to no timeout.`)
+	a.Describe(&c.Detached, `Run the command as a detached job that survives connection drops, e.g. for
+jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.`)
+	a.Describe(&c.Dir, `The directory on the remote host to run the command in. A leading '~' is the home
+directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+on the remote host.`)
+	a.Describe(&c.Interpreter, "The program and arguments to run the command with, e.g. "+
+		"`[\"bash\", \"-euo\", \"pipefail\", \"-c\"]`.\n"+
+		"The command is passed as the last argument. Defaults to running the command with the login shell of the user.")
+	a.Describe(&c.LoginShell, `Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+~/.profile are read, e.g. to set the PATH. Defaults to false.`)
 	a.Describe(&c.Environment, `Additional environment variables available to the command's process.
-Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-with the variables in the form 'VAR=value command'.`)
+How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+exported by the shell running as the become user instead, so AcceptEnv isn't needed.`)
+	a.Describe(&c.EnvironmentMode, `How the environment variables, including PULUMI_COMMAND_STDOUT and
+PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.`)
 	a.Describe(&c.AddPreviousOutputInEnv,
 		`If the previous command's stdout and stderr (as generated by the prior create/update) is
 injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
 Defaults to true.`)
+	a.Describe(&c.Become, `Run the command as another user, root by default, via the escalation tool set in
+'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+the quoting of the command intact and passes on the environment variables.`)
+	a.Describe(&c.BecomeUser, "The user to run the command as with `become`. Defaults to `root`.")
+	a.Describe(&c.BecomeMethod, "The escalation tool to use with `become`. Defaults to `sudo`.")
+	a.Describe(&c.BecomePassword, `The password to answer the prompt of the escalation tool with. Without a
+password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+password is never part of the command's output.`)
 }
 
 // The properties for a remote Command resource.
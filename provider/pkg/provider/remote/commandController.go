@@ -17,6 +17,7 @@ package remote
 import (
 	"context"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
@@ -25,8 +26,41 @@ import (
 // If the function signature doesn't match or isn't implemented, we get nice compile time errors in this file.
 var _ = (infer.CustomResource[CommandInputs, CommandOutputs])((*Command)(nil))
 var _ = (infer.CustomUpdate[CommandInputs, CommandOutputs])((*Command)(nil))
+var _ = (infer.CustomCheck[CommandInputs])((*Command)(nil))
 var _ = (infer.CustomDelete[CommandOutputs])((*Command)(nil))
 
+// Check validates the inputs beyond their types.
+func (*Command) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[CommandInputs], error) {
+	inputs, failures, err := infer.DefaultCheck[CommandInputs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[CommandInputs]{Inputs: inputs, Failures: failures}, err
+	}
+
+	failures = append(failures, inputs.check()...)
+	return infer.CheckResponse[CommandInputs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// check validates the options beyond their types, for Command and MultiCommand.
+func (c *CommandOptions) check() []p.CheckFailure {
+	var failures []p.CheckFailure
+	// A detached job runs without a terminal, so there's no one to answer a prompt.
+	if c.Detached != nil {
+		if c.Pty != nil {
+			failures = append(failures, p.CheckFailure{
+				Property: "pty",
+				Reason:   "a detached command can't run in a pseudo-terminal",
+			})
+		}
+		if c.BecomePassword != nil && *c.BecomePassword != "" {
+			failures = append(failures, p.CheckFailure{
+				Property: "becomePassword",
+				Reason:   "a detached command can't enter a password, configure the become method to not prompt",
+			})
+		}
+	}
+	return failures
+}
+
 // This is the Create method. This will be run on every Command resource creation.
 func (*Command) Create(
 	ctx context.Context,
@@ -21,9 +21,12 @@ import (
 	"testing"
 
 	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 
 	"github.com/pulumi/pulumi-command/provider/pkg/provider/common"
@@ -52,7 +55,7 @@ func TestOptionalLogging(t *testing.T) {
 
 			ctx := &testutil.TestContext{Context: context.Background()}
 			input := CommandInputs{
-				Logging: &logMode.Value,
+				CommandOptions: CommandOptions{Logging: &logMode.Value},
 				ResourceInputs: common.ResourceInputs{
 					Create: pulumi.StringRef("ignored"),
 				},
@@ -77,3 +80,26 @@ func TestOptionalLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandCheck(t *testing.T) {
+	check := func(inputs map[string]any) []p.CheckFailure {
+		inputs["connection"] = map[string]any{"host": "myhost"}
+		news := resource.FromResourcePropertyMap(resource.NewPropertyMapFromMap(inputs))
+		resp, err := (&Command{}).Check(t.Context(), infer.CheckRequest{Name: "name", NewInputs: news})
+		require.NoError(t, err)
+		return resp.Failures
+	}
+
+	assert.Empty(t, check(map[string]any{"create": "make", "detached": map[string]any{}}))
+	assert.Empty(t, check(map[string]any{"create": "make", "pty": map[string]any{}, "becomePassword": "secret"}))
+
+	failures := check(map[string]any{
+		"create":         "make",
+		"detached":       map[string]any{},
+		"pty":            map[string]any{},
+		"becomePassword": "secret",
+	})
+	require.Len(t, failures, 2)
+	assert.Equal(t, "pty", failures[0].Property)
+	assert.Equal(t, "becomePassword", failures[1].Property)
+}
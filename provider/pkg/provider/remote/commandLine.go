@@ -0,0 +1,55 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"fmt"
+	"strings"
+)
+
+// commandLine returns the shell command line that runs cmd in the directory, with the interpreter
+// and in the login shell set in c. Without any of them, cmd is returned as is.
+func (c *CommandOptions) commandLine(cmd string) string {
+	line := cmd
+	if c.Interpreter != nil && len(*c.Interpreter) > 0 {
+		args := make([]string, 0, len(*c.Interpreter)+1)
+		for _, arg := range *c.Interpreter {
+			args = append(args, shellQuote(arg))
+		}
+		line = strings.Join(append(args, shellQuote(cmd)), " ")
+	}
+	if c.LoginShell != nil && *c.LoginShell {
+		line = `exec "${SHELL:-/bin/sh}" -l -c ` + shellQuote(line)
+	}
+	if c.Dir != nil && *c.Dir != "" {
+		// Fail before running the command if the directory doesn't exist, rather than running it in
+		// the home directory.
+		dir := remoteDir(*c.Dir)
+		line = fmt.Sprintf("cd %s 2>/dev/null || { echo %s >&2; exit 1; }\n%s", dir,
+			shellQuote(fmt.Sprintf("dir %s doesn't exist or isn't accessible on the remote host", *c.Dir)), line)
+	}
+	return line
+}
+
+// remoteDir quotes dir for a POSIX shell, expanding a leading `~` to the home directory.
+func remoteDir(dir string) string {
+	if dir == "~" {
+		return `"$HOME"`
+	}
+	if rest, ok := strings.CutPrefix(dir, "~/"); ok {
+		return `"$HOME"/` + shellQuote(rest)
+	}
+	return shellQuote(dir)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestCommandLine(t *testing.T) {
+	c := CommandOptions{}
+	assert.Equal(t, "echo hi", c.commandLine("echo hi"))
+
+	c.Interpreter = &[]string{"bash", "-euo", "pipefail", "-c"}
+	assert.Equal(t, `'bash' '-euo' 'pipefail' '-c' 'echo '\''hi'\'''`, c.commandLine("echo 'hi'"))
+
+	c.LoginShell = pulumi.BoolRef(true)
+	assert.Equal(t, `exec "${SHELL:-/bin/sh}" -l -c `+shellQuote(`'bash' '-euo' 'pipefail' '-c' 'echo hi'`),
+		c.commandLine("echo hi"))
+
+	c = CommandOptions{Dir: pulumi.StringRef("~/my app")}
+	assert.Equal(t, `cd "$HOME"/'my app' 2>/dev/null || `+
+		`{ echo 'dir ~/my app doesn'\''t exist or isn'\''t accessible on the remote host' >&2; exit 1; }`+
+		"\necho hi", c.commandLine("echo hi"))
+}
+
+func TestCommandLineRun(t *testing.T) {
+	baseDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(baseDir, "work dir"), 0o755))
+	server := newExecServer(t, baseDir, "SHELL=/bin/sh")
+
+	run := func(opts CommandOptions, cmd string) (CommandOutputs, error) {
+		c := CommandOutputs{CommandInputs: CommandInputs{Connection: execConnection(server), CommandOptions: opts}}
+		ctx := &testutil.TestContext{Context: context.Background()}
+		err := c.run(ctx, cmd, nil)
+		return c, err
+	}
+
+	t.Run("dir", func(t *testing.T) {
+		c, err := run(CommandOptions{Dir: pulumi.StringRef("work dir")}, "basename \"$PWD\"")
+		require.NoError(t, err)
+		assert.Equal(t, "work dir", c.Stdout)
+	})
+
+	t.Run("missing dir", func(t *testing.T) {
+		_, err := run(CommandOptions{Dir: pulumi.StringRef("nope")}, "touch ran")
+		assert.ErrorContains(t, err, "dir nope doesn't exist")
+		assert.NoFileExists(t, filepath.Join(baseDir, "ran"))
+	})
+
+	t.Run("interpreter", func(t *testing.T) {
+		inputs := CommandOptions{Interpreter: &[]string{"bash", "-euo", "pipefail", "-c"}}
+		_, err := run(inputs, "false | true; echo unreachable")
+		require.Error(t, err)
+
+		c, err := run(inputs, `echo "${BASH_VERSION:+bash}"`)
+		require.NoError(t, err)
+		assert.Equal(t, "bash", c.Stdout)
+	})
+
+	t.Run("login shell", func(t *testing.T) {
+		inputs := CommandOptions{
+			Dir:         pulumi.StringRef("work dir"),
+			Interpreter: &[]string{"sh", "-c"},
+			LoginShell:  pulumi.BoolRef(true),
+		}
+		c, err := run(inputs, `echo "$0 $1" && basename "$PWD"`)
+		require.NoError(t, err)
+		assert.Equal(t, "sh \nwork dir", c.Stdout)
+	})
+}
@@ -17,9 +17,13 @@ package remote
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
@@ -28,10 +32,16 @@ import (
 )
 
 func (c *CommandOutputs) run(ctx context.Context, cmd string, logging *Logging) error {
+	return c.runWithLabel(ctx, cmd, logging, "")
+}
+
+// runWithLabel is run, prefixing the logged output with label, e.g. for the host of a MultiCommand.
+func (c *CommandOutputs) runWithLabel(ctx context.Context, cmd string, logging *Logging, label string) error {
 	client, err := c.Connection.Dial(ctx)
 	if err != nil {
 		return err
 	}
+	defer client.Close()
 
 	session, err := client.NewSession()
 	if err != nil {
@@ -39,79 +49,220 @@ func (c *CommandOutputs) run(ctx context.Context, cmd string, logging *Logging)
 	}
 	defer session.Close()
 
-	if c.Environment != nil {
-		for k, v := range c.Environment {
-			err := session.Setenv(k, v)
-			if err != nil {
-				return logAndWrapSetenvErr(ctx, diag.Error, k, err)
-			}
-		}
+	var remoteCmd string
+	line := c.commandLine(cmd)
+	become := c.become()
+	if become != nil {
+		// The escalation tools don't pass on the session's environment, so it's set by the shell.
+		remoteCmd, err = become.wrap(line, c.becomeEnvironment())
+	} else {
+		remoteCmd, err = c.setEnvironment(ctx, session, line)
+	}
+	if err != nil {
+		return err
 	}
 
-	if c.AddPreviousOutputInEnv == nil || *c.AddPreviousOutputInEnv {
-		// Set remote Stdout and Stderr environment variables optimistically, but log and continue if they fail.
-		if c.Stdout != "" {
-			err := session.Setenv(util.PulumiCommandStdout, c.Stdout)
-			if err != nil {
-				// Set remote Stdout var optimistically, but warn and continue on failure.
-				//
-				//nolint:errcheck
-				logAndWrapSetenvErr(ctx, diag.Warning, util.PulumiCommandStdout, err)
-			}
+	var stdin io.Reader
+	if c.Stdin != nil && len(*c.Stdin) > 0 {
+		stdin = strings.NewReader(*c.Stdin)
+	}
+	// su and doas only read the password from a terminal.
+	usePty := c.Pty != nil || (become != nil && become.usesPty())
+	if usePty {
+		// Like on a terminal, the input ends with Ctrl-D rather than when the channel is closed.
+		if stdin == nil {
+			stdin = strings.NewReader(util.TerminalEOF)
+		} else {
+			stdin = io.MultiReader(stdin, strings.NewReader(util.TerminalEOF))
 		}
-		if c.Stderr != "" {
-			err := session.Setenv(util.PulumiCommandStderr, c.Stderr)
-			if err != nil {
-				// Set remote STDERR var optimistically, but warn and continue on failure.
-				//
-				//nolint:errcheck
-				logAndWrapSetenvErr(ctx, diag.Warning, util.PulumiCommandStderr, err)
-			}
+		// Don't echo the input into the output.
+		modes := ssh.TerminalModes{ssh.ECHO: 0}
+		rows, cols := c.Pty.size()
+		if err := session.RequestPty(c.Pty.term(), rows, cols, modes); err != nil {
+			return fmt.Errorf("failed to request a pseudo-terminal: %w", err)
 		}
 	}
-
-	if c.Stdin != nil && len(*c.Stdin) > 0 {
-		session.Stdin = strings.NewReader(*c.Stdin)
+	var prompter *becomePrompter
+	if become != nil && become.password != "" {
+		prompter, stdin = become.newPrompter(stdin)
 	}
+	session.Stdin = stdin
 
-	var stdoutbuf, stderrbuf, stdouterrbuf bytes.Buffer
-	r, w := io.Pipe()
+	var stdoutbuf, stderrbuf bytes.Buffer
+	stdoutTail, stderrTail := util.NewTailWriter(c.ErrorOutputLines), util.NewTailWriter(c.ErrorOutputLines)
+	redactor := c.redactor(ctx)
+	logger := util.NewOutputLogger(ctx, redactor, label, c.LogStreamPrefix != nil && *c.LogStreamPrefix)
 
-	stdoutWriters := []io.Writer{&stdoutbuf, &stdouterrbuf}
-	if logging.ShouldLogStdout() {
-		stdoutWriters = append(stdoutWriters, w)
-	}
-	session.Stdout = io.MultiWriter(stdoutWriters...)
+	// The streams that aren't logged still run the workflow commands and count as output for the
+	// heartbeat.
+	workflowCommands := c.WorkflowCommands != nil && *c.WorkflowCommands
+	session.Stdout = io.MultiWriter(&stdoutbuf, stdoutTail, logger.Stream("stdout", util.StreamOptions{
+		Severity:         c.StdoutSeverity.diag(),
+		Quiet:            !logging.ShouldLogStdout(),
+		WorkflowCommands: workflowCommands,
+	}))
+	session.Stderr = io.MultiWriter(&stderrbuf, stderrTail, logger.Stream("stderr", util.StreamOptions{
+		Severity: c.StderrSeverity.diag(),
+		Quiet:    !logging.ShouldLogStderr(),
+	}))
 
-	stderrWriters := []io.Writer{&stderrbuf, &stdouterrbuf}
-	if logging.ShouldLogStderr() {
-		stderrWriters = append(stderrWriters, w)
+	if prompter != nil {
+		// The password prompt is on stderr for sudo, and on the pseudo-terminal otherwise.
+		if usePty {
+			prompter.out, session.Stdout = session.Stdout, prompter
+		} else {
+			prompter.out, session.Stderr = session.Stderr, prompter
+		}
 	}
-	session.Stderr = io.MultiWriter(stderrWriters...)
-
-	stdouterrch := make(chan struct{})
-	go util.LogOutput(ctx, r, stdouterrch, diag.Info)
 
-	err = session.Run(cmd)
+	var timeout time.Duration
+	if c.Timeout != nil && *c.Timeout > 0 {
+		timeout = time.Duration(*c.Timeout) * time.Second
+	}
+	start := time.Now()
+	logger.StartHeartbeat(util.HeartbeatInterval(c.HeartbeatInterval))
+	if c.Detached != nil {
+		err = c.Detached.run(ctx, c.Connection, client, session, remoteCmd, timeout, session.Stdout, session.Stderr)
+	} else {
+		err = runSession(ctx, client, session, remoteCmd, timeout)
+	}
 
-	w.Close()
-	<-stdouterrch
+	if prompter != nil {
+		prompter.Close()
+	}
+	logger.Close()
 
 	if err != nil {
-		return fmt.Errorf("%w: running %q:\n%s", err, cmd, stdouterrbuf.String())
+		exitCode, signal := exitStatus(err)
+		return &util.CommandError{
+			Command:  cmd,
+			ExitCode: exitCode,
+			Signal:   signal,
+			Duration: time.Since(start),
+			Stdout:   stdoutTail,
+			Stderr:   stderrTail,
+			Redactor: redactor,
+			Err:      err,
+		}
+	}
+	stdout := stdoutbuf.String()
+	if usePty {
+		stdout = util.TerminalOutput(stdout, c.Pty.stripANSI())
+	}
+	if workflowCommands && c.StripWorkflowCommands != nil && *c.StripWorkflowCommands {
+		stdout = util.RemoveWorkflowCommands(stdout)
+	}
+	stderr := stderrbuf.String()
+	if c.RedactOutputs != nil && *c.RedactOutputs {
+		stdout, stderr = redactor.Redact(stdout), redactor.Redact(stderr)
 	}
 	c.BaseOutputs = BaseOutputs{
-		Stdout: strings.TrimSuffix(stdoutbuf.String(), "\n"),
-		Stderr: strings.TrimSuffix(stderrbuf.String(), "\n"),
+		Stdout: strings.TrimSuffix(stdout, "\n"),
+		Stderr: strings.TrimSuffix(stderr, "\n"),
 	}
 	return nil
 }
 
+// exitStatus returns the exit code of a command from the error it failed with, or -1 if it didn't
+// exit, and the signal that killed it, if any.
+func exitStatus(err error) (int, string) {
+	var detachedErr *detachedExitError
+	if errors.As(err, &detachedErr) {
+		return detachedErr.code, ""
+	}
+	var exitErr *ssh.ExitError
+	if !errors.As(err, &exitErr) {
+		return -1, ""
+	}
+	if exitErr.Signal() != "" {
+		return -1, exitErr.Signal()
+	}
+	return exitErr.ExitStatus(), ""
+}
+
+// redactor returns a Redactor for the secret inputs, the passwords and keys of the connection,
+// the become password and the values to redact.
+func (c *CommandOutputs) redactor(ctx context.Context) *util.Redactor {
+	redactor := util.NewRedactor(ctx)
+	if c.Redact != nil {
+		redactor.Add(*c.Redact...)
+	}
+	add := func(values ...*string) {
+		for _, v := range values {
+			if v != nil {
+				redactor.Add(*v)
+			}
+		}
+	}
+	add(c.BecomePassword)
+	if c.Connection != nil {
+		add(c.Connection.Password, c.Connection.PrivateKey, c.Connection.PrivateKeyPassword)
+		if c.Connection.Proxy != nil {
+			add(c.Connection.Proxy.Password, c.Connection.Proxy.PrivateKey, c.Connection.Proxy.PrivateKeyPassword)
+		}
+	}
+	return redactor
+}
+
+// signalGracePeriod is how long a canceled command is given to exit after each signal.
+var signalGracePeriod = 10 * time.Second
+
+// runSession runs cmd like session.Run, but ends it when ctx is done or the timeout, if not zero,
+// elapses. The command is sent SIGTERM, then SIGKILL, each followed by signalGracePeriod to exit.
+// If it's still running, e.g. because the server doesn't support signals, the session and then the
+// client are closed. Since the output is copied until then, the caller has the partial output.
+func runSession(
+	ctx context.Context, client *ssh.Client, session *ssh.Session, cmd string, timeout time.Duration,
+) error {
+	if err := session.Start(cmd); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() { done <- session.Wait() }()
+
+	var timedOut <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timedOut = timer.C
+	}
+
+	var reason error
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		reason = fmt.Errorf("command canceled: %w", context.Cause(ctx))
+	case <-timedOut:
+		reason = fmt.Errorf("command timed out after %s", timeout)
+	}
+
+	for _, sig := range []ssh.Signal{ssh.SIGTERM, ssh.SIGKILL} {
+		// Best effort: not all servers support signals.
+		_ = session.Signal(sig)
+		select {
+		case <-done:
+			return reason
+		case <-time.After(signalGracePeriod):
+		}
+	}
+
+	// Wait for the output to be copied after closing, so that it's not written to concurrently.
+	session.Close()
+	select {
+	case <-done:
+	case <-time.After(signalGracePeriod):
+		client.Close()
+		<-done
+	}
+	return reason
+}
+
 func logAndWrapSetenvErr(ctx context.Context, severity diag.Severity, key string, err error) error {
 	l := p.GetLogger(ctx)
 	msg := fmt.Sprintf(`Unable to set '%s'. This only works if your SSH server is configured to accept
-	these variables via AcceptEnv. Alternatively, if a Bash-like shell runs the command on the remote host, you could
-	prefix the command itself with the variables in the form 'VAR=value command'`, key)
+	these variables via AcceptEnv. Alternatively, if a POSIX shell runs the command on the remote host, set
+	'environmentMode' to 'export' or 'auto' to have the shell export the variables instead`, key)
 	switch severity {
 	case diag.Error:
 		l.Error(msg)
@@ -0,0 +1,210 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestRunPty(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	run := func(pty *Pty, cmd string) (CommandOutputs, error) {
+		c := CommandOutputs{CommandInputs: CommandInputs{
+			Connection:     execConnection(server),
+			CommandOptions: CommandOptions{Pty: pty},
+		}}
+		ctx := &testutil.TestContext{Context: context.Background()}
+		err := c.run(ctx, cmd, nil)
+		return c, err
+	}
+
+	t.Run("merged output", func(t *testing.T) {
+		c, err := run(&Pty{}, `[ -t 0 ] && [ -t 1 ] && echo "tty $TERM"; echo oops >&2; stty size`)
+		require.NoError(t, err)
+		assert.Equal(t, "tty xterm\noops\n24 80", c.Stdout)
+		assert.Empty(t, c.Stderr)
+	})
+
+	t.Run("settings", func(t *testing.T) {
+		pty := &Pty{Term: pulumi.StringRef("vt100"), Rows: pulumi.IntRef(50), Cols: pulumi.IntRef(132)}
+		c, err := run(pty, `echo $TERM; stty size`)
+		require.NoError(t, err)
+		assert.Equal(t, "vt100\n50 132", c.Stdout)
+	})
+
+	t.Run("strip ANSI", func(t *testing.T) {
+		c, err := run(&Pty{StripAnsi: pulumi.BoolRef(true)}, `printf '\033[32mok\033[0m\n'`)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", c.Stdout)
+	})
+}
+
+func TestRunCancel(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	gracePeriod := signalGracePeriod
+	signalGracePeriod = 500 * time.Millisecond
+	t.Cleanup(func() { signalGracePeriod = gracePeriod })
+
+	t.Run("timeout", func(t *testing.T) {
+		c := CommandOutputs{CommandInputs: CommandInputs{
+			Connection:     execConnection(server),
+			CommandOptions: CommandOptions{Timeout: pulumi.IntRef(1)},
+		}}
+		ctx := &testutil.TestContext{Context: context.Background()}
+		start := time.Now()
+		err := c.run(ctx, `trap 'echo terminated; kill $!; exit 3' TERM; echo started; sleep 30 & wait`, nil)
+		require.ErrorContains(t, err, "command timed out after 1s")
+		assert.ErrorContains(t, err, "started\nterminated")
+		assert.Less(t, time.Since(start), 10*time.Second)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		c := CommandOutputs{CommandInputs: CommandInputs{Connection: execConnection(server)}}
+		cancelCtx, cancel := context.WithCancel(context.Background())
+		ctx := &testutil.TestContext{Context: cancelCtx}
+		time.AfterFunc(500*time.Millisecond, cancel)
+		start := time.Now()
+		// SIGTERM is ignored, so SIGKILL ends the command.
+		err := c.run(ctx, `trap '' TERM; echo started; sleep 30 & wait`, nil)
+		require.ErrorContains(t, err, "command canceled: context canceled")
+		assert.ErrorContains(t, err, "started")
+		assert.Less(t, time.Since(start), 10*time.Second)
+	})
+}
+
+func TestRunError(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+
+	t.Run("exit code", func(t *testing.T) {
+		conn := execConnection(server)
+		conn.Password = pulumi.StringRef("hunter2")
+		c := CommandOutputs{CommandInputs: CommandInputs{
+			Connection:     conn,
+			CommandOptions: CommandOptions{ErrorOutputLines: pulumi.IntRef(2)},
+		}}
+		err := c.run(ctx, `echo "password hunter2"; for i in 1 2 3; do echo "line $i"; done; echo oops >&2; exit 3`, nil)
+
+		var cmdErr *util.CommandError
+		require.ErrorAs(t, err, &cmdErr)
+		assert.Equal(t, 3, cmdErr.ExitCode)
+		assert.Empty(t, cmdErr.Signal)
+		msg := err.Error()
+		assert.Contains(t, msg, "failed with exit code 3 after ")
+		assert.Contains(t, msg, "stdout (last 2 lines, 2 omitted):\nline 2\nline 3\nstderr:\noops")
+		assert.Contains(t, msg, "password [secret]")
+		assert.NotContains(t, msg, "hunter2")
+	})
+}
+
+func TestRunRedact(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	c := CommandOutputs{CommandInputs: CommandInputs{
+		Connection: execConnection(server),
+		CommandOptions: CommandOptions{
+			Redact:        &[]string{"s3cr3t-token"},
+			RedactOutputs: pulumi.BoolRef(true),
+		},
+	}}
+	err := c.run(ctx, `echo "token s3cr3t-token"; echo "again s3cr3t-token" >&2`, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "token [secret]", c.Stdout)
+	assert.Equal(t, "again [secret]", c.Stderr)
+	assert.Contains(t, ctx.Output.String(), "token [secret]")
+	assert.NotContains(t, ctx.Output.String(), "s3cr3t-token")
+}
+
+func TestRunRedactShortSecrets(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	c := CommandOutputs{CommandInputs: CommandInputs{
+		Connection:     execConnection(server),
+		CommandOptions: CommandOptions{Redact: &[]string{"123", "abc"}},
+	}}
+	err := c.run(ctx, `echo "pin 123"`, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "pin 123", c.Stdout)
+	var warnings []string
+	for _, m := range ctx.Messages {
+		if m.Severity == diag.Warning {
+			warnings = append(warnings, m.Msg)
+		}
+	}
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "isn't masked")
+}
+
+func TestRunLogSeverity(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	stdoutSeverity, stderrSeverity := SeverityDebug, SeverityError
+	c := CommandOutputs{CommandInputs: CommandInputs{
+		Connection: execConnection(server),
+		CommandOptions: CommandOptions{
+			StdoutSeverity: &stdoutSeverity,
+			StderrSeverity: &stderrSeverity,
+		},
+	}}
+	// The sleeps order the lines, since the streams are read concurrently.
+	err := c.run(ctx, `echo one; sleep 0.1; echo two >&2; sleep 0.1; echo three`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []testutil.LogMessage{
+		{Severity: diag.Debug, Msg: "one"},
+		{Severity: diag.Error, Msg: "two"},
+		{Severity: diag.Debug, Msg: "three"},
+	}, ctx.Messages)
+}
+
+func TestRunWorkflowCommands(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	c := CommandOutputs{CommandInputs: CommandInputs{
+		Connection:     execConnection(server),
+		CommandOptions: CommandOptions{WorkflowCommands: pulumi.BoolRef(true)},
+	}}
+	// The workflow commands are run even if stdout isn't logged.
+	logging := NoLogging
+	err := c.run(ctx, `echo "::add-mask::hunter2"; echo "::debug::token hunter2"; echo output`, &logging)
+	require.NoError(t, err)
+	assert.Equal(t, []testutil.LogMessage{{Severity: diag.Debug, Msg: "token [secret]"}}, ctx.Messages)
+	assert.Equal(t, "::add-mask::hunter2\n::debug::token hunter2\noutput", c.Stdout)
+}
+
+func TestRunHeartbeat(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	c := CommandOutputs{CommandInputs: CommandInputs{
+		Connection:     execConnection(server),
+		CommandOptions: CommandOptions{HeartbeatInterval: pulumi.IntRef(1)},
+	}}
+	err := c.run(ctx, `sleep 1.5`, nil)
+	require.NoError(t, err)
+	require.Len(t, ctx.Messages, 1)
+	assert.Equal(t, "still running, 1s elapsed, no output yet", ctx.Messages[0].Msg)
+}
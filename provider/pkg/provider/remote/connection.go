@@ -83,6 +83,22 @@ func (c *Connection) Annotate(a infer.Annotator) {
 }
 
 func (con *connectionBase) SSHConfig() (*ssh.ClientConfig, error) {
+	config, err := con.baseSSHConfig()
+	if err != nil {
+		return nil, err
+	}
+	methods, err := con.authMethods()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range methods {
+		config.Auth = append(config.Auth, m.method)
+	}
+	return config, nil
+}
+
+// baseSSHConfig returns the configuration of the connection without the auth methods.
+func (con *connectionBase) baseSSHConfig() (*ssh.ClientConfig, error) {
 	var hostKeyCallback ssh.HostKeyCallback
 	var hostKeyAlgorithms []string
 	if con.HostKey != nil {
@@ -97,12 +113,23 @@ func (con *connectionBase) SSHConfig() (*ssh.ClientConfig, error) {
 		hostKeyCallback = ssh.InsecureIgnoreHostKey()
 	}
 
-	config := &ssh.ClientConfig{
+	return &ssh.ClientConfig{
 		User:              *con.User,
 		HostKeyCallback:   hostKeyCallback,
 		HostKeyAlgorithms: hostKeyAlgorithms,
 		Timeout:           time.Second * time.Duration(*con.PerDialTimeout),
-	}
+	}, nil
+}
+
+// authMethod is an auth method of a connection with its name in the SSH protocol.
+type authMethod struct {
+	name   string
+	method ssh.AuthMethod
+}
+
+// authMethods returns the auth methods of the connection in the order they're tried.
+func (con *connectionBase) authMethods() ([]authMethod, error) {
+	var methods []authMethod
 	if con.PrivateKey != nil {
 		var signer ssh.Signer
 		var err error
@@ -114,18 +141,18 @@ func (con *connectionBase) SSHConfig() (*ssh.ClientConfig, error) {
 		if err != nil {
 			return nil, err
 		}
-		config.Auth = append(config.Auth, ssh.PublicKeys(signer))
+		methods = append(methods, authMethod{"publickey", ssh.PublicKeys(signer)})
 	}
 	if con.Password != nil {
-		config.Auth = append(config.Auth, ssh.Password(*con.Password))
-		config.Auth = append(config.Auth, ssh.KeyboardInteractive(
+		methods = append(methods, authMethod{"password", ssh.Password(*con.Password)})
+		methods = append(methods, authMethod{"keyboard-interactive", ssh.KeyboardInteractive(
 			func(_, _ string, questions []string, _ []bool) ([]string, error) {
 				answers := make([]string, len(questions))
 				for i := range questions {
 					answers[i] = *con.Password
 				}
 				return answers, nil
-			}))
+			})})
 	}
 
 	var sshAgentSocketPath *string
@@ -141,15 +168,32 @@ func (con *connectionBase) SSHConfig() (*ssh.ClientConfig, error) {
 			return nil, err
 		}
 
-		config.Auth = append(config.Auth, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		methods = append(methods, authMethod{"agent", ssh.PublicKeysCallback(agent.NewClient(conn).Signers)})
 	}
 
-	return config, nil
+	return methods, nil
 }
 
 func dialWithRetry[T any](ctx context.Context, msg string, maxAttempts int, f func() (T, error)) (T, error) {
+	return dialWithRetryEvery(ctx, msg, maxAttempts, 0, f)
+}
+
+// dialWithRetryEvery is dialWithRetry with a fixed interval between the attempts, rather than the
+// default backoff if interval is 0.
+func dialWithRetryEvery[T any](
+	ctx context.Context, msg string, maxAttempts int, interval time.Duration, f func() (T, error),
+) (T, error) {
+	var delay, maxDelay *time.Duration
+	var backoff *float64
+	if interval > 0 {
+		constant := 1.0
+		delay, backoff, maxDelay = &interval, &constant, &interval
+	}
 	var userError error
 	ok, data, err := retry.Until(ctx, retry.Acceptor{
+		Delay:    delay,
+		Backoff:  backoff,
+		MaxDelay: maxDelay,
 		Accept: func(try int, _ time.Duration) (bool, any, error) {
 			var result T
 			result, userError = f()
@@ -175,7 +219,9 @@ func dialWithRetry[T any](ctx context.Context, msg string, maxAttempts int, f fu
 	// It's important to check both `ok` and `err` as sometimes `err` will be nil when `ok` is false,
 	// such as when the context is cancelled.
 	if ok && err == nil {
-		return data.(T), nil
+		// data is a nil interface if f returned the nil value of an interface type.
+		result, _ := data.(T)
+		return result, nil
 	}
 
 	var t T
@@ -188,10 +234,26 @@ func dialWithRetry[T any](ctx context.Context, msg string, maxAttempts int, f fu
 
 // Dial a ssh client connection from a ssh client configuration, retrying as necessary.
 func (c *Connection) Dial(ctx context.Context) (*ssh.Client, error) {
+	return c.dial(ctx, nil)
+}
+
+// dial is Dial, additionally passing the host key of the host, once verified, to onHostKey if
+// it's not nil.
+func (c *Connection) dial(ctx context.Context, onHostKey func(ssh.PublicKey)) (*ssh.Client, error) {
 	config, err := c.SSHConfig()
 	if err != nil {
 		return nil, err
 	}
+	if onHostKey != nil {
+		verify := config.HostKeyCallback
+		config.HostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			if err := verify(hostname, remote, key); err != nil {
+				return err
+			}
+			onHostKey(key)
+			return nil
+		}
+	}
 
 	endpoint := net.JoinHostPort(*c.Host, fmt.Sprintf("%d", int(*c.Port)))
 	tries := c.getDialErrorLimit()
@@ -201,20 +263,10 @@ func (c *Connection) Dial(ctx context.Context) (*ssh.Client, error) {
 		})
 	}
 
-	proxyConfig, err := c.Proxy.SSHConfig()
-	if err != nil {
-		return nil, fmt.Errorf("proxy: %w", err)
-	}
-
-	proxyTries := c.Proxy.getDialErrorLimit()
 	// The user has specified a proxy connection. First, connect to the proxy:
-	proxyClient, err := dialWithRetry(ctx, "Dial proxy", proxyTries, func() (*ssh.Client, error) {
-		return ssh.Dial("tcp",
-			net.JoinHostPort(*c.Proxy.Host, fmt.Sprintf("%d", int(*c.Proxy.Port))),
-			proxyConfig)
-	})
+	proxyClient, err := c.dialProxy(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("proxy: %w", err)
+		return nil, err
 	}
 
 	// Having connected with the proxy, we establish a connection from our proxy to
@@ -242,6 +294,25 @@ func (c *Connection) Dial(ctx context.Context) (*ssh.Client, error) {
 	return ssh.NewClient(proxyConn, channel, req), nil
 }
 
+// dialProxy dials a ssh client connection to the proxy of the connection, retrying as necessary.
+func (c *Connection) dialProxy(ctx context.Context) (*ssh.Client, error) {
+	proxyConfig, err := c.Proxy.SSHConfig()
+	if err != nil {
+		return nil, fmt.Errorf("proxy: %w", err)
+	}
+
+	proxyTries := c.Proxy.getDialErrorLimit()
+	proxyClient, err := dialWithRetry(ctx, "Dial proxy", proxyTries, func() (*ssh.Client, error) {
+		return ssh.Dial("tcp",
+			net.JoinHostPort(*c.Proxy.Host, fmt.Sprintf("%d", int(*c.Proxy.Port))),
+			proxyConfig)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("proxy: %w", err)
+	}
+	return proxyClient, nil
+}
+
 func (con connectionBase) getDialErrorLimit() int {
 	if con.DialErrorLimit == nil {
 		return dialErrorDefault
@@ -15,6 +15,10 @@
 package remote
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
 	_ "embed"
 
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -24,6 +28,8 @@ import (
 //go:embed copyToRemote.md
 var copyResourceDoc string
 
+const copyParallelismDefault = 1
+
 type CopyToRemote struct{}
 
 var _ = (infer.Annotated)((*CopyToRemote)(nil))
@@ -34,10 +40,19 @@ func (c *CopyToRemote) Annotate(a infer.Annotator) {
 }
 
 type CopyToRemoteInputs struct {
-	Connection *Connection          `pulumi:"connection"        provider:"secret"`
-	Triggers   *[]interface{}       `pulumi:"triggers,optional" provider:"replaceOnChanges"`
-	Source     types.AssetOrArchive `pulumi:"source"`
-	RemotePath string               `pulumi:"remotePath"`
+	Connection  *Connection          `pulumi:"connection"        provider:"secret"`
+	Triggers    *[]interface{}       `pulumi:"triggers,optional" provider:"replaceOnChanges"`
+	Source      types.AssetOrArchive `pulumi:"source"`
+	RemotePath  string               `pulumi:"remotePath"`
+	Parallelism *int                 `pulumi:"parallelism,optional"`
+	Atomic      *AtomicMode          `pulumi:"atomic,optional"`
+	Fsync       *bool                `pulumi:"fsync,optional"`
+	Extract     *bool                `pulumi:"extract,optional"`
+	Include     *[]string            `pulumi:"include,optional"`
+	Exclude     *[]string            `pulumi:"exclude,optional"`
+	Symlinks    *SymlinkPolicy       `pulumi:"symlinks,optional"`
+	Transport   *Transport           `pulumi:"transport,optional"`
+	Become      *bool                `pulumi:"become,optional"`
 }
 
 func (c *CopyToRemoteInputs) Annotate(a infer.Annotator) {
@@ -45,22 +60,205 @@ func (c *CopyToRemoteInputs) Annotate(a infer.Annotator) {
 	a.Describe(&c.Triggers, "Trigger replacements on changes to this input.")
 	a.Describe(&c.Source, "An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) "+
 		"to upload as the source of the copy. "+
-		"The item will be copied as-is; archives like .tgz will not be unpacked. "+
+		"The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. "+
 		"Directories are copied recursively, overwriting existing files.")
 	a.Describe(&c.RemotePath, "The destination path on the remote host. "+
 		"Any necessary parent directories will be created automatically. "+
 		"When the remote path is an existing directory, the source file or directory will be copied into that directory. "+
 		"When the source is a file and the remote path is an existing file, that file will be overwritten. "+
 		"When the source is a directory and the remote path an existing file, the copy will fail.")
+	a.Describe(&c.Parallelism, "The maximum number of files to upload concurrently when copying a directory. "+
+		"Directories are always created before any files are written into them. "+
+		"Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. "+
+		"Defaults to 1.")
+	a.SetDefault(&c.Parallelism, copyParallelismDefault)
+	a.Describe(&c.Atomic, "How to protect readers on the remote host from partially written files. "+
+		"With `file`, every file is written to a temporary file in the same remote directory and renamed into place "+
+		"once complete. With `directory`, a copied directory is staged in full next to the destination, "+
+		"which is then replaced by a symlink to the staged copy. Defaults to `none`.")
+	a.Describe(&c.Fsync, "If each remote file should be flushed to disk before it is closed (and, with `atomic`, "+
+		"before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.")
+	a.Describe(&c.Extract, "If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), "+
+		"unpack it into the directory at `remotePath` instead of copying the archive file itself. "+
+		"File modes, directories and symlinks are preserved from the archive. "+
+		"Entries that would be written outside of `remotePath`, including through a symlink from the archive, "+
+		"cause the copy to fail. The targets of symlinks are created as they are and may point outside of "+
+		"`remotePath`. Defaults to false.")
+	a.Describe(&c.Include, "A list of path globs selecting the files to copy when the source is a directory or "+
+		"an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: "+
+		"paths are relative to the source directory or archive root and use `/` as separator, "+
+		"`*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude "+
+		"files again, with later globs taking precedence. When set, directories are only created on the remote host "+
+		"as needed for the selected files. The list of selected files is part of the source hash, so changing "+
+		"the globs copies the source again if it changes which files are selected.")
+	a.Describe(&c.Exclude, "A list of path globs selecting files to skip when the source is a directory or "+
+		"an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.")
+	a.Describe(&c.Symlinks, "How symlinks are handled when copying a local directory. "+
+		"With `follow`, symlinks are copied as the files or directories they point to, "+
+		"and a symlink pointing to one of its parent directories is reported as an error. "+
+		"With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. "+
+		"With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. "+
+		"If not set, symlinks are copied like files, as before this option existed: a symlink to a file is "+
+		"copied as the file it points to, while a symlink to a directory fails the copy.")
+	a.Describe(&c.Transport, "How files are transferred to the remote host. "+
+		"`sftp` uses the SFTP subsystem and supports all options. "+
+		"For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host "+
+		"and `scp` uses the SCP protocol, which can't create symlinks. "+
+		"Both transfer all files in a single stream, which is often faster for large directories, "+
+		"but don't support `atomic` copies, and `parallelism` is ignored. "+
+		"Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. "+
+		"`auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. "+
+		"Defaults to `sftp`.")
+	a.Describe(&c.Become, "Write the files as root via `sudo -n`, for destinations the login user can't write to, "+
+		"such as `/etc`. The user must be allowed to run sudo without a password. "+
+		"With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, "+
+		"e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. "+
+		"The copied files are owned by root.")
+}
+
+type AtomicMode string
+
+const (
+	AtomicNone      AtomicMode = "none"
+	AtomicFile      AtomicMode = "file"
+	AtomicDirectory AtomicMode = "directory"
+)
+
+func (AtomicMode) Values() []infer.EnumValue[AtomicMode] {
+	return []infer.EnumValue[AtomicMode]{
+		{Name: string(AtomicNone), Value: AtomicNone, Description: "Write files in place"},
+		{Name: string(AtomicFile), Value: AtomicFile,
+			Description: "Write each file to a temporary file and rename it into place"},
+		{Name: string(AtomicDirectory), Value: AtomicDirectory,
+			Description: "Stage copied directories in full and swap them in via a symlink"},
+	}
 }
 
+type Transport string
+
+const (
+	TransportSFTP        Transport = "sftp"
+	TransportSCP         Transport = "scp"
+	TransportTarOverExec Transport = "tarOverExec"
+	TransportAuto        Transport = "auto"
+)
+
+func (Transport) Values() []infer.EnumValue[Transport] {
+	return []infer.EnumValue[Transport]{
+		{Name: string(TransportSFTP), Value: TransportSFTP, Description: "Use the SFTP subsystem"},
+		{Name: string(TransportSCP), Value: TransportSCP, Description: "Use the SCP protocol via `scp -t`"},
+		{Name: string(TransportTarOverExec), Value: TransportTarOverExec,
+			Description: "Stream a tar archive to `tar -x` on the remote host"},
+		{Name: string(TransportAuto), Value: TransportAuto,
+			Description: "Use SFTP if the host supports it, and fall back to `tarOverExec` or `scp`"},
+	}
+}
+
+// OrDefault returns the transport, or TransportSFTP if none is set.
+func (t *Transport) OrDefault() Transport {
+	if t == nil || *t == "" {
+		return TransportSFTP
+	}
+	return *t
+}
+
+// copyOptions holds the inputs that control how the copy is performed, as opposed to what is copied.
+type copyOptions struct {
+	parallelism int
+	// atomicFiles writes each file to a temporary file and renames it into place.
+	atomicFiles bool
+	// atomicDirs stages directory copies next to their destination and swaps them in via a symlink.
+	atomicDirs bool
+	fsync      bool
+	// extract unpacks file and remote archives on the remote host.
+	extract bool
+	// manifest, if set, records the hash of every file written.
+	manifest *manifest
+	// filter selects the files of a directory or archive to copy.
+	filter *copyFilter
+	// symlinks is the util.Symlinks* policy for local directories.
+	symlinks string
+	// become writes the files as root via sudo.
+	become bool
+}
+
+// withoutAtomicFiles returns a copy of opts for writing into a staging directory, where files don't
+// need to be renamed into place individually.
+func (opts copyOptions) withoutAtomicFiles() copyOptions {
+	opts.atomicFiles = false
+	return opts
+}
+
+func (c *CopyToRemoteInputs) copyOptions() copyOptions {
+	opts := copyOptions{parallelism: copyParallelismDefault}
+	if c.Parallelism != nil && *c.Parallelism > 0 {
+		opts.parallelism = *c.Parallelism
+	}
+	if c.Atomic != nil {
+		// Files that aren't part of a staged directory are still written atomically in directory mode.
+		opts.atomicFiles = *c.Atomic == AtomicFile || *c.Atomic == AtomicDirectory
+		opts.atomicDirs = *c.Atomic == AtomicDirectory
+	}
+	opts.fsync = c.Fsync != nil && *c.Fsync
+	opts.extract = c.Extract != nil && *c.Extract
+	opts.symlinks = c.Symlinks.policy()
+	opts.become = c.Become != nil && *c.Become
+	return opts
+}
+
+// hash returns the hash of the source. When files are filtered via `include` or `exclude`, the
+// list of selected files is included, or the globs themselves if the list can't be determined
+// locally, e.g. for remote archives.
 func (c *CopyToRemoteInputs) hash() string {
-	if c.Source.Archive != nil {
-		return c.Source.Archive.Hash
+	var sourceHash string
+	switch {
+	case c.Source.Archive != nil:
+		sourceHash = c.Source.Archive.Hash
+	case c.Source.Asset != nil:
+		sourceHash = c.Source.Asset.Hash
+	default:
+		// The source is unknown during previews.
+		return ""
+	}
+	if c.Include == nil && c.Exclude == nil {
+		return sourceHash
+	}
+
+	h := sha256.New()
+	fmt.Fprintln(h, sourceHash)
+	filter, err := c.filter()
+	var files []string
+	var ok bool
+	if err == nil {
+		files, ok, err = c.effectiveFiles(filter)
 	}
-	return c.Source.Asset.Hash
+	if err == nil && ok {
+		fmt.Fprintln(h, "files")
+		for _, f := range files {
+			fmt.Fprintln(h, f)
+		}
+	} else {
+		for _, rules := range []*[]string{c.Include, c.Exclude} {
+			fmt.Fprintln(h, "rules")
+			if rules != nil {
+				for _, r := range *rules {
+					fmt.Fprintln(h, r)
+				}
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 type CopyToRemoteOutputs struct {
 	CopyToRemoteInputs
+	Manifest *map[string]string `pulumi:"manifest,optional"`
+	Drift    *[]string          `pulumi:"drift,optional"`
+}
+
+func (c *CopyToRemoteOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Manifest, "The SHA256 hash of every file written to the remote host, keyed by remote path. "+
+		"Used to detect changes to the remote files on refresh.")
+	a.Describe(&c.Drift, "The remote files that were found missing or modified on the last refresh. "+
+		"If any, the next update copies the source again.")
 }
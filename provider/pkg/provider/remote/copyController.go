@@ -16,15 +16,20 @@ package remote
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"io/fs"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/pkg/sftp"
 
@@ -32,10 +37,12 @@ import (
 	"github.com/pulumi/pulumi-go-provider/infer"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
 )
 
 // copyTextContent writes text content directly to a remote file via SFTP.
-func copyTextContent(sftpClient *sftp.Client, content, destPath string) error {
+func copyTextContent(sftpClient *sftp.Client, content, destPath string, opts copyOptions) error {
 	destStat, err := remoteStat(sftpClient, destPath)
 	if err != nil {
 		return err
@@ -52,15 +59,8 @@ func copyTextContent(sftpClient *sftp.Client, content, destPath string) error {
 		return fmt.Errorf("failed to create parent directories for %s: %w", destPath, err)
 	}
 
-	remote, err := sftpClient.Create(destPath)
-	if err != nil {
-		return fmt.Errorf("failed to create remote file %s: %w", destPath, err)
-	}
-	defer remote.Close()
-
-	_, err = remote.Write([]byte(content))
-	if err != nil {
-		return fmt.Errorf("failed to write text content to remote path %s: %w", destPath, err)
+	if err := writeRemoteFile(sftpClient, strings.NewReader(content), destPath, opts); err != nil {
+		return fmt.Errorf("failed to write text content: %w", err)
 	}
 	return nil
 }
@@ -71,6 +71,8 @@ var (
 	_ = (infer.CustomResource[CopyToRemoteInputs, CopyToRemoteOutputs])((*CopyToRemote)(nil))
 	_ = (infer.CustomCheck[CopyToRemoteInputs])((*CopyToRemote)(nil))
 	_ = (infer.CustomUpdate[CopyToRemoteInputs, CopyToRemoteOutputs])((*CopyToRemote)(nil))
+	_ = (infer.CustomRead[CopyToRemoteInputs, CopyToRemoteOutputs])((*CopyToRemote)(nil))
+	_ = (infer.CustomDiff[CopyToRemoteInputs, CopyToRemoteOutputs])((*CopyToRemote)(nil))
 )
 
 func (c *CopyToRemote) Check(
@@ -86,6 +88,34 @@ func (c *CopyToRemote) Check(
 		return infer.CheckResponse[CopyToRemoteInputs]{Inputs: inputs, Failures: failures}, err
 	}
 
+	for _, globs := range []struct {
+		property string
+		rules    *[]string
+	}{{"include", inputs.Include}, {"exclude", inputs.Exclude}} {
+		if globs.rules == nil {
+			continue
+		}
+		if _, err := util.CompileGlobRules(*globs.rules); err != nil {
+			failures = append(failures, p.CheckFailure{Property: globs.property, Reason: err.Error()})
+		}
+	}
+
+	if inputs.Parallelism != nil && *inputs.Parallelism < 1 {
+		failures = append(failures, p.CheckFailure{
+			Property: "parallelism",
+			Reason:   fmt.Sprintf("parallelism must be at least 1, but is %d", *inputs.Parallelism),
+		})
+	}
+
+	if transport := inputs.Transport.OrDefault(); transport == TransportSCP || transport == TransportTarOverExec {
+		if inputs.copyOptions().atomicFiles {
+			failures = append(failures, p.CheckFailure{
+				Property: "atomic",
+				Reason:   fmt.Sprintf("atomic copies require the sftp transport, but transport is %s", transport),
+			})
+		}
+	}
+
 	// If source is unknown (computed during preview), skip asset/archive validation
 	// since the value isn't available yet.
 	sourceVal, sourceOk := newInputs.GetOk("source")
@@ -119,13 +149,14 @@ func (*CopyToRemote) Create(
 ) (infer.CreateResponse[CopyToRemoteOutputs], error) {
 	input := req.Inputs
 	preview := req.DryRun
+	state := CopyToRemoteOutputs{CopyToRemoteInputs: input}
 	if preview {
-		return infer.CreateResponse[CopyToRemoteOutputs]{ID: "", Output: CopyToRemoteOutputs{input}}, nil
+		return infer.CreateResponse[CopyToRemoteOutputs]{ID: "", Output: state}, nil
 	}
 
 	outputs, err := copyToRemote(ctx, input)
 	if err != nil {
-		return infer.CreateResponse[CopyToRemoteOutputs]{ID: "", Output: CopyToRemoteOutputs{input}}, err
+		return infer.CreateResponse[CopyToRemoteOutputs]{ID: "", Output: state}, err
 	}
 
 	id, err := resource.NewUniqueHex("", 8, 0)
@@ -139,46 +170,145 @@ func (c *CopyToRemote) Update(
 	olds := req.State
 	news := req.Inputs
 	preview := req.DryRun
+	state := CopyToRemoteOutputs{CopyToRemoteInputs: news, Manifest: olds.Manifest}
 	if preview {
-		return infer.UpdateResponse[CopyToRemoteOutputs]{Output: CopyToRemoteOutputs{news}}, nil
+		return infer.UpdateResponse[CopyToRemoteOutputs]{Output: state}, nil
 	}
 
-	needCopy := news.hash() != olds.hash() || news.RemotePath != olds.RemotePath
-	if needCopy {
+	if needsCopy(olds, news) {
 		outputs, err := copyToRemote(ctx, news)
 		return infer.UpdateResponse[CopyToRemoteOutputs]{Output: outputs}, err
 	}
-	return infer.UpdateResponse[CopyToRemoteOutputs]{Output: CopyToRemoteOutputs{news}}, nil
+	return infer.UpdateResponse[CopyToRemoteOutputs]{Output: state}, nil
+}
+
+// needsCopy reports whether the source has to be copied again, as opposed to only updating the
+// state, e.g. when just the connection details changed.
+func needsCopy(olds CopyToRemoteOutputs, news CopyToRemoteInputs) bool {
+	newOpts, oldOpts := news.copyOptions(), olds.copyOptions()
+	return news.hash() != olds.hash() || news.RemotePath != olds.RemotePath ||
+		newOpts.extract != oldOpts.extract || newOpts.symlinks != oldOpts.symlinks ||
+		(olds.Drift != nil && len(*olds.Drift) > 0)
+}
+
+// Diff compares the inputs like the default diff does, and additionally reports a change to
+// `source` when the last refresh found the remote files to have drifted.
+func (*CopyToRemote) Diff(
+	_ context.Context,
+	req infer.DiffRequest[CopyToRemoteInputs, CopyToRemoteOutputs],
+) (infer.DiffResponse, error) {
+	olds := req.State
+	news := req.Inputs
+	diff := map[string]p.PropertyDiff{}
+	update := func(prop string, changed bool) {
+		if changed {
+			diff[prop] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+		}
+	}
+
+	if !reflect.DeepEqual(olds.Triggers, news.Triggers) {
+		diff["triggers"] = p.PropertyDiff{Kind: p.UpdateReplace, InputDiff: true}
+	}
+	update("connection", !reflect.DeepEqual(olds.Connection, news.Connection))
+	update("source", olds.hash() != news.hash() || (olds.Drift != nil && len(*olds.Drift) > 0))
+	update("remotePath", olds.RemotePath != news.RemotePath)
+	update("parallelism", !reflect.DeepEqual(olds.Parallelism, news.Parallelism))
+	update("atomic", !reflect.DeepEqual(olds.Atomic, news.Atomic))
+	update("fsync", !reflect.DeepEqual(olds.Fsync, news.Fsync))
+	update("extract", !reflect.DeepEqual(olds.Extract, news.Extract))
+	update("include", !reflect.DeepEqual(olds.Include, news.Include))
+	update("exclude", !reflect.DeepEqual(olds.Exclude, news.Exclude))
+	update("symlinks", !reflect.DeepEqual(olds.Symlinks, news.Symlinks))
+	update("transport", !reflect.DeepEqual(olds.Transport, news.Transport))
+	update("become", !reflect.DeepEqual(olds.Become, news.Become))
+
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff}, nil
+}
+
+// Read hashes the files recorded in the manifest on the remote host and reports the ones that are
+// missing or were modified as `drift`, which causes the next update to copy the source again. If the
+// host can't be reached, e.g. because it's gone, the state is kept as is so that a refresh doesn't
+// fail.
+func (*CopyToRemote) Read(
+	ctx context.Context,
+	req infer.ReadRequest[CopyToRemoteInputs, CopyToRemoteOutputs],
+) (infer.ReadResponse[CopyToRemoteInputs, CopyToRemoteOutputs], error) {
+	state := req.State
+	resp := infer.ReadResponse[CopyToRemoteInputs, CopyToRemoteOutputs]{ID: req.ID, Inputs: req.Inputs, State: state}
+	// Resources created before the manifest was introduced can't be checked.
+	if state.Manifest == nil || len(*state.Manifest) == 0 || state.Connection == nil {
+		return resp, nil
+	}
+
+	client, err := state.Connection.Dial(ctx)
+	if err != nil {
+		p.GetLogger(ctx).Warningf("Not checking the remote files for drift, the host can't be reached: %v", err)
+		return resp, nil
+	}
+	defer client.Close()
+
+	sftpClient, err := newSftpClient(client, state.copyOptions().become)
+	if err != nil {
+		p.GetLogger(ctx).Warningf("Not checking the remote files for drift, SFTP isn't available: %v", err)
+		return resp, nil
+	}
+	defer sftpClient.Close()
+
+	drift, err := detectDrift(sftpClient, *state.Manifest, state.copyOptions().parallelism)
+	if err != nil {
+		return resp, err
+	}
+	if len(drift) > 0 {
+		p.GetLogger(ctx).Warningf("%d remote file(s) changed since the last copy: %s", len(drift), driftSummary(drift))
+		resp.State.Drift = &drift
+	} else {
+		resp.State.Drift = nil
+	}
+	return resp, nil
 }
 
 // copyToRemote unpacks the inputs, dials the SSH connection, creates an sFTP client, and dispatches
-// to the appropriate copy routine based on the source asset/archive subtype.
+// to the appropriate copy routine based on the source asset/archive subtype. Hosts without SFTP are
+// handled by copyOverExec, depending on the transport.
 func copyToRemote(ctx context.Context, input CopyToRemoteInputs) (CopyToRemoteOutputs, error) {
 	p.GetLogger(ctx).Debugf("Creating %s:%s from %s",
 		*input.Connection.Host, input.RemotePath, sourceDescription(input))
 
+	outputs := CopyToRemoteOutputs{CopyToRemoteInputs: input}
 	client, err := input.Connection.Dial(ctx)
 	if err != nil {
-		return CopyToRemoteOutputs{input}, err
+		return outputs, err
 	}
 	defer client.Close()
 
+	opts := input.copyOptions()
 	// The docs warns that concurrent writes "require special consideration. A write to a later
 	/// offset in a file after an error, could end up with a file length longer than what was
 	// successfully written."
 	// We don't do subsequent writes to the same file, only a single ReadFrom, so we should be fine.
-	sftpClient, err := sftp.NewClient(client, sftp.UseConcurrentWrites(true))
+	transport, sftpClient, err := openTransport(
+		client, input.Transport.OrDefault(), opts.become, sftp.UseConcurrentWrites(true))
 	if err != nil {
-		return CopyToRemoteOutputs{input}, err
+		return outputs, err
+	}
+
+	if opts.filter, err = input.filter(); err != nil {
+		return outputs, err
+	}
+	if sftpClient == nil {
+		p.GetLogger(ctx).Debugf("Copying to %s with the %s transport", *input.Connection.Host, transport)
+		return outputs, copyOverExec(client, transport, input, opts)
 	}
 	defer sftpClient.Close()
 
+	opts.manifest = newManifest()
 	if input.Source.Asset != nil {
-		err = copyAssetToRemote(sftpClient, input.Source.Asset, input.RemotePath)
+		err = copyAssetToRemote(sftpClient, input.Source.Asset, input.RemotePath, opts)
 	} else {
-		err = copyArchiveToRemote(sftpClient, input.Source.Archive, input.RemotePath)
+		err = copyArchiveToRemote(sftpClient, input.Source.Archive, input.RemotePath, opts)
 	}
-	return CopyToRemoteOutputs{input}, err
+	outputs.Manifest = opts.manifest.entries()
+	return outputs, err
 }
 
 func sourceDescription(input CopyToRemoteInputs) string {
@@ -205,27 +335,32 @@ func sourceDescription(input CopyToRemoteInputs) string {
 	return "unknown source"
 }
 
-func copyAssetToRemote(sftpClient *sftp.Client, a *resource.Asset, destPath string) error {
+func copyAssetToRemote(sftpClient *sftp.Client, a *resource.Asset, destPath string, opts copyOptions) error {
 	switch {
 	case a.IsText():
-		return copyTextContent(sftpClient, a.Text, destPath)
+		return copyTextContent(sftpClient, a.Text, destPath, opts)
 	case a.IsPath():
-		return sftpCopy(sftpClient, a.Path, destPath)
+		return sftpCopy(sftpClient, a.Path, destPath, opts)
 	case a.IsURI():
 		blob, err := a.Read()
 		if err != nil {
 			return fmt.Errorf("failed to read remote asset %s: %w", a.URI, err)
 		}
 		defer blob.Close()
-		return copyReaderAsFile(sftpClient, blob, uriBasename(a.URI), destPath)
+		return copyReaderAsFile(sftpClient, blob, uriBasename(a.URI), destPath, opts)
 	}
 	return fmt.Errorf("asset is neither path-based, text-based, nor URI-based")
 }
 
-func copyArchiveToRemote(sftpClient *sftp.Client, a *resource.Archive, destPath string) error {
+func copyArchiveToRemote(sftpClient *sftp.Client, a *resource.Archive, destPath string, opts copyOptions) error {
+	// Directories can't be extracted, they're copied as usual.
+	if opts.extract && (a.IsURI() || (a.IsPath() && !isLocalDir(a.Path))) {
+		return extractArchive(sftpClient, a, destPath, opts)
+	}
+
 	switch {
 	case a.IsPath():
-		return sftpCopy(sftpClient, a.Path, destPath)
+		return sftpCopy(sftpClient, a.Path, destPath, opts)
 	case a.IsURI():
 		format, rc, err := a.ReadSourceArchive()
 		if err != nil {
@@ -235,9 +370,9 @@ func copyArchiveToRemote(sftpClient *sftp.Client, a *resource.Archive, destPath
 			return fmt.Errorf("URL %q is not a recognized archive format", a.URI)
 		}
 		defer rc.Close()
-		return copyReaderAsFile(sftpClient, rc, uriBasename(a.URI), destPath)
+		return copyReaderAsFile(sftpClient, rc, uriBasename(a.URI), destPath, opts)
 	case a.IsAssets():
-		return copyAssetArchive(sftpClient, a, destPath)
+		return copyAssetArchive(sftpClient, a, destPath, opts)
 	}
 	return fmt.Errorf("archive is neither path-based, URI-based, nor asset-based")
 }
@@ -246,7 +381,7 @@ func copyArchiveToRemote(sftpClient *sftp.Client, a *resource.Archive, destPath
 // when destPath is an existing directory the contents are written to destPath/sourceName, when
 // destPath does not exist the parent directories are created and the file is written at destPath,
 // and when destPath is an existing file it is overwritten.
-func copyReaderAsFile(sftpClient *sftp.Client, r io.Reader, sourceName, destPath string) error {
+func copyReaderAsFile(sftpClient *sftp.Client, r io.Reader, sourceName, destPath string, opts copyOptions) error {
 	destStat, err := remoteStat(sftpClient, destPath)
 	if err != nil {
 		return err
@@ -264,26 +399,34 @@ func copyReaderAsFile(sftpClient *sftp.Client, r io.Reader, sourceName, destPath
 		}
 	}
 
-	remote, err := sftpClient.Create(dest)
-	if err != nil {
-		return fmt.Errorf("failed to create remote file %s: %w", dest, err)
-	}
-	defer remote.Close()
-
-	if _, err := remote.ReadFrom(r); err != nil {
-		return fmt.Errorf("failed to write to remote path %s: %w", dest, err)
-	}
-	return nil
+	return writeRemoteFile(sftpClient, r, dest, opts)
 }
 
 // copyAssetArchive iterates over the entries of an AssetArchive and writes each one to destPath/name.
-func copyAssetArchive(sftpClient *sftp.Client, a *resource.Archive, destPath string) error {
+func copyAssetArchive(sftpClient *sftp.Client, a *resource.Archive, destPath string, opts copyOptions) error {
+	return writeIntoRemoteDirectory(sftpClient, destPath, opts, func(dir string, opts copyOptions) error {
+		return writeAssetArchive(sftpClient, a, dir, opts)
+	})
+}
+
+// writeIntoRemoteDirectory calls write to populate the remote directory destPath, which is created
+// if it doesn't exist. With atomic directories, write populates a staging directory instead that is
+// swapped in once complete.
+func writeIntoRemoteDirectory(
+	sftpClient *sftp.Client, destPath string, opts copyOptions, write func(dir string, opts copyOptions) error,
+) error {
+	if opts.atomicDirs {
+		return swapRemoteDirectory(sftpClient, destPath, opts, func(staging string) error {
+			return write(staging, opts.withoutAtomicFiles())
+		})
+	}
+
 	destStat, err := remoteStat(sftpClient, destPath)
 	if err != nil {
 		return err
 	}
 	if destStat != nil && !destStat.IsDir() {
-		return fmt.Errorf("remote path %s exists but is not a directory; cannot copy asset archive contents", destPath)
+		return fmt.Errorf("remote path %s exists but is not a directory; cannot copy archive contents", destPath)
 	}
 	if destStat == nil {
 		if err := sftpClient.MkdirAll(destPath); err != nil {
@@ -291,6 +434,11 @@ func copyAssetArchive(sftpClient *sftp.Client, a *resource.Archive, destPath str
 		}
 	}
 
+	return write(destPath, opts)
+}
+
+// writeAssetArchive writes the entries of an AssetArchive into the existing directory destPath.
+func writeAssetArchive(sftpClient *sftp.Client, a *resource.Archive, destPath string, opts copyOptions) error {
 	reader, err := a.Open()
 	if err != nil {
 		return fmt.Errorf("failed to open asset archive: %w", err)
@@ -305,24 +453,154 @@ func copyAssetArchive(sftpClient *sftp.Client, a *resource.Archive, destPath str
 		if err != nil {
 			return fmt.Errorf("failed to read asset archive entry: %w", err)
 		}
-		if err := writeArchiveEntry(sftpClient, blob, filepath.Join(destPath, name)); err != nil {
+		if !opts.filter.includes(name) {
+			blob.Close()
+			continue
+		}
+		if err := writeArchiveEntry(sftpClient, blob, filepath.Join(destPath, name), opts); err != nil {
 			return err
 		}
 	}
 }
 
-func writeArchiveEntry(sftpClient *sftp.Client, blob io.ReadCloser, remotePath string) error {
+func writeArchiveEntry(sftpClient *sftp.Client, blob io.ReadCloser, remotePath string, opts copyOptions) error {
 	defer blob.Close()
 	if err := sftpClient.MkdirAll(filepath.Dir(remotePath)); err != nil {
 		return fmt.Errorf("failed to create parent directories for %s: %w", remotePath, err)
 	}
-	remote, err := sftpClient.Create(remotePath)
+	if err := writeRemoteFile(sftpClient, blob, remotePath, opts); err != nil {
+		return fmt.Errorf("failed to copy archive entry: %w", err)
+	}
+	return nil
+}
+
+// writeRemoteFile streams r to the remote file dest, creating or truncating it. When opts.atomicFiles
+// is set, the content is first written to a temporary file in the same directory which is then
+// renamed over dest, so that dest is never observed partially written. An existing dest keeps its
+// permissions.
+func writeRemoteFile(sftpClient *sftp.Client, r io.Reader, dest string, opts copyOptions) error {
+	var h hash.Hash
+	if opts.manifest != nil {
+		h = sha256.New()
+		r = io.TeeReader(r, h)
+	}
+
+	target := dest
+	if opts.atomicFiles {
+		tmp, err := resource.NewUniqueHex(filepath.Join(filepath.Dir(dest), "."+filepath.Base(dest)+".tmp-"), 8, 0)
+		if err != nil {
+			return err
+		}
+		target = tmp
+	}
+
+	remote, err := sftpClient.Create(target)
 	if err != nil {
-		return fmt.Errorf("failed to create remote file %s: %w", remotePath, err)
+		return fmt.Errorf("failed to create remote file %s: %w", target, err)
 	}
-	defer remote.Close()
-	if _, err := remote.ReadFrom(blob); err != nil {
-		return fmt.Errorf("failed to copy archive entry to %s: %w", remotePath, err)
+
+	err = writeAndClose(remote, r, opts.fsync)
+	if err == nil && opts.atomicFiles {
+		err = replaceRemoteFile(sftpClient, target, dest)
+	}
+	if err != nil {
+		if opts.atomicFiles {
+			// Best effort: don't leave stray temporary files behind.
+			_ = sftpClient.Remove(target)
+		}
+		return fmt.Errorf("failed to write to remote path %s: %w", dest, err)
+	}
+	if h != nil {
+		opts.manifest.record(dest, h)
+	}
+	return nil
+}
+
+func writeAndClose(remote *sftp.File, r io.Reader, fsync bool) error {
+	_, err := remote.ReadFrom(r)
+	if err == nil && fsync {
+		err = remote.Sync()
+	}
+	return errors.Join(err, remote.Close())
+}
+
+// replaceRemoteFile renames src over dest, carrying over the permissions of an existing dest.
+func replaceRemoteFile(sftpClient *sftp.Client, src, dest string) error {
+	destStat, err := remoteStat(sftpClient, dest)
+	if err != nil {
+		return err
+	}
+	if destStat != nil {
+		if err := sftpClient.Chmod(src, destStat.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to set permissions of %s: %w", src, err)
+		}
+	}
+	if err := sftpClient.PosixRename(src, dest); err != nil {
+		return fmt.Errorf("failed to rename %s to %s: %w", src, dest, err)
+	}
+	return nil
+}
+
+// swapRemoteDirectory calls write to populate a new staging directory next to dest, then atomically
+// points dest at it by renaming a symlink over dest. dest must not exist or must be a symlink, for
+// instance from a previous swap. The directory that dest previously pointed to is removed if it was
+// created by an earlier swap.
+func swapRemoteDirectory(
+	sftpClient *sftp.Client, dest string, opts copyOptions, write func(staging string) error,
+) error {
+	destStat, err := sftpClient.Lstat(dest)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to stat remote path %s: %w", dest, err)
+	}
+	if destStat != nil && destStat.Mode()&os.ModeSymlink == 0 {
+		return fmt.Errorf("remote path %s exists but is not a symlink; "+
+			"atomic directory copies replace the destination with a symlink, remove it first", dest)
+	}
+
+	var previous string
+	if destStat != nil {
+		if previous, err = sftpClient.ReadLink(dest); err != nil {
+			return fmt.Errorf("failed to read remote symlink %s: %w", dest, err)
+		}
+	}
+
+	parent := filepath.Dir(dest)
+	if err := sftpClient.MkdirAll(parent); err != nil {
+		return fmt.Errorf("failed to create parent directories for %s: %w", dest, err)
+	}
+	staging, err := resource.NewUniqueHex(dest+".", 8, 0)
+	if err != nil {
+		return err
+	}
+	if err := sftpClient.Mkdir(staging); err != nil {
+		return fmt.Errorf("failed to create remote directory %s: %w", staging, err)
+	}
+	if err := write(staging); err != nil {
+		_ = sftpClient.RemoveAll(staging)
+		return err
+	}
+
+	// The link is relative so that the swapped tree can be moved together with its parent.
+	link := staging + ".link"
+	if err := sftpClient.Symlink(filepath.Base(staging), link); err != nil {
+		_ = sftpClient.RemoveAll(staging)
+		return fmt.Errorf("failed to create remote symlink %s: %w", link, err)
+	}
+	if err := sftpClient.PosixRename(link, dest); err != nil {
+		_ = sftpClient.Remove(link)
+		_ = sftpClient.RemoveAll(staging)
+		return fmt.Errorf("failed to swap remote directory %s: %w", dest, err)
+	}
+	if opts.manifest != nil {
+		// Record the files at the paths they're read from, rather than below the staging directory.
+		opts.manifest.rebase(staging, dest)
+	}
+
+	// Only remove siblings named like our staging directories. Some servers report link targets as
+	// absolute paths, so we match on the base name rather than the full target.
+	if previous != "" && strings.HasPrefix(filepath.Base(previous), filepath.Base(dest)+".") {
+		// Best effort: the new tree is already in place.
+		_ = sftpClient.RemoveAll(filepath.Join(parent, filepath.Base(previous)))
 	}
 	return nil
 }
@@ -351,7 +629,7 @@ func remoteStat(sftpClient *sftp.Client, path string) (fs.FileInfo, error) {
 	return info, nil
 }
 
-func sftpCopy(sftpClient *sftp.Client, sourcePath, destPath string) error {
+func sftpCopy(sftpClient *sftp.Client, sourcePath, destPath string, opts copyOptions) error {
 	src, err := os.Open(sourcePath)
 	if err != nil {
 		return err
@@ -379,13 +657,23 @@ func sftpCopy(sftpClient *sftp.Client, sourcePath, destPath string) error {
 	// file   | dest/file            | dest                  | dest (overwritten)
 	dest := destPath
 	if srcInfo.IsDir() {
-		if destStat == nil {
+		// With atomic directories and a trailing slash, dest itself is swapped in later.
+		if destStat == nil && (!opts.atomicDirs || !strings.HasSuffix(sourcePath, "/")) {
 			err = sftpClient.MkdirAll(dest)
 			if err != nil {
 				return fmt.Errorf("failed to create remote directory %s: %w", dest, err)
 			}
 		}
 
+		if opts.atomicDirs {
+			if !strings.HasSuffix(sourcePath, "/") {
+				dest = filepath.Join(dest, filepath.Base(sourcePath))
+			}
+			return swapRemoteDirectory(sftpClient, dest, opts, func(staging string) error {
+				return copyDir(sftpClient, sourcePath, staging, opts.withoutAtomicFiles())
+			})
+		}
+
 		if !strings.HasSuffix(sourcePath, "/") {
 			dest = filepath.Join(dest, filepath.Base(sourcePath))
 			destStat, err := remoteStat(sftpClient, dest)
@@ -403,7 +691,7 @@ func sftpCopy(sftpClient *sftp.Client, sourcePath, destPath string) error {
 				}
 			}
 		}
-		err = copyDir(sftpClient, sourcePath, dest)
+		err = copyDir(sftpClient, sourcePath, dest, opts)
 	} else {
 		// If the file is f and the destination is existing dir/, copy to dir/f.
 		if destStat != nil && destStat.IsDir() {
@@ -414,46 +702,35 @@ func sftpCopy(sftpClient *sftp.Client, sourcePath, destPath string) error {
 				return fmt.Errorf("failed to create parent directories for %s: %w", dest, err)
 			}
 		}
-		err = copyFile(sftpClient, sourcePath, dest)
+		err = copyFile(sftpClient, sourcePath, dest, opts)
 	}
 	return err
 }
 
-func copyFile(sftp *sftp.Client, src, dst string) error {
+func copyFile(sftp *sftp.Client, src, dst string, opts copyOptions) error {
 	local, err := os.Open(src)
 	if err != nil {
 		return err
 	}
 	defer local.Close()
 
-	remote, err := sftp.Create(dst)
-	if err != nil {
-		return fmt.Errorf("failed to create remote file %s: %w", dst, err)
-	}
-	defer remote.Close()
-
-	_, err = remote.ReadFrom(local)
-	if err != nil {
-		return fmt.Errorf("failed to copy file %s to remote path %s: %w", src, dst, err)
+	if err := writeRemoteFile(sftp, local, dst, opts); err != nil {
+		return fmt.Errorf("failed to copy file %s: %w", src, err)
 	}
 	return nil
 }
 
-// copyDir copies a directory recursively from the local file system to a remote host.
-// Note that the current implementation is naive and sequential and therefore can be slow.
-func copyDir(sftp *sftp.Client, src, dst string) error {
-	fileSystem := os.DirFS(src)
-	return fs.WalkDir(fileSystem, ".", func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-
-		remotePath := filepath.Join(dst, path)
-
-		if !d.IsDir() {
-			return copyFile(sftp, filepath.Join(src, path), remotePath)
-		}
+// copyDir copies a directory recursively from the local file system to a remote host. The tree is
+// walked first, creating remote directories in order, and the files are then uploaded by up to
+// opts.parallelism concurrent workers sharing the sFTP client.
+func copyDir(sftp *sftp.Client, src, dst string, opts copyOptions) error {
+	dirs, files, links, err := walkLocalDir(src, opts)
+	if err != nil {
+		return err
+	}
 
+	for _, dir := range dirs {
+		remotePath := filepath.Join(dst, dir.Path)
 		dirInfo, err := remoteStat(sftp, remotePath)
 		if err != nil {
 			return err
@@ -466,6 +743,78 @@ func copyDir(sftp *sftp.Client, src, dst string) error {
 		} else if !dirInfo.IsDir() {
 			return fmt.Errorf("remote path %s exists but is not a directory", remotePath)
 		}
+	}
+
+	for _, link := range links {
+		remotePath := filepath.Join(dst, link.Path)
+		if err := prepareRemoteLink(sftp, remotePath); err != nil {
+			return err
+		}
+		if err := sftp.Symlink(link.Target, remotePath); err != nil {
+			return fmt.Errorf("failed to create remote symlink %s: %w", remotePath, err)
+		}
+	}
+
+	return forEachConcurrently(files, opts.parallelism, func(_ int, f util.WalkEntry) error {
+		return copyFile(sftp, filepath.Join(src, f.Path), filepath.Join(dst, f.Path), opts)
+	})
+}
+
+// walkLocalDir lists the directories, files and symlinks of the local directory src that are
+// copied. Symlinks are handled according to opts.symlinks. With a filter, only the selected files and
+// the directories containing them are listed.
+func walkLocalDir(src string, opts copyOptions) (dirs, files, links []util.WalkEntry, err error) {
+	err = util.WalkFiles(src, opts.symlinks, func(e util.WalkEntry) error {
+		switch {
+		case e.IsDir():
+			dirs = append(dirs, e)
+		case !opts.filter.includes(e.Path):
+		case e.IsSymlink():
+			links = append(links, e)
+		default:
+			files = append(files, e)
+		}
 		return nil
 	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if opts.filter != nil {
+		needed := map[string]bool{".": true}
+		for _, f := range slices.Concat(files, links) {
+			for dir := path.Dir(f.Path); !needed[dir]; dir = path.Dir(dir) {
+				needed[dir] = true
+			}
+		}
+		dirs = slices.DeleteFunc(dirs, func(dir util.WalkEntry) bool { return !needed[dir.Path] })
+	}
+	return dirs, files, links, nil
+}
+
+// forEachConcurrently calls f for every item and its index using at most `parallelism` goroutines.
+// Each index is passed to exactly one call, so f may store a result at it without locking. All items
+// are processed even if some fail, and the errors are joined in the order of the items.
+func forEachConcurrently[T any](items []T, parallelism int, f func(int, T) error) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	errs := make([]error, len(items))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range min(parallelism, len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				errs[i] = f(i, items[i])
+			}
+		}()
+	}
+	for i := range items {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return errors.Join(errs...)
 }
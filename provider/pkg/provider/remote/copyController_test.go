@@ -5,10 +5,13 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -25,6 +28,9 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/asset"
 	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
 )
 
 // Fixture values reused across the copy controller tests.
@@ -121,7 +127,13 @@ func initCopyTest(t *testing.T) (srcDir, destDir string, sftpClient *sftp.Client
 
 	srcDirName := "src"
 	srcDir = filepath.Join(baseDir, srcDirName)
+	makeCopyTestTree(t, srcDir)
 
+	return srcDir, destDir, sftpClient
+}
+
+// makeCopyTestTree creates the source directory structure of the copy tests at srcDir.
+func makeCopyTestTree(t *testing.T, srcDir string) {
 	// Our test directory structure:
 	// file1
 	// one/
@@ -135,8 +147,6 @@ func initCopyTest(t *testing.T) (srcDir, destDir string, sftpClient *sftp.Client
 	require.NoError(t, err)
 	_, err = os.Create(filepath.Join(srcDir, "one", "two", "file3"))
 	require.NoError(t, err)
-
-	return srcDir, destDir, sftpClient
 }
 
 // assertDirectoryTree asserts that the directory structure under baseDir matches the structure
@@ -163,58 +173,58 @@ func assertDirectoryTree(t *testing.T, baseDir string) {
 func TestCopyDirectories(t *testing.T) {
 	t.Run("copy file into directory", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
-		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), destDir))
+		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), destDir, copyOptions{}))
 		assert.FileExists(t, filepath.Join(destDir, "file1"))
 	})
 
 	t.Run("copy file to file", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 		dest := filepath.Join(destDir, "remoteFile")
-		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), dest))
+		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), dest, copyOptions{}))
 		assert.FileExists(t, dest)
 	})
 
 	t.Run("copy dir recursively", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 		assertDirectoryTree(t, filepath.Join(destDir, filepath.Base(srcDir)))
 	})
 
 	t.Run("copy dir contents recursively", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
-		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", destDir, copyOptions{}))
 		assertDirectoryTree(t, destDir)
 	})
 
 	t.Run("copy dir then no-op update", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 		assertDirectoryTree(t, filepath.Join(destDir, filepath.Base(srcDir)))
 
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 	})
 
 	t.Run("don't replace file with directory", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 		assertDirectoryTree(t, filepath.Join(destDir, filepath.Base(srcDir)))
 
 		fileTwo := filepath.Join(destDir, "src", "one", "two")
 		require.NoError(t, os.RemoveAll(fileTwo))
 		require.NoError(t, os.WriteFile(fileTwo, []byte("dir turned to file"), 0o600))
 
-		require.Error(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.Error(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 	})
 
 	t.Run("wildcards are not supported", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
-		require.Error(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file*"), destDir))
+		require.Error(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file*"), destDir, copyOptions{}))
 	})
 
 	t.Run("overwrite file", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 		destFile := filepath.Join(destDir, "src", "file1")
 		assert.FileExists(t, destFile)
 
@@ -223,7 +233,7 @@ func TestCopyDirectories(t *testing.T) {
 		require.NoError(t, os.WriteFile(srcFile, []byte("new content"), 0o600))
 
 		// copy it to remote again
-		require.NoError(t, sftpCopy(sftpClient, srcFile, destFile))
+		require.NoError(t, sftpCopy(sftpClient, srcFile, destFile, copyOptions{}))
 		content, err := os.ReadFile(destFile)
 		require.NoError(t, err)
 		assert.Equal(t, "new content", string(content))
@@ -232,7 +242,7 @@ func TestCopyDirectories(t *testing.T) {
 	t.Run("overwrite file copying dir", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 		destFile := filepath.Join(destDir, "src", "file1")
 		assert.FileExists(t, destFile)
 
@@ -241,7 +251,7 @@ func TestCopyDirectories(t *testing.T) {
 		require.NoError(t, os.WriteFile(srcFile, []byte("new content"), 0o600))
 
 		// copy it to remote again
-		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{}))
 		content, err := os.ReadFile(destFile)
 		require.NoError(t, err)
 		assert.Equal(t, "new content", string(content))
@@ -251,7 +261,7 @@ func TestCopyDirectories(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 		// Copy a file to a nested path where parent dirs don't exist
 		dest := filepath.Join("a", "b", "c", "remoteFile")
-		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), dest))
+		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), dest, copyOptions{}))
 		assert.FileExists(t, filepath.Join(destDir, dest))
 	})
 
@@ -259,7 +269,7 @@ func TestCopyDirectories(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 		// Copy a directory to a nested path where parent dirs don't exist
 		dest := filepath.Join("a", "b", "c")
-		require.NoError(t, sftpCopy(sftpClient, srcDir, dest))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, dest, copyOptions{}))
 		assertDirectoryTree(t, filepath.Join(destDir, dest, filepath.Base(srcDir)))
 	})
 
@@ -267,14 +277,20 @@ func TestCopyDirectories(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 		// Copy directory contents to a nested path where parent dirs don't exist
 		dest := filepath.Join("x", "y", "z")
-		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", dest))
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", dest, copyOptions{}))
 		assertDirectoryTree(t, filepath.Join(destDir, dest))
 	})
 
+	t.Run("copy dir recursively in parallel", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		require.NoError(t, sftpCopy(sftpClient, srcDir, destDir, copyOptions{parallelism: 4}))
+		assertDirectoryTree(t, filepath.Join(destDir, filepath.Base(srcDir)))
+	})
+
 	t.Run("overwrite file copying dir contents", func(t *testing.T) {
 		srcDir, destDir, sftpClient := initCopyTest(t)
 
-		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", destDir, copyOptions{}))
 		destFile := filepath.Join(destDir, "file1")
 		assert.FileExists(t, destFile)
 
@@ -283,13 +299,353 @@ func TestCopyDirectories(t *testing.T) {
 		require.NoError(t, os.WriteFile(srcFile, []byte("new content"), 0o600))
 
 		// copy it to remote again
-		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", destDir))
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", destDir, copyOptions{}))
 		content, err := os.ReadFile(destFile)
 		require.NoError(t, err)
 		assert.Equal(t, "new content", string(content))
 	})
 }
 
+func TestAtomicCopy(t *testing.T) {
+	t.Run("atomic file copy overwrites and keeps permissions", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		dest := filepath.Join(destDir, "remoteFile")
+		require.NoError(t, os.WriteFile(dest, []byte("old content"), 0o640))
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "file1"), []byte("new content"), 0o600))
+
+		require.NoError(t, sftpCopy(sftpClient, filepath.Join(srcDir, "file1"), "remoteFile",
+			copyOptions{atomicFiles: true}))
+
+		content, err := os.ReadFile(dest)
+		require.NoError(t, err)
+		assert.Equal(t, "new content", string(content))
+		info, err := os.Stat(dest)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+
+		// The temporary file was renamed, so only the original tree and the copy remain.
+		entries, err := os.ReadDir(destDir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("atomic dir copy swaps via symlink", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		opts := copyOptions{atomicFiles: true, atomicDirs: true}
+		require.NoError(t, sftpCopy(sftpClient, srcDir, ".", opts))
+
+		dest := filepath.Join(destDir, filepath.Base(srcDir))
+		first, err := os.Readlink(dest)
+		require.NoError(t, err)
+		assertDirectoryTree(t, dest)
+
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "file1"), []byte("new content"), 0o600))
+		require.NoError(t, sftpCopy(sftpClient, srcDir, ".", opts))
+
+		second, err := os.Readlink(dest)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+		assertDirectoryTree(t, dest)
+		content, err := os.ReadFile(filepath.Join(dest, "file1"))
+		require.NoError(t, err)
+		assert.Equal(t, "new content", string(content))
+
+		// The previously staged tree was cleaned up.
+		staged, err := filepath.Glob(filepath.Join(destDir, filepath.Base(srcDir)+".*"))
+		require.NoError(t, err)
+		assert.Len(t, staged, 1)
+	})
+
+	t.Run("atomic asset archive copy swaps via symlink", func(t *testing.T) {
+		baseDir := t.TempDir()
+		destDir := filepath.Join(baseDir, "dest")
+		require.NoError(t, os.Mkdir(destDir, 0o755))
+		sftpClient := startSSHServer(t, destDir)
+
+		fileA, err := asset.FromText("alpha")
+		require.NoError(t, err)
+		arc, err := archive.FromAssets(map[string]any{aTxtFile: fileA})
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", copyOptions{atomicDirs: true}))
+
+		_, err = os.Readlink(filepath.Join(destDir, "out"))
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(destDir, "out", aTxtFile))
+		require.NoError(t, err)
+		assert.Equal(t, "alpha", string(got))
+	})
+
+	t.Run("atomic dir copy refuses to replace a directory", func(t *testing.T) {
+		srcDir, _, sftpClient := initCopyTest(t)
+		err := sftpCopy(sftpClient, srcDir+"/", ".", copyOptions{atomicDirs: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not a symlink")
+	})
+}
+
+func TestDetectDrift(t *testing.T) {
+	t.Run("manifest records copied files", func(t *testing.T) {
+		srcDir, _, sftpClient := initCopyTest(t)
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "file1"), []byte("hello, world"), 0o600))
+		opts := copyOptions{manifest: newManifest()}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", opts))
+
+		entries := opts.manifest.entries()
+		require.NotNil(t, entries)
+		assert.Len(t, *entries, 3)
+		// The SHA256 of "hello, world".
+		assert.Equal(t, "09ca7e4eaa6e8ae9c7d261167129184883644d07dfba7cbfbc4c8a2e08360d5b", (*entries)["file1"])
+	})
+
+	t.Run("manifest records the swapped path of atomic directories", func(t *testing.T) {
+		srcDir, _, sftpClient := initCopyTest(t)
+		opts := copyOptions{atomicDirs: true, manifest: newManifest()}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", "out", opts))
+
+		entries := opts.manifest.entries()
+		require.NotNil(t, entries)
+		assert.Contains(t, *entries, filepath.Join("out", "one", "two", "file3"))
+	})
+
+	t.Run("report missing and modified files", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		opts := copyOptions{manifest: newManifest()}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", opts))
+		entries := *opts.manifest.entries()
+
+		drift, err := detectDrift(sftpClient, entries, 2)
+		require.NoError(t, err)
+		assert.Empty(t, drift)
+
+		require.NoError(t, os.Remove(filepath.Join(destDir, "file1")))
+		require.NoError(t, os.WriteFile(filepath.Join(destDir, "one", "file2"), []byte("changed"), 0o600))
+
+		drift, err = detectDrift(sftpClient, entries, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"file1 (missing)", filepath.Join("one", "file2") + " (modified)"}, drift)
+	})
+
+	t.Run("unreachable host keeps the state", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		require.NoError(t, listener.Close())
+
+		_, input := createAssetInput(t)
+		input.RemotePath = "dest"
+		input.Connection = &Connection{connectionBase: connectionBase{
+			Host:           pulumi.StringRef("127.0.0.1"),
+			Port:           pulumi.Float64Ref(float64(port)),
+			User:           pulumi.StringRef("user"),
+			PerDialTimeout: pulumi.IntRef(1),
+			DialErrorLimit: pulumi.IntRef(1),
+		}}
+		drift := []string{"dest (missing)"}
+		state := CopyToRemoteOutputs{
+			CopyToRemoteInputs: *input,
+			Manifest:           &map[string]string{"dest": "09ca7e4e"},
+			Drift:              &drift,
+		}
+
+		resp, err := (&CopyToRemote{}).Read(t.Context(), infer.ReadRequest[CopyToRemoteInputs, CopyToRemoteOutputs]{
+			ID: "copy", Inputs: *input, State: state,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, state, resp.State)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	_, input := createAssetInput(t)
+	input.RemotePath = "dest"
+	diff := func(olds CopyToRemoteOutputs, news CopyToRemoteInputs) p.DiffResponse {
+		resp, err := (&CopyToRemote{}).Diff(t.Context(), infer.DiffRequest[CopyToRemoteInputs, CopyToRemoteOutputs]{
+			State: olds, Inputs: news,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		resp := diff(CopyToRemoteOutputs{CopyToRemoteInputs: *input}, *input)
+		assert.False(t, resp.HasChanges)
+	})
+
+	t.Run("drift updates the source", func(t *testing.T) {
+		drift := []string{"dest (missing)"}
+		resp := diff(CopyToRemoteOutputs{CopyToRemoteInputs: *input, Drift: &drift}, *input)
+		assert.True(t, resp.HasChanges)
+		assert.Equal(t, map[string]p.PropertyDiff{"source": {Kind: p.Update, InputDiff: true}}, resp.DetailedDiff)
+	})
+
+	t.Run("triggers replace", func(t *testing.T) {
+		news := *input
+		news.Triggers = &[]interface{}{"new"}
+		resp := diff(CopyToRemoteOutputs{CopyToRemoteInputs: *input}, news)
+		assert.True(t, resp.HasChanges)
+		assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["triggers"].Kind)
+	})
+
+	t.Run("unknown source", func(t *testing.T) {
+		news := *input
+		news.Source = types.AssetOrArchive{}
+		resp := diff(CopyToRemoteOutputs{CopyToRemoteInputs: *input}, news)
+		assert.Equal(t, map[string]p.PropertyDiff{"source": {Kind: p.Update, InputDiff: true}}, resp.DetailedDiff)
+	})
+
+	t.Run("remote path updates", func(t *testing.T) {
+		news := *input
+		news.RemotePath = "elsewhere"
+		resp := diff(CopyToRemoteOutputs{CopyToRemoteInputs: *input}, news)
+		assert.Equal(t, map[string]p.PropertyDiff{"remotePath": {Kind: p.Update, InputDiff: true}}, resp.DetailedDiff)
+	})
+}
+
+func TestFilteredCopy(t *testing.T) {
+	t.Run("copy dir with include and exclude", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		input := CopyToRemoteInputs{Include: &[]string{"one/**"}, Exclude: &[]string{"**/file3"}}
+		filter, err := input.filter()
+		require.NoError(t, err)
+
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", copyOptions{filter: filter}))
+
+		assert.FileExists(t, filepath.Join(destDir, "one", "file2"))
+		assert.NoFileExists(t, filepath.Join(destDir, "file1"))
+		// two/ only contained excluded files.
+		assert.NoDirExists(t, filepath.Join(destDir, "one", "two"))
+	})
+
+	t.Run("copy asset archive with exclude", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		fileA, err := asset.FromText("alpha")
+		require.NoError(t, err)
+		key, err := asset.FromText("secret")
+		require.NoError(t, err)
+		arc, err := archive.FromAssets(map[string]any{aTxtFile: fileA, "sub/key.pem": key})
+		require.NoError(t, err)
+		input := CopyToRemoteInputs{Exclude: &[]string{"**.pem"}}
+		filter, err := input.filter()
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", copyOptions{filter: filter}))
+
+		assert.FileExists(t, filepath.Join(destDir, "out", aTxtFile))
+		assert.NoFileExists(t, filepath.Join(destDir, "out", "sub", "key.pem"))
+	})
+}
+
+func TestSymlinkCopy(t *testing.T) {
+	// initSymlinkTest adds a link to a file and a link to a directory to the initCopyTest tree.
+	initSymlinkTest := func(t *testing.T) (srcDir, destDir string, sftpClient *sftp.Client) {
+		srcDir, destDir, sftpClient = initCopyTest(t)
+		require.NoError(t, os.Symlink("file1", filepath.Join(srcDir, "link1")))
+		require.NoError(t, os.Symlink(filepath.Join("one", "two"), filepath.Join(srcDir, "linkdir")))
+		return srcDir, destDir, sftpClient
+	}
+
+	t.Run("follow", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initSymlinkTest(t)
+
+		opts := copyOptions{symlinks: util.SymlinksFollow}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", opts))
+
+		assert.FileExists(t, filepath.Join(destDir, "one", "two", "file3"))
+		info, err := os.Lstat(filepath.Join(destDir, "link1"))
+		require.NoError(t, err)
+		assert.True(t, info.Mode().IsRegular())
+		assert.FileExists(t, filepath.Join(destDir, "linkdir", "file3"))
+	})
+
+	t.Run("preserve", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initSymlinkTest(t)
+
+		opts := copyOptions{symlinks: util.SymlinksPreserve}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", opts))
+
+		assert.FileExists(t, filepath.Join(destDir, "one", "two", "file3"))
+		for link, target := range map[string]string{"link1": "file1", "linkdir": filepath.Join("one", "two")} {
+			dest, err := os.Readlink(filepath.Join(destDir, link))
+			require.NoError(t, err)
+			// The test sFTP server makes relative targets absolute.
+			assert.True(t, strings.HasSuffix(dest, target), dest)
+		}
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initSymlinkTest(t)
+
+		opts := copyOptions{symlinks: util.SymlinksSkip}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", opts))
+
+		assertDirectoryTree(t, destDir)
+		assert.NoFileExists(t, filepath.Join(destDir, "link1"))
+		assert.NoDirExists(t, filepath.Join(destDir, "linkdir"))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		// Without a policy, links are copied like files, as before the policies existed.
+		srcDir, destDir, sftpClient := initSymlinkTest(t)
+		require.NoError(t, os.Remove(filepath.Join(srcDir, "linkdir")))
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", copyOptions{}))
+
+		info, err := os.Lstat(filepath.Join(destDir, "link1"))
+		require.NoError(t, err)
+		assert.True(t, info.Mode().IsRegular())
+
+		srcDir, _, sftpClient = initSymlinkTest(t)
+		require.Error(t, sftpCopy(sftpClient, srcDir+"/", ".", copyOptions{}))
+	})
+
+	t.Run("follow reports loops", func(t *testing.T) {
+		srcDir, _, sftpClient := initCopyTest(t)
+		require.NoError(t, os.Symlink("..", filepath.Join(srcDir, "one", "up")))
+
+		opts := copyOptions{symlinks: util.SymlinksFollow}
+		err := sftpCopy(sftpClient, srcDir+"/", ".", opts)
+		require.ErrorContains(t, err, "symlink loop")
+	})
+
+	t.Run("preserve copies loops as links", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		require.NoError(t, os.Symlink("..", filepath.Join(srcDir, "one", "up")))
+
+		opts := copyOptions{symlinks: util.SymlinksPreserve}
+		require.NoError(t, sftpCopy(sftpClient, srcDir+"/", ".", opts))
+
+		info, err := os.Lstat(filepath.Join(destDir, "one", "up"))
+		require.NoError(t, err)
+		assert.Equal(t, os.ModeSymlink, info.Mode().Type())
+	})
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	t.Run("processes every item", func(t *testing.T) {
+		items := []int{1, 2, 3, 4, 5, 6, 7}
+		var mu sync.Mutex
+		seen := map[int]bool{}
+		require.NoError(t, forEachConcurrently(items, 3, func(_ int, i int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			seen[i] = true
+			return nil
+		}))
+		assert.Len(t, seen, len(items))
+	})
+
+	t.Run("aggregates all errors", func(t *testing.T) {
+		err := forEachConcurrently([]string{"a", "b", "c"}, 2, func(_ int, s string) error {
+			if s == "b" {
+				return nil
+			}
+			return fmt.Errorf("failed %s", s)
+		})
+		require.Error(t, err)
+		assert.Equal(t, "failed a\nfailed c", err.Error())
+	})
+}
+
 func TestCheck(t *testing.T) {
 	makeNewInput := func(asset *asset.Asset, archive *archive.Archive) property.Map {
 		m := map[string]any{
@@ -363,6 +719,30 @@ func TestCheck(t *testing.T) {
 		assert.Empty(t, failures)
 	})
 
+	t.Run("invalid exclude glob", func(t *testing.T) {
+		news := makeNewInput(&asset.Asset{Path: pathToFile}, nil)
+		news = news.Set("exclude", property.New([]property.Value{property.New("[unclosed")}))
+		failures := check(news)
+		require.Len(t, failures, 1)
+		assert.Equal(t, "exclude", failures[0].Property)
+	})
+
+	t.Run("atomic requires sftp", func(t *testing.T) {
+		news := makeNewInput(&asset.Asset{Path: pathToFile}, nil)
+		news = news.Set("atomic", property.New("file")).Set("transport", property.New("scp"))
+		failures := check(news)
+		require.Len(t, failures, 1)
+		assert.Equal(t, "atomic", failures[0].Property)
+	})
+
+	t.Run("parallelism must be positive", func(t *testing.T) {
+		news := makeNewInput(&asset.Asset{Path: pathToFile}, nil)
+		news = news.Set("parallelism", property.New(0.0))
+		failures := check(news)
+		require.Len(t, failures, 1)
+		assert.Equal(t, "parallelism", failures[0].Property)
+	})
+
 	t.Run("unknown source is allowed during preview", func(t *testing.T) {
 		// When source is unknown (computed), Check should skip asset/archive validation.
 		news := property.NewMap(map[string]property.Value{
@@ -387,7 +767,7 @@ func TestCopyTextContent(t *testing.T) {
 
 		textContent := "hello from text asset"
 		destFile := "textfile.txt"
-		require.NoError(t, copyTextContent(sftpClient, textContent, destFile))
+		require.NoError(t, copyTextContent(sftpClient, textContent, destFile, copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, destFile))
 		require.NoError(t, err)
@@ -407,7 +787,7 @@ func TestCopyTextContent(t *testing.T) {
 
 		// Overwrite with text content
 		textContent := "new content from text asset"
-		require.NoError(t, copyTextContent(sftpClient, textContent, destFile))
+		require.NoError(t, copyTextContent(sftpClient, textContent, destFile, copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, destFile))
 		require.NoError(t, err)
@@ -423,7 +803,7 @@ func TestCopyTextContent(t *testing.T) {
 
 		textContent := "hello from nested text asset"
 		destFile := filepath.Join("a", "b", "c", "textfile.txt")
-		require.NoError(t, copyTextContent(sftpClient, textContent, destFile))
+		require.NoError(t, copyTextContent(sftpClient, textContent, destFile, copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, destFile))
 		require.NoError(t, err)
@@ -443,7 +823,7 @@ func TestCopyTextContent(t *testing.T) {
 
 		// Trying to copy text content to a directory should fail
 		textContent := "hello"
-		err := copyTextContent(sftpClient, textContent, subDir)
+		err := copyTextContent(sftpClient, textContent, subDir, copyOptions{})
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "is a directory")
 	})
@@ -463,7 +843,7 @@ func TestCopyAssetToRemote_RemoteAsset(t *testing.T) {
 		a, err := asset.FromURI(srvURL + "/data/file.txt")
 		require.NoError(t, err)
 
-		require.NoError(t, copyAssetToRemote(sftpClient, a, "out.txt"))
+		require.NoError(t, copyAssetToRemote(sftpClient, a, "out.txt", copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, "out.txt"))
 		require.NoError(t, err)
@@ -480,7 +860,7 @@ func TestCopyAssetToRemote_RemoteAsset(t *testing.T) {
 		a, err := asset.FromURI(srvURL + "/data/file.txt")
 		require.NoError(t, err)
 
-		require.NoError(t, copyAssetToRemote(sftpClient, a, "sub"))
+		require.NoError(t, copyAssetToRemote(sftpClient, a, "sub", copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, "sub", "file.txt"))
 		require.NoError(t, err)
@@ -504,7 +884,7 @@ func TestCopyArchiveToRemote_RemoteArchive(t *testing.T) {
 		arc, err := archive.FromURI(srvURL + "/pkgs/bundle.zip")
 		require.NoError(t, err)
 
-		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out.zip"))
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out.zip", copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, "out.zip"))
 		require.NoError(t, err)
@@ -520,7 +900,7 @@ func TestCopyArchiveToRemote_RemoteArchive(t *testing.T) {
 		arc, err := archive.FromURI(srvURL + "/pkgs/bundle.zip")
 		require.NoError(t, err)
 
-		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "."))
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, ".", copyOptions{}))
 
 		content, err := os.ReadFile(filepath.Join(destDir, "bundle.zip"))
 		require.NoError(t, err)
@@ -536,7 +916,7 @@ func TestCopyArchiveToRemote_RemoteArchive(t *testing.T) {
 		arc := &archive.Archive{URI: srvURL + "/pkgs/bundle.zip"}
 		// Override URI to a non-archive extension to force the format detection branch.
 		arc.URI = srvURL + "/data/file.txt"
-		err := copyArchiveToRemote(sftpClient, arc, "out")
+		err := copyArchiveToRemote(sftpClient, arc, "out", copyOptions{})
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "not a recognized archive format")
 	})
@@ -560,7 +940,7 @@ func TestCopyArchiveToRemote_AssetArchive(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out"))
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", copyOptions{}))
 
 		got, err := os.ReadFile(filepath.Join(destDir, "out", aTxtFile))
 		require.NoError(t, err)
@@ -583,7 +963,7 @@ func TestCopyArchiveToRemote_AssetArchive(t *testing.T) {
 		arc, err := archive.FromAssets(map[string]any{aTxtFile: fileA})
 		require.NoError(t, err)
 
-		err = copyArchiveToRemote(sftpClient, arc, "out")
+		err = copyArchiveToRemote(sftpClient, arc, "out", copyOptions{})
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "is not a directory")
 	})
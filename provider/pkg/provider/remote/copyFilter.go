@@ -0,0 +1,158 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+// copyFilter selects the files of a directory or archive to copy, as configured by `include` and
+// `exclude`. A nil *copyFilter selects everything.
+type copyFilter struct {
+	include *util.GlobRules
+	exclude *util.GlobRules
+}
+
+// filter compiles the `include` and `exclude` globs, returning nil if neither is set.
+func (c *CopyToRemoteInputs) filter() (*copyFilter, error) {
+	if c.Include == nil && c.Exclude == nil {
+		return nil, nil
+	}
+	var f copyFilter
+	if c.Include != nil {
+		rules, err := util.CompileGlobRules(*c.Include)
+		if err != nil {
+			return nil, fmt.Errorf("invalid include glob: %w", err)
+		}
+		f.include = &rules
+	}
+	if c.Exclude != nil {
+		rules, err := util.CompileGlobRules(*c.Exclude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude glob: %w", err)
+		}
+		f.exclude = &rules
+	}
+	return &f, nil
+}
+
+// includes reports whether the file at the relative path p should be copied. p may use the OS
+// path separator.
+func (f *copyFilter) includes(p string) bool {
+	if f == nil {
+		return true
+	}
+	p = filepath.ToSlash(p)
+	if f.include != nil {
+		if _, included := f.include.Match(p); !included {
+			return false
+		}
+	}
+	if f.exclude != nil {
+		if _, excluded := f.exclude.Match(p); excluded {
+			return false
+		}
+	}
+	return true
+}
+
+// effectiveFiles lists the files of the source that the filter selects, sorted, with `/` as the
+// separator. ok is false when the list can't be determined without downloading the source.
+func (c *CopyToRemoteInputs) effectiveFiles(f *copyFilter) (files []string, ok bool, err error) {
+	a := c.Source.Archive
+	if a == nil {
+		return nil, false, nil
+	}
+
+	add := func(p string) {
+		if f.includes(p) {
+			files = append(files, filepath.ToSlash(p))
+		}
+	}
+	switch {
+	case a.IsPath() && isLocalDir(a.Path):
+		err = util.WalkFiles(a.Path, c.copyOptions().symlinks, func(e util.WalkEntry) error {
+			if !e.IsDir() {
+				add(e.Path)
+			}
+			return nil
+		})
+	case a.IsPath() && c.copyOptions().extract:
+		err = listArchiveEntries(a, add)
+	case a.IsAssets():
+		err = listAssetArchive(a, add)
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	slices.Sort(files)
+	return files, true, nil
+}
+
+func listArchiveEntries(a *archive.Archive, add func(string)) error {
+	format, rc, err := a.ReadSourceArchive()
+	if err != nil || rc == nil {
+		return err
+	}
+	defer rc.Close()
+	if format == archive.NotArchive {
+		return nil
+	}
+	entries, err := newArchiveEntryReader(format, rc)
+	if err != nil {
+		return err
+	}
+	for {
+		entry, err := entries.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !entry.mode.IsDir() {
+			add(filepath.Clean(entry.name))
+		}
+	}
+}
+
+func listAssetArchive(a *archive.Archive, add func(string)) error {
+	reader, err := a.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	for {
+		name, blob, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		blob.Close()
+		add(name)
+	}
+}
@@ -19,10 +19,14 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pulumi/pulumi-go-provider/infer/types"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
 )
 
 func TestAssetHash(t *testing.T) {
@@ -83,3 +87,79 @@ func TestTextAssetHash(t *testing.T) {
 	require.NotNil(t, input.Source.Asset)
 	require.NotEmpty(t, input.hash())
 }
+
+func TestCopyOptions(t *testing.T) {
+	_, input := createAssetInput(t)
+	assert.Equal(t, copyParallelismDefault, input.copyOptions().parallelism)
+
+	input.Parallelism = pulumi.IntRef(8)
+	assert.Equal(t, 8, input.copyOptions().parallelism)
+
+	input.Parallelism = pulumi.IntRef(0)
+	assert.Equal(t, copyParallelismDefault, input.copyOptions().parallelism)
+
+	for mode, want := range map[AtomicMode]copyOptions{
+		AtomicNone:      {parallelism: copyParallelismDefault},
+		AtomicFile:      {parallelism: copyParallelismDefault, atomicFiles: true},
+		AtomicDirectory: {parallelism: copyParallelismDefault, atomicFiles: true, atomicDirs: true},
+	} {
+		input.Atomic = &mode
+		want.symlinks = util.SymlinksAsFiles
+		assert.Equal(t, want, input.copyOptions())
+	}
+
+	skip := SymlinksSkip
+	input.Symlinks = &skip
+	assert.Equal(t, util.SymlinksSkip, input.copyOptions().symlinks)
+}
+
+func TestCopyFilter(t *testing.T) {
+	input := &CopyToRemoteInputs{
+		Include: &[]string{"src/**", "!src/**.test.js"},
+		Exclude: &[]string{"**.pem", "node_modules/**"},
+	}
+	f, err := input.filter()
+	require.NoError(t, err)
+
+	assert.True(t, f.includes("src/index.js"))
+	assert.True(t, f.includes(filepath.Join("src", "lib", "util.js")))
+	assert.False(t, f.includes("src/index.test.js"))
+	assert.False(t, f.includes("src/key.pem"))
+	assert.False(t, f.includes("README.md"))
+
+	input.Include = nil
+	f, err = input.filter()
+	require.NoError(t, err)
+	assert.True(t, f.includes("README.md"))
+	assert.False(t, f.includes("node_modules/pkg/index.js"))
+
+	var none *copyFilter
+	assert.True(t, none.includes("anything"))
+
+	input.Exclude = &[]string{"[unclosed"}
+	_, err = input.filter()
+	require.Error(t, err)
+}
+
+func TestFilteredHash(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.pem"), []byte("b"), 0o600))
+	arc, err := resource.NewPathArchive(dir)
+	require.NoError(t, err)
+	input := &CopyToRemoteInputs{Source: types.AssetOrArchive{Archive: arc}}
+	unfiltered := input.hash()
+	assert.Equal(t, arc.Hash, unfiltered)
+
+	input.Exclude = &[]string{"**.pem"}
+	excludePem := input.hash()
+	assert.NotEqual(t, unfiltered, excludePem)
+
+	// A different glob that selects the same files results in the same hash.
+	input.Exclude = nil
+	input.Include = &[]string{"*.txt"}
+	assert.Equal(t, excludePem, input.hash())
+
+	input.Include = &[]string{"*"}
+	assert.NotEqual(t, excludePem, input.hash())
+}
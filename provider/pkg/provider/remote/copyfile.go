@@ -34,6 +34,8 @@ type CopyFileInputs struct {
 	Triggers   *[]interface{} `pulumi:"triggers,optional"                   providers:"replaceOnDelete"`
 	LocalPath  string         `pulumi:"localPath"`
 	RemotePath string         `pulumi:"remotePath"`
+	Transport  *Transport     `pulumi:"transport,optional"`
+	Become     *bool          `pulumi:"become,optional"`
 }
 
 // CopyFile implements Annotate which allows you to attach descriptions to the CopyFile resource's fields.
@@ -42,6 +44,10 @@ func (c *CopyFileInputs) Annotate(a infer.Annotator) {
 	a.Describe(&c.Triggers, "Trigger replacements on changes to this input.")
 	a.Describe(&c.LocalPath, "The path of the file to be copied.")
 	a.Describe(&c.RemotePath, "The destination path in the remote host.")
+	a.Describe(&c.Transport, "How the file is transferred to the remote host: "+
+		"`sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.")
+	a.Describe(&c.Become, "Write the file as root via `sudo -n`, for destinations the login user can't write to, "+
+		"such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.")
 }
 
 type CopyFileOutputs struct {
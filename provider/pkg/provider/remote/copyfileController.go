@@ -17,8 +17,7 @@ package remote
 import (
 	"context"
 	"os"
-
-	"github.com/pkg/sftp"
+	"path/filepath"
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -55,10 +54,21 @@ func (*CopyFile) Create(
 	}
 	defer client.Close()
 
-	sftp, err := sftp.NewClient(client)
+	become := input.Become != nil && *input.Become
+	transport, sftp, err := openTransport(client, input.Transport.OrDefault(), become)
 	if err != nil {
 		return infer.CreateResponse[CopyFileOutputs]{}, err
 	}
+	if sftp == nil {
+		upload := execUpload{base: filepath.Dir(input.RemotePath), entries: func(emit func(execEntry) error) error {
+			return emitFile(emit, filepath.Base(input.RemotePath), 0o644, src)
+		}}
+		if err := upload.run(client, transport, copyOptions{become: become}); err != nil {
+			return infer.CreateResponse[CopyFileOutputs]{}, err
+		}
+		id, err := resource.NewUniqueHex("", 8, 0)
+		return infer.CreateResponse[CopyFileOutputs]{ID: id, Output: CopyFileOutputs{input}}, err
+	}
 	defer sftp.Close()
 
 	dst, err := sftp.Create(input.RemotePath)
@@ -0,0 +1,310 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+const defaultDetachedStateDir = "~/.pulumi-command/jobs"
+
+// Detached configures running a command as a job that is independent of the SSH session.
+type Detached struct {
+	StateDir     *string `pulumi:"stateDir,optional"`
+	PollInterval *int    `pulumi:"pollInterval,optional"`
+}
+
+func (d *Detached) Annotate(a infer.Annotator) {
+	a.Describe(&d, "Settings for running a command as a detached job.")
+	a.Describe(&d.StateDir, `The directory on the remote host that holds a subdirectory per job, with its pid,
+exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+directory of the user. Defaults to `+"`"+defaultDetachedStateDir+"`.")
+	a.Describe(&d.PollInterval, "The number of seconds between checks whether the job finished. Defaults to 5.")
+}
+
+func (d *Detached) stateDir() string {
+	if d.StateDir == nil || *d.StateDir == "" {
+		return defaultDetachedStateDir
+	}
+	return *d.StateDir
+}
+
+func (d *Detached) pollInterval() time.Duration {
+	if d.PollInterval == nil || *d.PollInterval <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(*d.PollInterval) * time.Second
+}
+
+// detachedJob is a command started in the background with nohup and setsid, so that it keeps running
+// when the connection drops. It's polled over new sessions, redialing the connection when needed.
+type detachedJob struct {
+	conn   *Connection
+	client *ssh.Client
+	// dir is the job's state directory, quoted for the shell.
+	dir string
+	// name is the job's state directory for messages.
+	name string
+	// stdout and stderr receive the job's output, of which the first stdoutLen and stderrLen
+	// bytes were copied.
+	stdout, stderr       io.Writer
+	stdoutLen, stderrLen int64
+}
+
+// run starts cmd as a detached job using session, whose standard input is passed on to the
+// job, and waits for the job like runSession, copying its output to stdout and stderr. If the job
+// was already started by an earlier run that was interrupted, it's resumed instead: it's waited for
+// if it's still running, and its result is used if it finished. When the deployment is canceled,
+// the job keeps running, to be resumed by the next run.
+func (d *Detached) run(
+	ctx context.Context, conn *Connection, client *ssh.Client, session *ssh.Session, cmd string,
+	timeout time.Duration, stdout, stderr io.Writer,
+) error {
+	id, err := jobID(ctx, conn, client, cmd)
+	if err != nil {
+		return err
+	}
+	name := path.Join(d.stateDir(), id)
+	job := &detachedJob{conn: conn, client: client, dir: remoteDir(name), name: name, stdout: stdout, stderr: stderr}
+	defer func() {
+		// The client is closed by the caller, but not the ones redialed while polling.
+		if job.client != client {
+			job.client.Close()
+		}
+	}()
+
+	// The job runs with the login shell like a command run over SSH. Its exit code is written last,
+	// via a rename, so that it's complete once it exists.
+	script := fmt.Sprintf(`echo $$ > %[1]s/pid
+"${SHELL:-/bin/sh}" -c %[2]s < %[1]s/stdin > %[1]s/stdout 2> %[1]s/stderr
+echo $? > %[1]s/exit.tmp && mv %[1]s/exit.tmp %[1]s/exit`, job.dir, shellQuote(cmd))
+	// A job whose directory exists is resumed if it finished or is still running. Otherwise, e.g.
+	// after a reboot of the host, it's started again.
+	launch := fmt.Sprintf(`if [ -f %[1]s/exit ] || kill -0 "$(cat %[1]s/pid 2> /dev/null)" 2> /dev/null; then `+
+		`cat > /dev/null && echo resumed && exit 0; fi && `+
+		`rm -rf %[1]s && (umask 077 && mkdir -p %[1]s) && cat > %[1]s/stdin && `+
+		`if command -v setsid > /dev/null; then s=setsid; else s=; fi && `+
+		`{ nohup $s sh -c %[2]s > /dev/null 2>&1 < /dev/null & } && echo $! > %[1]s/pid`,
+		job.dir, shellQuote(script))
+
+	var launchOut, launchErr bytes.Buffer
+	session.Stdout, session.Stderr = &launchOut, &launchErr
+	if err := session.Run(launch); err != nil {
+		return fmt.Errorf("failed to start the detached job: %w\n%s", err, launchErr.String())
+	}
+	if strings.TrimSpace(launchOut.String()) == "resumed" {
+		p.GetLogger(ctx).Infof("Resuming detached job %s, which was started by an earlier run", name)
+	} else {
+		p.GetLogger(ctx).Debugf("Started detached job %s", name)
+	}
+
+	var timedOut <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timedOut = timer.C
+	}
+	ticker := time.NewTicker(d.pollInterval())
+	defer ticker.Stop()
+	for {
+		code, exited, err := job.poll(ctx)
+		if err != nil {
+			return err
+		}
+		if exited {
+			job.remove()
+			if code != 0 {
+				return &detachedExitError{code: code}
+			}
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("command canceled, detached job %s keeps running and is resumed by the next run: %w",
+				name, context.Cause(ctx))
+		case <-timedOut:
+			return job.stop(fmt.Errorf("command timed out after %s", timeout))
+		}
+	}
+}
+
+// jobID returns the id of the job running cmd on the host of conn for the resource of ctx. It's
+// derived from them, so that a run that was interrupted, e.g. because the provider was stopped, is
+// resumed by the next run. Without a resource, it's random.
+func jobID(ctx context.Context, conn *Connection, client *ssh.Client, cmd string) (string, error) {
+	urn := util.URN(ctx)
+	if urn == "" {
+		id := make([]byte, 8)
+		if _, err := rand.Read(id); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(id), nil
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s@%s:%d\x00%s", urn, client.User(), *conn.Host, int(*conn.Port), cmd)
+	return hex.EncodeToString(h.Sum(nil))[:16], nil
+}
+
+// detachedExitError is the error of a detached job that exited with a non-zero code.
+type detachedExitError struct {
+	code int
+}
+
+func (e *detachedExitError) Error() string {
+	return fmt.Sprintf("detached job exited with status %d", e.code)
+}
+
+// errDetachedJobLost is the error of a detached job that's no longer running but didn't write its
+// exit code.
+var errDetachedJobLost = errors.New("ended without an exit code, e.g. because the host rebooted")
+
+// detachedReconnectDelay is the time between the attempts to poll a detached job over a new connection.
+var detachedReconnectDelay = time.Second
+
+// poll copies the new output of the job and reports whether it exited, and with which code. If the
+// connection dropped, it's redialed, up to the dial error limit of the connection.
+func (j *detachedJob) poll(ctx context.Context) (code int, exited bool, err error) {
+	limit := j.conn.getDialErrorLimit()
+	for reconnects := 1; ; reconnects++ {
+		code, exited, err = j.pollOnce()
+		var exitErr *ssh.ExitError
+		if err == nil || errors.As(err, &exitErr) || errors.Is(err, errDetachedJobLost) {
+			return code, exited, err
+		}
+		if reachedDialingErrorLimit(reconnects, limit) {
+			return 0, false, fmt.Errorf("failed to wait for detached job %s, which keeps running, after %d reconnects: %w",
+				j.name, reconnects-1, err)
+		}
+
+		// The job doesn't depend on the connection, so resume waiting on a new one.
+		p.GetLogger(ctx).Warningf("Lost the connection while waiting for detached job %s, reconnecting: %v",
+			j.name, err)
+		select {
+		case <-ctx.Done():
+			return 0, false, ctx.Err()
+		case <-time.After(detachedReconnectDelay):
+		}
+		j.client.Close()
+		client, dialErr := j.conn.Dial(ctx)
+		if dialErr != nil {
+			return 0, false, fmt.Errorf("failed to reconnect to detached job %s, which keeps running: %w",
+				j.name, dialErr)
+		}
+		j.client = client
+	}
+}
+
+func (j *detachedJob) pollOnce() (code int, exited bool, err error) {
+	// Read the exit code before the output, so that the output is complete once the job exited. The
+	// exit code is read again after checking that the job is gone, since it may just have exited.
+	out, err := runRemote(j.client, fmt.Sprintf(`cat %[1]s/exit 2> /dev/null || `+
+		`kill -0 "$(cat %[1]s/pid 2> /dev/null)" 2> /dev/null || cat %[1]s/exit 2> /dev/null || echo lost`,
+		j.dir), nil)
+	if err != nil {
+		return 0, false, err
+	}
+	out = strings.TrimSpace(out)
+	if out == "lost" {
+		return 0, false, fmt.Errorf("detached job %s %w", j.name, errDetachedJobLost)
+	}
+	if out != "" {
+		if code, err = strconv.Atoi(out); err != nil {
+			return 0, false, fmt.Errorf("invalid exit code %q of detached job %s", out, j.name)
+		}
+		exited = true
+	}
+
+	if j.stdoutLen, err = j.copyOutput("stdout", j.stdoutLen, j.stdout); err != nil {
+		return 0, false, err
+	}
+	if j.stderrLen, err = j.copyOutput("stderr", j.stderrLen, j.stderr); err != nil {
+		return 0, false, err
+	}
+	return code, exited, nil
+}
+
+// copyOutput copies the output file of the job from offset to w, and returns the new offset.
+func (j *detachedJob) copyOutput(file string, offset int64, w io.Writer) (int64, error) {
+	session, err := j.client.NewSession()
+	if err != nil {
+		return offset, err
+	}
+	defer session.Close()
+
+	counter := &countingWriter{w: w}
+	session.Stdout = counter
+	// The file doesn't exist until the job started.
+	err = session.Run(fmt.Sprintf("tail -c +%d %s/%s 2> /dev/null || true", offset+1, j.dir, file))
+	return offset + counter.n, err
+}
+
+// stop terminates the job's process group with SIGTERM, then SIGKILL after signalGracePeriod,
+// and returns reason, after copying the job's remaining output.
+func (j *detachedJob) stop(reason error) error {
+	for _, sig := range []string{"TERM", "KILL"} {
+		cmd := fmt.Sprintf(`pid=$(cat %[1]s/pid) && { kill -%[2]s -$pid 2> /dev/null || kill -%[2]s $pid; }`,
+			j.dir, sig)
+		if _, err := runRemote(j.client, cmd, nil); err != nil {
+			// The job already exited.
+			break
+		}
+		time.Sleep(signalGracePeriod)
+		if _, exited, err := j.pollOnce(); err != nil || exited {
+			break
+		}
+	}
+	// Best effort: the connection may be gone.
+	_, _, _ = j.pollOnce()
+	j.remove()
+	return reason
+}
+
+// remove deletes the job's state directory.
+func (j *detachedJob) remove() {
+	// Best effort: a leftover directory doesn't affect other jobs.
+	_, _ = runRemote(j.client, "rm -rf "+j.dir, nil)
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
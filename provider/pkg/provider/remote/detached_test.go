@@ -0,0 +1,174 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestDetached(t *testing.T) {
+	server := newExecServer(t, t.TempDir(), "SHELL=/bin/sh")
+	run := func(t *testing.T, opts CommandOptions, cmd string) (CommandOutputs, string, error) {
+		stateDir := t.TempDir()
+		opts.Detached = &Detached{StateDir: &stateDir, PollInterval: pulumi.IntRef(1)}
+		c := CommandOutputs{CommandInputs: CommandInputs{Connection: execConnection(server), CommandOptions: opts}}
+		ctx := &testutil.TestContext{Context: context.Background()}
+		err := c.run(ctx, cmd, nil)
+		return c, stateDir, err
+	}
+
+	t.Run("output and stdin", func(t *testing.T) {
+		inputs := CommandOptions{Stdin: pulumi.StringRef("input"), Environment: map[string]string{"GREETING": "hi"}}
+		mode := EnvironmentExport
+		inputs.EnvironmentMode = &mode
+		c, stateDir, err := run(t, inputs, `sleep 1; cat; echo " $GREETING"; echo oops >&2`)
+		require.NoError(t, err)
+		assert.Equal(t, "input hi", c.Stdout)
+		assert.Equal(t, "oops", c.Stderr)
+
+		// The job's directory is removed once it finished.
+		entries, err := os.ReadDir(stateDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("exit code", func(t *testing.T) {
+		_, _, err := run(t, CommandOptions{}, `echo partial; exit 3`)
+		var cmdErr *util.CommandError
+		require.ErrorAs(t, err, &cmdErr)
+		assert.Equal(t, 3, cmdErr.ExitCode)
+		assert.ErrorContains(t, err, "failed with exit code 3")
+		assert.ErrorContains(t, err, "partial")
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		for name, finished := range map[string]bool{"running job": false, "finished job": true} {
+			t.Run(name, func(t *testing.T) {
+				stateDir := t.TempDir()
+				counter := filepath.Join(t.TempDir(), "counter")
+				c := CommandOutputs{CommandInputs: CommandInputs{
+					Connection: execConnection(server),
+					CommandOptions: CommandOptions{
+						Detached: &Detached{StateDir: &stateDir, PollInterval: pulumi.IntRef(1)},
+					},
+				}}
+				cmd := "echo started; sleep 2; echo run >> " + counter + "; echo done"
+				urnCtx := util.ContextWithURN(context.Background(), "urn:pulumi:dev::app::command:remote:Command::job")
+
+				// The deployment is canceled while waiting for the job, which keeps running.
+				canceled, cancel := context.WithTimeout(urnCtx, 1500*time.Millisecond)
+				defer cancel()
+				err := c.run(&testutil.TestContext{Context: canceled}, cmd, nil)
+				require.ErrorContains(t, err, "keeps running and is resumed by the next run")
+				if finished {
+					time.Sleep(2 * time.Second)
+				}
+
+				err = c.run(&testutil.TestContext{Context: urnCtx}, cmd, nil)
+				require.NoError(t, err)
+				assert.Equal(t, "started\ndone", c.Stdout)
+				counted, err := os.ReadFile(counter)
+				require.NoError(t, err)
+				assert.Equal(t, "run\n", string(counted), "the job ran once")
+			})
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		gracePeriod := signalGracePeriod
+		signalGracePeriod = 500 * time.Millisecond
+		t.Cleanup(func() { signalGracePeriod = gracePeriod })
+
+		marker := filepath.Join(t.TempDir(), "finished")
+		_, _, err := run(t, CommandOptions{Timeout: pulumi.IntRef(1)}, "echo started; sleep 3; touch "+marker)
+		require.ErrorContains(t, err, "command timed out after 1s")
+		assert.ErrorContains(t, err, "started")
+
+		// The job was terminated rather than left running.
+		time.Sleep(3 * time.Second)
+		assert.NoFileExists(t, marker)
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "stdout"), []byte("done"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "exit"), []byte("0\n"), 0o600))
+
+		client := startExecServer(t, t.TempDir())
+		require.NoError(t, client.Close())
+		var stdout bytes.Buffer
+		job := &detachedJob{
+			conn: execConnection(server), client: client, dir: shellQuote(dir), name: dir,
+			stdout: &stdout, stderr: &bytes.Buffer{},
+		}
+		ctx := &testutil.TestContext{Context: context.Background()}
+
+		code, exited, err := job.poll(ctx)
+		require.NoError(t, err)
+		assert.True(t, exited)
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "done", stdout.String())
+	})
+
+	t.Run("reconnect limit", func(t *testing.T) {
+		delay := detachedReconnectDelay
+		detachedReconnectDelay = 10 * time.Millisecond
+		t.Cleanup(func() { detachedReconnectDelay = delay })
+
+		// The server accepts connections, but ends every session without an exit status.
+		var sessions atomic.Int32
+		broken := testutil.NewTestSSHServer(t, func(s ssh.Session) {
+			sessions.Add(1)
+			_ = s.Close()
+		})
+		conn := execConnection(broken)
+		conn.DialErrorLimit = pulumi.IntRef(2)
+		client := startExecServer(t, t.TempDir())
+		require.NoError(t, client.Close())
+		job := &detachedJob{conn: conn, client: client, dir: "dir", name: "dir"}
+		ctx := &testutil.TestContext{Context: context.Background()}
+
+		_, _, err := job.poll(ctx)
+		require.ErrorContains(t, err, "after 2 reconnects")
+		assert.Equal(t, int32(2), sessions.Load())
+		require.NoError(t, job.client.Close())
+	})
+
+	t.Run("reconnect canceled", func(t *testing.T) {
+		client := startExecServer(t, t.TempDir())
+		require.NoError(t, client.Close())
+		job := &detachedJob{conn: execConnection(server), client: client, dir: "dir", name: "dir"}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err := job.poll(&testutil.TestContext{Context: ctx})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
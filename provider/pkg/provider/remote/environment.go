@@ -0,0 +1,130 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+type EnvironmentMode string
+
+const (
+	EnvironmentSetenv EnvironmentMode = "setenv"
+	EnvironmentExport EnvironmentMode = "export"
+	EnvironmentAuto   EnvironmentMode = "auto"
+)
+
+func (EnvironmentMode) Values() []infer.EnumValue[EnvironmentMode] {
+	return []infer.EnumValue[EnvironmentMode]{
+		{Name: string(EnvironmentSetenv), Value: EnvironmentSetenv,
+			Description: "Set the variables on the SSH session, which requires AcceptEnv on the server"},
+		{Name: string(EnvironmentExport), Value: EnvironmentExport,
+			Description: "Export the variables in the command, which requires a POSIX shell on the remote host"},
+		{Name: string(EnvironmentAuto), Value: EnvironmentAuto,
+			Description: "Set the variables on the SSH session, and export those that the server rejects"},
+	}
+}
+
+// OrDefault returns the mode, or EnvironmentSetenv if none is set.
+func (m *EnvironmentMode) OrDefault() EnvironmentMode {
+	if m == nil || *m == "" {
+		return EnvironmentSetenv
+	}
+	return *m
+}
+
+// shellNameRegexp matches the names that a POSIX shell can export.
+var shellNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// exportEnvironment returns the lines of a POSIX shell script that export env, in a stable order.
+func exportEnvironment(env map[string]string) (string, error) {
+	var script strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(env)) {
+		if !shellNameRegexp.MatchString(k) {
+			return "", fmt.Errorf("environment variable %q can't be exported by the shell: "+
+				"the name must consist of letters, digits and underscores and must not start with a digit", k)
+		}
+		fmt.Fprintf(&script, "export %s=%s\n", k, shellQuote(env[k]))
+	}
+	return script.String(), nil
+}
+
+// setenver is the part of ssh.Session that sets environment variables.
+type setenver interface {
+	Setenv(name, value string) error
+}
+
+// setEnvironment passes the environment and, unless disabled, the previous output to cmd according
+// to the environment mode. It sets the variables on the session, and returns cmd with the variables
+// to export prepended.
+func (c *CommandOutputs) setEnvironment(ctx context.Context, session setenver, cmd string) (string, error) {
+	mode := c.EnvironmentMode.OrDefault()
+	exports := map[string]string{}
+
+	// required variables fail the command if they can't be set, others are set optimistically.
+	set := func(k, v string, required bool) error {
+		if mode != EnvironmentExport {
+			err := session.Setenv(k, v)
+			switch {
+			case err == nil:
+				return nil
+			case mode == EnvironmentSetenv && required:
+				return logAndWrapSetenvErr(ctx, diag.Error, k, err)
+			case mode == EnvironmentSetenv:
+				// Warn and continue on failure.
+				//
+				//nolint:errcheck
+				logAndWrapSetenvErr(ctx, diag.Warning, k, err)
+				return nil
+			}
+		}
+		exports[k] = v
+		return nil
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(c.Environment)) {
+		if err := set(k, c.Environment[k], true); err != nil {
+			return "", err
+		}
+	}
+	if c.AddPreviousOutputInEnv == nil || *c.AddPreviousOutputInEnv {
+		if c.Stdout != "" {
+			if err := set(util.PulumiCommandStdout, c.Stdout, false); err != nil {
+				return "", err
+			}
+		}
+		if c.Stderr != "" {
+			if err := set(util.PulumiCommandStderr, c.Stderr, false); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	script, err := exportEnvironment(exports)
+	if err != nil {
+		return "", err
+	}
+	return script + cmd, nil
+}
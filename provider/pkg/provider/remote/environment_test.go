@@ -0,0 +1,104 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+// fakeSession accepts the variables in accept, like sshd's AcceptEnv, and records them.
+type fakeSession struct {
+	accept map[string]bool
+	env    map[string]string
+}
+
+func (s *fakeSession) Setenv(name, value string) error {
+	if !s.accept[name] {
+		return errors.New("ssh: setenv failed")
+	}
+	s.env[name] = value
+	return nil
+}
+
+func TestSetEnvironment(t *testing.T) {
+	setEnvironment := func(mode EnvironmentMode, env map[string]string) (*fakeSession, string, error) {
+		session := &fakeSession{accept: map[string]bool{"LANG": true}, env: map[string]string{}}
+		c := CommandOutputs{
+			CommandInputs: CommandInputs{CommandOptions: CommandOptions{Environment: env, EnvironmentMode: &mode}},
+			BaseOutputs:   BaseOutputs{Stdout: "previous"},
+		}
+		ctx := &testutil.TestContext{Context: context.Background()}
+		cmd, err := c.setEnvironment(ctx, session, "env")
+		return session, cmd, err
+	}
+	env := map[string]string{"LANG": "C", "MSG": "it's $HOME"}
+
+	t.Run("setenv", func(t *testing.T) {
+		_, _, err := setEnvironment(EnvironmentSetenv, env)
+		assert.ErrorContains(t, err, `could not set environment variable "MSG"`)
+
+		session, cmd, err := setEnvironment(EnvironmentSetenv, map[string]string{"LANG": "C"})
+		require.NoError(t, err)
+		assert.Equal(t, "env", cmd)
+		assert.Equal(t, map[string]string{"LANG": "C"}, session.env)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		session, cmd, err := setEnvironment(EnvironmentExport, env)
+		require.NoError(t, err)
+		assert.Equal(t,
+			"export LANG='C'\nexport MSG='it'\\''s $HOME'\nexport PULUMI_COMMAND_STDOUT='previous'\nenv", cmd)
+		assert.Empty(t, session.env)
+	})
+
+	t.Run("auto", func(t *testing.T) {
+		session, cmd, err := setEnvironment(EnvironmentAuto, env)
+		require.NoError(t, err)
+		assert.Equal(t, "export MSG='it'\\''s $HOME'\nexport PULUMI_COMMAND_STDOUT='previous'\nenv", cmd)
+		assert.Equal(t, map[string]string{"LANG": "C"}, session.env)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, _, err := setEnvironment(EnvironmentExport, map[string]string{"MY-VAR": "x"})
+		assert.ErrorContains(t, err, `"MY-VAR" can't be exported`)
+	})
+}
+
+func TestExportEnvironmentCommand(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	mode := EnvironmentExport
+	c := CommandOutputs{CommandInputs: CommandInputs{
+		Connection: execConnection(server),
+		CommandOptions: CommandOptions{
+			Environment:     map[string]string{"GREETING": `it's "quoted" $HOME`},
+			EnvironmentMode: &mode,
+		},
+	}}
+	ctx := &testutil.TestContext{Context: context.Background()}
+
+	require.NoError(t, c.run(ctx, `echo "$GREETING"`, nil))
+	assert.Equal(t, `it's "quoted" $HOME`, c.Stdout)
+
+	// The previous output is passed on as well.
+	require.NoError(t, c.run(ctx, `echo "$PULUMI_COMMAND_STDOUT!"`, nil))
+	assert.Equal(t, `it's "quoted" $HOME!`, c.Stdout)
+}
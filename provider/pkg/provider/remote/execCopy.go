@@ -0,0 +1,523 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+)
+
+// execEntry is a directory, file or link written by the scp and tarOverExec transports.
+type execEntry struct {
+	// name is relative to the base directory of the upload and uses `/` as the separator.
+	name string
+	// mode holds the permission bits as well as the type of the entry.
+	mode fs.FileMode
+	// linkname is the target of a symlink or, for hard links, the name of the linked entry.
+	linkname string
+	hardlink bool
+	// size is the length of body. Both transports announce it before sending the content.
+	size int64
+	body io.Reader
+}
+
+// execEntries calls emit for each entry of an upload, in order. Parent directories are emitted
+// before their contents.
+type execEntries func(emit func(execEntry) error) error
+
+// execUpload is a copy with the scp or tarOverExec transport: the entries are written below the
+// remote directory base, which is created if it doesn't exist.
+type execUpload struct {
+	base    string
+	entries execEntries
+}
+
+// run writes the upload with the given transport. Only opts.fsync and opts.become apply.
+func (u execUpload) run(client *ssh.Client, transport Transport, opts copyOptions) error {
+	if transport == TransportSCP {
+		return scpUpload(client, u, opts)
+	}
+	return tarUpload(client, u, opts)
+}
+
+// copyOverExec copies the source of input to the remote host with the scp or tarOverExec transport.
+func copyOverExec(client *ssh.Client, transport Transport, input CopyToRemoteInputs, opts copyOptions) error {
+	if opts.atomicFiles {
+		return fmt.Errorf("atomic copies require the sftp transport, but %s is used", transport)
+	}
+	upload, err := planExecUpload(client, input, opts)
+	if err != nil {
+		return err
+	}
+	return upload.run(client, transport, opts)
+}
+
+// planExecUpload determines the entries to write and where to write them, following the same rules
+// for remotePath as the sftp transport.
+func planExecUpload(client *ssh.Client, input CopyToRemoteInputs, opts copyOptions) (execUpload, error) {
+	dest := input.RemotePath
+	exists, isDir, err := remoteKind(client, dest, opts.become)
+	if err != nil {
+		return execUpload{}, err
+	}
+
+	// asFile writes a single file to dest or, if dest is a directory, to dest/sourceName.
+	asFile := func(sourceName string, perm fs.FileMode, open func() (io.ReadCloser, error)) (execUpload, error) {
+		base, name := filepath.Dir(dest), filepath.Base(dest)
+		if isDir {
+			if sourceName == "" {
+				return execUpload{}, fmt.Errorf("remote path %s is a directory; cannot determine destination filename", dest)
+			}
+			base, name = dest, sourceName
+		}
+		return execUpload{base: base, entries: func(emit func(execEntry) error) error {
+			r, err := open()
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			return emitFile(emit, filepath.ToSlash(name), perm, r)
+		}}, nil
+	}
+	asDir := func(entries execEntries) (execUpload, error) {
+		if exists && !isDir {
+			return execUpload{}, fmt.Errorf("remote path %s exists but is not a directory", dest)
+		}
+		return execUpload{base: dest, entries: entries}, nil
+	}
+	localPath := func(src string) (execUpload, error) {
+		info, err := os.Stat(src)
+		if err != nil {
+			return execUpload{}, err
+		}
+		if !info.IsDir() {
+			return asFile(filepath.Base(src), info.Mode().Perm(), func() (io.ReadCloser, error) { return os.Open(src) })
+		}
+		// As with sftpCopy, a trailing slash copies the contents of the directory.
+		prefix := ""
+		if !strings.HasSuffix(src, "/") {
+			prefix = filepath.Base(src)
+		}
+		return asDir(func(emit func(execEntry) error) error { return localDirEntries(src, prefix, opts, emit) })
+	}
+
+	if a := input.Source.Asset; a != nil {
+		switch {
+		case a.IsText():
+			if isDir {
+				return execUpload{}, fmt.Errorf(
+					"remote path %s is a directory; when using a text asset, remotePath must be a file path", dest)
+			}
+			return asFile("", 0o644, func() (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(a.Text)), nil
+			})
+		case a.IsPath():
+			return localPath(a.Path)
+		case a.IsURI():
+			return asFile(uriBasename(a.URI), 0o644, func() (io.ReadCloser, error) {
+				blob, err := a.Read()
+				if err != nil {
+					return nil, fmt.Errorf("failed to read remote asset %s: %w", a.URI, err)
+				}
+				return blob, nil
+			})
+		}
+		return execUpload{}, fmt.Errorf("asset is neither path-based, text-based, nor URI-based")
+	}
+
+	a := input.Source.Archive
+	if opts.extract && (a.IsURI() || (a.IsPath() && !isLocalDir(a.Path))) {
+		return asDir(func(emit func(execEntry) error) error { return extractedEntries(a, opts, emit) })
+	}
+	switch {
+	case a.IsPath():
+		return localPath(a.Path)
+	case a.IsURI():
+		return asFile(uriBasename(a.URI), 0o644, func() (io.ReadCloser, error) {
+			format, rc, err := a.ReadSourceArchive()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read remote archive %s: %w", a.URI, err)
+			}
+			if format == archive.NotArchive || rc == nil {
+				return nil, fmt.Errorf("URL %q is not a recognized archive format", a.URI)
+			}
+			return rc, nil
+		})
+	case a.IsAssets():
+		return asDir(func(emit func(execEntry) error) error { return assetArchiveEntries(a, opts, emit) })
+	}
+	return execUpload{}, fmt.Errorf("archive is neither path-based, URI-based, nor asset-based")
+}
+
+// localDirEntries emits the contents of the local directory src, named below prefix.
+func localDirEntries(src, prefix string, opts copyOptions, emit func(execEntry) error) error {
+	dirs, files, links, err := walkLocalDir(src, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if name := path.Join(prefix, dir.Path); name != "." {
+			if err := emit(execEntry{name: name, mode: fs.ModeDir | 0o755}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, link := range links {
+		entry := execEntry{name: path.Join(prefix, link.Path), mode: fs.ModeSymlink | 0o777, linkname: link.Target}
+		if err := emit(entry); err != nil {
+			return err
+		}
+	}
+	for _, file := range files {
+		if err := emitLocalFile(emit, path.Join(prefix, file.Path), filepath.Join(src, file.Path)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func emitLocalFile(emit func(execEntry) error, name, src string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return emitFile(emit, name, info.Mode().Perm(), f)
+}
+
+// assetArchiveEntries emits the entries of an AssetArchive selected by opts.filter.
+func assetArchiveEntries(a *resource.Archive, opts copyOptions, emit func(execEntry) error) error {
+	reader, err := a.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open asset archive: %w", err)
+	}
+	defer reader.Close()
+
+	for {
+		name, blob, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read asset archive entry: %w", err)
+		}
+		if opts.filter.includes(name) {
+			err = emitFile(emit, name, 0o644, blob)
+		}
+		blob.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractedEntries emits the members of a file or remote archive selected by opts.filter, with the
+// same checks as the extraction over sFTP.
+func extractedEntries(a *resource.Archive, opts copyOptions, emit func(execEntry) error) error {
+	entries, closer, err := openArchiveEntries(a)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	x := extractor{opts: opts, links: map[string]bool{}}
+	for {
+		entry, err := entries.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive entry: %w", err)
+		}
+
+		name, err := x.localName(entry.name)
+		if err != nil {
+			return err
+		}
+		if name == "." || (opts.filter != nil && (entry.mode.IsDir() || !opts.filter.includes(name))) {
+			continue
+		}
+		e := execEntry{name: filepath.ToSlash(name), mode: entry.mode}
+
+		switch {
+		case entry.mode.IsDir():
+			if e.mode.Perm() == 0 {
+				e.mode |= 0o755
+			}
+			err = emit(e)
+		case entry.mode&fs.ModeSymlink != 0:
+			x.links[name] = true
+			e.linkname = entry.linkname
+			err = emit(e)
+		case entry.hardlink:
+			var target string
+			if target, err = x.localName(entry.linkname); err == nil {
+				e.linkname, e.hardlink = filepath.ToSlash(target), true
+				err = emit(e)
+			}
+		case entry.mode.IsRegular():
+			perm := entry.mode.Perm()
+			if perm == 0 {
+				perm = 0o644
+			}
+			err = emitFile(emit, e.name, perm, entry.body)
+		default:
+			return fmt.Errorf("archive entry %s has unsupported type %s", entry.name, entry.mode.Type())
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// emitFile emits a regular file with the content of r. Both transports need the size up front, so
+// content of unknown size is spooled to a temporary file first.
+func emitFile(emit func(execEntry) error, name string, perm fs.FileMode, r io.Reader) error {
+	if f, ok := r.(*os.File); ok {
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		return emit(execEntry{name: name, mode: perm, size: info.Size(), body: f})
+	}
+
+	tmp, err := os.CreateTemp("", "pulumi-command-copy-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return emit(execEntry{name: name, mode: perm, size: size, body: tmp})
+}
+
+// tarUpload streams the entries of u as a tar archive to `tar -x` on the remote host.
+func tarUpload(client *ssh.Client, u execUpload, opts copyOptions) error {
+	cmd := fmt.Sprintf("mkdir -p %[1]s && tar -x -f - -C %[1]s", shellQuote(u.base))
+	if opts.fsync {
+		cmd += " && sync"
+	}
+	cmd = becomeCommand(cmd, opts.become)
+
+	r, w := io.Pipe()
+	written := make(chan error, 1)
+	go func() {
+		err := writeTar(w, u.entries)
+		w.CloseWithError(err)
+		written <- err
+	}()
+
+	_, err := runRemote(client, cmd, r)
+	// Unblock the writer in case the remote command exited early.
+	r.Close()
+	if writeErr := <-written; writeErr != nil && !errors.Is(writeErr, io.ErrClosedPipe) {
+		return writeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to copy files to %s: %w", u.base, err)
+	}
+	return nil
+}
+
+func writeTar(w io.Writer, entries execEntries) error {
+	tw := tar.NewWriter(w)
+	now := time.Now()
+	err := entries(func(e execEntry) error {
+		header := &tar.Header{Name: e.name, Mode: int64(e.mode.Perm()), ModTime: now}
+		switch {
+		case e.mode.IsDir():
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+		case e.mode&fs.ModeSymlink != 0:
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = e.linkname
+		case e.hardlink:
+			header.Typeflag = tar.TypeLink
+			header.Linkname = e.linkname
+		default:
+			header.Typeflag = tar.TypeReg
+			header.Size = e.size
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if header.Typeflag == tar.TypeReg {
+			if _, err := io.CopyN(tw, e.body, e.size); err != nil {
+				return fmt.Errorf("failed to send %s: %w", e.name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// scpUpload writes the entries of u to the sink side of the SCP protocol, `scp -t`, on the remote
+// host.
+func scpUpload(client *ssh.Client, u execUpload, opts copyOptions) error {
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+
+	cmd := fmt.Sprintf("mkdir -p %[1]s && scp -r -t %[1]s", shellQuote(u.base))
+	if opts.fsync {
+		cmd += " && sync"
+	}
+	cmd = becomeCommand(cmd, opts.become)
+	if err := session.Start(cmd); err != nil {
+		return err
+	}
+
+	sink := &scpSink{w: stdin, r: bufio.NewReader(stdout)}
+	err = sink.ack()
+	if err == nil {
+		err = u.entries(sink.write)
+	}
+	if err == nil {
+		err = sink.leave(0)
+	}
+	stdin.Close()
+	if err = errors.Join(err, session.Wait()); err != nil {
+		return fmt.Errorf("failed to copy files to %s: %w: running %q:\n%s", u.base, err, cmd, stderr.String())
+	}
+	return nil
+}
+
+// scpSink sends files to a remote `scp -t`, keeping track of the directory it's in.
+type scpSink struct {
+	w io.Writer
+	r *bufio.Reader
+	// dirs is the path of the current directory relative to the base of the upload.
+	dirs []string
+}
+
+func (s *scpSink) write(e execEntry) error {
+	if strings.Contains(e.name, "\n") {
+		return fmt.Errorf("the scp transport can't copy %q since its name contains a line break", e.name)
+	}
+	parts := strings.Split(e.name, "/")
+	switch {
+	case e.mode.IsDir():
+		return s.cd(parts, e.mode.Perm())
+	case e.mode&fs.ModeSymlink != 0 || e.hardlink:
+		return fmt.Errorf("the scp transport can't create the link %s; "+
+			"use the sftp or tarOverExec transport, or set `symlinks` to `follow` or `skip`", e.name)
+	}
+
+	if err := s.cd(parts[:len(parts)-1], 0o755); err != nil {
+		return err
+	}
+	if err := s.send(fmt.Sprintf("C%04o %d %s\n", e.mode.Perm(), e.size, parts[len(parts)-1])); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(s.w, e.body, e.size); err != nil {
+		return fmt.Errorf("failed to send %s: %w", e.name, err)
+	}
+	return s.send("\x00")
+}
+
+// cd enters the directory dir, relative to the base of the upload, leaving the current directory
+// and creating directories with the permissions perm as needed.
+func (s *scpSink) cd(dir []string, perm fs.FileMode) error {
+	common := 0
+	for common < len(s.dirs) && common < len(dir) && s.dirs[common] == dir[common] {
+		common++
+	}
+	if err := s.leave(common); err != nil {
+		return err
+	}
+	for _, name := range dir[common:] {
+		if err := s.send(fmt.Sprintf("D%04o 0 %s\n", perm, name)); err != nil {
+			return err
+		}
+		s.dirs = append(s.dirs, name)
+	}
+	return nil
+}
+
+// leave goes up until depth directories below the base of the upload.
+func (s *scpSink) leave(depth int) error {
+	for len(s.dirs) > depth {
+		if err := s.send("E\n"); err != nil {
+			return err
+		}
+		s.dirs = s.dirs[:len(s.dirs)-1]
+	}
+	return nil
+}
+
+// send sends a protocol message and waits for it to be acknowledged.
+func (s *scpSink) send(msg string) error {
+	if _, err := io.WriteString(s.w, msg); err != nil {
+		return err
+	}
+	return s.ack()
+}
+
+// ack reads the response to a message: a zero byte on success, or a non-zero status followed by an
+// error message.
+func (s *scpSink) ack() error {
+	status, err := s.r.ReadByte()
+	if err != nil {
+		return fmt.Errorf("failed to read the scp response: %w", err)
+	}
+	if status == 0 {
+		return nil
+	}
+	msg, _ := s.r.ReadString('\n')
+	return fmt.Errorf("scp: %s", strings.TrimSpace(msg))
+}
@@ -0,0 +1,376 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/sftp"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+)
+
+// archiveEntry is a single member of an archive that is unpacked on the remote host. Unlike the
+// readers in the Pulumi SDK, it carries the header information needed to preserve modes and links.
+type archiveEntry struct {
+	name string
+	// mode holds the permission bits as well as the type of the entry: a regular file, a directory
+	// or a symlink.
+	mode fs.FileMode
+	// linkname is the target of a symlink or, for hard links, the name of the linked entry.
+	linkname string
+	hardlink bool
+	body     io.Reader
+}
+
+type archiveEntryReader interface {
+	// Next returns the next entry of the archive, or io.EOF when there are no more entries. The
+	// body of an entry is only valid until the next call to Next.
+	Next() (archiveEntry, error)
+}
+
+func isLocalDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// extractArchive unpacks a file or remote archive into the remote directory destPath. Only the entries
+// selected by opts.filter are written.
+func extractArchive(sftpClient *sftp.Client, a *resource.Archive, destPath string, opts copyOptions) error {
+	entries, closer, err := openArchiveEntries(a)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	return writeIntoRemoteDirectory(sftpClient, destPath, opts, func(dir string, opts copyOptions) error {
+		x := extractor{sftpClient: sftpClient, dest: dir, opts: opts, links: map[string]bool{}, dirs: map[string]bool{}}
+		for {
+			entry, err := entries.Next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read archive entry: %w", err)
+			}
+			if err := x.write(entry); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// openArchiveEntries opens a file or remote archive for reading its entries. The returned closer
+// must be closed once done.
+func openArchiveEntries(a *resource.Archive) (archiveEntryReader, io.Closer, error) {
+	source := a.Path
+	if a.IsURI() {
+		source = a.URI
+	}
+	format, rc, err := a.ReadSourceArchive()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read archive %s: %w", source, err)
+	}
+	if format == archive.NotArchive || rc == nil {
+		return nil, nil, fmt.Errorf("%q is not a recognized archive format and cannot be extracted", source)
+	}
+
+	entries, err := newArchiveEntryReader(format, rc)
+	if err != nil {
+		rc.Close()
+		return nil, nil, fmt.Errorf("failed to read archive %s: %w", source, err)
+	}
+	return entries, rc, nil
+}
+
+// extractor writes archive entries below dest.
+type extractor struct {
+	sftpClient *sftp.Client
+	dest       string
+	opts       copyOptions
+	// links holds the symlinks created so far, relative to dest. Entries at or below a symlink are
+	// rejected since the server would follow the link and could write outside of dest.
+	links map[string]bool
+	// dirs holds the parent directories, relative to dest, that were checked not to be symlinks on
+	// the remote host.
+	dirs map[string]bool
+}
+
+func (x *extractor) write(entry archiveEntry) error {
+	name, err := x.localName(entry.name)
+	if err != nil {
+		return err
+	}
+	if name == "." {
+		return nil
+	}
+	remotePath := filepath.Join(x.dest, name)
+
+	if x.opts.filter != nil {
+		// Directories are created as needed for the selected files.
+		if entry.mode.IsDir() || !x.opts.filter.includes(name) {
+			return nil
+		}
+	}
+
+	if err := x.checkRemoteParents(name); err != nil {
+		return err
+	}
+
+	switch {
+	case entry.mode.IsDir():
+		if err := removeRemoteSymlink(x.sftpClient, remotePath); err != nil {
+			return err
+		}
+		if err := x.sftpClient.MkdirAll(remotePath); err != nil {
+			return fmt.Errorf("failed to create remote directory %s: %w", remotePath, err)
+		}
+	case entry.mode&fs.ModeSymlink != 0:
+		if err := prepareRemoteLink(x.sftpClient, remotePath); err != nil {
+			return err
+		}
+		if err := x.sftpClient.Symlink(entry.linkname, remotePath); err != nil {
+			return fmt.Errorf("failed to create remote symlink %s: %w", remotePath, err)
+		}
+		x.links[name] = true
+		return nil
+	case entry.hardlink:
+		target, err := x.localName(entry.linkname)
+		if err != nil {
+			return err
+		}
+		if err := x.checkRemoteParents(target); err != nil {
+			return err
+		}
+		if err := prepareRemoteLink(x.sftpClient, remotePath); err != nil {
+			return err
+		}
+		if err := x.sftpClient.Link(filepath.Join(x.dest, target), remotePath); err != nil {
+			return fmt.Errorf("failed to create remote hard link %s: %w", remotePath, err)
+		}
+		return nil
+	case entry.mode.IsRegular():
+		if err := removeRemoteSymlink(x.sftpClient, remotePath); err != nil {
+			return err
+		}
+		if err := writeArchiveEntry(x.sftpClient, io.NopCloser(entry.body), remotePath, x.opts); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("archive entry %s has unsupported type %s", entry.name, entry.mode.Type())
+	}
+
+	if perm := entry.mode.Perm(); perm != 0 {
+		if err := x.sftpClient.Chmod(remotePath, perm); err != nil {
+			return fmt.Errorf("failed to set permissions of %s: %w", remotePath, err)
+		}
+	}
+	return nil
+}
+
+// localName cleans the name of an archive entry and makes sure that it stays within dest.
+func (x *extractor) localName(name string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(name, "./")))
+	if !filepath.IsLocal(cleaned) && cleaned != "." {
+		return "", fmt.Errorf("archive entry %s points outside of the destination directory", name)
+	}
+	if x.links[cleaned] {
+		return "", fmt.Errorf("archive entry %s replaces the symlink %s", name, cleaned)
+	}
+	for parent := filepath.Dir(cleaned); parent != "."; parent = filepath.Dir(parent) {
+		if x.links[parent] {
+			return "", fmt.Errorf("archive entry %s is located below the symlink %s", name, parent)
+		}
+	}
+	return cleaned, nil
+}
+
+// checkRemoteParents makes sure that none of the parent directories of name below dest is an
+// existing symlink on the remote host, e.g. from an earlier copy, which the server would follow when
+// writing name.
+func (x *extractor) checkRemoteParents(name string) error {
+	var parents []string
+	for parent := filepath.Dir(name); parent != "." && !x.dirs[parent]; parent = filepath.Dir(parent) {
+		parents = append(parents, parent)
+	}
+	// Check from the top, since nothing below a missing directory exists.
+	for i := len(parents) - 1; i >= 0; i-- {
+		remotePath := filepath.Join(x.dest, parents[i])
+		info, err := x.sftpClient.Lstat(remotePath)
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to stat remote path %s: %w", remotePath, err)
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("archive entry %s is located below the existing remote symlink %s", name, remotePath)
+		}
+		x.dirs[parents[i]] = true
+	}
+	return nil
+}
+
+// removeRemoteSymlink removes an existing symlink at remotePath, e.g. from an earlier copy, so that
+// writing a file or creating a directory there doesn't follow it outside of the destination.
+func removeRemoteSymlink(sftpClient *sftp.Client, remotePath string) error {
+	info, err := sftpClient.Lstat(remotePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat remote path %s: %w", remotePath, err)
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		return nil
+	}
+	if err := sftpClient.Remove(remotePath); err != nil {
+		return fmt.Errorf("failed to remove remote symlink %s: %w", remotePath, err)
+	}
+	return nil
+}
+
+// prepareRemoteLink removes an existing file at remotePath so that a link can be created in its
+// place, and ensures that the parent directories exist.
+func prepareRemoteLink(sftpClient *sftp.Client, remotePath string) error {
+	if err := sftpClient.MkdirAll(filepath.Dir(remotePath)); err != nil {
+		return fmt.Errorf("failed to create parent directories for %s: %w", remotePath, err)
+	}
+	info, err := sftpClient.Lstat(remotePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat remote path %s: %w", remotePath, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("remote path %s is a directory and cannot be replaced by a link", remotePath)
+	}
+	if err := sftpClient.Remove(remotePath); err != nil {
+		return fmt.Errorf("failed to remove remote path %s: %w", remotePath, err)
+	}
+	return nil
+}
+
+func newArchiveEntryReader(format archive.Format, r io.Reader) (archiveEntryReader, error) {
+	switch format {
+	case archive.TarArchive:
+		return &tarEntryReader{tr: tar.NewReader(r)}, nil
+	case archive.TarGZIPArchive:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return &tarEntryReader{tr: tar.NewReader(gz)}, nil
+	case archive.ZIPArchive, archive.JARArchive:
+		// The ZIP reader requires random access, which we only get for local files. Anything else is
+		// buffered in memory, as the Pulumi SDK does.
+		var ra io.ReaderAt
+		var size int64
+		if f, ok := r.(*os.File); ok {
+			info, err := f.Stat()
+			if err != nil {
+				return nil, err
+			}
+			ra, size = f, info.Size()
+		} else {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			ra, size = bytes.NewReader(data), int64(len(data))
+		}
+		zr, err := zip.NewReader(ra, size)
+		if err != nil {
+			return nil, err
+		}
+		return &zipEntryReader{zr: zr}, nil
+	}
+	return nil, fmt.Errorf("unsupported archive format %v", format)
+}
+
+type tarEntryReader struct {
+	tr *tar.Reader
+}
+
+func (r *tarEntryReader) Next() (archiveEntry, error) {
+	for {
+		header, err := r.tr.Next()
+		if err != nil {
+			return archiveEntry{}, err
+		}
+		entry := archiveEntry{name: header.Name, mode: header.FileInfo().Mode(), body: r.tr}
+		switch header.Typeflag {
+		case tar.TypeXGlobalHeader:
+			// PAX global headers, e.g. from `git archive`, carry no file.
+			continue
+		case tar.TypeSymlink:
+			entry.linkname = header.Linkname
+		case tar.TypeLink:
+			entry.linkname = header.Linkname
+			entry.hardlink = true
+		}
+		return entry, nil
+	}
+}
+
+type zipEntryReader struct {
+	zr    *zip.Reader
+	index int
+	open  io.ReadCloser
+}
+
+func (r *zipEntryReader) Next() (archiveEntry, error) {
+	if r.open != nil {
+		r.open.Close()
+		r.open = nil
+	}
+	if r.index >= len(r.zr.File) {
+		return archiveEntry{}, io.EOF
+	}
+	file := r.zr.File[r.index]
+	r.index++
+
+	entry := archiveEntry{name: file.Name, mode: file.Mode()}
+	if entry.mode.IsDir() {
+		return entry, nil
+	}
+	body, err := file.Open()
+	if err != nil {
+		return archiveEntry{}, fmt.Errorf("failed to read ZIP entry %s: %w", file.Name, err)
+	}
+	r.open = body
+	entry.body = body
+	if entry.mode&fs.ModeSymlink != 0 {
+		// ZIP archives store the target of a symlink as its content.
+		target, err := io.ReadAll(body)
+		if err != nil {
+			return archiveEntry{}, fmt.Errorf("failed to read ZIP entry %s: %w", file.Name, err)
+		}
+		entry.linkname = string(target)
+	}
+	return entry, nil
+}
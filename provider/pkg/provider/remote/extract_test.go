@@ -0,0 +1,226 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+)
+
+// makeTgzFile writes a gzipped tarball with the given headers to a temporary file and returns its
+// path. Regular files get their name as content.
+func makeTgzFile(t *testing.T, headers []*tar.Header) string {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, h := range headers {
+		if h.Typeflag == tar.TypeReg {
+			h.Size = int64(len(h.Name))
+		}
+		require.NoError(t, tw.WriteHeader(h))
+		if h.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(h.Name))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	path := filepath.Join(t.TempDir(), "bundle.tgz")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	return path
+}
+
+func TestExtractArchive(t *testing.T) {
+	extractOpts := copyOptions{extract: true}
+
+	t.Run("extract tgz preserving modes and symlinks", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		path := makeTgzFile(t, []*tar.Header{
+			{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0o755},
+			{Name: "bin/run.sh", Typeflag: tar.TypeReg, Mode: 0o750},
+			{Name: "conf.txt", Typeflag: tar.TypeReg, Mode: 0o600},
+			{Name: "run", Typeflag: tar.TypeSymlink, Linkname: "bin/run.sh"},
+		})
+		arc, err := archive.FromPath(path)
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", extractOpts))
+
+		info, err := os.Stat(filepath.Join(destDir, "out", "bin", "run.sh"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o750), info.Mode().Perm())
+		content, err := os.ReadFile(filepath.Join(destDir, "out", "conf.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "conf.txt", string(content))
+		// The test server resolves symlink targets against its working directory.
+		target, err := os.Readlink(filepath.Join(destDir, "out", "run"))
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(target, "bin/run.sh"), target)
+	})
+
+	t.Run("extract remote zip", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		zipBytes := makeZipBytes(t, map[string][]byte{
+			aTxtFile:    []byte("a"),
+			"sub/b.txt": []byte("b"),
+		})
+		srvURL := startStaticServer(t, map[string][]byte{"/pkgs/bundle.zip": zipBytes})
+		arc, err := archive.FromURI(srvURL + "/pkgs/bundle.zip")
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", extractOpts))
+
+		content, err := os.ReadFile(filepath.Join(destDir, "out", "sub", "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(content))
+	})
+
+	t.Run("extract selected entries only", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		path := makeTgzFile(t, []*tar.Header{
+			{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0o755},
+			{Name: "bin/run.sh", Typeflag: tar.TypeReg, Mode: 0o750},
+			{Name: "docs/", Typeflag: tar.TypeDir, Mode: 0o755},
+			{Name: "docs/README", Typeflag: tar.TypeReg, Mode: 0o644},
+		})
+		arc, err := archive.FromPath(path)
+		require.NoError(t, err)
+		input := CopyToRemoteInputs{Include: &[]string{"bin/**"}}
+		filter, err := input.filter()
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", copyOptions{extract: true, filter: filter}))
+
+		assert.FileExists(t, filepath.Join(destDir, "out", "bin", "run.sh"))
+		assert.NoDirExists(t, filepath.Join(destDir, "out", "docs"))
+	})
+
+	t.Run("directories are copied as usual", func(t *testing.T) {
+		srcDir, destDir, sftpClient := initCopyTest(t)
+		arc, err := archive.FromPath(srcDir)
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, ".", extractOpts))
+		assertDirectoryTree(t, filepath.Join(destDir, filepath.Base(srcDir)))
+	})
+
+	t.Run("reject entries outside of the destination", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		path := makeTgzFile(t, []*tar.Header{
+			{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0o644},
+		})
+		arc, err := archive.FromPath(path)
+		require.NoError(t, err)
+
+		err = copyArchiveToRemote(sftpClient, arc, "out", extractOpts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "outside of the destination")
+		assert.NoFileExists(t, filepath.Join(destDir, "evil"))
+	})
+
+	t.Run("reject entries below symlinks", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		path := makeTgzFile(t, []*tar.Header{
+			{Name: "escape", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			{Name: "escape/evil", Typeflag: tar.TypeReg, Mode: 0o644},
+		})
+		arc, err := archive.FromPath(path)
+		require.NoError(t, err)
+
+		err = copyArchiveToRemote(sftpClient, arc, "out", extractOpts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "below the symlink")
+		assert.NoFileExists(t, filepath.Join(destDir, "evil"))
+	})
+
+	t.Run("reject entries replacing symlinks", func(t *testing.T) {
+		outside := filepath.Join(t.TempDir(), "passwd")
+		require.NoError(t, os.WriteFile(outside, []byte("root"), 0o600))
+		for name, entry := range map[string]*tar.Header{
+			"file": {Name: "x", Typeflag: tar.TypeReg, Mode: 0o777},
+			"dir":  {Name: "x/", Typeflag: tar.TypeDir, Mode: 0o777},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, sftpClient := initCopyTest(t)
+				path := makeTgzFile(t, []*tar.Header{
+					{Name: "x", Typeflag: tar.TypeSymlink, Linkname: outside},
+					entry,
+				})
+				arc, err := archive.FromPath(path)
+				require.NoError(t, err)
+
+				err = copyArchiveToRemote(sftpClient, arc, "out", extractOpts)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "replaces the symlink")
+				content, err := os.ReadFile(outside)
+				require.NoError(t, err)
+				assert.Equal(t, "root", string(content))
+				info, err := os.Stat(outside)
+				require.NoError(t, err)
+				assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+			})
+		}
+	})
+
+	t.Run("replace existing remote symlinks", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		outside := filepath.Join(t.TempDir(), "passwd")
+		require.NoError(t, os.WriteFile(outside, []byte("root"), 0o600))
+		require.NoError(t, os.MkdirAll(filepath.Join(destDir, "out"), 0o755))
+		require.NoError(t, os.Symlink(outside, filepath.Join(destDir, "out", "x")))
+		path := makeTgzFile(t, []*tar.Header{
+			{Name: "x", Typeflag: tar.TypeReg, Mode: 0o644},
+		})
+		arc, err := archive.FromPath(path)
+		require.NoError(t, err)
+
+		require.NoError(t, copyArchiveToRemote(sftpClient, arc, "out", extractOpts))
+
+		content, err := os.ReadFile(outside)
+		require.NoError(t, err)
+		assert.Equal(t, "root", string(content))
+		info, err := os.Lstat(filepath.Join(destDir, "out", "x"))
+		require.NoError(t, err)
+		assert.True(t, info.Mode().IsRegular())
+	})
+
+	t.Run("reject entries below existing remote symlinks", func(t *testing.T) {
+		_, destDir, sftpClient := initCopyTest(t)
+		elsewhere := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(destDir, "out"), 0o755))
+		require.NoError(t, os.Symlink(elsewhere, filepath.Join(destDir, "out", "sub")))
+		path := makeTgzFile(t, []*tar.Header{
+			{Name: "sub/file", Typeflag: tar.TypeReg, Mode: 0o644},
+		})
+		arc, err := archive.FromPath(path)
+		require.NoError(t, err)
+
+		err = copyArchiveToRemote(sftpClient, arc, "out", extractOpts)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "below the existing remote symlink")
+		assert.NoFileExists(t, filepath.Join(elsewhere, "file"))
+	})
+}
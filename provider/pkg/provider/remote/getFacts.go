@@ -0,0 +1,74 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// This is the type that implements the GetFacts function methods.
+// The methods are declared in the getFactsController.go file.
+type GetFacts struct{}
+
+func (f *GetFacts) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Gathers facts about a remote host, like its operating system, architecture and resources.\n"+
+		"Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are "+
+		"unset, and the reason is in `errors`, so that a partial result is returned rather than an error.")
+}
+
+type GetFactsInputs struct {
+	Connection *Connection `pulumi:"connection" provider:"secret"`
+}
+
+func (f *GetFactsInputs) Annotate(a infer.Annotator) {
+	a.Describe(&f.Connection, "The parameters with which to connect to the remote host.")
+}
+
+type GetFactsOutputs struct {
+	Os               *string           `pulumi:"os,optional"`
+	OsFamily         *string           `pulumi:"osFamily,optional"`
+	Distro           *string           `pulumi:"distro,optional"`
+	DistroVersion    *string           `pulumi:"distroVersion,optional"`
+	Kernel           *string           `pulumi:"kernel,optional"`
+	Arch             *string           `pulumi:"arch,optional"`
+	CPUCount         *int              `pulumi:"cpuCount,optional"`
+	MemoryMb         *int              `pulumi:"memoryMb,optional"`
+	Hostname         *string           `pulumi:"hostname,optional"`
+	IPAddresses      *[]string         `pulumi:"ipAddresses,optional"`
+	Shell            *string           `pulumi:"shell,optional"`
+	InitSystem       *string           `pulumi:"initSystem,optional"`
+	PasswordlessSudo *bool             `pulumi:"passwordlessSudo,optional"`
+	Errors           map[string]string `pulumi:"errors"`
+}
+
+func (f *GetFactsOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&f.Os, "The operating system as reported by `uname -s` in lower case, e.g. `linux` or `darwin`.")
+	a.Describe(&f.OsFamily, "The family of the distribution, e.g. `debian` or `rhel`, which is the first entry "+
+		"of `ID_LIKE` in `/etc/os-release`, or `ID` if it's not set. Outside of Linux, the same as `os`.")
+	a.Describe(&f.Distro, "The distribution, e.g. `ubuntu`, which is `ID` in `/etc/os-release`, or `macos`.")
+	a.Describe(&f.DistroVersion, "The version of the distribution, e.g. `24.04`.")
+	a.Describe(&f.Kernel, "The kernel release as reported by `uname -r`.")
+	a.Describe(&f.Arch, "The machine architecture as reported by `uname -m`, e.g. `x86_64` or `aarch64`.")
+	a.Describe(&f.CPUCount, "The number of online CPUs.")
+	a.Describe(&f.MemoryMb, "The total memory in MiB.")
+	a.Describe(&f.Hostname, "The host name of the host.")
+	a.Describe(&f.IPAddresses, "The IP addresses of the host, without loopback and link-local addresses.")
+	a.Describe(&f.Shell, "The login shell of the user.")
+	a.Describe(&f.InitSystem, "The init system, `systemd`, `openrc`, `launchd` or `sysvinit`.")
+	a.Describe(&f.PasswordlessSudo, "If the user can run commands with sudo without a password.")
+	a.Describe(&f.Errors, "The errors of the probes that failed, keyed by the name of the probe: `uname` for "+
+		"`os`, `kernel` and `arch`, `osRelease` for `osFamily`, `distro` and `distroVersion`, and otherwise the name "+
+		"of the fact.")
+}
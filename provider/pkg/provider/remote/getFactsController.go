@@ -0,0 +1,219 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Invoke connects to the host and runs the probes, returning the facts of the ones that
+// succeeded.
+func (*GetFacts) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetFactsInputs],
+) (infer.FunctionResponse[GetFactsOutputs], error) {
+	client, err := req.Input.Connection.Dial(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetFactsOutputs]{}, err
+	}
+	defer client.Close()
+
+	facts := gatherFacts(func(cmd string) (string, error) {
+		return runRemote(client, cmd, nil)
+	})
+	return infer.FunctionResponse[GetFactsOutputs]{Output: facts}, nil
+}
+
+// factProbe is a command that gathers one or more facts, and parses its output into them.
+type factProbe struct {
+	name  string
+	cmd   string
+	parse func(out string, f *GetFactsOutputs) error
+}
+
+var factProbes = []factProbe{
+	{
+		name: "uname",
+		cmd:  "uname -s && uname -r && uname -m",
+		parse: func(out string, f *GetFactsOutputs) error {
+			lines := strings.Split(strings.TrimSpace(out), "\n")
+			if len(lines) != 3 {
+				return fmt.Errorf("unexpected output %q", out)
+			}
+			os := strings.ToLower(strings.TrimSpace(lines[0]))
+			kernel, arch := strings.TrimSpace(lines[1]), strings.TrimSpace(lines[2])
+			f.Os, f.Kernel, f.Arch = &os, &kernel, &arch
+			return nil
+		},
+	},
+	{
+		name: "osRelease",
+		cmd: `if [ -r /etc/os-release ]; then cat /etc/os-release
+elif [ -r /usr/lib/os-release ]; then cat /usr/lib/os-release
+elif command -v sw_vers > /dev/null; then printf 'ID=macos\nVERSION_ID=%s\n' "$(sw_vers -productVersion)"
+else echo 'no os-release file' >&2; exit 1; fi`,
+		parse: func(out string, f *GetFactsOutputs) error {
+			release := parseOsRelease(out)
+			id := release["ID"]
+			if id == "" {
+				return fmt.Errorf("no ID in os-release")
+			}
+			family := id
+			if like := strings.Fields(release["ID_LIKE"]); len(like) > 0 {
+				family = like[0]
+			}
+			f.Distro, f.OsFamily = &id, &family
+			if version := release["VERSION_ID"]; version != "" {
+				f.DistroVersion = &version
+			}
+			return nil
+		},
+	},
+	{
+		name: "cpuCount",
+		cmd:  "getconf _NPROCESSORS_ONLN 2> /dev/null || nproc 2> /dev/null || sysctl -n hw.ncpu",
+		parse: func(out string, f *GetFactsOutputs) error {
+			count, err := strconv.Atoi(strings.TrimSpace(out))
+			if err != nil {
+				return err
+			}
+			f.CPUCount = &count
+			return nil
+		},
+	},
+	{
+		name: "memoryMb",
+		cmd: `if [ -r /proc/meminfo ]; then awk '/^MemTotal:/ { print $2 * 1024 }' /proc/meminfo
+else sysctl -n hw.memsize 2> /dev/null || sysctl -n hw.physmem; fi`,
+		parse: func(out string, f *GetFactsOutputs) error {
+			bytes, err := strconv.ParseFloat(strings.TrimSpace(out), 64)
+			if err != nil {
+				return err
+			}
+			mb := int(bytes / (1 << 20))
+			f.MemoryMb = &mb
+			return nil
+		},
+	},
+	{
+		name: "hostname",
+		cmd:  "hostname 2> /dev/null || uname -n",
+		parse: func(out string, f *GetFactsOutputs) error {
+			hostname := strings.TrimSpace(out)
+			f.Hostname = &hostname
+			return nil
+		},
+	},
+	{
+		name: "ipAddresses",
+		cmd: `if command -v ip > /dev/null; then ip -o addr show | awk '{ split($4, a, "/"); print a[1] }'
+else ifconfig | awk '$1 == "inet" || $1 == "inet6" { print $2 }'; fi`,
+		parse: func(out string, f *GetFactsOutputs) error {
+			addresses := []string{}
+			for _, field := range strings.Fields(out) {
+				// ifconfig appends the zone to IPv6 addresses, e.g. fe80::1%lo0.
+				field, _, _ = strings.Cut(field, "%")
+				ip := net.ParseIP(field)
+				if ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+					continue
+				}
+				addresses = append(addresses, ip.String())
+			}
+			f.IPAddresses = &addresses
+			return nil
+		},
+	},
+	{
+		name: "shell",
+		cmd:  `getent passwd "$(id -un)" 2> /dev/null | cut -d: -f7 | grep . || echo "$SHELL"`,
+		parse: func(out string, f *GetFactsOutputs) error {
+			shell := strings.TrimSpace(out)
+			if shell == "" {
+				return fmt.Errorf("no login shell")
+			}
+			f.Shell = &shell
+			return nil
+		},
+	},
+	{
+		name: "initSystem",
+		cmd: `if [ -d /run/systemd/system ]; then echo systemd
+elif command -v launchctl > /dev/null; then echo launchd
+elif command -v openrc > /dev/null || command -v rc-service > /dev/null; then echo openrc
+elif [ -d /etc/init.d ]; then echo sysvinit
+else echo 'unknown init system' >&2; exit 1; fi`,
+		parse: func(out string, f *GetFactsOutputs) error {
+			initSystem := strings.TrimSpace(out)
+			f.InitSystem = &initSystem
+			return nil
+		},
+	},
+	{
+		name: "passwordlessSudo",
+		// A missing sudo or a prompt for the password isn't a failure of the probe.
+		cmd: "if sudo -n true > /dev/null 2>&1; then echo true; else echo false; fi",
+		parse: func(out string, f *GetFactsOutputs) error {
+			sudo, err := strconv.ParseBool(strings.TrimSpace(out))
+			if err != nil {
+				return err
+			}
+			f.PasswordlessSudo = &sudo
+			return nil
+		},
+	},
+}
+
+// gatherFacts runs the probes with run, which runs a command on the host and returns its stdout.
+func gatherFacts(run func(cmd string) (string, error)) GetFactsOutputs {
+	facts := GetFactsOutputs{Errors: map[string]string{}}
+	for _, probe := range factProbes {
+		out, err := run(probe.cmd)
+		if err == nil {
+			err = probe.parse(out, &facts)
+		}
+		if err != nil {
+			facts.Errors[probe.name] = err.Error()
+		}
+	}
+	// The distribution is only a family of Linux, e.g. macOS has an ID but no family.
+	if facts.Os != nil && *facts.Os != "linux" {
+		facts.OsFamily = facts.Os
+	}
+	return facts
+}
+
+// parseOsRelease parses the variables of an os-release file, removing their quotes.
+func parseOsRelease(content string) map[string]string {
+	vars := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok || strings.HasPrefix(key, "#") {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else {
+			value = strings.Trim(value, `"'`)
+		}
+		vars[key] = value
+	}
+	return vars
+}
@@ -0,0 +1,103 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestGatherFacts(t *testing.T) {
+	outputs := map[string]string{
+		"uname":       "Linux\n6.8.0-45-generic\nx86_64\n",
+		"osRelease":   "NAME=\"Rocky Linux\"\nID=\"rocky\"\nID_LIKE=\"rhel centos fedora\"\nVERSION_ID=\"9.4\"\n",
+		"cpuCount":    "4\n",
+		"memoryMb":    "8141361152\n",
+		"ipAddresses": "127.0.0.1\n10.0.0.5\n::1\nfe80::5054:ff:fe12:3456\n2001:db8::5\n",
+	}
+	facts := gatherFacts(func(cmd string) (string, error) {
+		for _, probe := range factProbes {
+			if probe.cmd == cmd {
+				if out, ok := outputs[probe.name]; ok {
+					return out, nil
+				}
+			}
+		}
+		return "", errors.New("command not found")
+	})
+
+	assert.Equal(t, "linux", *facts.Os)
+	assert.Equal(t, "6.8.0-45-generic", *facts.Kernel)
+	assert.Equal(t, "x86_64", *facts.Arch)
+	assert.Equal(t, "rocky", *facts.Distro)
+	assert.Equal(t, "rhel", *facts.OsFamily)
+	assert.Equal(t, "9.4", *facts.DistroVersion)
+	assert.Equal(t, 4, *facts.CPUCount)
+	assert.Equal(t, 7764, *facts.MemoryMb)
+	assert.Equal(t, []string{"10.0.0.5", "2001:db8::5"}, *facts.IPAddresses)
+
+	// The facts of the probes that failed are unset.
+	assert.Nil(t, facts.Hostname)
+	assert.Nil(t, facts.PasswordlessSudo)
+	assert.Equal(t, map[string]string{
+		"hostname":         "command not found",
+		"shell":            "command not found",
+		"initSystem":       "command not found",
+		"passwordlessSudo": "command not found",
+	}, facts.Errors)
+}
+
+func TestParseOsRelease(t *testing.T) {
+	release := parseOsRelease("# comment\nID=debian\nPRETTY_NAME=\"Debian GNU/Linux 12 (bookworm)\"\n" +
+		"VERSION_CODENAME='bookworm'\n\n")
+	assert.Equal(t, map[string]string{
+		"ID":               "debian",
+		"PRETTY_NAME":      "Debian GNU/Linux 12 (bookworm)",
+		"VERSION_CODENAME": "bookworm",
+	}, release)
+}
+
+func TestGetFacts(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	resp, err := (&GetFacts{}).Invoke(ctx, infer.FunctionRequest[GetFactsInputs]{
+		Input: GetFactsInputs{Connection: execConnection(server)},
+	})
+	require.NoError(t, err)
+	facts := resp.Output
+
+	require.NotNil(t, facts.Os, facts.Errors)
+	assert.Equal(t, runtime.GOOS, *facts.Os)
+	require.NotNil(t, facts.CPUCount, facts.Errors)
+	assert.Positive(t, *facts.CPUCount)
+	require.NotNil(t, facts.Hostname, facts.Errors)
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	assert.Equal(t, strings.ToLower(hostname), strings.ToLower(*facts.Hostname))
+	require.NotNil(t, facts.MemoryMb, facts.Errors)
+	assert.Positive(t, *facts.MemoryMb)
+	assert.NotNil(t, facts.PasswordlessSudo)
+}
@@ -0,0 +1,61 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// This is the type that implements the GetHostKeys function methods.
+// The methods are declared in the getHostKeysController.go file.
+type GetHostKeys struct{}
+
+func (f *GetHostKeys) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of "+
+		"a connection.\n"+
+		"It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and "+
+		"the `hostKey` of the connection is ignored. The proxy of the connection is used if set.")
+}
+
+type GetHostKeysInputs struct {
+	Connection *Connection `pulumi:"connection" provider:"secret"`
+}
+
+func (f *GetHostKeysInputs) Annotate(a infer.Annotator) {
+	a.Describe(&f.Connection, "The parameters with which to connect to the remote host.")
+}
+
+type GetHostKeysOutputs struct {
+	Keys []HostKey `pulumi:"keys"`
+}
+
+func (f *GetHostKeysOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&f.Keys, "The host keys that the host offers, one for each type.")
+}
+
+// HostKey is a public key that a host offers to identify itself.
+type HostKey struct {
+	Type        string `pulumi:"type"`
+	Key         string `pulumi:"key"`
+	Fingerprint string `pulumi:"fingerprint"`
+}
+
+func (k *HostKey) Annotate(a infer.Annotator) {
+	a.Describe(&k, "A public key that a host offers to identify itself.")
+	a.Describe(&k.Type, "The type of the key, e.g. `ssh-ed25519`.")
+	a.Describe(&k.Key, "The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the "+
+		"`hostKey` of a connection.")
+	a.Describe(&k.Fingerprint, "The SHA256 fingerprint of the key, e.g. `SHA256:...`.")
+}
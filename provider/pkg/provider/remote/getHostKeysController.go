@@ -0,0 +1,120 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// hostKeyAlgorithms are the host key algorithms to ask for in each handshake, one handshake
+// for each type of key. The server picks the first one it supports.
+var hostKeyAlgorithms = [][]string{
+	{ssh.KeyAlgoED25519},
+	{ssh.KeyAlgoECDSA256},
+	{ssh.KeyAlgoECDSA384},
+	{ssh.KeyAlgoECDSA521},
+	{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA},
+}
+
+// errHostKeyRecorded aborts a handshake once the host key is recorded.
+var errHostKeyRecorded = errors.New("host key recorded")
+
+// Invoke performs a handshake with the host for each type of key and returns the keys it offered.
+func (*GetHostKeys) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetHostKeysInputs],
+) (infer.FunctionResponse[GetHostKeysOutputs], error) {
+	conn := req.Input.Connection
+	endpoint := net.JoinHostPort(*conn.Host, fmt.Sprintf("%d", int(*conn.Port)))
+	timeout := time.Duration(*conn.PerDialTimeout) * time.Second
+	dial := func() (net.Conn, error) {
+		return net.DialTimeout("tcp", endpoint, timeout)
+	}
+	if conn.Proxy != nil {
+		proxyClient, err := conn.dialProxy(ctx)
+		if err != nil {
+			return infer.FunctionResponse[GetHostKeysOutputs]{}, err
+		}
+		defer proxyClient.Close()
+		dial = func() (net.Conn, error) {
+			return proxyClient.Dial("tcp", endpoint)
+		}
+	}
+
+	keys := []HostKey{}
+	for _, algorithms := range hostKeyAlgorithms {
+		key, err := dialWithRetry(ctx, "Dial", conn.getDialErrorLimit(), func() (ssh.PublicKey, error) {
+			return scanHostKey(dial, endpoint, timeout, algorithms)
+		})
+		if err != nil {
+			return infer.FunctionResponse[GetHostKeysOutputs]{}, err
+		}
+		if key == nil {
+			continue
+		}
+		keys = append(keys, HostKey{
+			Type:        key.Type(),
+			Key:         strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))),
+			Fingerprint: ssh.FingerprintSHA256(key),
+		})
+	}
+	if len(keys) == 0 {
+		return infer.FunctionResponse[GetHostKeysOutputs]{},
+			fmt.Errorf("%s offered no host key of a supported type", endpoint)
+	}
+	return infer.FunctionResponse[GetHostKeysOutputs]{Output: GetHostKeysOutputs{Keys: keys}}, nil
+}
+
+// scanHostKey performs a handshake asking for one of the host key algorithms and returns the
+// host key, or nil if the host offers none of them.
+func scanHostKey(
+	dial func() (net.Conn, error), endpoint string, timeout time.Duration, algorithms []string,
+) (ssh.PublicKey, error) {
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	var hostKey ssh.PublicKey
+	_, _, _, err = ssh.NewClientConn(conn, endpoint, &ssh.ClientConfig{
+		User:              "none",
+		HostKeyAlgorithms: algorithms,
+		HostKeyCallback: func(_ string, _ net.Addr, key ssh.PublicKey) error {
+			hostKey = key
+			return errHostKeyRecorded
+		},
+	})
+	switch {
+	case hostKey != nil:
+		return hostKey, nil
+	case err != nil && strings.Contains(err.Error(), "no common algorithm for host key"):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	default:
+		return nil, errors.New("the handshake completed without a host key")
+	}
+}
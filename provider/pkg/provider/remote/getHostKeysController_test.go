@@ -0,0 +1,101 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xssh "golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestGetHostKeys(t *testing.T) {
+	ctx := &testutil.TestContext{Context: context.Background()}
+	getHostKeys := func(conn *Connection) ([]HostKey, error) {
+		resp, err := (&GetHostKeys{}).Invoke(ctx, infer.FunctionRequest[GetHostKeysInputs]{
+			Input: GetHostKeysInputs{Connection: conn},
+		})
+		return resp.Output.Keys, err
+	}
+
+	t.Run("every type", func(t *testing.T) {
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		server := &ssh.Server{Handler: func(ssh.Session) {}}
+		var signers []xssh.Signer
+		for _, key := range []any{edKey, ecKey} {
+			signer, err := xssh.NewSignerFromKey(key)
+			require.NoError(t, err)
+			server.AddHostKey(signer)
+			signers = append(signers, signer)
+		}
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(func() { _ = server.Close() })
+
+		conn := execConnection(testutil.TestSSHServer{
+			Host: "127.0.0.1",
+			Port: int64(listener.Addr().(*net.TCPAddr).Port),
+		})
+		keys, err := getHostKeys(conn)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		for i, signer := range signers {
+			assert.Equal(t, signer.PublicKey().Type(), keys[i].Type)
+			assert.Equal(t, xssh.FingerprintSHA256(signer.PublicKey()), keys[i].Fingerprint)
+		}
+	})
+
+	t.Run("pin", func(t *testing.T) {
+		server := newExecServer(t, t.TempDir())
+		keys, err := getHostKeys(execConnection(server))
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "ssh-rsa", keys[0].Type)
+
+		// The key is accepted as the host key of a connection.
+		conn := execConnection(server)
+		conn.HostKey = pulumi.StringRef(keys[0].Key)
+		client, err := conn.Dial(ctx)
+		require.NoError(t, err)
+		require.NoError(t, client.Close())
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		server := newExecServer(t, t.TempDir())
+		proxy := testutil.NewForwardingTestSSHServer(t, func(ssh.Session) {})
+		conn := execConnection(server)
+		conn.Proxy = &ProxyConnection{connectionBase: execConnection(proxy).connectionBase}
+		keys, err := getHostKeys(conn)
+		require.NoError(t, err)
+		assert.Len(t, keys, 1)
+	})
+}
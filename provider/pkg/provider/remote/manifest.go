@@ -0,0 +1,135 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/sftp"
+)
+
+// manifest records the SHA256 hash of every file written to the remote host, keyed by remote path.
+// It's safe for concurrent use.
+type manifest struct {
+	mu     sync.Mutex
+	hashes map[string]string
+}
+
+func newManifest() *manifest {
+	return &manifest{hashes: map[string]string{}}
+}
+
+func (m *manifest) record(remotePath string, h hash.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hashes[remotePath] = hex.EncodeToString(h.Sum(nil))
+}
+
+// rebase moves all entries below the directory from to the same relative path below to.
+func (m *manifest) rebase(from, to string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for p, h := range m.hashes {
+		if rel, err := filepath.Rel(from, p); err == nil && filepath.IsLocal(rel) {
+			delete(m.hashes, p)
+			m.hashes[filepath.Join(to, rel)] = h
+		}
+	}
+}
+
+func (m *manifest) entries() *map[string]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.hashes) == 0 {
+		return nil
+	}
+	entries := make(map[string]string, len(m.hashes))
+	for p, h := range m.hashes {
+		entries[p] = h
+	}
+	return &entries
+}
+
+// detectDrift compares the files on the remote host with the hashes in entries and returns a
+// description of each file that's missing or has been modified, sorted by path.
+func detectDrift(sftpClient *sftp.Client, entries map[string]string, parallelism int) ([]string, error) {
+	paths := make([]string, 0, len(entries))
+	for p := range entries {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+
+	drift := make([]string, len(paths))
+	err := forEachConcurrently(paths, parallelism, func(i int, p string) error {
+		sum, err := remoteFileHash(sftpClient, p)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			drift[i] = p + " (missing)"
+		case err != nil:
+			return err
+		case sum != entries[p]:
+			drift[i] = p + " (modified)"
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(drift, func(s string) bool { return s == "" }), nil
+}
+
+// remoteFileHash returns the hex-encoded SHA256 hash of the remote file at p. Paths that aren't
+// regular files are reported as os.ErrNotExist.
+func remoteFileHash(sftpClient *sftp.Client, p string) (string, error) {
+	info, err := sftpClient.Stat(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to stat remote path %s: %w", p, err)
+	}
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("%s is not a regular file: %w", p, os.ErrNotExist)
+	}
+
+	f, err := sftpClient.Open(p)
+	if err != nil {
+		return "", fmt.Errorf("failed to open remote file %s: %w", p, err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := f.WriteTo(h); err != nil {
+		return "", fmt.Errorf("failed to read remote file %s: %w", p, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// driftSummary shortens a list of drifted files for log messages.
+func driftSummary(drift []string) string {
+	const maxListed = 5
+	if len(drift) <= maxListed {
+		return strings.Join(drift, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(drift[:maxListed], ", "), len(drift)-maxListed)
+}
@@ -0,0 +1,188 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/common"
+)
+
+type MultiCommand struct{}
+
+var _ = (infer.Annotated)((*MultiCommand)(nil))
+
+// MultiCommand implements Annotate which allows you to attach descriptions to the MultiCommand resource.
+func (c *MultiCommand) Annotate(a infer.Annotator) {
+	a.Describe(&c, `Runs the same command on several remote hosts, e.g. a fleet of identical servers. The
+hosts are given either as 'connections', or as 'hosts' that share the settings of 'connection'. The command
+is run on 'batchSize' hosts at a time, and stops after more than 'maxFailures' hosts failed. The output and
+exit code of each host is available via the 'results' property.
+
+On update, the 'delete' command is run on the hosts that were removed and the 'create' command on the hosts
+that were added or that it didn't succeed on yet. The 'update' command, or 'create' if it's not set, is run on
+the other hosts only if the command's inputs or the host's connection changed, or if it failed on the host
+before. The output of each host is logged prefixed with the host.`)
+}
+
+// The arguments for a remote MultiCommand resource.
+type MultiCommandInputs struct {
+	common.ResourceInputs
+	Connections *[]Connection       `pulumi:"connections,optional" provider:"secret"`
+	Hosts       *[]string           `pulumi:"hosts,optional"`
+	Connection  *ConnectionTemplate `pulumi:"connection,optional"  provider:"secret"`
+	BatchSize   *int                `pulumi:"batchSize,optional"`
+	MaxFailures *int                `pulumi:"maxFailures,optional"`
+	CommandOptions
+}
+
+func (c *MultiCommandInputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Connections, `The connections to the hosts to run the command on. Each host may only be
+listed once. Either 'connections' or 'hosts' must be set.`)
+	a.Describe(&c.Hosts, `The addresses of the hosts to run the command on, which are connected to with the
+settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+must be set.`)
+	a.Describe(&c.Connection, "The connection settings shared by the hosts in 'hosts'.")
+	a.Describe(&c.BatchSize, `The number of hosts the command is run on at the same time. The next batch of
+hosts starts once all hosts of the batch finished. Defaults to all hosts at once.`)
+	a.Describe(&c.MaxFailures, `The number of hosts the command may fail on. Once more hosts failed, no further
+batches are started and the resource fails. Defaults to 0.`)
+}
+
+// The properties for a remote MultiCommand resource.
+type MultiCommandOutputs struct {
+	MultiCommandInputs
+	Results map[string]HostResult `pulumi:"results"`
+}
+
+func (c *MultiCommandOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Results, `The result of the last command run on each host, keyed by the host's address,
+followed by ':' and the port if that's not 22. A host that the command hasn't been run on yet, e.g. because an
+earlier batch failed, has no result.`)
+}
+
+// HostResult is the result of running a command on one of the hosts of a MultiCommand.
+type HostResult struct {
+	BaseOutputs
+	ExitCode int     `pulumi:"exitCode"`
+	Error    *string `pulumi:"error,optional"`
+	Created  bool    `pulumi:"created"`
+}
+
+func (r *HostResult) Annotate(a infer.Annotator) {
+	a.Describe(&r, "The result of running a command on a host.")
+	a.Describe(&r.ExitCode, `The exit code of the command's process, or -1 if the command didn't exit, e.g.
+because the host couldn't be reached.`)
+	a.Describe(&r.Error, `Why the command failed on the host, including its output. Unset if the command
+succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.`)
+	a.Describe(&r.Created, `Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+than 'update' is run on the host on update.`)
+}
+
+// ConnectionTemplate holds the connection settings that are shared by several hosts.
+type ConnectionTemplate struct {
+	User               *string          `pulumi:"user,optional"`
+	Password           *string          `pulumi:"password,optional"           provider:"secret"`
+	Port               *float64         `pulumi:"port,optional"`
+	PrivateKey         *string          `pulumi:"privateKey,optional"         provider:"secret"`
+	PrivateKeyPassword *string          `pulumi:"privateKeyPassword,optional" provider:"secret"`
+	AgentSocketPath    *string          `pulumi:"agentSocketPath,optional"`
+	DialErrorLimit     *int             `pulumi:"dialErrorLimit,optional"`
+	PerDialTimeout     *int             `pulumi:"perDialTimeout,optional"`
+	Proxy              *ProxyConnection `pulumi:"proxy,optional"`
+}
+
+func (c *ConnectionTemplate) Annotate(a infer.Annotator) {
+	a.Describe(&c, "Instructions for how to connect to several remote endpoints with the same settings.")
+	a.Describe(&c.User, "The user that we should use for the connection.")
+	a.SetDefault(&c.User, "root")
+	a.Describe(&c.Password, "The password we should use for the connection.")
+	a.Describe(&c.Port, "The port to connect to. Defaults to 22.")
+	a.SetDefault(&c.Port, 22)
+	a.Describe(
+		&c.PrivateKey,
+		"The contents of an SSH key to use for the connection. This takes preference over the password if provided.",
+	)
+	a.Describe(&c.PrivateKeyPassword, "The password to use in case the private key is encrypted.")
+	a.Describe(&c.AgentSocketPath, "SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.")
+	a.Describe(
+		&c.DialErrorLimit,
+		"Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.",
+	)
+	a.Describe(&c.Proxy, "The connection settings for the bastion/proxy host.")
+	a.SetDefault(&c.DialErrorLimit, dialErrorDefault)
+	a.Describe(
+		&c.PerDialTimeout,
+		"Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.",
+	)
+	a.SetDefault(&c.PerDialTimeout, 15)
+}
+
+// forHost returns the connection to host with the settings of c, which may be nil, and the
+// defaults for the settings that aren't set.
+func (c *ConnectionTemplate) forHost(host string) *Connection {
+	conn := &Connection{}
+	if c != nil {
+		conn.connectionBase = connectionBase{
+			User:               c.User,
+			Password:           c.Password,
+			Port:               c.Port,
+			PrivateKey:         c.PrivateKey,
+			PrivateKeyPassword: c.PrivateKeyPassword,
+			AgentSocketPath:    c.AgentSocketPath,
+			DialErrorLimit:     c.DialErrorLimit,
+			PerDialTimeout:     c.PerDialTimeout,
+		}
+		conn.Proxy = c.Proxy
+	}
+	address, port, err := splitHostPort(host)
+	if err == nil && port != nil {
+		conn.Port = port
+	}
+	conn.Host = &address
+	if conn.User == nil {
+		user := "root"
+		conn.User = &user
+	}
+	if conn.Port == nil {
+		port := 22.0
+		conn.Port = &port
+	}
+	if conn.PerDialTimeout == nil {
+		timeout := 15
+		conn.PerDialTimeout = &timeout
+	}
+	return conn
+}
+
+// splitHostPort splits an entry of 'hosts' into the address and, if the entry has one, the port.
+func splitHostPort(host string) (string, *float64, error) {
+	address, portStr, err := net.SplitHostPort(host)
+	if err != nil {
+		// No port, e.g. "10.0.0.5" or "::1".
+		return host, nil, nil
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return host, nil, fmt.Errorf("invalid port %q of host %s", portStr, host)
+	}
+	p := float64(port)
+	return address, &p, nil
+}
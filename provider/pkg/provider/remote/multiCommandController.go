@@ -0,0 +1,336 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"reflect"
+	"strconv"
+	"sync"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+var _ = (infer.CustomResource[MultiCommandInputs, MultiCommandOutputs])((*MultiCommand)(nil))
+var _ = (infer.CustomUpdate[MultiCommandInputs, MultiCommandOutputs])((*MultiCommand)(nil))
+var _ = (infer.CustomCheck[MultiCommandInputs])((*MultiCommand)(nil))
+var _ = (infer.CustomDelete[MultiCommandOutputs])((*MultiCommand)(nil))
+
+// Check validates the inputs beyond their types.
+func (*MultiCommand) Check(
+	ctx context.Context, req infer.CheckRequest,
+) (infer.CheckResponse[MultiCommandInputs], error) {
+	inputs, failures, err := infer.DefaultCheck[MultiCommandInputs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[MultiCommandInputs]{Inputs: inputs, Failures: failures}, err
+	}
+
+	switch {
+	case inputs.Connections == nil && inputs.Hosts == nil:
+		failures = append(failures, p.CheckFailure{
+			Property: "hosts",
+			Reason:   "either 'connections' or 'hosts' must be set",
+		})
+	case inputs.Connections != nil && inputs.Hosts != nil:
+		failures = append(failures, p.CheckFailure{
+			Property: "hosts",
+			Reason:   "only one of 'connections' and 'hosts' can be set",
+		})
+	case inputs.Connections != nil && inputs.Connection != nil:
+		failures = append(failures, p.CheckFailure{
+			Property: "connection",
+			Reason:   "'connection' only applies to 'hosts', set the settings of each of the 'connections' instead",
+		})
+	default:
+		seen := map[string]bool{}
+		for _, host := range inputs.hosts() {
+			if inputs.Hosts != nil {
+				if _, _, err := splitHostPort(host); err != nil {
+					failures = append(failures, p.CheckFailure{Property: "hosts", Reason: err.Error()})
+				}
+			}
+			if seen[host] {
+				failures = append(failures, p.CheckFailure{
+					Property: "hosts",
+					Reason:   fmt.Sprintf("host %s is listed more than once", host),
+				})
+			}
+			seen[host] = true
+		}
+	}
+	if inputs.BatchSize != nil && *inputs.BatchSize < 1 {
+		failures = append(failures, p.CheckFailure{
+			Property: "batchSize",
+			Reason:   "batchSize must be at least 1",
+		})
+	}
+	if inputs.MaxFailures != nil && *inputs.MaxFailures < 0 {
+		failures = append(failures, p.CheckFailure{
+			Property: "maxFailures",
+			Reason:   "maxFailures can't be negative",
+		})
+	}
+	failures = append(failures, inputs.check()...)
+	return infer.CheckResponse[MultiCommandInputs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// Create runs the create command on all hosts.
+func (*MultiCommand) Create(
+	ctx context.Context,
+	req infer.CreateRequest[MultiCommandInputs],
+) (infer.CreateResponse[MultiCommandOutputs], error) {
+	input := req.Inputs
+	state := MultiCommandOutputs{MultiCommandInputs: input, Results: map[string]HostResult{}}
+	id, err := resource.NewUniqueHex(req.Name, 8, 0)
+	if err != nil {
+		return infer.CreateResponse[MultiCommandOutputs]{ID: "", Output: state}, err
+	}
+	if req.DryRun {
+		return infer.CreateResponse[MultiCommandOutputs]{ID: id, Output: state}, nil
+	}
+
+	var runs []hostRun
+	for _, host := range input.hosts() {
+		runs = append(runs, hostRun{host: host, inputs: &input, cmd: input.Create, create: true})
+	}
+	err = state.runRolling(ctx, runs)
+	return infer.CreateResponse[MultiCommandOutputs]{ID: id, Output: state}, err
+}
+
+// Update runs the delete command on the hosts that were removed, the create command on the hosts
+// that were added, and the update command on the hosts whose command or connection changed.
+func (*MultiCommand) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[MultiCommandInputs, MultiCommandOutputs],
+) (infer.UpdateResponse[MultiCommandOutputs], error) {
+	olds := req.State
+	news := req.Inputs
+	state := MultiCommandOutputs{MultiCommandInputs: news, Results: maps.Clone(olds.Results)}
+	if state.Results == nil {
+		state.Results = map[string]HostResult{}
+	}
+	if req.DryRun {
+		return infer.UpdateResponse[MultiCommandOutputs]{Output: state}, nil
+	}
+
+	update := news.Update
+	if update == nil {
+		update = news.Create
+	}
+	oldConns := olds.connections()
+	newConns := news.connections()
+	commandChanged := !reflect.DeepEqual(olds.shared(), news.shared())
+
+	var runs []hostRun
+	// Remove the hosts that are gone first, e.g. to free up resources that the new hosts take over.
+	for _, host := range olds.hosts() {
+		if _, ok := newConns[host]; !ok {
+			if _, ran := olds.Results[host]; ran {
+				runs = append(runs, hostRun{host: host, inputs: &olds.MultiCommandInputs, cmd: olds.Delete, remove: true})
+			}
+		}
+	}
+	for _, host := range news.hosts() {
+		result, ran := olds.Results[host]
+		switch {
+		case !ran || !result.Created:
+			runs = append(runs, hostRun{host: host, inputs: &news, cmd: news.Create, create: true})
+		case commandChanged || result.Error != nil || !reflect.DeepEqual(oldConns[host], newConns[host]):
+			runs = append(runs, hostRun{host: host, inputs: &news, cmd: update})
+		}
+	}
+	err := state.runRolling(ctx, runs)
+	return infer.UpdateResponse[MultiCommandOutputs]{Output: state}, err
+}
+
+// Delete runs the delete command on all hosts that a command was run on.
+func (*MultiCommand) Delete(
+	ctx context.Context, req infer.DeleteRequest[MultiCommandOutputs],
+) (infer.DeleteResponse, error) {
+	state := req.State
+	if state.Delete == nil {
+		return infer.DeleteResponse{}, nil
+	}
+	var runs []hostRun
+	for _, host := range state.hosts() {
+		if _, ran := state.Results[host]; ran {
+			runs = append(runs, hostRun{host: host, inputs: &state.MultiCommandInputs, cmd: state.Delete, remove: true})
+		}
+	}
+	return infer.DeleteResponse{}, state.runRolling(ctx, runs)
+}
+
+// hosts returns the keys of the hosts in the order they're listed. The key of a host is its
+// address, followed by its port if that's not 22.
+func (c *MultiCommandInputs) hosts() []string {
+	if c.Hosts != nil {
+		return *c.Hosts
+	}
+	var hosts []string
+	if c.Connections != nil {
+		for i := range *c.Connections {
+			hosts = append(hosts, hostKey(&(*c.Connections)[i]))
+		}
+	}
+	return hosts
+}
+
+// connections returns the connections to the hosts by their keys.
+func (c *MultiCommandInputs) connections() map[string]*Connection {
+	conns := map[string]*Connection{}
+	if c.Hosts != nil {
+		for _, host := range *c.Hosts {
+			conns[host] = c.Connection.forHost(host)
+		}
+	} else if c.Connections != nil {
+		for i := range *c.Connections {
+			conn := &(*c.Connections)[i]
+			conns[hostKey(conn)] = conn
+		}
+	}
+	return conns
+}
+
+func hostKey(conn *Connection) string {
+	if conn.Host == nil {
+		return ""
+	}
+	if conn.Port == nil || *conn.Port == 22 {
+		return *conn.Host
+	}
+	return net.JoinHostPort(*conn.Host, strconv.Itoa(int(*conn.Port)))
+}
+
+// shared returns the inputs that apply to all hosts, which rerun the command on all hosts when
+// they change.
+func (c MultiCommandInputs) shared() MultiCommandInputs {
+	c.Connections, c.Hosts, c.Connection, c.BatchSize, c.MaxFailures = nil, nil, nil, nil, nil
+	return c
+}
+
+// hostRun is a command to run on one of the hosts of a MultiCommand.
+type hostRun struct {
+	host   string
+	inputs *MultiCommandInputs
+	// cmd is the command to run, or nil to only record the host as run.
+	cmd *string
+	// create marks the host as created once cmd succeeded.
+	create bool
+	// remove drops the host's result once cmd succeeded.
+	remove bool
+}
+
+// runRolling runs the commands on batchSize hosts at a time, and records their results in c.
+// After a batch, it stops if more than maxFailures commands failed.
+func (c *MultiCommandOutputs) runRolling(ctx context.Context, runs []hostRun) error {
+	batchSize := len(runs)
+	if c.BatchSize != nil && *c.BatchSize > 0 {
+		batchSize = *c.BatchSize
+	}
+	maxFailures := 0
+	if c.MaxFailures != nil {
+		maxFailures = *c.MaxFailures
+	}
+
+	var failed []error
+	for start := 0; start < len(runs); start += batchSize {
+		batch := runs[start:min(start+batchSize, len(runs))]
+		if len(runs) > batchSize {
+			p.GetLogger(ctx).InfoStatusf("Running on hosts %d-%d of %d", start+1, start+len(batch), len(runs))
+		}
+
+		results := make([]HostResult, len(batch))
+		var wg sync.WaitGroup
+		for i, run := range batch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = run.run(ctx, c.Results[run.host])
+			}()
+		}
+		wg.Wait()
+
+		for i, run := range batch {
+			result := results[i]
+			if result.Error != nil {
+				failed = append(failed, fmt.Errorf("%s: %s", run.host, *result.Error))
+			}
+			if run.remove && result.Error == nil {
+				delete(c.Results, run.host)
+			} else {
+				c.Results[run.host] = result
+			}
+		}
+
+		if len(failed) > maxFailures {
+			return fmt.Errorf("the command failed on %d hosts, more than the %d allowed, so %d hosts weren't run: %w",
+				len(failed), maxFailures, len(runs)-start-len(batch), errors.Join(failed...))
+		}
+	}
+	for _, err := range failed {
+		p.GetLogger(ctx).Warningf("The command failed on host %v", err)
+	}
+	return nil
+}
+
+// run runs the command on the host, with the previous result's output in the environment. The
+// output is logged prefixed with the host.
+func (r hostRun) run(ctx context.Context, previous HostResult) HostResult {
+	result := r.runCommand(ctx, previous)
+	result.Created = previous.Created || (r.create && result.Error == nil)
+	return result
+}
+
+func (r hostRun) runCommand(ctx context.Context, previous HostResult) HostResult {
+	if r.cmd == nil {
+		return HostResult{}
+	}
+	conn, ok := r.inputs.connections()[r.host]
+	if !ok {
+		msg := "no connection to the host"
+		return HostResult{ExitCode: -1, Error: &msg}
+	}
+	cmd := CommandOutputs{
+		CommandInputs: CommandInputs{
+			ResourceInputs: r.inputs.ResourceInputs,
+			Connection:     conn,
+			CommandOptions: r.inputs.CommandOptions,
+		},
+		BaseOutputs: previous.BaseOutputs,
+	}
+	if err := cmd.runWithLabel(ctx, *r.cmd, r.inputs.Logging, "["+r.host+"] "); err != nil {
+		msg := err.Error()
+		exitCode, _ := exitStatus(err)
+		result := HostResult{ExitCode: exitCode, Error: &msg}
+		// The output of a failed command is only known from the lines kept for the error.
+		var cmdErr *util.CommandError
+		if errors.As(err, &cmdErr) {
+			result.Stdout, result.Stderr = cmdErr.Stdout.String(), cmdErr.Stderr.String()
+			if r.inputs.RedactOutputs != nil && *r.inputs.RedactOutputs {
+				result.Stdout, result.Stderr = cmdErr.Redactor.Redact(result.Stdout), cmdErr.Redactor.Redact(result.Stderr)
+			}
+		}
+		return result
+	}
+	return HostResult{BaseOutputs: cmd.BaseOutputs}
+}
@@ -0,0 +1,246 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/common"
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+// multiCommandHost is a test server that runs commands in dir with NAME set to name.
+type multiCommandHost struct {
+	name string
+	dir  string
+	conn Connection
+	key  string
+}
+
+func newMultiCommandHosts(t *testing.T, names ...string) []multiCommandHost {
+	hosts := make([]multiCommandHost, 0, len(names))
+	for _, name := range names {
+		dir := t.TempDir()
+		conn := execConnection(newExecServer(t, dir, "NAME="+name))
+		hosts = append(hosts, multiCommandHost{name: name, dir: dir, conn: *conn, key: hostKey(conn)})
+	}
+	return hosts
+}
+
+func multiCommandConnections(hosts ...multiCommandHost) *[]Connection {
+	conns := make([]Connection, 0, len(hosts))
+	for _, host := range hosts {
+		conns = append(conns, host.conn)
+	}
+	return &conns
+}
+
+func TestMultiCommand(t *testing.T) {
+	hosts := newMultiCommandHosts(t, "a", "b", "c")
+	ctx := &testutil.TestContext{Context: context.Background()}
+	inputs := MultiCommandInputs{
+		ResourceInputs: common.ResourceInputs{
+			Create: pulumi.StringRef(`echo "$NAME"; echo created >> log`),
+			Update: pulumi.StringRef(`echo "updated $PULUMI_COMMAND_STDOUT"; echo updated >> log`),
+			Delete: pulumi.StringRef(`echo deleted >> log`),
+		},
+		Connections: multiCommandConnections(hosts[0], hosts[1]),
+		BatchSize:   pulumi.IntRef(1),
+	}
+
+	created, err := (&MultiCommand{}).Create(ctx, infer.CreateRequest[MultiCommandInputs]{Name: "name", Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]HostResult{
+		hosts[0].key: {BaseOutputs: BaseOutputs{Stdout: "a"}, Created: true},
+		hosts[1].key: {BaseOutputs: BaseOutputs{Stdout: "b"}, Created: true},
+	}, created.Output.Results)
+	// The output of each host is prefixed with the host.
+	var logged []string
+	for _, m := range ctx.Messages {
+		logged = append(logged, m.Msg)
+	}
+	assert.Contains(t, logged, "["+hosts[0].key+"] a")
+	assert.Contains(t, logged, "["+hosts[1].key+"] b")
+
+	// Adding a host only runs the create command on it.
+	news := inputs
+	news.Connections = multiCommandConnections(hosts[1], hosts[2])
+	updated, err := (&MultiCommand{}).Update(ctx, infer.UpdateRequest[MultiCommandInputs, MultiCommandOutputs]{
+		Inputs: news,
+		State:  created.Output,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]HostResult{
+		hosts[1].key: {BaseOutputs: BaseOutputs{Stdout: "b"}, Created: true},
+		hosts[2].key: {BaseOutputs: BaseOutputs{Stdout: "c"}, Created: true},
+	}, updated.Output.Results)
+	assertLog(t, hosts[0], "created", "deleted")
+	assertLog(t, hosts[1], "created")
+	assertLog(t, hosts[2], "created")
+
+	// Changing the command reruns it on all hosts.
+	news.Environment = map[string]string{"FOO": "bar"}
+	updated, err = (&MultiCommand{}).Update(ctx, infer.UpdateRequest[MultiCommandInputs, MultiCommandOutputs]{
+		Inputs: news,
+		State:  updated.Output,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "updated b", updated.Output.Results[hosts[1].key].Stdout)
+	assert.Equal(t, "updated c", updated.Output.Results[hosts[2].key].Stdout)
+
+	_, err = (&MultiCommand{}).Delete(ctx, infer.DeleteRequest[MultiCommandOutputs]{State: updated.Output})
+	require.NoError(t, err)
+	assertLog(t, hosts[0], "created", "deleted")
+	assertLog(t, hosts[1], "created", "updated", "deleted")
+	assertLog(t, hosts[2], "created", "updated", "deleted")
+}
+
+func TestMultiCommandFailures(t *testing.T) {
+	hosts := newMultiCommandHosts(t, "a", "b", "c")
+	ctx := &testutil.TestContext{Context: context.Background()}
+	inputs := MultiCommandInputs{
+		ResourceInputs: common.ResourceInputs{
+			Create: pulumi.StringRef(`echo created >> log; echo "$NAME"; test "$NAME" != a || exit 3`),
+			Update: pulumi.StringRef(`echo updated >> log`),
+		},
+		Connections: multiCommandConnections(hosts...),
+		BatchSize:   pulumi.IntRef(2),
+	}
+
+	t.Run("stops after maxFailures", func(t *testing.T) {
+		created, err := (&MultiCommand{}).Create(ctx, infer.CreateRequest[MultiCommandInputs]{Name: "name", Inputs: inputs})
+		require.ErrorContains(t, err, "the command failed on 1 hosts, more than the 0 allowed, so 1 hosts weren't run")
+		assert.ErrorContains(t, err, hosts[0].key)
+
+		results := created.Output.Results
+		require.Len(t, results, 2)
+		assert.Equal(t, 3, results[hosts[0].key].ExitCode)
+		require.NotNil(t, results[hosts[0].key].Error)
+		assert.Equal(t, "a", results[hosts[0].key].Stdout, "the output of a failed host is kept")
+		assert.False(t, results[hosts[0].key].Created)
+		assert.Equal(t, HostResult{BaseOutputs: BaseOutputs{Stdout: "b"}, Created: true}, results[hosts[1].key])
+		assert.NoFileExists(t, filepath.Join(hosts[2].dir, "log"))
+
+		// The create command is run again on the failed host and run on the ones that weren't run.
+		updated, err := (&MultiCommand{}).Update(ctx, infer.UpdateRequest[MultiCommandInputs, MultiCommandOutputs]{
+			Inputs: inputs,
+			State:  created.Output,
+		})
+		require.Error(t, err)
+		assert.Len(t, updated.Output.Results, 3)
+		assertLog(t, hosts[0], "created", "created")
+		assertLog(t, hosts[1], "created")
+		assertLog(t, hosts[2], "created")
+	})
+
+	t.Run("allowed failures", func(t *testing.T) {
+		inputs := inputs
+		inputs.MaxFailures = pulumi.IntRef(1)
+		inputs.Connections = multiCommandConnections(hosts[0])
+		created, err := (&MultiCommand{}).Create(ctx, infer.CreateRequest[MultiCommandInputs]{Name: "name", Inputs: inputs})
+		require.NoError(t, err)
+		assert.Equal(t, 3, created.Output.Results[hosts[0].key].ExitCode)
+	})
+}
+
+func assertLog(t *testing.T, host multiCommandHost, lines ...string) {
+	t.Helper()
+	log, err := os.ReadFile(filepath.Join(host.dir, "log"))
+	require.NoError(t, err)
+	assert.Equal(t, lines, strings.Fields(string(log)), "log of host %s", host.name)
+}
+
+func TestMultiCommandHosts(t *testing.T) {
+	inputs := MultiCommandInputs{
+		Hosts:      &[]string{"10.0.0.1", "10.0.0.2"},
+		Connection: &ConnectionTemplate{User: pulumi.StringRef("admin")},
+	}
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, inputs.hosts())
+	conn := inputs.connections()["10.0.0.2"]
+	assert.Equal(t, "10.0.0.2", *conn.Host)
+	assert.Equal(t, "admin", *conn.User)
+	assert.Equal(t, 22.0, *conn.Port)
+
+	inputs = MultiCommandInputs{
+		Hosts:      &[]string{"10.0.0.5:2222", "[::1]:2222", "::1"},
+		Connection: &ConnectionTemplate{Port: pulumi.Float64Ref(23)},
+	}
+	conns := inputs.connections()
+	assert.Equal(t, "10.0.0.5", *conns["10.0.0.5:2222"].Host)
+	assert.Equal(t, 2222.0, *conns["10.0.0.5:2222"].Port)
+	assert.Equal(t, "::1", *conns["[::1]:2222"].Host)
+	assert.Equal(t, 2222.0, *conns["[::1]:2222"].Port)
+	assert.Equal(t, "::1", *conns["::1"].Host)
+	assert.Equal(t, 23.0, *conns["::1"].Port)
+	assert.Equal(t, 23.0, *inputs.Connection.Port, "the template isn't changed")
+
+	connections := []Connection{{connectionBase: connectionBase{
+		Host: pulumi.StringRef("::1"), Port: pulumi.Float64Ref(2222),
+	}}}
+	inputs = MultiCommandInputs{Connections: &connections}
+	assert.Equal(t, []string{"[::1]:2222"}, inputs.hosts())
+}
+
+func TestMultiCommandCheck(t *testing.T) {
+	check := func(inputs map[string]any) []p.CheckFailure {
+		inputs["create"] = "make"
+		news := resource.FromResourcePropertyMap(resource.NewPropertyMapFromMap(inputs))
+		resp, err := (&MultiCommand{}).Check(t.Context(), infer.CheckRequest{Name: "name", NewInputs: news})
+		require.NoError(t, err)
+		return resp.Failures
+	}
+	properties := func(failures []p.CheckFailure) []string {
+		var properties []string
+		for _, f := range failures {
+			properties = append(properties, f.Property)
+		}
+		return properties
+	}
+
+	assert.Empty(t, check(map[string]any{"hosts": []any{"a", "b"}, "connection": map[string]any{"user": "admin"}}))
+	assert.Empty(t, check(map[string]any{"connections": []any{map[string]any{"host": "a"}}, "batchSize": 1}))
+
+	assert.Equal(t, []string{"hosts"}, properties(check(map[string]any{})))
+	assert.Equal(t, []string{"hosts"}, properties(check(map[string]any{
+		"hosts":       []any{"a"},
+		"connections": []any{map[string]any{"host": "b"}},
+	})))
+	assert.Equal(t, []string{"hosts"}, properties(check(map[string]any{"hosts": []any{"a", "b", "a"}})))
+	assert.Empty(t, check(map[string]any{"hosts": []any{"a:2222", "[::1]:2222"}}))
+	assert.Equal(t, []string{"hosts"}, properties(check(map[string]any{"hosts": []any{"a:99999"}})))
+	assert.Equal(t, []string{"connection"}, properties(check(map[string]any{
+		"connections": []any{map[string]any{"host": "a"}},
+		"connection":  map[string]any{"user": "admin"},
+	})))
+	assert.Equal(t, []string{"batchSize", "maxFailures", "pty"}, properties(check(map[string]any{
+		"hosts":       []any{"a"},
+		"batchSize":   0,
+		"maxFailures": -1,
+		"detached":    map[string]any{},
+		"pty":         map[string]any{},
+	})))
+}
@@ -0,0 +1,50 @@
+package remote
+
+// TODO Like logging.go, this file should be in the `common` package since its contents are used by
+// `local` and `remote`. It's duplicated in `local` and `remote` for the time being due to
+// pulumi/pulumi#16221, and changes need to be made in both copies.
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util"
+)
+
+// Pty configures the pseudo-terminal that a command is run in.
+type Pty struct {
+	Term      *string `pulumi:"term,optional"`
+	Rows      *int    `pulumi:"rows,optional"`
+	Cols      *int    `pulumi:"cols,optional"`
+	StripAnsi *bool   `pulumi:"stripAnsi,optional"`
+}
+
+func (p *Pty) Annotate(a infer.Annotator) {
+	a.Describe(&p, "The pseudo-terminal that a command is run in.")
+	a.Describe(&p.Term, "The terminal type, set as TERM. Defaults to `xterm`.")
+	a.Describe(&p.Rows, "The height of the terminal in characters. Defaults to 24.")
+	a.Describe(&p.Cols, "The width of the terminal in characters. Defaults to 80.")
+	a.Describe(&p.StripAnsi, `If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+captured stdout. They are always part of the logged output. Defaults to false.`)
+}
+
+func (p *Pty) term() string {
+	if p == nil || p.Term == nil || *p.Term == "" {
+		return util.DefaultTerm
+	}
+	return *p.Term
+}
+
+func (p *Pty) size() (rows, cols int) {
+	rows, cols = util.DefaultRows, util.DefaultCols
+	if p != nil && p.Rows != nil && *p.Rows > 0 {
+		rows = *p.Rows
+	}
+	if p != nil && p.Cols != nil && *p.Cols > 0 {
+		cols = *p.Cols
+	}
+	return rows, cols
+}
+
+func (p *Pty) stripANSI() bool {
+	return p != nil && p.StripAnsi != nil && *p.StripAnsi
+}
@@ -0,0 +1,72 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// This is the type that implements the TestConnection function methods.
+// The methods are declared in the testConnectionController.go file.
+type TestConnection struct{}
+
+func (f *TestConnection) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Tests a connection step by step to diagnose why it fails: resolving the address, the TCP "+
+		"connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then "+
+		"to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its "+
+		"result rather than as an error.")
+}
+
+type TestConnectionInputs struct {
+	Connection *Connection `pulumi:"connection" provider:"secret"`
+}
+
+func (f *TestConnectionInputs) Annotate(a infer.Annotator) {
+	a.Describe(&f.Connection, "The parameters with which to connect to the remote host.")
+}
+
+type TestConnectionOutputs struct {
+	Success bool              `pulumi:"success"`
+	Stages  []ConnectionStage `pulumi:"stages"`
+}
+
+func (f *TestConnectionOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&f.Success, "If the connection to the host succeeded.")
+	a.Describe(&f.Stages, "The stages of the connection in the order they were run. The stages after the first "+
+		"one that failed aren't run, except that all auth methods are tried until one succeeds.")
+}
+
+// ConnectionStage is the result of a step of establishing a connection.
+type ConnectionStage struct {
+	Hop        string  `pulumi:"hop"`
+	Stage      string  `pulumi:"stage"`
+	Method     *string `pulumi:"method,optional"`
+	Success    bool    `pulumi:"success"`
+	DurationMs float64 `pulumi:"durationMs"`
+	Detail     *string `pulumi:"detail,optional"`
+	Error      *string `pulumi:"error,optional"`
+}
+
+func (s *ConnectionStage) Annotate(a infer.Annotator) {
+	a.Describe(&s, "The result of a step of establishing a connection.")
+	a.Describe(&s.Hop, "The host that the stage connects to, `proxy` or `host`.")
+	a.Describe(&s.Stage, "The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the "+
+		"`hostKey` check or `auth`.")
+	a.Describe(&s.Method, "The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.")
+	a.Describe(&s.Success, "If the stage succeeded.")
+	a.Describe(&s.DurationMs, "How long the stage took in milliseconds.")
+	a.Describe(&s.Detail, "What the stage found, e.g. the resolved addresses or the fingerprint of the host key.")
+	a.Describe(&s.Error, "Why the stage failed.")
+}
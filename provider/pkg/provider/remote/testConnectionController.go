@@ -0,0 +1,206 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Invoke tests the connection to the proxy, if set, and then to the host.
+func (*TestConnection) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[TestConnectionInputs],
+) (infer.FunctionResponse[TestConnectionOutputs], error) {
+	conn := req.Input.Connection
+	report := &connectionReport{}
+
+	var proxyClient *ssh.Client
+	if conn.Proxy != nil {
+		proxyClient = report.testHop(ctx, "proxy", &conn.Proxy.connectionBase, nil)
+		if proxyClient == nil {
+			return infer.FunctionResponse[TestConnectionOutputs]{Output: report.TestConnectionOutputs}, nil
+		}
+		defer proxyClient.Close()
+	}
+	if client := report.testHop(ctx, "host", &conn.connectionBase, proxyClient); client != nil {
+		client.Close()
+		report.Success = true
+	}
+	return infer.FunctionResponse[TestConnectionOutputs]{Output: report.TestConnectionOutputs}, nil
+}
+
+// connectionReport records the stages of testing a connection.
+type connectionReport struct {
+	TestConnectionOutputs
+}
+
+// run runs a stage and records its result. f returns the detail of the stage.
+func (r *connectionReport) run(hop, stage string, method *string, f func() (string, error)) bool {
+	start := time.Now()
+	detail, err := f()
+	return r.record(hop, stage, method, time.Since(start), detail, err)
+}
+
+// record records the result of a stage and returns whether it succeeded.
+func (r *connectionReport) record(
+	hop, stage string, method *string, duration time.Duration, detail string, err error,
+) bool {
+	result := ConnectionStage{
+		Hop:        hop,
+		Stage:      stage,
+		Method:     method,
+		Success:    err == nil,
+		DurationMs: float64(duration.Microseconds()) / 1000,
+	}
+	if detail != "" {
+		result.Detail = &detail
+	}
+	if err != nil {
+		msg := err.Error()
+		result.Error = &msg
+	}
+	r.Stages = append(r.Stages, result)
+	return err == nil
+}
+
+// testHop connects to a host step by step, through via if it's not nil, and returns the
+// connected client, or nil if a stage failed.
+func (r *connectionReport) testHop(
+	ctx context.Context, hop string, con *connectionBase, via *ssh.Client,
+) *ssh.Client {
+	port := 22
+	if con.Port != nil {
+		port = int(*con.Port)
+	}
+	endpoint := net.JoinHostPort(*con.Host, strconv.Itoa(port))
+	timeout := 15 * time.Second
+	if con.PerDialTimeout != nil {
+		timeout = time.Duration(*con.PerDialTimeout) * time.Second
+	}
+	dial := func() (net.Conn, error) {
+		if via != nil {
+			return via.Dial("tcp", endpoint)
+		}
+		return (&net.Dialer{Timeout: timeout}).DialContext(ctx, "tcp", endpoint)
+	}
+
+	// Through a proxy, the address is resolved by the proxy.
+	if via == nil && !r.run(hop, "resolve", nil, func() (string, error) {
+		addrs, err := net.DefaultResolver.LookupHost(ctx, *con.Host)
+		return strings.Join(addrs, ", "), err
+	}) {
+		return nil
+	}
+
+	var conn net.Conn
+	if !r.run(hop, "tcp", nil, func() (string, error) {
+		var err error
+		conn, err = dial()
+		if err != nil {
+			return "", err
+		}
+		return "connected to " + conn.RemoteAddr().String(), nil
+	}) {
+		return nil
+	}
+
+	config, err := con.baseSSHConfig()
+	if err != nil {
+		conn.Close()
+		r.record(hop, "hostKey", nil, 0, "", err)
+		return nil
+	}
+	// The host key is checked during the handshake, which then continues with the auth methods.
+	// Without any, the handshake fails after the host key check unless the server allows the
+	// "none" method.
+	var hostKey ssh.PublicKey
+	var hostKeyErr error
+	var hostKeyDuration time.Duration
+	verify := config.HostKeyCallback
+	config.HostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		start := time.Now()
+		hostKey, hostKeyErr = key, verify(hostname, remote, key)
+		hostKeyDuration = time.Since(start)
+		return hostKeyErr
+	}
+	handshakeConfig := *config
+	var client *ssh.Client
+	if !r.run(hop, "handshake", nil, func() (string, error) {
+		_ = conn.SetDeadline(time.Now().Add(timeout))
+		c, chans, reqs, err := ssh.NewClientConn(conn, endpoint, &handshakeConfig)
+		if err == nil {
+			_ = conn.SetDeadline(time.Time{})
+			client = ssh.NewClient(c, chans, reqs)
+			return "authenticated without credentials", nil
+		}
+		conn.Close()
+		if hostKey == nil {
+			return "", err
+		}
+		return "", nil
+	}) {
+		return nil
+	}
+
+	ok := r.record(hop, "hostKey", nil, hostKeyDuration, ssh.FingerprintSHA256(hostKey), hostKeyErr)
+	if !ok || client != nil {
+		return client
+	}
+
+	methods, err := con.authMethods()
+	if err != nil {
+		r.record(hop, "auth", nil, 0, "", err)
+		return nil
+	}
+	if len(methods) == 0 {
+		r.record(hop, "auth", nil, 0, "",
+			errors.New("no auth method is configured: set a private key, a password or an agent socket"))
+		return nil
+	}
+	for _, m := range methods {
+		name := m.name
+		r.run(hop, "auth", &name, func() (string, error) {
+			conn, err := dial()
+			if err != nil {
+				return "", fmt.Errorf("failed to reconnect: %w", err)
+			}
+			_ = conn.SetDeadline(time.Now().Add(timeout))
+			authConfig := *config
+			authConfig.Auth = []ssh.AuthMethod{m.method}
+			c, chans, reqs, err := ssh.NewClientConn(conn, endpoint, &authConfig)
+			if err != nil {
+				conn.Close()
+				return "", err
+			}
+			_ = conn.SetDeadline(time.Time{})
+			client = ssh.NewClient(c, chans, reqs)
+			return "authenticated as " + config.User, nil
+		})
+		if client != nil {
+			return client
+		}
+	}
+	return nil
+}
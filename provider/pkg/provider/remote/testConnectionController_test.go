@@ -0,0 +1,125 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xssh "golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestTestConnection(t *testing.T) {
+	server := newExecServer(t, t.TempDir())
+	ctx := &testutil.TestContext{Context: context.Background()}
+	test := func(conn *Connection) TestConnectionOutputs {
+		resp, err := (&TestConnection{}).Invoke(ctx, infer.FunctionRequest[TestConnectionInputs]{
+			Input: TestConnectionInputs{Connection: conn},
+		})
+		require.NoError(t, err)
+		return resp.Output
+	}
+	stages := func(out TestConnectionOutputs) []string {
+		var names []string
+		for _, s := range out.Stages {
+			names = append(names, s.Hop+"/"+s.Stage)
+		}
+		return names
+	}
+
+	t.Run("success", func(t *testing.T) {
+		out := test(execConnection(server))
+		assert.True(t, out.Success)
+		assert.Equal(t, []string{"host/resolve", "host/tcp", "host/handshake", "host/hostKey"}, stages(out))
+		for _, s := range out.Stages {
+			assert.True(t, s.Success, s.Stage)
+			assert.Nil(t, s.Error, s.Stage)
+			assert.GreaterOrEqual(t, s.DurationMs, 0.0, s.Stage)
+		}
+		require.NotNil(t, out.Stages[3].Detail)
+		assert.Contains(t, *out.Stages[3].Detail, "SHA256:")
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		proxy := testutil.NewForwardingTestSSHServer(t, func(ssh.Session) {})
+		conn := execConnection(server)
+		conn.Proxy = &ProxyConnection{connectionBase: execConnection(proxy).connectionBase}
+		out := test(conn)
+		assert.True(t, out.Success)
+		// The address of the host is resolved by the proxy.
+		assert.Equal(t, []string{
+			"proxy/resolve", "proxy/tcp", "proxy/handshake", "proxy/hostKey",
+			"host/tcp", "host/handshake", "host/hostKey",
+		}, stages(out))
+	})
+
+	t.Run("client outlives the dial timeout", func(t *testing.T) {
+		// A proxy's client is used to dial the next hop, possibly after its own timeout.
+		var r connectionReport
+		conn := execConnection(server)
+		client := r.testHop(ctx, "host", &conn.connectionBase, nil)
+		require.NotNil(t, client)
+		t.Cleanup(func() { _ = client.Close() })
+		time.Sleep(time.Duration(*conn.PerDialTimeout)*time.Second + 500*time.Millisecond)
+
+		out, err := runRemote(client, "echo ok", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "ok\n", out)
+	})
+
+	t.Run("wrong host key", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		publicKey, err := xssh.NewPublicKey(&key.PublicKey)
+		require.NoError(t, err)
+		conn := execConnection(server)
+		conn.HostKey = pulumi.StringRef(string(xssh.MarshalAuthorizedKey(publicKey)))
+
+		out := test(conn)
+		assert.False(t, out.Success)
+		assert.Equal(t, []string{"host/resolve", "host/tcp", "host/handshake", "host/hostKey"}, stages(out))
+		hostKey := out.Stages[3]
+		assert.False(t, hostKey.Success)
+		require.NotNil(t, hostKey.Error)
+		assert.Contains(t, *hostKey.Error, "host key mismatch")
+	})
+
+	t.Run("closed port", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		require.NoError(t, listener.Close())
+		conn := execConnection(server)
+		conn.Port = pulumi.Float64Ref(float64(port))
+
+		out := test(conn)
+		assert.False(t, out.Success)
+		assert.Equal(t, []string{"host/resolve", "host/tcp"}, stages(out))
+		require.NotNil(t, out.Stages[1].Error)
+		assert.Contains(t, *out.Stages[1].Error, "connection refused")
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// sftpServerPaths are the locations of the SFTP server binary on common distributions.
+var sftpServerPaths = []string{
+	"/usr/lib/openssh/sftp-server",
+	"/usr/libexec/openssh/sftp-server",
+	"/usr/lib/ssh/sftp-server",
+	"/usr/libexec/sftp-server",
+	"/usr/lib/sftp-server",
+}
+
+// sudoSftpServer starts the first SFTP server found in sftpServerPaths as root.
+var sudoSftpServer = fmt.Sprintf(`for p in %s; do if [ -x "$p" ]; then exec sudo -n "$p"; fi; done; `+
+	`echo "sftp-server not found" >&2; exit 127`, strings.Join(sftpServerPaths, " "))
+
+// errSftpUnavailable is the error of newSftpClient if the host has no SFTP server.
+var errSftpUnavailable = errors.New("the SFTP server is not available")
+
+// openTransport resolves the transport to copy files with. For TransportSFTP, and for TransportAuto
+// when the host supports SFTP, it also returns an sFTP client that the caller must close.
+func openTransport(
+	client *ssh.Client, transport Transport, become bool, opts ...sftp.ClientOption,
+) (Transport, *sftp.Client, error) {
+	if transport != TransportSFTP && transport != TransportAuto {
+		return transport, nil, nil
+	}
+
+	sftpClient, err := newSftpClient(client, become, opts...)
+	if err == nil {
+		return TransportSFTP, sftpClient, nil
+	}
+	// Other errors, e.g. sudo requiring a password, would also fail the other transports, or make
+	// them write with different permissions than expected.
+	if transport == TransportSFTP || !errors.Is(err, errSftpUnavailable) {
+		return "", nil, err
+	}
+
+	// The SFTP subsystem is disabled; prefer tar since it preserves symlinks.
+	if _, err := runRemote(client, "command -v tar", nil); err == nil {
+		return TransportTarOverExec, nil, nil
+	}
+	return TransportSCP, nil, nil
+}
+
+// newSftpClient starts an sFTP session. With become, the SFTP server is run as root via sudo in an
+// exec session rather than as the SFTP subsystem of the login user. Closing the client ends the
+// session. If the SFTP subsystem is disabled or the SFTP server isn't installed, the error wraps
+// errSftpUnavailable.
+func newSftpClient(client *ssh.Client, become bool, opts ...sftp.ClientOption) (*sftp.Client, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+	if !become {
+		if err := session.RequestSubsystem("sftp"); err != nil {
+			session.Close()
+			return nil, fmt.Errorf("%w: %w", errSftpUnavailable, err)
+		}
+	} else if err := session.Start(sudoSftpServer); err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	sftpClient, err := sftp.NewClientPipe(stdout, stdin, opts...)
+	if err != nil {
+		// Let the server exit so that its error message is complete.
+		stdin.Close()
+		waitErr := session.Wait()
+		session.Close()
+		// The shell exits with 127 if the SFTP server isn't found, like sshd's for the subsystem.
+		var exitErr *ssh.ExitError
+		if errors.As(waitErr, &exitErr) && exitErr.ExitStatus() == 127 {
+			err = fmt.Errorf("%w: %w", errSftpUnavailable, err)
+		}
+		if become {
+			return nil, fmt.Errorf("failed to start the SFTP server with sudo: %w\n%s", err, stderr.String())
+		}
+		return nil, fmt.Errorf("failed to start the SFTP server: %w\n%s", err, stderr.String())
+	}
+	return sftpClient, nil
+}
+
+// runRemote runs cmd on the remote host, feeding it stdin, and returns its standard output. The
+// standard error of a failed command is included in the returned error.
+func runRemote(client *ssh.Client, cmd string, stdin io.Reader) (string, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdin = stdin
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+	if err := session.Run(cmd); err != nil {
+		return "", fmt.Errorf("%w: running %q:\n%s", err, cmd, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// remoteKind reports whether the remote path exists and is a directory, using a POSIX shell
+// rather than SFTP.
+func remoteKind(client *ssh.Client, path string, become bool) (exists, isDir bool, err error) {
+	cmd := fmt.Sprintf("if [ -d %[1]s ]; then echo dir; elif [ -e %[1]s ]; then echo file; fi", shellQuote(path))
+	out, err := runRemote(client, becomeCommand(cmd, become), nil)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to stat remote path %s: %w", path, err)
+	}
+	kind := strings.TrimSpace(out)
+	return kind != "", kind == "dir", nil
+}
+
+// becomeCommand wraps the shell command cmd to run as root via `sudo -n` when become is set.
+func becomeCommand(cmd string, become bool) string {
+	if !become {
+		return cmd
+	}
+	return "sudo -n sh -c " + shellQuote(cmd)
+}
+
+// shellQuote quotes s as a single word for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,392 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/creack/pty"
+	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xssh "golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi-go-provider/infer/types"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/asset"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+// startExecServer starts an SSH server without the SFTP subsystem that runs commands with `sh` in
+// baseDir, with the additional environment variables env and those set on the session, and returns a
+// client connected to it.
+func startExecServer(t *testing.T, baseDir string, env ...string) *xssh.Client {
+	server := newExecServer(t, baseDir, env...)
+	client, err := xssh.Dial("tcp", fmt.Sprintf("%s:%d", server.Host, server.Port), &xssh.ClientConfig{
+		//nolint:gosec // G106: InsecureIgnoreHostKey is acceptable in tests
+		HostKeyCallback: xssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// newExecServer starts the SSH server of startExecServer.
+func newExecServer(t *testing.T, baseDir string, env ...string) testutil.TestSSHServer {
+	return testutil.NewTestSSHServer(t, func(s ssh.Session) {
+		cmd := exec.Command("sh", "-c", s.RawCommand())
+		cmd.Dir = baseDir
+		cmd.Env = append(append(os.Environ(), env...), s.Environ()...)
+		var err error
+		if ptyReq, _, isPty := s.Pty(); isPty {
+			err = runInPty(cmd, s, ptyReq)
+		} else {
+			cmd.Stdout, cmd.Stderr = s, s.Stderr()
+			// Like sshd, don't wait for the end of the input once the command exited.
+			var stdin io.WriteCloser
+			stdin, err = cmd.StdinPipe()
+			require.NoError(t, err)
+			go func() {
+				_, _ = io.Copy(stdin, s)
+				stdin.Close()
+			}()
+			if err = cmd.Start(); err == nil {
+				forwardSignals(s, cmd.Process)
+				err = cmd.Wait()
+			}
+		}
+		var exitErr *exec.ExitError
+		switch {
+		case errors.As(err, &exitErr):
+			_ = s.Exit(exitErr.ExitCode())
+		case err != nil:
+			_ = s.Exit(1)
+		default:
+			_ = s.Exit(0)
+		}
+	})
+}
+
+// runInPty runs cmd in a pseudo-terminal with the settings requested on the session s. Unlike
+// sshd, it doesn't apply the terminal modes, so the terminal echoes the input.
+func runInPty(cmd *exec.Cmd, s ssh.Session, ptyReq ssh.Pty) error {
+	cmd.Env = append(cmd.Env, "TERM="+ptyReq.Term)
+	size := &pty.Winsize{Rows: uint16(ptyReq.Window.Height), Cols: uint16(ptyReq.Window.Width)} //nolint:gosec
+	ptmx, err := pty.StartWithSize(cmd, size)
+	if err != nil {
+		return err
+	}
+	defer ptmx.Close()
+	forwardSignals(s, cmd.Process)
+	go func() { _, _ = io.Copy(ptmx, s) }()
+	// Ends with EIO once the command exited.
+	_, _ = io.Copy(s, ptmx)
+	return cmd.Wait()
+}
+
+// forwardSignals passes SIGTERM and SIGKILL sent on the session s on to process.
+func forwardSignals(s ssh.Session, process *os.Process) {
+	signals := make(chan ssh.Signal, 1)
+	s.Signals(signals)
+	go func() {
+		for sig := range signals {
+			switch sig {
+			case ssh.SIGTERM:
+				_ = process.Signal(syscall.SIGTERM)
+			case ssh.SIGKILL:
+				_ = process.Kill()
+			}
+		}
+	}()
+}
+
+// execConnection returns the connection to a server started by newExecServer.
+func execConnection(server testutil.TestSSHServer) *Connection {
+	return &Connection{
+		connectionBase: connectionBase{
+			Host:           pulumi.StringRef(server.Host),
+			Port:           pulumi.Float64Ref(float64(server.Port)),
+			User:           pulumi.StringRef("user"), // unused but prevents nil panic
+			PerDialTimeout: pulumi.IntRef(1),         // unused but prevents nil panic
+		},
+	}
+}
+
+// fakeSudoScript runs the command as the current user and logs it. With -S, it prompts for the
+// password "secret" on stderr and reads it from stdin like sudo.
+const fakeSudoScript = `#!/bin/sh
+while [ $# -gt 0 ]; do
+  case "$1" in
+    -S) read_password=1; shift ;;
+    -p) prompt="$2"; shift 2 ;;
+    -u) shift 2 ;;
+    -n) shift ;;
+    --) shift; break ;;
+    *) break ;;
+  esac
+done
+if [ -n "$read_password" ]; then
+  printf '%s' "$prompt" >&2
+  read -r password
+  if [ "$password" != secret ]; then echo "sudo: incorrect password" >&2; exit 1; fi
+fi
+echo "$@" >> "$SUDO_LOG"
+exec "$@"
+`
+
+// fakeSudo installs fakeSudoScript as `sudo`, and returns the environment for startExecServer and
+// the path of the log.
+func fakeSudo(t *testing.T) (env []string, log string) {
+	dir := t.TempDir()
+	log = filepath.Join(dir, "sudo.log")
+	//nolint:gosec // G306: the script has to be executable
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sudo"), []byte(fakeSudoScript), 0o755))
+	return []string{"PATH=" + dir + string(os.PathListSeparator) + os.Getenv("PATH"), "SUDO_LOG=" + log}, log
+}
+
+func TestBecome(t *testing.T) {
+	t.Run("tarOverExec runs tar via sudo", func(t *testing.T) {
+		destDir := t.TempDir()
+		env, log := fakeSudo(t)
+		client := startExecServer(t, destDir, env...)
+		a, err := asset.FromText("hello")
+		require.NoError(t, err)
+		become := true
+		input := CopyToRemoteInputs{Source: types.AssetOrArchive{Asset: a}, RemotePath: "etc/hello.txt", Become: &become}
+
+		require.NoError(t, copyOverExec(client, TransportTarOverExec, input, input.copyOptions()))
+
+		assert.FileExists(t, filepath.Join(destDir, "etc", "hello.txt"))
+		calls, err := os.ReadFile(log)
+		require.NoError(t, err)
+		assert.Contains(t, string(calls), "tar -x")
+	})
+
+	t.Run("CopyFile runs tar via sudo", func(t *testing.T) {
+		destDir := t.TempDir()
+		env, log := fakeSudo(t)
+		server := newExecServer(t, destDir, env...)
+		src := filepath.Join(t.TempDir(), "hello.txt")
+		require.NoError(t, os.WriteFile(src, []byte("hello"), 0o600))
+		transport, become := TransportTarOverExec, true
+		input := CopyFileInputs{
+			Connection: execConnection(server),
+			LocalPath:  src,
+			RemotePath: "etc/hello.txt",
+			Transport:  &transport,
+			Become:     &become,
+		}
+
+		_, err := (&CopyFile{}).Create(t.Context(), infer.CreateRequest[CopyFileInputs]{Name: "name", Inputs: input})
+		require.NoError(t, err)
+
+		assert.FileExists(t, filepath.Join(destDir, "etc", "hello.txt"))
+		calls, err := os.ReadFile(log)
+		require.NoError(t, err)
+		assert.Contains(t, string(calls), "tar -x")
+	})
+
+	t.Run("sftp reports a missing sftp-server", func(t *testing.T) {
+		env, _ := fakeSudo(t)
+		client := startExecServer(t, t.TempDir(), env...)
+		for _, path := range sftpServerPaths {
+			if _, err := os.Stat(path); err == nil {
+				t.Skip("sftp-server is installed")
+			}
+		}
+
+		_, err := newSftpClient(client, true)
+		assert.ErrorContains(t, err, "sftp-server not found")
+		assert.ErrorIs(t, err, errSftpUnavailable)
+
+		transport, sftpClient, err := openTransport(client, TransportAuto, true)
+		require.NoError(t, err)
+		assert.Nil(t, sftpClient)
+		assert.Equal(t, TransportTarOverExec, transport)
+	})
+
+	t.Run("auto doesn't fall back when sudo fails", func(t *testing.T) {
+		dir := t.TempDir()
+		//nolint:gosec // G306: the script has to be executable
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sudo"),
+			[]byte("#!/bin/sh\necho 'sudo: a password is required' >&2\nexit 1\n"), 0o755))
+		client := startExecServer(t, t.TempDir(), "PATH="+dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+		server := sudoSftpServer
+		sudoSftpServer = "exec sudo -n sftp-server"
+		t.Cleanup(func() { sudoSftpServer = server })
+
+		_, _, err := openTransport(client, TransportAuto, true)
+		require.ErrorContains(t, err, "a password is required")
+		assert.NotErrorIs(t, err, errSftpUnavailable)
+	})
+}
+
+func TestOpenTransport(t *testing.T) {
+	client := startExecServer(t, t.TempDir())
+
+	_, _, err := openTransport(client, TransportSFTP, false)
+	require.Error(t, err)
+
+	transport, sftpClient, err := openTransport(client, TransportAuto, false)
+	require.NoError(t, err)
+	assert.Nil(t, sftpClient)
+	assert.Equal(t, TransportTarOverExec, transport)
+
+	transport, _, err = openTransport(client, TransportSCP, false)
+	require.NoError(t, err)
+	assert.Equal(t, TransportSCP, transport)
+}
+
+func TestCopyOverExec(t *testing.T) {
+	for _, transport := range []Transport{TransportSCP, TransportTarOverExec} {
+		t.Run(string(transport), func(t *testing.T) {
+			setup := func(t *testing.T) (srcDir, destDir string, client *xssh.Client) {
+				srcDir = filepath.Join(t.TempDir(), "src")
+				makeCopyTestTree(t, srcDir)
+				destDir = t.TempDir()
+				return srcDir, destDir, startExecServer(t, destDir)
+			}
+			copyWith := func(client *xssh.Client, input CopyToRemoteInputs) error {
+				opts := input.copyOptions()
+				var err error
+				if opts.filter, err = input.filter(); err != nil {
+					return err
+				}
+				return copyOverExec(client, transport, input, opts)
+			}
+
+			t.Run("directory", func(t *testing.T) {
+				srcDir, destDir, client := setup(t)
+				input := CopyToRemoteInputs{
+					Source:     types.AssetOrArchive{Archive: &archive.Archive{Path: srcDir}},
+					RemotePath: "out",
+				}
+				require.NoError(t, copyWith(client, input))
+				assertDirectoryTree(t, filepath.Join(destDir, "out", "src"))
+			})
+
+			t.Run("directory contents", func(t *testing.T) {
+				srcDir, destDir, client := setup(t)
+				input := CopyToRemoteInputs{
+					Source:     types.AssetOrArchive{Archive: &archive.Archive{Path: srcDir + "/"}},
+					RemotePath: "out",
+				}
+				require.NoError(t, copyWith(client, input))
+				assertDirectoryTree(t, filepath.Join(destDir, "out"))
+			})
+
+			t.Run("file into existing directory", func(t *testing.T) {
+				srcDir, destDir, client := setup(t)
+				require.NoError(t, os.Mkdir(filepath.Join(destDir, "dir"), 0o755))
+				a, err := asset.FromPath(filepath.Join(srcDir, "file1"))
+				require.NoError(t, err)
+				input := CopyToRemoteInputs{Source: types.AssetOrArchive{Asset: a}, RemotePath: "dir"}
+				require.NoError(t, copyWith(client, input))
+				assert.FileExists(t, filepath.Join(destDir, "dir", "file1"))
+			})
+
+			t.Run("text asset", func(t *testing.T) {
+				_, destDir, client := setup(t)
+				a, err := asset.FromText("hello")
+				require.NoError(t, err)
+				input := CopyToRemoteInputs{Source: types.AssetOrArchive{Asset: a}, RemotePath: "a/b/hello.txt"}
+				require.NoError(t, copyWith(client, input))
+				content, err := os.ReadFile(filepath.Join(destDir, "a", "b", "hello.txt"))
+				require.NoError(t, err)
+				assert.Equal(t, "hello", string(content))
+			})
+
+			t.Run("asset archive with exclude", func(t *testing.T) {
+				_, destDir, client := setup(t)
+				fileA, err := asset.FromText("alpha")
+				require.NoError(t, err)
+				key, err := asset.FromText("secret")
+				require.NoError(t, err)
+				arc, err := archive.FromAssets(map[string]any{"sub/a.txt": fileA, "key.pem": key})
+				require.NoError(t, err)
+				input := CopyToRemoteInputs{
+					Source:     types.AssetOrArchive{Archive: arc},
+					RemotePath: "out",
+					Exclude:    &[]string{"**.pem"},
+				}
+				require.NoError(t, copyWith(client, input))
+				content, err := os.ReadFile(filepath.Join(destDir, "out", "sub", "a.txt"))
+				require.NoError(t, err)
+				assert.Equal(t, "alpha", string(content))
+				assert.NoFileExists(t, filepath.Join(destDir, "out", "key.pem"))
+			})
+
+			t.Run("extract", func(t *testing.T) {
+				_, destDir, client := setup(t)
+				path := makeTgzFile(t, []*tar.Header{
+					{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0o755},
+					{Name: "bin/run.sh", Typeflag: tar.TypeReg, Mode: 0o750},
+				})
+				extract := true
+				input := CopyToRemoteInputs{
+					Source:     types.AssetOrArchive{Archive: &archive.Archive{Path: path}},
+					RemotePath: "out",
+					Extract:    &extract,
+				}
+				require.NoError(t, copyWith(client, input))
+				info, err := os.Stat(filepath.Join(destDir, "out", "bin", "run.sh"))
+				require.NoError(t, err)
+				assert.Equal(t, os.FileMode(0o750), info.Mode().Perm())
+			})
+
+			t.Run("preserved symlinks", func(t *testing.T) {
+				srcDir, destDir, client := setup(t)
+				require.NoError(t, os.Symlink("file1", filepath.Join(srcDir, "link1")))
+				symlinks := SymlinksPreserve
+				input := CopyToRemoteInputs{
+					Source:     types.AssetOrArchive{Archive: &archive.Archive{Path: srcDir + "/"}},
+					RemotePath: "out",
+					Symlinks:   &symlinks,
+				}
+				err := copyWith(client, input)
+				if transport == TransportSCP {
+					assert.ErrorContains(t, err, "can't create the link link1")
+					return
+				}
+				require.NoError(t, err)
+				target, err := os.Readlink(filepath.Join(destDir, "out", "link1"))
+				require.NoError(t, err)
+				assert.Equal(t, "file1", target)
+			})
+
+			t.Run("atomic is rejected", func(t *testing.T) {
+				srcDir, _, client := setup(t)
+				atomic := AtomicFile
+				input := CopyToRemoteInputs{
+					Source:     types.AssetOrArchive{Archive: &archive.Archive{Path: srcDir}},
+					RemotePath: "out",
+					Atomic:     &atomic,
+				}
+				assert.ErrorContains(t, copyWith(client, input), "require the sftp transport")
+			})
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type Tunnel struct{}
+
+var _ = (infer.Annotated)((*Tunnel)(nil))
+
+// Tunnel implements Annotate which allows you to attach descriptions to the Tunnel resource.
+func (c *Tunnel) Annotate(a infer.Annotator) {
+	a.Describe(&c, `Forwards a port over SSH for the duration of a deployment, e.g. to reach a private
+database through a bastion host from other providers.
+
+A 'local' tunnel listens on 'localHost' and 'localPort' on the machine running Pulumi, and forwards the
+connections to 'remoteHost' and 'remotePort' as seen from the remote host. A 'remote' tunnel listens on
+'remoteHost' and 'remotePort' on the remote host, and forwards the connections to 'localHost' and 'localPort'
+as seen from the machine running Pulumi. The listening address is available via 'address' and 'port'.
+
+The tunnel is open while the provider runs, and is reopened on the same address on each deployment when the
+Tunnel is checked for changes. Only if that address isn't free anymore, the Tunnel shows as updated, with a
+new 'address' for a free port. Set the listening port to keep 'address' the same in any case. Resources that use
+the tunnel should depend on it.`)
+}
+
+// The arguments for a remote Tunnel resource.
+type TunnelInputs struct {
+	Connection *Connection `pulumi:"connection"          provider:"secret"`
+	Type       *TunnelType `pulumi:"type,optional"`
+	LocalHost  *string     `pulumi:"localHost,optional"`
+	LocalPort  *int        `pulumi:"localPort,optional"`
+	RemoteHost *string     `pulumi:"remoteHost,optional"`
+	RemotePort *int        `pulumi:"remotePort,optional"`
+}
+
+func (c *TunnelInputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Connection, "The parameters with which to connect to the remote host.")
+	a.Describe(&c.Type, "The direction of the tunnel. Defaults to `local`.")
+	a.Describe(&c.LocalHost, "The address on the machine running Pulumi. Defaults to `127.0.0.1`.")
+	a.Describe(&c.LocalPort, `The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+if it's not set, while a 'remote' tunnel requires it.`)
+	a.Describe(&c.RemoteHost, "The address as seen from the remote host. Defaults to `localhost`.")
+	a.Describe(&c.RemotePort, `The port as seen from the remote host. A 'remote' tunnel listens on a port
+chosen by the SSH server if it's not set, while a 'local' tunnel requires it.`)
+}
+
+// The properties for a remote Tunnel resource.
+type TunnelOutputs struct {
+	TunnelInputs
+	Address string `pulumi:"address"`
+	Port    int    `pulumi:"port"`
+}
+
+func (c *TunnelOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Address, `The address that the tunnel listens on as host and port, on the machine running
+Pulumi for a 'local' tunnel and on the remote host for a 'remote' one.`)
+	a.Describe(&c.Port, "The port that the tunnel listens on.")
+}
+
+// TunnelType is the direction in which a Tunnel forwards connections.
+type TunnelType string
+
+const (
+	TunnelLocal  TunnelType = "local"
+	TunnelRemote TunnelType = "remote"
+)
+
+func (TunnelType) Values() []infer.EnumValue[TunnelType] {
+	return []infer.EnumValue[TunnelType]{
+		{Name: string(TunnelLocal), Value: TunnelLocal,
+			Description: "Forward the connections to a local port to the remote host, like `ssh -L`"},
+		{Name: string(TunnelRemote), Value: TunnelRemote,
+			Description: "Forward the connections to a port on the remote host to the local machine, like `ssh -R`"},
+	}
+}
+
+// OrDefault returns the type, or TunnelLocal if none is set.
+func (t *TunnelType) OrDefault() TunnelType {
+	if t == nil || *t == "" {
+		return TunnelLocal
+	}
+	return *t
+}
+
+func (c *TunnelInputs) localHost() string {
+	if c.LocalHost == nil || *c.LocalHost == "" {
+		return "127.0.0.1"
+	}
+	return *c.LocalHost
+}
+
+func (c *TunnelInputs) remoteHost() string {
+	if c.RemoteHost == nil || *c.RemoteHost == "" {
+		return "localhost"
+	}
+	return *c.RemoteHost
+}
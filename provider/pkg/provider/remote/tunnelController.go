@@ -0,0 +1,307 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+var (
+	_ = (infer.CustomResource[TunnelInputs, TunnelOutputs])((*Tunnel)(nil))
+	_ = (infer.CustomCheck[TunnelInputs])((*Tunnel)(nil))
+	_ = (infer.CustomDiff[TunnelInputs, TunnelOutputs])((*Tunnel)(nil))
+	_ = (infer.CustomUpdate[TunnelInputs, TunnelOutputs])((*Tunnel)(nil))
+	_ = (infer.CustomDelete[TunnelOutputs])((*Tunnel)(nil))
+)
+
+// Check validates the inputs beyond their types.
+func (*Tunnel) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[TunnelInputs], error) {
+	inputs, failures, err := infer.DefaultCheck[TunnelInputs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[TunnelInputs]{Inputs: inputs, Failures: failures}, err
+	}
+
+	// The port that's forwarded to must be known.
+	target, targetPort := "remotePort", inputs.RemotePort
+	if inputs.Type.OrDefault() == TunnelRemote {
+		target, targetPort = "localPort", inputs.LocalPort
+	}
+	if targetPort == nil || *targetPort == 0 {
+		failures = append(failures, p.CheckFailure{
+			Property: target,
+			Reason:   fmt.Sprintf("a %s tunnel requires %s", inputs.Type.OrDefault(), target),
+		})
+	}
+	checkPort := func(prop string, port *int) {
+		if port != nil && (*port < 0 || *port > 65535) {
+			failures = append(failures, p.CheckFailure{
+				Property: prop,
+				Reason:   fmt.Sprintf("%d isn't a valid port", *port),
+			})
+		}
+	}
+	checkPort("localPort", inputs.LocalPort)
+	checkPort("remotePort", inputs.RemotePort)
+	return infer.CheckResponse[TunnelInputs]{Inputs: inputs, Failures: failures}, nil
+}
+
+// Create opens the tunnel.
+func (*Tunnel) Create(
+	ctx context.Context,
+	req infer.CreateRequest[TunnelInputs],
+) (infer.CreateResponse[TunnelOutputs], error) {
+	state := TunnelOutputs{TunnelInputs: req.Inputs}
+	id, err := resource.NewUniqueHex(req.Name, 8, 0)
+	if err != nil {
+		return infer.CreateResponse[TunnelOutputs]{ID: "", Output: state}, err
+	}
+	if req.DryRun {
+		state.Address, state.Port = req.Inputs.listenAddress()
+		return infer.CreateResponse[TunnelOutputs]{ID: id, Output: state}, nil
+	}
+
+	err = state.open(ctx, id)
+	return infer.CreateResponse[TunnelOutputs]{ID: id, Output: state}, err
+}
+
+// Diff compares the inputs like the default diff does. If they're unchanged but the tunnel isn't
+// open in this provider process, e.g. in a new deployment, it's reopened on the same address, so that
+// the Tunnel doesn't show as changed. Only if that fails, a change to `address` is reported, for
+// Update to open the tunnel on a new address.
+func (*Tunnel) Diff(
+	ctx context.Context,
+	req infer.DiffRequest[TunnelInputs, TunnelOutputs],
+) (infer.DiffResponse, error) {
+	olds := req.State
+	news := req.Inputs
+	diff := map[string]p.PropertyDiff{}
+	update := func(prop string, changed bool) {
+		if changed {
+			diff[prop] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+		}
+	}
+
+	update("connection", !reflect.DeepEqual(olds.Connection, news.Connection))
+	update("type", olds.Type.OrDefault() != news.Type.OrDefault())
+	update("localHost", !reflect.DeepEqual(olds.LocalHost, news.LocalHost))
+	update("localPort", !reflect.DeepEqual(olds.LocalPort, news.LocalPort))
+	update("remoteHost", !reflect.DeepEqual(olds.RemoteHost, news.RemoteHost))
+	update("remotePort", !reflect.DeepEqual(olds.RemotePort, news.RemotePort))
+	if len(diff) == 0 && openTunnel(req.ID) == nil && !reopen(ctx, req.ID, olds) {
+		diff["address"] = p.PropertyDiff{Kind: p.Update}
+	}
+
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff}, nil
+}
+
+// Update reopens the tunnel with the new inputs.
+func (*Tunnel) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[TunnelInputs, TunnelOutputs],
+) (infer.UpdateResponse[TunnelOutputs], error) {
+	state := TunnelOutputs{TunnelInputs: req.Inputs}
+	if req.DryRun {
+		state.Address, state.Port = req.Inputs.listenAddress()
+		if state.Port == 0 && reflect.DeepEqual(req.State.TunnelInputs, req.Inputs) {
+			// Assume the tunnel gets the same free port again.
+			state.Address, state.Port = req.State.Address, req.State.Port
+		}
+		return infer.UpdateResponse[TunnelOutputs]{Output: state}, nil
+	}
+
+	closeTunnel(req.ID)
+	err := state.open(ctx, req.ID)
+	return infer.UpdateResponse[TunnelOutputs]{Output: state}, err
+}
+
+// Delete closes the tunnel.
+func (*Tunnel) Delete(_ context.Context, req infer.DeleteRequest[TunnelOutputs]) (infer.DeleteResponse, error) {
+	closeTunnel(req.ID)
+	return infer.DeleteResponse{}, nil
+}
+
+// reopen opens the tunnel of state on the port it listened on before, and reports whether it's open
+// on the same address again.
+func reopen(ctx context.Context, id string, state TunnelOutputs) bool {
+	reopened := TunnelOutputs{TunnelInputs: state.TunnelInputs}
+	port := state.Port
+	if reopened.Type.OrDefault() == TunnelRemote {
+		reopened.RemotePort = &port
+	} else {
+		reopened.LocalPort = &port
+	}
+	if err := reopened.open(ctx, id); err != nil {
+		p.GetLogger(ctx).Debugf("Couldn't reopen the tunnel on %s: %v", state.Address, err)
+		return false
+	}
+	if reopened.Address != state.Address {
+		closeTunnel(id)
+		return false
+	}
+	return true
+}
+
+// listenAddress returns the address that the tunnel listens on if it's known before the tunnel
+// is opened, and an empty address and port 0 otherwise.
+func (c *TunnelInputs) listenAddress() (string, int) {
+	host, port := c.localHost(), c.LocalPort
+	if c.Type.OrDefault() == TunnelRemote {
+		host, port = c.remoteHost(), c.RemotePort
+	}
+	if port == nil || *port == 0 {
+		return "", 0
+	}
+	return net.JoinHostPort(host, strconv.Itoa(*port)), *port
+}
+
+// tunnels holds the tunnels that are open in this provider process by the IDs of their resources.
+var tunnels = struct {
+	sync.Mutex
+	m map[string]*tunnel
+}{m: map[string]*tunnel{}}
+
+func openTunnel(id string) *tunnel {
+	tunnels.Lock()
+	defer tunnels.Unlock()
+	return tunnels.m[id]
+}
+
+func closeTunnel(id string) {
+	tunnels.Lock()
+	t := tunnels.m[id]
+	delete(tunnels.m, id)
+	tunnels.Unlock()
+	if t != nil {
+		t.close()
+	}
+}
+
+// CloseTunnels closes all open tunnels. It's called when the provider shuts down.
+func CloseTunnels() {
+	tunnels.Lock()
+	open := tunnels.m
+	tunnels.m = map[string]*tunnel{}
+	tunnels.Unlock()
+	for _, t := range open {
+		t.close()
+	}
+}
+
+// tunnel accepts connections on listener and forwards them to the connections returned by dial.
+type tunnel struct {
+	client   *ssh.Client
+	listener net.Listener
+	dial     func() (net.Conn, error)
+	// target is the address that the connections are forwarded to, for messages.
+	target string
+	logger p.Logger
+}
+
+// open dials the connection, opens the tunnel and records its address in c.
+func (c *TunnelOutputs) open(ctx context.Context, id string) error {
+	client, err := c.Connection.Dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The tunnel outlives the request, so its messages mustn't be canceled with it.
+	t := &tunnel{client: client, logger: p.GetLogger(context.WithoutCancel(ctx))}
+	local := net.JoinHostPort(c.localHost(), strconv.Itoa(ptrOr(c.LocalPort, 0)))
+	remote := net.JoinHostPort(c.remoteHost(), strconv.Itoa(ptrOr(c.RemotePort, 0)))
+	if c.Type.OrDefault() == TunnelRemote {
+		t.listener, err = client.Listen("tcp", remote)
+		t.dial = func() (net.Conn, error) { return net.Dial("tcp", local) }
+		t.target = local + " on the machine running Pulumi"
+	} else {
+		t.listener, err = net.Listen("tcp", local)
+		t.dial = func() (net.Conn, error) { return client.Dial("tcp", remote) }
+		t.target = remote + " as seen from " + *c.Connection.Host
+	}
+	if err != nil {
+		client.Close()
+		return fmt.Errorf("failed to open the %s tunnel: %w", c.Type.OrDefault(), err)
+	}
+
+	c.Address = t.listener.Addr().String()
+	if addr, ok := t.listener.Addr().(*net.TCPAddr); ok {
+		c.Port = addr.Port
+	}
+	p.GetLogger(ctx).Debugf("Opened %s tunnel on %s", c.Type.OrDefault(), c.Address)
+
+	tunnels.Lock()
+	tunnels.m[id] = t
+	tunnels.Unlock()
+	go t.serve()
+	return nil
+}
+
+func (t *tunnel) serve() {
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			// The tunnel was closed.
+			return
+		}
+		go t.forward(conn)
+	}
+}
+
+// forward copies between conn and a new connection to the target until either side closes.
+func (t *tunnel) forward(conn net.Conn) {
+	defer conn.Close()
+	target, err := t.dial()
+	if err != nil {
+		t.logger.Warningf("The tunnel couldn't forward a connection to %s: %v", t.target, err)
+		return
+	}
+	defer target.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(target, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, target)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// close stops accepting connections and closes the SSH connection, which also ends the
+// forwarded connections.
+func (t *tunnel) close() {
+	t.listener.Close()
+	t.client.Close()
+}
+
+func ptrOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
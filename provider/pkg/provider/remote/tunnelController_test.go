@@ -0,0 +1,160 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+// startEchoServer listens on a free local port and echoes what's sent to it, and returns the port.
+func startEchoServer(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// assertEcho asserts that a message sent to address is echoed back.
+func assertEcho(t *testing.T, address string) {
+	t.Helper()
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+}
+
+func TestTunnel(t *testing.T) {
+	echoPort := startEchoServer(t)
+	server := testutil.NewForwardingTestSSHServer(t, func(ssh.Session) {})
+	ctx := &testutil.TestContext{Context: context.Background()}
+
+	t.Run("local", func(t *testing.T) {
+		inputs := TunnelInputs{Connection: execConnection(server), RemotePort: &echoPort}
+		created, err := (&Tunnel{}).Create(ctx, infer.CreateRequest[TunnelInputs]{Name: "name", Inputs: inputs})
+		require.NoError(t, err)
+		address := created.Output.Address
+		assert.NotZero(t, created.Output.Port)
+		assertEcho(t, address)
+
+		diff := func(id string) infer.DiffResponse {
+			resp, err := (&Tunnel{}).Diff(ctx, infer.DiffRequest[TunnelInputs, TunnelOutputs]{
+				ID: id, State: created.Output, Inputs: inputs,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+		assert.False(t, diff(created.ID).HasChanges)
+
+		// A tunnel that isn't open in this provider process is reopened on the same address.
+		closeTunnel(created.ID)
+		assert.False(t, diff(created.ID).HasChanges)
+		assertEcho(t, address)
+
+		// If the address is taken, the tunnel is opened on a new address by the update.
+		assert.Equal(t, map[string]p.PropertyDiff{"address": {Kind: p.Update}}, diff("other").DetailedDiff)
+		assert.Nil(t, openTunnel("other"))
+
+		_, err = (&Tunnel{}).Delete(ctx, infer.DeleteRequest[TunnelOutputs]{ID: created.ID, State: created.Output})
+		require.NoError(t, err)
+		_, err = net.Dial("tcp", address)
+		assert.Error(t, err)
+	})
+
+	t.Run("remote", func(t *testing.T) {
+		remoteType := TunnelRemote
+		inputs := TunnelInputs{
+			Connection: execConnection(server),
+			Type:       &remoteType,
+			LocalPort:  &echoPort,
+			RemoteHost: pulumi.StringRef("127.0.0.1"),
+		}
+		created, err := (&Tunnel{}).Create(ctx, infer.CreateRequest[TunnelInputs]{Name: "name", Inputs: inputs})
+		require.NoError(t, err)
+		assertEcho(t, created.Output.Address)
+
+		// The server stops listening once the connection is closed.
+		CloseTunnels()
+		assert.Eventually(t, func() bool {
+			conn, err := net.Dial("tcp", created.Output.Address)
+			if err == nil {
+				conn.Close()
+			}
+			return err != nil
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		inputs := TunnelInputs{Connection: execConnection(server), LocalPort: pulumi.IntRef(8432), RemotePort: &echoPort}
+		created, err := (&Tunnel{}).Create(ctx, infer.CreateRequest[TunnelInputs]{
+			Name: "name", Inputs: inputs, DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:8432", created.Output.Address)
+		assert.Nil(t, openTunnel(created.ID))
+	})
+}
+
+func TestTunnelCheck(t *testing.T) {
+	check := func(inputs map[string]any) []p.CheckFailure {
+		inputs["connection"] = map[string]any{"host": "myhost"}
+		news := resource.FromResourcePropertyMap(resource.NewPropertyMapFromMap(inputs))
+		resp, err := (&Tunnel{}).Check(t.Context(), infer.CheckRequest{Name: "name", NewInputs: news})
+		require.NoError(t, err)
+		return resp.Failures
+	}
+
+	assert.Empty(t, check(map[string]any{"remotePort": 5432}))
+	assert.Empty(t, check(map[string]any{"type": "remote", "localPort": 8080}))
+
+	failures := check(map[string]any{"localPort": 5432})
+	require.Len(t, failures, 1)
+	assert.Equal(t, "remotePort", failures[0].Property)
+
+	failures = check(map[string]any{"type": "remote", "localPort": 70000})
+	require.Len(t, failures, 1)
+	assert.Equal(t, "localPort", failures[0].Property)
+}
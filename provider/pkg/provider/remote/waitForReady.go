@@ -0,0 +1,82 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"time"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type WaitForReady struct{}
+
+var _ = (infer.Annotated)((*WaitForReady)(nil))
+
+// WaitForReady implements Annotate which allows you to attach descriptions to the WaitForReady resource.
+func (c *WaitForReady) Annotate(a infer.Annotator) {
+	a.Describe(&c, `Waits until a remote host accepts SSH connections, e.g. after creating a VM, so that the
+resources depending on it don't have to retry. Optionally, it also waits until a probe command succeeds on the
+host, e.g. 'cloud-init status --wait'.
+
+The host is waited for on creation, and again when any of the inputs change.`)
+}
+
+// The arguments for a remote WaitForReady resource.
+type WaitForReadyInputs struct {
+	Connection *Connection    `pulumi:"connection"        provider:"secret"`
+	Triggers   *[]interface{} `pulumi:"triggers,optional"`
+	Probe      *string        `pulumi:"probe,optional"`
+	Timeout    *int           `pulumi:"timeout,optional"`
+	Interval   *int           `pulumi:"interval,optional"`
+}
+
+func (c *WaitForReadyInputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.Connection, `The parameters with which to connect to the remote host. Each attempt dials
+the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.`)
+	a.Describe(&c.Triggers, "Wait for the host again on changes to this input.")
+	a.Describe(&c.Probe, `A command that must succeed on the host for it to be ready, e.g.
+'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.`)
+	a.Describe(&c.Timeout, `The maximum number of seconds to wait for the host in total. Defaults to 600.`)
+	a.Describe(&c.Interval, `The number of seconds between the attempts to connect and run the probe.
+Defaults to 5.`)
+}
+
+// The properties for a remote WaitForReady resource.
+type WaitForReadyOutputs struct {
+	WaitForReadyInputs
+	HostKey        string `pulumi:"hostKey"`
+	ConnectLatency int    `pulumi:"connectLatency"`
+}
+
+func (c *WaitForReadyOutputs) Annotate(a infer.Annotator) {
+	a.Describe(&c.HostKey, `The host key that the host presented on the successful connection, in the
+authorized_keys format, e.g. to pin it as the 'hostKey' of later connections.`)
+	a.Describe(&c.ConnectLatency, "The number of milliseconds that the successful connection took to establish.")
+}
+
+func (c *WaitForReadyInputs) timeout() time.Duration {
+	if c.Timeout == nil || *c.Timeout <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(*c.Timeout) * time.Second
+}
+
+func (c *WaitForReadyInputs) interval() time.Duration {
+	if c.Interval == nil || *c.Interval <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(*c.Interval) * time.Second
+}
@@ -0,0 +1,123 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+var (
+	_ = (infer.CustomResource[WaitForReadyInputs, WaitForReadyOutputs])((*WaitForReady)(nil))
+	_ = (infer.CustomUpdate[WaitForReadyInputs, WaitForReadyOutputs])((*WaitForReady)(nil))
+)
+
+// Create waits for the host.
+func (*WaitForReady) Create(
+	ctx context.Context,
+	req infer.CreateRequest[WaitForReadyInputs],
+) (infer.CreateResponse[WaitForReadyOutputs], error) {
+	state := WaitForReadyOutputs{WaitForReadyInputs: req.Inputs}
+	id, err := resource.NewUniqueHex(req.Name, 8, 0)
+	if err != nil {
+		return infer.CreateResponse[WaitForReadyOutputs]{ID: "", Output: state}, err
+	}
+	if req.DryRun {
+		return infer.CreateResponse[WaitForReadyOutputs]{ID: id, Output: state}, nil
+	}
+
+	err = state.wait(ctx)
+	return infer.CreateResponse[WaitForReadyOutputs]{ID: id, Output: state}, err
+}
+
+// Update waits for the host again.
+func (*WaitForReady) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[WaitForReadyInputs, WaitForReadyOutputs],
+) (infer.UpdateResponse[WaitForReadyOutputs], error) {
+	state := WaitForReadyOutputs{WaitForReadyInputs: req.Inputs}
+	if req.DryRun {
+		return infer.UpdateResponse[WaitForReadyOutputs]{Output: state}, nil
+	}
+
+	err := state.wait(ctx)
+	return infer.UpdateResponse[WaitForReadyOutputs]{Output: state}, err
+}
+
+// wait connects to the host and runs the probe every interval until both succeed, and records the
+// host key and latency of the successful connection in c.
+func (c *WaitForReadyOutputs) wait(ctx context.Context) error {
+	timeout := c.timeout()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Each attempt dials once, the retries are up to the timeout.
+	conn := *c.Connection
+	conn.DialErrorLimit = new(int)
+
+	var lastErr error
+	_, err := dialWithRetryEvery(ctx, "Waiting for the host", dialErrorUnlimited, c.interval(),
+		func() (struct{}, error) {
+			var hostKey ssh.PublicKey
+			start := time.Now()
+			client, err := conn.dial(ctx, func(key ssh.PublicKey) { hostKey = key })
+			if err != nil {
+				lastErr = err
+				return struct{}{}, err
+			}
+			latency := time.Since(start)
+			defer client.Close()
+
+			if c.Probe != nil && *c.Probe != "" {
+				if err := runProbe(ctx, client, *c.Probe); err != nil {
+					lastErr = fmt.Errorf("probe failed: %w", err)
+					return struct{}{}, lastErr
+				}
+			}
+			c.HostKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey)))
+			c.ConnectLatency = int(latency.Milliseconds())
+			return struct{}{}, nil
+		})
+	if err != nil && errors.Is(err, context.DeadlineExceeded) && lastErr != nil {
+		return fmt.Errorf("host %s wasn't ready after %s: %w", *c.Connection.Host, timeout, lastErr)
+	}
+	return err
+}
+
+// runProbe runs the probe like runSession, so that a probe that blocks, e.g. `cloud-init status
+// --wait`, is stopped when ctx is done.
+func runProbe(ctx context.Context, client *ssh.Client, probe string) error {
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+	if err := runSession(ctx, client, session, probe, 0); err != nil {
+		return fmt.Errorf("%w: running %q:\n%s", err, probe, stderr.String())
+	}
+	return nil
+}
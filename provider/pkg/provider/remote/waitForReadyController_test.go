@@ -0,0 +1,93 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+	"github.com/pulumi/pulumi-command/provider/pkg/provider/util/testutil"
+)
+
+func TestWaitForReady(t *testing.T) {
+	dir := t.TempDir()
+	server := newExecServer(t, dir)
+	ctx := &testutil.TestContext{Context: context.Background()}
+	create := func(inputs WaitForReadyInputs) (WaitForReadyOutputs, error) {
+		resp, err := (&WaitForReady{}).Create(ctx, infer.CreateRequest[WaitForReadyInputs]{Name: "name", Inputs: inputs})
+		return resp.Output, err
+	}
+
+	t.Run("connect", func(t *testing.T) {
+		out, err := create(WaitForReadyInputs{Connection: execConnection(server)})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out.HostKey, "ssh-rsa "), out.HostKey)
+		assert.GreaterOrEqual(t, out.ConnectLatency, 0)
+	})
+
+	t.Run("probe", func(t *testing.T) {
+		go func() {
+			time.Sleep(1500 * time.Millisecond)
+			_ = os.WriteFile(filepath.Join(dir, "ready"), nil, 0o600)
+		}()
+		start := time.Now()
+		_, err := create(WaitForReadyInputs{
+			Connection: execConnection(server),
+			Probe:      pulumi.StringRef("test -e ready"),
+			Interval:   pulumi.IntRef(1),
+		})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		// A port that nothing listens on.
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		conn := execConnection(server)
+		conn.Port = pulumi.Float64Ref(float64(listener.Addr().(*net.TCPAddr).Port))
+		listener.Close()
+
+		_, err = create(WaitForReadyInputs{Connection: conn, Timeout: pulumi.IntRef(2), Interval: pulumi.IntRef(1)})
+		require.ErrorContains(t, err, "wasn't ready after 2s")
+		assert.ErrorContains(t, err, "connection refused")
+	})
+	t.Run("probe that never exits", func(t *testing.T) {
+		gracePeriod := signalGracePeriod
+		signalGracePeriod = 500 * time.Millisecond
+		t.Cleanup(func() { signalGracePeriod = gracePeriod })
+
+		start := time.Now()
+		_, err := create(WaitForReadyInputs{
+			Connection: execConnection(server),
+			Probe:      pulumi.StringRef("sleep 1000"),
+			Timeout:    pulumi.IntRef(2),
+		})
+		require.ErrorContains(t, err, "wasn't ready after 2s")
+		assert.ErrorContains(t, err, "probe failed")
+		assert.Less(t, time.Since(start), 10*time.Second)
+	})
+}
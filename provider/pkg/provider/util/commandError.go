@@ -0,0 +1,165 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultErrorOutputLines is how many of the last lines of stdout and stderr a CommandError
+// shows by default.
+const DefaultErrorOutputLines = 20
+
+// CommandError is the error of a command that failed, rendered the same for local and remote
+// commands. Rather than the whole output, it shows the last lines of stdout and stderr.
+type CommandError struct {
+	// Command is the command that was run.
+	Command string
+	// ExitCode is the exit code of the command, or -1 if it didn't exit, e.g. because it was
+	// killed by a signal or couldn't be started.
+	ExitCode int
+	// Signal is the name of the signal that killed the command, if any, e.g. "KILL".
+	Signal string
+	// Duration is how long the command ran.
+	Duration time.Duration
+	// Stdout and Stderr are the last lines of the output of the command.
+	Stdout, Stderr *TailWriter
+	// Redactor masks the secrets when rendering the error.
+	Redactor *Redactor
+	// Err is the error that the command failed with. It's only rendered if the command didn't
+	// exit, since it then says why, e.g. that the command timed out.
+	Err error
+}
+
+func (e *CommandError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "running %q ", e.Command)
+	duration := e.Duration.Round(time.Millisecond)
+	switch {
+	case e.Signal != "":
+		fmt.Fprintf(&b, "was killed by signal %s after %s", e.Signal, duration)
+	case e.ExitCode >= 0:
+		fmt.Fprintf(&b, "failed with exit code %d after %s", e.ExitCode, duration)
+	default:
+		fmt.Fprintf(&b, "failed after %s: %v", duration, e.Err)
+	}
+	writeTail(&b, "stdout", e.Stdout)
+	writeTail(&b, "stderr", e.Stderr)
+	return e.Redactor.Redact(b.String())
+}
+
+func (e *CommandError) Unwrap() error {
+	return e.Err
+}
+
+func writeTail(b *strings.Builder, name string, tail *TailWriter) {
+	if tail == nil {
+		return
+	}
+	lines, omitted := tail.Lines()
+	if len(lines) == 0 {
+		return
+	}
+	if omitted > 0 {
+		fmt.Fprintf(b, "\n%s (last %d lines, %d omitted):", name, len(lines), omitted)
+	} else {
+		fmt.Fprintf(b, "\n%s:", name)
+	}
+	for _, line := range lines {
+		b.WriteString("\n")
+		b.WriteString(line)
+	}
+}
+
+// TailWriter is a writer that keeps the last lines written to it. It's safe for concurrent use.
+type TailWriter struct {
+	max     int
+	mu      sync.Mutex
+	lines   []string
+	partial []byte
+	omitted int
+}
+
+// NewTailWriter returns a TailWriter that keeps the last lines, DefaultErrorOutputLines if lines
+// is nil.
+func NewTailWriter(lines *int) *TailWriter {
+	if lines == nil {
+		return &TailWriter{max: DefaultErrorOutputLines}
+	}
+	return &TailWriter{max: max(*lines, 0)}
+}
+
+func (w *TailWriter) Write(bs []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.partial = append(w.partial, bs...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.add(string(bytes.TrimSuffix(w.partial[:i], []byte("\r"))))
+		w.partial = w.partial[i+1:]
+	}
+	// Don't keep an unbounded line, e.g. a progress bar without newlines.
+	if len(w.partial) > maxTailLineLength {
+		w.partial = w.partial[len(w.partial)-maxTailLineLength:]
+	}
+	return len(bs), nil
+}
+
+// maxTailLineLength is the length of the end of a line that a TailWriter keeps.
+const maxTailLineLength = 4096
+
+func (w *TailWriter) add(line string) {
+	if len(line) > maxTailLineLength {
+		line = line[len(line)-maxTailLineLength:]
+	}
+	w.lines = append(w.lines, line)
+	if len(w.lines) > w.max {
+		w.omitted += len(w.lines) - w.max
+		w.lines = w.lines[len(w.lines)-w.max:]
+	}
+}
+
+// String returns the last lines, including an unterminated last line, joined by newlines.
+func (w *TailWriter) String() string {
+	if w == nil {
+		return ""
+	}
+	lines, _ := w.Lines()
+	return strings.Join(lines, "\n")
+}
+
+// Lines returns the last lines, including an unterminated last line, and how many lines were
+// omitted before them.
+func (w *TailWriter) Lines() ([]string, int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	lines := append([]string{}, w.lines...)
+	omitted := w.omitted
+	if len(w.partial) > 0 && w.max > 0 {
+		lines = append(lines, string(w.partial))
+		if len(lines) > w.max {
+			omitted++
+			lines = lines[1:]
+		}
+	}
+	return lines, omitted
+}
@@ -0,0 +1,59 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// GlobRules is an ordered list of path globs as accepted by `assetPaths` and `archivePaths`. Globs
+// starting with `!` are exclude rules, and later rules take precedence over earlier ones. Paths
+// are relative and use `/` as the separator on all platforms.
+type GlobRules struct {
+	globs     []glob.Glob
+	isExclude []bool
+}
+
+func CompileGlobRules(rules []string) (GlobRules, error) {
+	compiled := GlobRules{
+		globs:     make([]glob.Glob, len(rules)),
+		isExclude: make([]bool, len(rules)),
+	}
+	for i, g := range rules {
+		isExclude := strings.HasPrefix(g, "!")
+		g = strings.TrimPrefix(g, "!")
+		c, err := glob.Compile(g, '/')
+		if err != nil {
+			return GlobRules{}, err
+		}
+		compiled.globs[i] = c
+		compiled.isExclude[i] = isExclude
+	}
+	return compiled, nil
+}
+
+// Match evaluates the rules against path. matched reports whether any rule matched, and included
+// whether the last matching rule was an include rule.
+func (r GlobRules) Match(path string) (matched, included bool) {
+	for i, g := range r.globs {
+		if g.Match(path) {
+			matched = true
+			included = !r.isExclude[i]
+		}
+	}
+	return matched, included
+}
@@ -0,0 +1,162 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// RedactedValue replaces secret values in logs, errors and, optionally, outputs.
+const RedactedValue = "[secret]"
+
+// minRedactedLength is the length below which values aren't masked, since masking e.g. "1" or
+// "on" everywhere would make the output unreadable without protecting much. A warning is logged
+// instead.
+const minRedactedLength = 4
+
+// Redactor masks secret values in text. It's safe for concurrent use.
+type Redactor struct {
+	mu sync.RWMutex
+	// values are sorted longest first, so that a secret containing another is masked as a whole.
+	values []string
+	// ctx is used to warn about secrets that are too short to mask, once.
+	ctx    context.Context
+	warned bool
+}
+
+// NewRedactor returns a Redactor for the secrets of the request in ctx, see RedactSecrets, and
+// the given values.
+func NewRedactor(ctx context.Context, values ...string) *Redactor {
+	r := &Redactor{ctx: ctx}
+	if secrets, ok := ctx.Value(secretsKey{}).([]string); ok {
+		r.Add(secrets...)
+	}
+	r.Add(values...)
+	return r
+}
+
+// Add adds secret values to mask. The lines of a multi-line value, like a private key, are also
+// masked separately, since logs are streamed by line.
+func (r *Redactor) Add(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	add := func(v string) {
+		if len(v) >= minRedactedLength && !slices.Contains(r.values, v) {
+			r.values = append(r.values, v)
+		}
+	}
+	short := false
+	for _, v := range values {
+		short = short || (v != "" && len(v) < minRedactedLength)
+		add(v)
+		if strings.Contains(v, "\n") {
+			for _, line := range strings.Split(v, "\n") {
+				add(strings.TrimSpace(line))
+			}
+		}
+	}
+	slices.SortFunc(r.values, func(a, b string) int { return len(b) - len(a) })
+	if short && !r.warned && r.ctx != nil {
+		r.warned = true
+		logMessage(r.ctx, diag.Warning,
+			fmt.Sprintf("A secret value shorter than %d characters isn't masked in the output", minRedactedLength), false)
+	}
+}
+
+// Redact returns s with the secret values masked. A nil Redactor doesn't mask anything.
+func (r *Redactor) Redact(s string) string {
+	if r == nil {
+		return s
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, v := range r.values {
+		s = strings.ReplaceAll(s, v, RedactedValue)
+	}
+	return s
+}
+
+type secretsKey struct{}
+
+// RedactSecrets wraps a provider so that the secret strings in the inputs of each create, update,
+// delete and invoke request are added to its context, for NewRedactor to mask them, since the
+// typed inputs of infer don't say which values were secret. The strings in a secret array or map
+// are secret too, except in the top-level inputs named by opaque, like connections, which are
+// secret as a whole although e.g. their host isn't.
+func RedactSecrets(provider p.Provider, opaque ...string) p.Provider {
+	create, update, del, invoke := provider.Create, provider.Update, provider.Delete, provider.Invoke
+	if create != nil {
+		provider.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			return create(withSecrets(ctx, opaque, req.Properties), req)
+		}
+	}
+	if update != nil {
+		provider.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			return update(withSecrets(ctx, opaque, req.Inputs, req.OldInputs), req)
+		}
+	}
+	if del != nil {
+		provider.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			return del(withSecrets(ctx, opaque, req.OldInputs), req)
+		}
+	}
+	if invoke != nil {
+		provider.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+			return invoke(withSecrets(ctx, opaque, req.Args), req)
+		}
+	}
+	return provider
+}
+
+func withSecrets(ctx context.Context, opaque []string, maps ...property.Map) context.Context {
+	var secrets []string
+	var collect func(v property.Value, secret bool)
+	collect = func(v property.Value, secret bool) {
+		secret = secret || v.Secret()
+		switch {
+		case v.IsString() && secret:
+			secrets = append(secrets, v.AsString())
+		case v.IsArray():
+			for _, e := range v.AsArray().All {
+				collect(e, secret)
+			}
+		case v.IsMap():
+			for _, e := range v.AsMap().All {
+				collect(e, secret)
+			}
+		}
+	}
+	for _, m := range maps {
+		for k, v := range m.All {
+			if slices.Contains(opaque, k) {
+				// Only the values that are secret themselves.
+				v = v.WithSecret(false)
+			}
+			collect(v, false)
+		}
+	}
+	if len(secrets) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, secretsKey{}, secrets)
+}
@@ -0,0 +1,50 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Defaults of the pseudo-terminal that commands are run in.
+const (
+	DefaultTerm = "xterm"
+	DefaultRows = 24
+	DefaultCols = 80
+)
+
+// TerminalEOF ends the standard input written to a pseudo-terminal. The first Ctrl-D ends an
+// unterminated last line, the second one is read as the end of the input.
+const TerminalEOF = "\x04\x04"
+
+// ansiRegexp matches ANSI escape sequences: CSI sequences like colors and cursor movements, OSC
+// sequences like window titles, and two-character escapes.
+var ansiRegexp = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b[@-Z\\-_]`)
+
+// StripANSI removes the ANSI escape sequences from s.
+func StripANSI(s string) string {
+	return ansiRegexp.ReplaceAllString(s, "")
+}
+
+// TerminalOutput converts the output of a pseudo-terminal to plain lines: the terminal's "\r\n"
+// line endings become "\n", and with stripANSI, escape sequences are removed.
+func TerminalOutput(s string, stripANSI bool) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if stripANSI {
+		s = StripANSI(s)
+	}
+	return s
+}
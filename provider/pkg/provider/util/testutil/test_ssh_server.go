@@ -20,6 +20,30 @@ type TestSSHServer struct {
 // The server is bound to an arbitrary free port, and automatically closed
 // during test cleanup.
 func NewTestSSHServer(t *testing.T, handler ssh.Handler) TestSSHServer {
+	return serve(t, &ssh.Server{Handler: handler})
+}
+
+// NewForwardingTestSSHServer creates a new in-process SSH server like NewTestSSHServer that
+// additionally allows local and remote port forwarding.
+func NewForwardingTestSSHServer(t *testing.T, handler ssh.Handler) TestSSHServer {
+	forwarded := &ssh.ForwardedTCPHandler{}
+	allow := func(ssh.Context, string, uint32) bool { return true }
+	return serve(t, &ssh.Server{
+		Handler:                       handler,
+		LocalPortForwardingCallback:   allow,
+		ReversePortForwardingCallback: allow,
+		ChannelHandlers: map[string]ssh.ChannelHandler{
+			"session":      ssh.DefaultSessionHandler,
+			"direct-tcpip": ssh.DirectTCPIPHandler,
+		},
+		RequestHandlers: map[string]ssh.RequestHandler{
+			"tcpip-forward":        forwarded.HandleSSHRequest,
+			"cancel-tcpip-forward": forwarded.HandleSSHRequest,
+		},
+	})
+}
+
+func serve(t *testing.T, server *ssh.Server) TestSSHServer {
 	const host = "127.0.0.1"
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", host, 0))
@@ -28,7 +52,6 @@ func NewTestSSHServer(t *testing.T, handler ssh.Handler) TestSSHServer {
 	port, err := strconv.ParseInt(strings.Split(listener.Addr().String(), ":")[1], 10, 64)
 	require.NoErrorf(t, err, "parse address %s allocated port number as int", listener.Addr())
 
-	server := ssh.Server{Handler: handler}
 	go func() {
 		// "Serve always returns a non-nil error."
 		_ = server.Serve(listener)
@@ -12,14 +12,23 @@ import (
 type TestContext struct {
 	context.Context
 	Output bytes.Buffer
+	// Messages are the log messages with their severity.
+	Messages []LogMessage
 }
 
-func (c *TestContext) log(msg string) {
+// LogMessage is a message logged to a TestContext.
+type LogMessage struct {
+	Severity diag.Severity
+	Msg      string
+}
+
+func (c *TestContext) log(severity diag.Severity, msg string) {
 	c.Output.WriteString(msg)
+	c.Messages = append(c.Messages, LogMessage{Severity: severity, Msg: msg})
 }
 
-func (c *TestContext) Log(_ diag.Severity, msg string)                  { c.log(msg) }
-func (c *TestContext) Logf(_ diag.Severity, msg string, _ ...any)       { c.log(msg) }
-func (c *TestContext) LogStatus(_ diag.Severity, msg string)            { c.log(msg) }
-func (c *TestContext) LogStatusf(_ diag.Severity, msg string, _ ...any) { c.log(msg) }
-func (c *TestContext) RuntimeInformation() p.RunInfo                    { return p.RunInfo{} }
+func (c *TestContext) Log(severity diag.Severity, msg string)                  { c.log(severity, msg) }
+func (c *TestContext) Logf(severity diag.Severity, msg string, _ ...any)       { c.log(severity, msg) }
+func (c *TestContext) LogStatus(severity diag.Severity, msg string)            { c.log(severity, msg) }
+func (c *TestContext) LogStatusf(severity diag.Severity, msg string, _ ...any) { c.log(severity, msg) }
+func (c *TestContext) RuntimeInformation() p.RunInfo                           { return p.RunInfo{} }
@@ -0,0 +1,58 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"context"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type urnKey struct{}
+
+// WithURN wraps a provider so that the URN of the resource of each create, update and delete
+// request is added to its context, for URN to return it, since the typed requests of infer don't
+// include it.
+func WithURN(provider p.Provider) p.Provider {
+	create, update, del := provider.Create, provider.Update, provider.Delete
+	if create != nil {
+		provider.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			return create(ContextWithURN(ctx, req.Urn), req)
+		}
+	}
+	if update != nil {
+		provider.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			return update(ContextWithURN(ctx, req.Urn), req)
+		}
+	}
+	if del != nil {
+		provider.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			return del(ContextWithURN(ctx, req.Urn), req)
+		}
+	}
+	return provider
+}
+
+// ContextWithURN returns a copy of ctx for the resource with the urn.
+func ContextWithURN(ctx context.Context, urn resource.URN) context.Context {
+	return context.WithValue(ctx, urnKey{}, urn)
+}
+
+// URN returns the URN of the resource that ctx is for, see WithURN, or "" if there is none.
+func URN(ctx context.Context) resource.URN {
+	urn, _ := ctx.Value(urnKey{}).(resource.URN)
+	return urn
+}
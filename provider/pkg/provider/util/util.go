@@ -15,10 +15,13 @@
 package util //nolint:revive
 
 import (
-	"bufio"
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"strings"
 	"sync"
+	"time"
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
@@ -29,27 +32,191 @@ import (
 const PulumiCommandStdout = "PULUMI_COMMAND_STDOUT"
 const PulumiCommandStderr = "PULUMI_COMMAND_STDERR"
 
-func LogOutput(ctx context.Context, r io.Reader, doneCh chan<- struct{}, severity diag.Severity) {
-	defer close(doneCh)
-	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
-		msg := scanner.Text()
-		l := p.GetLogger(ctx)
-		switch severity {
-		case diag.Info:
-			l.InfoStatus(msg)
-		case diag.Warning:
-			l.WarningStatus(msg)
-		case diag.Error:
-			l.ErrorStatus(msg)
-		default:
-			l.DebugStatus(msg)
+// OutputLogger logs the lines of the output streams of a command, in the order they arrive.
+type OutputLogger struct {
+	ctx      context.Context
+	redactor *Redactor
+	label    string
+	prefix   bool
+	mu       sync.Mutex
+	streams  []*streamLogger
+
+	start time.Time
+	// lastOutput is when the last output arrived, and lastLine the last line that was logged.
+	lastOutput time.Time
+	lastLine   string
+	stop       chan struct{}
+	stopped    chan struct{}
+}
+
+// NewOutputLogger returns an OutputLogger that masks the secrets of redactor. It prefixes each
+// line with label, if set, e.g. "[10.0.0.5] " for a host, and, if prefix is set, with the name of
+// its stream, e.g. "[stderr] ".
+func NewOutputLogger(ctx context.Context, redactor *Redactor, label string, prefix bool) *OutputLogger {
+	return &OutputLogger{ctx: ctx, redactor: redactor, label: label, prefix: prefix}
+}
+
+// DefaultHeartbeatInterval is how long a command is silent before the heartbeat by default.
+const DefaultHeartbeatInterval = time.Minute
+
+// HeartbeatInterval returns the interval of the heartbeat in seconds, DefaultHeartbeatInterval
+// if it's nil, or 0 to disable it.
+func HeartbeatInterval(seconds *int) time.Duration {
+	if seconds == nil {
+		return DefaultHeartbeatInterval
+	}
+	return time.Duration(*seconds) * time.Second
+}
+
+// StartHeartbeat sets the status to "still running" with the elapsed time and the last line each
+// time the command is silent for the interval, so that a long-running command doesn't look stuck.
+// Close stops it.
+func (l *OutputLogger) StartHeartbeat(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	l.start = time.Now()
+	l.stop, l.stopped = make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(l.stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-l.stop:
+				return
+			case <-ticker.C:
+				l.heartbeat(interval)
+			}
+		}
+	}()
+}
+
+func (l *OutputLogger) heartbeat(interval time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	elapsed := now.Sub(l.start).Round(time.Second)
+	var msg string
+	switch {
+	case l.lastOutput.IsZero():
+		msg = fmt.Sprintf("still running, %s elapsed, no output yet", elapsed)
+	case now.Sub(l.lastOutput) < interval:
+		return
+	default:
+		msg = fmt.Sprintf("still running, %s elapsed, last output %s ago", elapsed,
+			now.Sub(l.lastOutput).Round(time.Second))
+	}
+	if l.lastLine != "" {
+		msg += ": " + l.lastLine
+	}
+	logMessage(l.ctx, diag.Info, l.label+msg, true)
+}
+
+// StreamOptions are the options of a stream of an OutputLogger.
+type StreamOptions struct {
+	// Severity is the severity with which the lines are logged.
+	Severity diag.Severity
+	// Quiet doesn't log the lines, e.g. to only run the workflow commands.
+	Quiet bool
+	// WorkflowCommands runs the workflow commands among the lines, see RunWorkflowCommand,
+	// rather than logging them.
+	WorkflowCommands bool
+}
+
+// Stream returns a writer for the stream with the name.
+func (l *OutputLogger) Stream(name string, opts StreamOptions) io.Writer {
+	s := &streamLogger{logger: l, name: name, StreamOptions: opts}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.streams = append(l.streams, s)
+	return s
+}
+
+// Close stops the heartbeat and logs the unterminated last lines of the streams.
+func (l *OutputLogger) Close() {
+	if l.stop != nil {
+		close(l.stop)
+		<-l.stopped
+		l.stop = nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, s := range l.streams {
+		if len(s.partial) > 0 {
+			l.log(s, string(s.partial))
+			s.partial = nil
 		}
+	}
+}
+
+// log logs a line of the stream. l.mu must be held.
+func (l *OutputLogger) log(s *streamLogger, line string) {
+	line = strings.TrimSuffix(line, "\r")
+	if s.WorkflowCommands && RunWorkflowCommand(l.ctx, line, l.label, l.redactor) {
+		return
+	}
+	if s.Quiet {
+		return
+	}
+	msg := l.redactor.Redact(line)
+	if l.prefix {
+		msg = "[" + s.name + "] " + msg
+	}
+	l.lastLine = msg
+	logMessage(l.ctx, s.Severity, l.label+msg, true)
+}
+
+// logMessage logs the message with the severity, as an ephemeral status message if status is set.
+func logMessage(ctx context.Context, severity diag.Severity, msg string, status bool) {
+	logger := p.GetLogger(ctx)
+	switch {
+	case severity == diag.Info && status:
+		logger.InfoStatus(msg)
+	case severity == diag.Info:
+		logger.Info(msg)
+	case severity == diag.Warning && status:
+		logger.WarningStatus(msg)
+	case severity == diag.Warning:
+		logger.Warning(msg)
+	case severity == diag.Error && status:
+		logger.ErrorStatus(msg)
+	case severity == diag.Error:
+		logger.Error(msg)
+	case status:
+		logger.DebugStatus(msg)
+	default:
+		logger.Debug(msg)
+	}
+
+	if testCtx, ok := ctx.(*testutil.TestContext); ok {
+		testCtx.Log(severity, msg)
+	}
+}
+
+type streamLogger struct {
+	StreamOptions
+	logger  *OutputLogger
+	name    string
+	partial []byte
+}
 
-		if testCtx, ok := ctx.(*testutil.TestContext); ok {
-			testCtx.Log(severity, msg)
+// Write logs the complete lines, holding the logger's lock so that the lines of all streams are
+// logged in the order they arrive.
+func (s *streamLogger) Write(bs []byte) (int, error) {
+	s.logger.mu.Lock()
+	defer s.logger.mu.Unlock()
+	s.logger.lastOutput = time.Now()
+	s.partial = append(s.partial, bs...)
+	for {
+		i := bytes.IndexByte(s.partial, '\n')
+		if i < 0 {
+			break
 		}
+		s.logger.log(s, string(s.partial[:i]))
+		s.partial = s.partial[i+1:]
 	}
+	return len(bs), nil
 }
 
 // NoopLogger satisfies the expected logger shape but doesn't actually log.
@@ -0,0 +1,134 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+)
+
+// The ways of handling symlinks when walking a local directory tree. These are the values of the
+// `SymlinkPolicy` enums of `local` and `remote`.
+const (
+	SymlinksPreserve = "preserve"
+	SymlinksFollow   = "follow"
+	SymlinksSkip     = "skip"
+)
+
+// SymlinksAsFiles is the policy if none is set. It keeps the behavior from before the policies
+// existed: links are reported as files, like fs.WalkDir does, so that links to files are read through
+// the link and links to directories aren't walked.
+const SymlinksAsFiles = ""
+
+// WalkEntry is a directory, file or symlink found by WalkFiles.
+type WalkEntry struct {
+	// Path is relative to the root of the walk and uses `/` as the separator. The root itself is ".".
+	Path string
+	// Mode is either fs.ModeDir, fs.ModeSymlink or 0 for files.
+	Mode fs.FileMode
+	// Target is the destination of a symlink, as stored in the link.
+	Target string
+}
+
+func (e WalkEntry) IsDir() bool     { return e.Mode == fs.ModeDir }
+func (e WalkEntry) IsSymlink() bool { return e.Mode == fs.ModeSymlink }
+
+// WalkFiles walks the directory tree at root in lexical order, calling fn for each directory before
+// its contents. Symlinks below root are handled according to policy:
+//   - SymlinksFollow: links are resolved and reported as the file or directory they point to.
+//     Linked directories are walked, and a link to one of its own parent directories is an error.
+//   - SymlinksPreserve: links are reported as symlinks and not followed.
+//   - SymlinksSkip: links are ignored.
+//   - SymlinksAsFiles: links are reported as files and not followed.
+func WalkFiles(root, policy string, fn func(WalkEntry) error) error {
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return err
+	}
+	if err := fn(WalkEntry{Path: ".", Mode: fs.ModeDir}); err != nil {
+		return err
+	}
+	return walkDir(root, ".", []string{realRoot}, policy, fn)
+}
+
+// walkDir walks the local directory dir, reported as rel. ancestors holds the resolved paths of dir
+// and its parents, for loop detection.
+func walkDir(dir, rel string, ancestors []string, policy string, fn func(WalkEntry) error) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		full := filepath.Join(dir, e.Name())
+		entry := WalkEntry{Path: path.Join(rel, e.Name())}
+		realPath := filepath.Join(ancestors[len(ancestors)-1], e.Name())
+
+		if e.Type()&fs.ModeSymlink != 0 {
+			switch policy {
+			case SymlinksSkip:
+				continue
+			case SymlinksAsFiles:
+				if err := fn(entry); err != nil {
+					return err
+				}
+				continue
+			case SymlinksPreserve:
+				if entry.Target, err = os.Readlink(full); err != nil {
+					return err
+				}
+				entry.Mode = fs.ModeSymlink
+				if err := fn(entry); err != nil {
+					return err
+				}
+				continue
+			}
+
+			info, err := os.Stat(full)
+			if err != nil {
+				return fmt.Errorf("failed to follow symlink %s: %w", full, err)
+			}
+			if !info.IsDir() {
+				if err := fn(entry); err != nil {
+					return err
+				}
+				continue
+			}
+			if realPath, err = filepath.EvalSymlinks(full); err != nil {
+				return fmt.Errorf("failed to follow symlink %s: %w", full, err)
+			}
+			if slices.Contains(ancestors, realPath) {
+				return fmt.Errorf("symlink loop detected: %s points to its parent directory %s", full, realPath)
+			}
+		} else if !e.IsDir() {
+			if err := fn(entry); err != nil {
+				return err
+			}
+			continue
+		}
+
+		entry.Mode = fs.ModeDir
+		if err := fn(entry); err != nil {
+			return err
+		}
+		if err := walkDir(full, entry.Path, append(slices.Clip(ancestors), realPath), policy, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util //nolint:revive
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+)
+
+// workflowCommandPattern matches a workflow command like those of GitHub Actions, e.g.
+// "::warning::disk almost full" or "::error file=app.js::failed". The parameters are ignored.
+var workflowCommandPattern = regexp.MustCompile(`^::([a-z-]+)(?: [^:]*)?::(.*)$`)
+
+// workflowCommandSeverities are the severities of the workflow commands that log a message.
+var workflowCommandSeverities = map[string]diag.Severity{
+	"debug":   diag.Debug,
+	"notice":  diag.Info,
+	"warning": diag.Warning,
+	"error":   diag.Error,
+}
+
+// workflowCommandData unescapes the data of a workflow command, which encodes "%", "\r" and "\n".
+var workflowCommandData = strings.NewReplacer("%25", "%", "%0D", "\r", "%0A", "\n")
+
+// parseWorkflowCommand returns the name and data of the workflow command in line, if it's one of
+// the supported ones.
+func parseWorkflowCommand(line string) (name, data string, ok bool) {
+	m := workflowCommandPattern.FindStringSubmatch(line)
+	if m == nil {
+		return "", "", false
+	}
+	if _, logs := workflowCommandSeverities[m[1]]; !logs && m[1] != "add-mask" {
+		return "", "", false
+	}
+	return m[1], workflowCommandData.Replace(m[2]), true
+}
+
+// RunWorkflowCommand runs the workflow command in line, if it's one, and reports whether it was:
+// "::debug::", "::notice::", "::warning::" and "::error::" log their message, prefixed with label,
+// as a diagnostic with the severity, and "::add-mask::" adds its value to the secrets of redactor.
+func RunWorkflowCommand(ctx context.Context, line, label string, redactor *Redactor) bool {
+	name, data, ok := parseWorkflowCommand(line)
+	if !ok {
+		return false
+	}
+	if name == "add-mask" {
+		if redactor != nil {
+			redactor.Add(data)
+		}
+		return true
+	}
+	logMessage(ctx, workflowCommandSeverities[name], label+redactor.Redact(data), false)
+	return true
+}
+
+// RemoveWorkflowCommands returns output without the lines that are workflow commands.
+func RemoveWorkflowCommands(output string) string {
+	lines := strings.SplitAfter(output, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if _, _, ok := parseWorkflowCommand(strings.TrimRight(line, "\r\n")); !ok {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "")
+}
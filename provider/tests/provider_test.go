@@ -395,3 +395,35 @@ func TestLocalRun(t *testing.T) {
 		stdoutKey: property.New("Hello, World!"),
 	}), resp.Return)
 }
+
+func TestLocalCommandRedactSecrets(t *testing.T) {
+	t.Parallel()
+	cmd := provider(t)
+	urn := urn("local", "Command", "secret")
+	create := func(command string, redactOutputs bool) (p.CreateResponse, error) {
+		return cmd.Create(p.CreateRequest{
+			Urn: urn,
+			Properties: property.NewMap(map[string]property.Value{
+				createKey: property.New(command),
+				environmentKey: property.New(property.NewMap(map[string]property.Value{
+					nameEnvVar: property.New("hunter2").WithSecret(true),
+				})),
+				"redactOutputs": property.New(redactOutputs),
+			}),
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		_, err := create(`echo "password $NAME"; exit 1`, false)
+		require.ErrorContains(t, err, "password [secret]")
+		assert.NotContains(t, err.Error(), "hunter2")
+	})
+
+	t.Run("outputs", func(t *testing.T) {
+		t.Parallel()
+		resp, err := create(`echo "password $NAME"`, true)
+		require.NoError(t, err)
+		assert.Equal(t, "password [secret]", resp.Properties.Get(stdoutKey).AsString())
+	})
+}
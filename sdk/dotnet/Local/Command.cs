@@ -296,6 +296,22 @@ namespace Pulumi.Command.Local
         [Output("environment")]
         public Output<ImmutableDictionary<string, string>?> Environment { get; private set; } = null!;
 
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Output("errorOutputLines")]
+        public Output<int?> ErrorOutputLines { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Output("heartbeatInterval")]
+        public Output<int?> HeartbeatInterval { get; private set; } = null!;
+
         /// <summary>
         /// The program and arguments to run the command.
         /// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
@@ -303,6 +319,12 @@ namespace Pulumi.Command.Local
         [Output("interpreter")]
         public Output<ImmutableArray<string>> Interpreter { get; private set; } = null!;
 
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Output("logStreamPrefix")]
+        public Output<bool?> LogStreamPrefix { get; private set; } = null!;
+
         /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -311,12 +333,42 @@ namespace Pulumi.Command.Local
         [Output("logging")]
         public Output<Pulumi.Command.Local.Logging?> Logging { get; private set; } = null!;
 
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input. Not supported on Windows.
+        /// </summary>
+        [Output("pty")]
+        public Output<Outputs.Pty?> Pty { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        [Output("redact")]
+        public Output<ImmutableArray<string>> Redact { get; private set; } = null!;
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Output("redactOutputs")]
+        public Output<bool?> RedactOutputs { get; private set; } = null!;
+
         /// <summary>
         /// The standard error of the command's process
         /// </summary>
         [Output("stderr")]
         public Output<string> Stderr { get; private set; } = null!;
 
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Output("stderrSeverity")]
+        public Output<Pulumi.Command.Local.LogSeverity?> StderrSeverity { get; private set; } = null!;
+
         /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
@@ -329,6 +381,31 @@ namespace Pulumi.Command.Local
         [Output("stdout")]
         public Output<string> Stdout { get; private set; } = null!;
 
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Output("stdoutSeverity")]
+        public Output<Pulumi.Command.Local.LogSeverity?> StdoutSeverity { get; private set; } = null!;
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Output("stripWorkflowCommands")]
+        public Output<bool?> StripWorkflowCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        /// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        /// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        /// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        /// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        /// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        /// a directory isn't searched.
+        /// </summary>
+        [Output("symlinks")]
+        public Output<Pulumi.Command.Local.SymlinkPolicy?> Symlinks { get; private set; } = null!;
+
         /// <summary>
         /// The resource will be updated (or replaced) if any of these values change.
         /// 
@@ -355,6 +432,15 @@ namespace Pulumi.Command.Local
         [Output("update")]
         public Output<string?> Update { get; private set; } = null!;
 
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Output("workflowCommands")]
+        public Output<bool?> WorkflowCommands { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Command resource with the given unique name, arguments, and options.
@@ -378,6 +464,10 @@ namespace Pulumi.Command.Local
             var defaultOptions = new CustomResourceOptions
             {
                 Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "redact",
+                },
                 ReplaceOnChanges =
                 {
                     "triggers[*]",
@@ -549,6 +639,22 @@ namespace Pulumi.Command.Local
             set => _environment = value;
         }
 
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Input("errorOutputLines")]
+        public Input<int>? ErrorOutputLines { get; set; }
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Input("heartbeatInterval")]
+        public Input<int>? HeartbeatInterval { get; set; }
+
         [Input("interpreter")]
         private InputList<string>? _interpreter;
 
@@ -562,6 +668,12 @@ namespace Pulumi.Command.Local
             set => _interpreter = value;
         }
 
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Input("logStreamPrefix")]
+        public Input<bool>? LogStreamPrefix { get; set; }
+
         /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -570,12 +682,77 @@ namespace Pulumi.Command.Local
         [Input("logging")]
         public Input<Pulumi.Command.Local.Logging>? Logging { get; set; }
 
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input. Not supported on Windows.
+        /// </summary>
+        [Input("pty")]
+        public Input<Inputs.PtyArgs>? Pty { get; set; }
+
+        [Input("redact")]
+        private InputList<string>? _redact;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        public InputList<string> Redact
+        {
+            get => _redact ?? (_redact = new InputList<string>());
+            set
+            {
+                var emptySecret = Output.CreateSecret(ImmutableArray.Create<string>());
+                _redact = Output.All(value, emptySecret).Apply(v => v[0]);
+            }
+        }
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Input("redactOutputs")]
+        public Input<bool>? RedactOutputs { get; set; }
+
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Input("stderrSeverity")]
+        public Input<Pulumi.Command.Local.LogSeverity>? StderrSeverity { get; set; }
+
         /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
         [Input("stdin")]
         public Input<string>? Stdin { get; set; }
 
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Input("stdoutSeverity")]
+        public Input<Pulumi.Command.Local.LogSeverity>? StdoutSeverity { get; set; }
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Input("stripWorkflowCommands")]
+        public Input<bool>? StripWorkflowCommands { get; set; }
+
+        /// <summary>
+        /// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        /// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        /// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        /// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        /// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        /// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        /// a directory isn't searched.
+        /// </summary>
+        [Input("symlinks")]
+        public Input<Pulumi.Command.Local.SymlinkPolicy>? Symlinks { get; set; }
+
         [Input("triggers")]
         private InputList<object>? _triggers;
 
@@ -608,6 +785,15 @@ namespace Pulumi.Command.Local
         [Input("update")]
         public Input<string>? Update { get; set; }
 
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Input("workflowCommands")]
+        public Input<bool>? WorkflowCommands { get; set; }
+
         public CommandArgs()
         {
         }
@@ -7,6 +7,48 @@ using Pulumi;
 
 namespace Pulumi.Command.Local
 {
+    [EnumType]
+    public readonly struct LogSeverity : IEquatable<LogSeverity>
+    {
+        private readonly string _value;
+
+        private LogSeverity(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Log the lines as debug messages
+        /// </summary>
+        public static LogSeverity Debug { get; } = new LogSeverity("debug");
+        /// <summary>
+        /// Log the lines as info messages
+        /// </summary>
+        public static LogSeverity Info { get; } = new LogSeverity("info");
+        /// <summary>
+        /// Log the lines as warnings
+        /// </summary>
+        public static LogSeverity Warning { get; } = new LogSeverity("warning");
+        /// <summary>
+        /// Log the lines as errors
+        /// </summary>
+        public static LogSeverity Error { get; } = new LogSeverity("error");
+
+        public static bool operator ==(LogSeverity left, LogSeverity right) => left.Equals(right);
+        public static bool operator !=(LogSeverity left, LogSeverity right) => !left.Equals(right);
+
+        public static explicit operator string(LogSeverity value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is LogSeverity other && Equals(other);
+        public bool Equals(LogSeverity other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
     [EnumType]
     public readonly struct Logging : IEquatable<Logging>
     {
@@ -48,4 +90,42 @@ namespace Pulumi.Command.Local
 
         public override string ToString() => _value;
     }
+
+    [EnumType]
+    public readonly struct SymlinkPolicy : IEquatable<SymlinkPolicy>
+    {
+        private readonly string _value;
+
+        private SymlinkPolicy(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Keep symlinks as symlinks
+        /// </summary>
+        public static SymlinkPolicy Preserve { get; } = new SymlinkPolicy("preserve");
+        /// <summary>
+        /// Treat symlinks as the files or directories they point to
+        /// </summary>
+        public static SymlinkPolicy Follow { get; } = new SymlinkPolicy("follow");
+        /// <summary>
+        /// Ignore symlinks
+        /// </summary>
+        public static SymlinkPolicy Skip { get; } = new SymlinkPolicy("skip");
+
+        public static bool operator ==(SymlinkPolicy left, SymlinkPolicy right) => left.Equals(right);
+        public static bool operator !=(SymlinkPolicy left, SymlinkPolicy right) => !left.Equals(right);
+
+        public static explicit operator string(SymlinkPolicy value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is SymlinkPolicy other && Equals(other);
+        public bool Equals(SymlinkPolicy other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
 }
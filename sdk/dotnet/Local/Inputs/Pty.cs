@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Local.Inputs
+{
+
+    /// <summary>
+    /// The pseudo-terminal that a command is run in.
+    /// </summary>
+    public sealed class Pty : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The width of the terminal in characters. Defaults to 80.
+        /// </summary>
+        [Input("cols")]
+        public int? Cols { get; set; }
+
+        /// <summary>
+        /// The height of the terminal in characters. Defaults to 24.
+        /// </summary>
+        [Input("rows")]
+        public int? Rows { get; set; }
+
+        /// <summary>
+        /// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        /// captured stdout. They are always part of the logged output. Defaults to false.
+        /// </summary>
+        [Input("stripAnsi")]
+        public bool? StripAnsi { get; set; }
+
+        /// <summary>
+        /// The terminal type, set as TERM. Defaults to `xterm`.
+        /// </summary>
+        [Input("term")]
+        public string? Term { get; set; }
+
+        public Pty()
+        {
+        }
+        public static new Pty Empty => new Pty();
+    }
+}
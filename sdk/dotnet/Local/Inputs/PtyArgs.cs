@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Local.Inputs
+{
+
+    /// <summary>
+    /// The pseudo-terminal that a command is run in.
+    /// </summary>
+    public sealed class PtyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The width of the terminal in characters. Defaults to 80.
+        /// </summary>
+        [Input("cols")]
+        public Input<int>? Cols { get; set; }
+
+        /// <summary>
+        /// The height of the terminal in characters. Defaults to 24.
+        /// </summary>
+        [Input("rows")]
+        public Input<int>? Rows { get; set; }
+
+        /// <summary>
+        /// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        /// captured stdout. They are always part of the logged output. Defaults to false.
+        /// </summary>
+        [Input("stripAnsi")]
+        public Input<bool>? StripAnsi { get; set; }
+
+        /// <summary>
+        /// The terminal type, set as TERM. Defaults to `xterm`.
+        /// </summary>
+        [Input("term")]
+        public Input<string>? Term { get; set; }
+
+        public PtyArgs()
+        {
+        }
+        public static new PtyArgs Empty => new PtyArgs();
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Local.Outputs
+{
+
+    /// <summary>
+    /// The pseudo-terminal that a command is run in.
+    /// </summary>
+    [OutputType]
+    public sealed class Pty
+    {
+        /// <summary>
+        /// The width of the terminal in characters. Defaults to 80.
+        /// </summary>
+        public readonly int? Cols;
+        /// <summary>
+        /// The height of the terminal in characters. Defaults to 24.
+        /// </summary>
+        public readonly int? Rows;
+        /// <summary>
+        /// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        /// captured stdout. They are always part of the logged output. Defaults to false.
+        /// </summary>
+        public readonly bool? StripAnsi;
+        /// <summary>
+        /// The terminal type, set as TERM. Defaults to `xterm`.
+        /// </summary>
+        public readonly string? Term;
+
+        [OutputConstructor]
+        private Pty(
+            int? cols,
+
+            int? rows,
+
+            bool? stripAnsi,
+
+            string? term)
+        {
+            Cols = cols;
+            Rows = rows;
+            StripAnsi = stripAnsi;
+            Term = term;
+        }
+    }
+}
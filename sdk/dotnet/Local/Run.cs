@@ -167,6 +167,22 @@ namespace Pulumi.Command.Local
             set => _environment = value;
         }
 
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Input("errorOutputLines")]
+        public int? ErrorOutputLines { get; set; }
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Input("heartbeatInterval")]
+        public int? HeartbeatInterval { get; set; }
+
         [Input("interpreter")]
         private List<string>? _interpreter;
 
@@ -180,6 +196,12 @@ namespace Pulumi.Command.Local
             set => _interpreter = value;
         }
 
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Input("logStreamPrefix")]
+        public bool? LogStreamPrefix { get; set; }
+
         /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -188,12 +210,82 @@ namespace Pulumi.Command.Local
         [Input("logging")]
         public Pulumi.Command.Local.Logging? Logging { get; set; }
 
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input. Not supported on Windows.
+        /// </summary>
+        [Input("pty")]
+        public Inputs.Pty? Pty { get; set; }
+
+        [Input("redact")]
+        private List<string>? _redact;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        public List<string> Redact
+        {
+            get => _redact ?? (_redact = new List<string>());
+            set => _redact = value;
+        }
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Input("redactOutputs")]
+        public bool? RedactOutputs { get; set; }
+
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Input("stderrSeverity")]
+        public Pulumi.Command.Local.LogSeverity? StderrSeverity { get; set; }
+
         /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
         [Input("stdin")]
         public string? Stdin { get; set; }
 
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Input("stdoutSeverity")]
+        public Pulumi.Command.Local.LogSeverity? StdoutSeverity { get; set; }
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Input("stripWorkflowCommands")]
+        public bool? StripWorkflowCommands { get; set; }
+
+        /// <summary>
+        /// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        /// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        /// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        /// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        /// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        /// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        /// a directory isn't searched.
+        /// </summary>
+        [Input("symlinks")]
+        public Pulumi.Command.Local.SymlinkPolicy? Symlinks { get; set; }
+
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Input("workflowCommands")]
+        public bool? WorkflowCommands { get; set; }
+
         public RunArgs()
         {
         }
@@ -333,6 +425,22 @@ namespace Pulumi.Command.Local
             set => _environment = value;
         }
 
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Input("errorOutputLines")]
+        public Input<int>? ErrorOutputLines { get; set; }
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Input("heartbeatInterval")]
+        public Input<int>? HeartbeatInterval { get; set; }
+
         [Input("interpreter")]
         private InputList<string>? _interpreter;
 
@@ -346,6 +454,12 @@ namespace Pulumi.Command.Local
             set => _interpreter = value;
         }
 
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Input("logStreamPrefix")]
+        public Input<bool>? LogStreamPrefix { get; set; }
+
         /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -354,12 +468,86 @@ namespace Pulumi.Command.Local
         [Input("logging")]
         public Input<Pulumi.Command.Local.Logging>? Logging { get; set; }
 
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input. Not supported on Windows.
+        /// </summary>
+        [Input("pty")]
+        public Input<Inputs.PtyArgs>? Pty { get; set; }
+
+        [Input("redact")]
+        private InputList<string>? _redact;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        public InputList<string> Redact
+        {
+            get => _redact ?? (_redact = new InputList<string>());
+            set
+            {
+                var emptySecret = Output.CreateSecret(ImmutableArray.Create<string>());
+                _redact = Output.All(value, emptySecret).Apply(v => v[0]);
+            }
+        }
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Input("redactOutputs")]
+        public Input<bool>? RedactOutputs { get; set; }
+
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Input("stderrSeverity")]
+        public Input<Pulumi.Command.Local.LogSeverity>? StderrSeverity { get; set; }
+
         /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
         [Input("stdin")]
         public Input<string>? Stdin { get; set; }
 
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Input("stdoutSeverity")]
+        public Input<Pulumi.Command.Local.LogSeverity>? StdoutSeverity { get; set; }
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Input("stripWorkflowCommands")]
+        public Input<bool>? StripWorkflowCommands { get; set; }
+
+        /// <summary>
+        /// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        /// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        /// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        /// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        /// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        /// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        /// a directory isn't searched.
+        /// </summary>
+        [Input("symlinks")]
+        public Input<Pulumi.Command.Local.SymlinkPolicy>? Symlinks { get; set; }
+
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Input("workflowCommands")]
+        public Input<bool>? WorkflowCommands { get; set; }
+
         public RunInvokeArgs()
         {
         }
@@ -481,21 +669,59 @@ namespace Pulumi.Command.Local
         /// </summary>
         public readonly ImmutableDictionary<string, string>? Environment;
         /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        public readonly int? ErrorOutputLines;
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        public readonly int? HeartbeatInterval;
+        /// <summary>
         /// The program and arguments to run the command.
         /// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
         /// </summary>
         public readonly ImmutableArray<string> Interpreter;
         /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        public readonly bool? LogStreamPrefix;
+        /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
         /// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
         /// </summary>
         public readonly Pulumi.Command.Local.Logging? Logging;
         /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input. Not supported on Windows.
+        /// </summary>
+        public readonly Outputs.Pty? Pty;
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        public readonly ImmutableArray<string> Redact;
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        public readonly bool? RedactOutputs;
+        /// <summary>
         /// The standard error of the command's process
         /// </summary>
         public readonly string Stderr;
         /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        public readonly Pulumi.Command.Local.LogSeverity? StderrSeverity;
+        /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
         public readonly string? Stdin;
@@ -503,6 +729,32 @@ namespace Pulumi.Command.Local
         /// The standard output of the command's process
         /// </summary>
         public readonly string Stdout;
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        public readonly Pulumi.Command.Local.LogSeverity? StdoutSeverity;
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        public readonly bool? StripWorkflowCommands;
+        /// <summary>
+        /// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        /// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        /// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        /// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        /// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        /// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        /// a directory isn't searched.
+        /// </summary>
+        public readonly Pulumi.Command.Local.SymlinkPolicy? Symlinks;
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        public readonly bool? WorkflowCommands;
 
         [OutputConstructor]
         private RunResult(
@@ -522,15 +774,37 @@ namespace Pulumi.Command.Local
 
             ImmutableDictionary<string, string>? environment,
 
+            int? errorOutputLines,
+
+            int? heartbeatInterval,
+
             ImmutableArray<string> interpreter,
 
+            bool? logStreamPrefix,
+
             Pulumi.Command.Local.Logging? logging,
 
+            Outputs.Pty? pty,
+
+            ImmutableArray<string> redact,
+
+            bool? redactOutputs,
+
             string stderr,
 
+            Pulumi.Command.Local.LogSeverity? stderrSeverity,
+
             string? stdin,
 
-            string stdout)
+            string stdout,
+
+            Pulumi.Command.Local.LogSeverity? stdoutSeverity,
+
+            bool? stripWorkflowCommands,
+
+            Pulumi.Command.Local.SymlinkPolicy? symlinks,
+
+            bool? workflowCommands)
         {
             AddPreviousOutputInEnv = addPreviousOutputInEnv;
             Archive = archive;
@@ -540,11 +814,22 @@ namespace Pulumi.Command.Local
             Command = command;
             Dir = dir;
             Environment = environment;
+            ErrorOutputLines = errorOutputLines;
+            HeartbeatInterval = heartbeatInterval;
             Interpreter = interpreter;
+            LogStreamPrefix = logStreamPrefix;
             Logging = logging;
+            Pty = pty;
+            Redact = redact;
+            RedactOutputs = redactOutputs;
             Stderr = stderr;
+            StderrSeverity = stderrSeverity;
             Stdin = stdin;
             Stdout = stdout;
+            StdoutSeverity = stdoutSeverity;
+            StripWorkflowCommands = stripWorkflowCommands;
+            Symlinks = symlinks;
+            WorkflowCommands = workflowCommands;
         }
     }
 }
@@ -106,6 +106,35 @@ namespace Pulumi.Command.Remote
         [Output("addPreviousOutputInEnv")]
         public Output<bool?> AddPreviousOutputInEnv { get; private set; } = null!;
 
+        /// <summary>
+        /// Run the command as another user, root by default, via the escalation tool set in
+        /// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+        /// the quoting of the command intact and passes on the environment variables.
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
+        /// <summary>
+        /// The escalation tool to use with `become`. Defaults to `sudo`.
+        /// </summary>
+        [Output("becomeMethod")]
+        public Output<Pulumi.Command.Remote.BecomeMethod?> BecomeMethod { get; private set; } = null!;
+
+        /// <summary>
+        /// The password to answer the prompt of the escalation tool with. Without a
+        /// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+        /// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+        /// password is never part of the command's output.
+        /// </summary>
+        [Output("becomePassword")]
+        public Output<string?> BecomePassword { get; private set; } = null!;
+
+        /// <summary>
+        /// The user to run the command as with `become`. Defaults to `root`.
+        /// </summary>
+        [Output("becomeUser")]
+        public Output<string?> BecomeUser { get; private set; } = null!;
+
         /// <summary>
         /// The parameters with which to connect to the remote host.
         /// </summary>
@@ -132,15 +161,74 @@ namespace Pulumi.Command.Remote
         [Output("delete")]
         public Output<string?> Delete { get; private set; } = null!;
 
+        /// <summary>
+        /// Run the command as a detached job that survives connection drops, e.g. for
+        /// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+        /// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+        /// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+        /// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+        /// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+        /// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+        /// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        /// </summary>
+        [Output("detached")]
+        public Output<Outputs.Detached?> Detached { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory on the remote host to run the command in. A leading '~' is the home
+        /// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+        /// on the remote host.
+        /// </summary>
+        [Output("dir")]
+        public Output<string?> Dir { get; private set; } = null!;
+
         /// <summary>
         /// Additional environment variables available to the command's process.
-        /// Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-        /// Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-        /// with the variables in the form 'VAR=value command'.
+        /// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+        /// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
         /// </summary>
         [Output("environment")]
         public Output<ImmutableDictionary<string, string>?> Environment { get; private set; } = null!;
 
+        /// <summary>
+        /// How the environment variables, including PULUMI_COMMAND_STDOUT and
+        /// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+        /// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+        /// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+        /// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        /// </summary>
+        [Output("environmentMode")]
+        public Output<Pulumi.Command.Remote.EnvironmentMode?> EnvironmentMode { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Output("errorOutputLines")]
+        public Output<int?> ErrorOutputLines { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Output("heartbeatInterval")]
+        public Output<int?> HeartbeatInterval { get; private set; } = null!;
+
+        /// <summary>
+        /// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+        /// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        /// </summary>
+        [Output("interpreter")]
+        public Output<ImmutableArray<string>> Interpreter { get; private set; } = null!;
+
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Output("logStreamPrefix")]
+        public Output<bool?> LogStreamPrefix { get; private set; } = null!;
+
         /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -149,12 +237,50 @@ namespace Pulumi.Command.Remote
         [Output("logging")]
         public Output<Pulumi.Command.Remote.Logging?> Logging { get; private set; } = null!;
 
+        /// <summary>
+        /// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+        /// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        /// </summary>
+        [Output("loginShell")]
+        public Output<bool?> LoginShell { get; private set; } = null!;
+
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input.
+        /// </summary>
+        [Output("pty")]
+        public Output<Outputs.Pty?> Pty { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+        /// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        [Output("redact")]
+        public Output<ImmutableArray<string>> Redact { get; private set; } = null!;
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Output("redactOutputs")]
+        public Output<bool?> RedactOutputs { get; private set; } = null!;
+
         /// <summary>
         /// The standard error of the command's process
         /// </summary>
         [Output("stderr")]
         public Output<string> Stderr { get; private set; } = null!;
 
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Output("stderrSeverity")]
+        public Output<Pulumi.Command.Remote.LogSeverity?> StderrSeverity { get; private set; } = null!;
+
         /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
@@ -167,6 +293,29 @@ namespace Pulumi.Command.Remote
         [Output("stdout")]
         public Output<string> Stdout { get; private set; } = null!;
 
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Output("stdoutSeverity")]
+        public Output<Pulumi.Command.Remote.LogSeverity?> StdoutSeverity { get; private set; } = null!;
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Output("stripWorkflowCommands")]
+        public Output<bool?> StripWorkflowCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// The maximum number of seconds the command may run. When it elapses, or the
+        /// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+        /// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+        /// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+        /// Defaults to no timeout.
+        /// </summary>
+        [Output("timeout")]
+        public Output<int?> Timeout { get; private set; } = null!;
+
         /// <summary>
         /// The resource will be updated (or replaced) if any of these values change.
         /// 
@@ -193,6 +342,15 @@ namespace Pulumi.Command.Remote
         [Output("update")]
         public Output<string?> Update { get; private set; } = null!;
 
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Output("workflowCommands")]
+        public Output<bool?> WorkflowCommands { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Command resource with the given unique name, arguments, and options.
@@ -218,7 +376,9 @@ namespace Pulumi.Command.Remote
                 Version = Utilities.Version,
                 AdditionalSecretOutputs =
                 {
+                    "becomePassword",
                     "connection",
+                    "redact",
                 },
                 ReplaceOnChanges =
                 {
@@ -254,6 +414,45 @@ namespace Pulumi.Command.Remote
         [Input("addPreviousOutputInEnv")]
         public Input<bool>? AddPreviousOutputInEnv { get; set; }
 
+        /// <summary>
+        /// Run the command as another user, root by default, via the escalation tool set in
+        /// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+        /// the quoting of the command intact and passes on the environment variables.
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
+        /// <summary>
+        /// The escalation tool to use with `become`. Defaults to `sudo`.
+        /// </summary>
+        [Input("becomeMethod")]
+        public Input<Pulumi.Command.Remote.BecomeMethod>? BecomeMethod { get; set; }
+
+        [Input("becomePassword")]
+        private Input<string>? _becomePassword;
+
+        /// <summary>
+        /// The password to answer the prompt of the escalation tool with. Without a
+        /// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+        /// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+        /// password is never part of the command's output.
+        /// </summary>
+        public Input<string>? BecomePassword
+        {
+            get => _becomePassword;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _becomePassword = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The user to run the command as with `become`. Defaults to `root`.
+        /// </summary>
+        [Input("becomeUser")]
+        public Input<string>? BecomeUser { get; set; }
+
         [Input("connection", required: true)]
         private Input<Inputs.ConnectionArgs>? _connection;
 
@@ -290,14 +489,34 @@ namespace Pulumi.Command.Remote
         [Input("delete")]
         public Input<string>? Delete { get; set; }
 
+        /// <summary>
+        /// Run the command as a detached job that survives connection drops, e.g. for
+        /// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+        /// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+        /// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+        /// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+        /// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+        /// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+        /// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        /// </summary>
+        [Input("detached")]
+        public Input<Inputs.DetachedArgs>? Detached { get; set; }
+
+        /// <summary>
+        /// The directory on the remote host to run the command in. A leading '~' is the home
+        /// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+        /// on the remote host.
+        /// </summary>
+        [Input("dir")]
+        public Input<string>? Dir { get; set; }
+
         [Input("environment")]
         private InputMap<string>? _environment;
 
         /// <summary>
         /// Additional environment variables available to the command's process.
-        /// Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-        /// Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-        /// with the variables in the form 'VAR=value command'.
+        /// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+        /// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
         /// </summary>
         public InputMap<string> Environment
         {
@@ -305,6 +524,51 @@ namespace Pulumi.Command.Remote
             set => _environment = value;
         }
 
+        /// <summary>
+        /// How the environment variables, including PULUMI_COMMAND_STDOUT and
+        /// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+        /// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+        /// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+        /// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        /// </summary>
+        [Input("environmentMode")]
+        public Input<Pulumi.Command.Remote.EnvironmentMode>? EnvironmentMode { get; set; }
+
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Input("errorOutputLines")]
+        public Input<int>? ErrorOutputLines { get; set; }
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Input("heartbeatInterval")]
+        public Input<int>? HeartbeatInterval { get; set; }
+
+        [Input("interpreter")]
+        private InputList<string>? _interpreter;
+
+        /// <summary>
+        /// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+        /// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        /// </summary>
+        public InputList<string> Interpreter
+        {
+            get => _interpreter ?? (_interpreter = new InputList<string>());
+            set => _interpreter = value;
+        }
+
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Input("logStreamPrefix")]
+        public Input<bool>? LogStreamPrefix { get; set; }
+
         /// <summary>
         /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
         /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -313,12 +577,83 @@ namespace Pulumi.Command.Remote
         [Input("logging")]
         public Input<Pulumi.Command.Remote.Logging>? Logging { get; set; }
 
+        /// <summary>
+        /// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+        /// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        /// </summary>
+        [Input("loginShell")]
+        public Input<bool>? LoginShell { get; set; }
+
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input.
+        /// </summary>
+        [Input("pty")]
+        public Input<Inputs.PtyArgs>? Pty { get; set; }
+
+        [Input("redact")]
+        private InputList<string>? _redact;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+        /// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        public InputList<string> Redact
+        {
+            get => _redact ?? (_redact = new InputList<string>());
+            set
+            {
+                var emptySecret = Output.CreateSecret(ImmutableArray.Create<string>());
+                _redact = Output.All(value, emptySecret).Apply(v => v[0]);
+            }
+        }
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Input("redactOutputs")]
+        public Input<bool>? RedactOutputs { get; set; }
+
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Input("stderrSeverity")]
+        public Input<Pulumi.Command.Remote.LogSeverity>? StderrSeverity { get; set; }
+
         /// <summary>
         /// Pass a string to the command's process as standard in
         /// </summary>
         [Input("stdin")]
         public Input<string>? Stdin { get; set; }
 
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Input("stdoutSeverity")]
+        public Input<Pulumi.Command.Remote.LogSeverity>? StdoutSeverity { get; set; }
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Input("stripWorkflowCommands")]
+        public Input<bool>? StripWorkflowCommands { get; set; }
+
+        /// <summary>
+        /// The maximum number of seconds the command may run. When it elapses, or the
+        /// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+        /// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+        /// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+        /// Defaults to no timeout.
+        /// </summary>
+        [Input("timeout")]
+        public Input<int>? Timeout { get; set; }
+
         [Input("triggers")]
         private InputList<object>? _triggers;
 
@@ -351,6 +686,15 @@ namespace Pulumi.Command.Remote
         [Input("update")]
         public Input<string>? Update { get; set; }
 
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Input("workflowCommands")]
+        public Input<bool>? WorkflowCommands { get; set; }
+
         public CommandArgs()
         {
         }
@@ -16,6 +16,12 @@ namespace Pulumi.Command.Remote
     [CommandResourceType("command:remote:CopyFile")]
     public partial class CopyFile : global::Pulumi.CustomResource
     {
+        /// <summary>
+        /// Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
         /// <summary>
         /// The parameters with which to connect to the remote host.
         /// </summary>
@@ -34,6 +40,12 @@ namespace Pulumi.Command.Remote
         [Output("remotePath")]
         public Output<string> RemotePath { get; private set; } = null!;
 
+        /// <summary>
+        /// How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+        /// </summary>
+        [Output("transport")]
+        public Output<Pulumi.Command.Remote.Transport?> Transport { get; private set; } = null!;
+
         /// <summary>
         /// Trigger replacements on changes to this input.
         /// </summary>
@@ -89,6 +101,12 @@ namespace Pulumi.Command.Remote
 
     public sealed class CopyFileArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
         [Input("connection", required: true)]
         private Input<Inputs.ConnectionArgs>? _connection;
 
@@ -117,6 +135,12 @@ namespace Pulumi.Command.Remote
         [Input("remotePath", required: true)]
         public Input<string> RemotePath { get; set; } = null!;
 
+        /// <summary>
+        /// How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+        /// </summary>
+        [Input("transport")]
+        public Input<Pulumi.Command.Remote.Transport>? Transport { get; set; }
+
         [Input("triggers")]
         private InputList<object>? _triggers;
 
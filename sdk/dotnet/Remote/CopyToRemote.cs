@@ -76,12 +76,66 @@ namespace Pulumi.Command.Remote
     [CommandResourceType("command:remote:CopyToRemote")]
     public partial class CopyToRemote : global::Pulumi.CustomResource
     {
+        /// <summary>
+        /// How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+        /// </summary>
+        [Output("atomic")]
+        public Output<Pulumi.Command.Remote.AtomicMode?> Atomic { get; private set; } = null!;
+
+        /// <summary>
+        /// Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
         /// <summary>
         /// The parameters with which to connect to the remote host.
         /// </summary>
         [Output("connection")]
         public Output<Outputs.Connection> Connection { get; private set; } = null!;
 
+        /// <summary>
+        /// The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+        /// </summary>
+        [Output("drift")]
+        public Output<ImmutableArray<string>> Drift { get; private set; } = null!;
+
+        /// <summary>
+        /// A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+        /// </summary>
+        [Output("exclude")]
+        public Output<ImmutableArray<string>> Exclude { get; private set; } = null!;
+
+        /// <summary>
+        /// If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+        /// </summary>
+        [Output("extract")]
+        public Output<bool?> Extract { get; private set; } = null!;
+
+        /// <summary>
+        /// If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+        /// </summary>
+        [Output("fsync")]
+        public Output<bool?> Fsync { get; private set; } = null!;
+
+        /// <summary>
+        /// A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+        /// </summary>
+        [Output("include")]
+        public Output<ImmutableArray<string>> Include { get; private set; } = null!;
+
+        /// <summary>
+        /// The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+        /// </summary>
+        [Output("manifest")]
+        public Output<ImmutableDictionary<string, string>?> Manifest { get; private set; } = null!;
+
+        /// <summary>
+        /// The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+        /// </summary>
+        [Output("parallelism")]
+        public Output<int?> Parallelism { get; private set; } = null!;
+
         /// <summary>
         /// The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
         /// </summary>
@@ -89,11 +143,23 @@ namespace Pulumi.Command.Remote
         public Output<string> RemotePath { get; private set; } = null!;
 
         /// <summary>
-        /// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+        /// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
         /// </summary>
         [Output("source")]
         public Output<AssetOrArchive> Source { get; private set; } = null!;
 
+        /// <summary>
+        /// How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+        /// </summary>
+        [Output("symlinks")]
+        public Output<Pulumi.Command.Remote.SymlinkPolicy?> Symlinks { get; private set; } = null!;
+
+        /// <summary>
+        /// How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+        /// </summary>
+        [Output("transport")]
+        public Output<Pulumi.Command.Remote.Transport?> Transport { get; private set; } = null!;
+
         /// <summary>
         /// Trigger replacements on changes to this input.
         /// </summary>
@@ -153,6 +219,18 @@ namespace Pulumi.Command.Remote
 
     public sealed class CopyToRemoteArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+        /// </summary>
+        [Input("atomic")]
+        public Input<Pulumi.Command.Remote.AtomicMode>? Atomic { get; set; }
+
+        /// <summary>
+        /// Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
         [Input("connection", required: true)]
         private Input<Inputs.ConnectionArgs>? _connection;
 
@@ -169,6 +247,48 @@ namespace Pulumi.Command.Remote
             }
         }
 
+        [Input("exclude")]
+        private InputList<string>? _exclude;
+
+        /// <summary>
+        /// A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+        /// </summary>
+        public InputList<string> Exclude
+        {
+            get => _exclude ?? (_exclude = new InputList<string>());
+            set => _exclude = value;
+        }
+
+        /// <summary>
+        /// If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+        /// </summary>
+        [Input("extract")]
+        public Input<bool>? Extract { get; set; }
+
+        /// <summary>
+        /// If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+        /// </summary>
+        [Input("fsync")]
+        public Input<bool>? Fsync { get; set; }
+
+        [Input("include")]
+        private InputList<string>? _include;
+
+        /// <summary>
+        /// A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+        /// </summary>
+        public InputList<string> Include
+        {
+            get => _include ?? (_include = new InputList<string>());
+            set => _include = value;
+        }
+
+        /// <summary>
+        /// The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+        /// </summary>
+        [Input("parallelism")]
+        public Input<int>? Parallelism { get; set; }
+
         /// <summary>
         /// The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
         /// </summary>
@@ -176,11 +296,23 @@ namespace Pulumi.Command.Remote
         public Input<string> RemotePath { get; set; } = null!;
 
         /// <summary>
-        /// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+        /// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
         /// </summary>
         [Input("source", required: true)]
         public Input<AssetOrArchive> Source { get; set; } = null!;
 
+        /// <summary>
+        /// How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+        /// </summary>
+        [Input("symlinks")]
+        public Input<Pulumi.Command.Remote.SymlinkPolicy>? Symlinks { get; set; }
+
+        /// <summary>
+        /// How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+        /// </summary>
+        [Input("transport")]
+        public Input<Pulumi.Command.Remote.Transport>? Transport { get; set; }
+
         [Input("triggers")]
         private InputList<object>? _triggers;
 
@@ -195,6 +327,7 @@ namespace Pulumi.Command.Remote
 
         public CopyToRemoteArgs()
         {
+            Parallelism = 1;
         }
         public static new CopyToRemoteArgs Empty => new CopyToRemoteArgs();
     }
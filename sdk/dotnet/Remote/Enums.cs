@@ -7,6 +7,162 @@ using Pulumi;
 
 namespace Pulumi.Command.Remote
 {
+    [EnumType]
+    public readonly struct AtomicMode : IEquatable<AtomicMode>
+    {
+        private readonly string _value;
+
+        private AtomicMode(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Write files in place
+        /// </summary>
+        public static AtomicMode None { get; } = new AtomicMode("none");
+        /// <summary>
+        /// Write each file to a temporary file and rename it into place
+        /// </summary>
+        public static AtomicMode File { get; } = new AtomicMode("file");
+        /// <summary>
+        /// Stage copied directories in full and swap them in via a symlink
+        /// </summary>
+        public static AtomicMode Directory { get; } = new AtomicMode("directory");
+
+        public static bool operator ==(AtomicMode left, AtomicMode right) => left.Equals(right);
+        public static bool operator !=(AtomicMode left, AtomicMode right) => !left.Equals(right);
+
+        public static explicit operator string(AtomicMode value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is AtomicMode other && Equals(other);
+        public bool Equals(AtomicMode other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct BecomeMethod : IEquatable<BecomeMethod>
+    {
+        private readonly string _value;
+
+        private BecomeMethod(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Use `sudo`
+        /// </summary>
+        public static BecomeMethod Sudo { get; } = new BecomeMethod("sudo");
+        /// <summary>
+        /// Use `su`
+        /// </summary>
+        public static BecomeMethod Su { get; } = new BecomeMethod("su");
+        /// <summary>
+        /// Use `doas`
+        /// </summary>
+        public static BecomeMethod Doas { get; } = new BecomeMethod("doas");
+
+        public static bool operator ==(BecomeMethod left, BecomeMethod right) => left.Equals(right);
+        public static bool operator !=(BecomeMethod left, BecomeMethod right) => !left.Equals(right);
+
+        public static explicit operator string(BecomeMethod value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is BecomeMethod other && Equals(other);
+        public bool Equals(BecomeMethod other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct EnvironmentMode : IEquatable<EnvironmentMode>
+    {
+        private readonly string _value;
+
+        private EnvironmentMode(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Set the variables on the SSH session, which requires AcceptEnv on the server
+        /// </summary>
+        public static EnvironmentMode Setenv { get; } = new EnvironmentMode("setenv");
+        /// <summary>
+        /// Export the variables in the command, which requires a POSIX shell on the remote host
+        /// </summary>
+        public static EnvironmentMode Export { get; } = new EnvironmentMode("export");
+        /// <summary>
+        /// Set the variables on the SSH session, and export those that the server rejects
+        /// </summary>
+        public static EnvironmentMode Auto { get; } = new EnvironmentMode("auto");
+
+        public static bool operator ==(EnvironmentMode left, EnvironmentMode right) => left.Equals(right);
+        public static bool operator !=(EnvironmentMode left, EnvironmentMode right) => !left.Equals(right);
+
+        public static explicit operator string(EnvironmentMode value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is EnvironmentMode other && Equals(other);
+        public bool Equals(EnvironmentMode other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct LogSeverity : IEquatable<LogSeverity>
+    {
+        private readonly string _value;
+
+        private LogSeverity(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Log the lines as debug messages
+        /// </summary>
+        public static LogSeverity Debug { get; } = new LogSeverity("debug");
+        /// <summary>
+        /// Log the lines as info messages
+        /// </summary>
+        public static LogSeverity Info { get; } = new LogSeverity("info");
+        /// <summary>
+        /// Log the lines as warnings
+        /// </summary>
+        public static LogSeverity Warning { get; } = new LogSeverity("warning");
+        /// <summary>
+        /// Log the lines as errors
+        /// </summary>
+        public static LogSeverity Error { get; } = new LogSeverity("error");
+
+        public static bool operator ==(LogSeverity left, LogSeverity right) => left.Equals(right);
+        public static bool operator !=(LogSeverity left, LogSeverity right) => !left.Equals(right);
+
+        public static explicit operator string(LogSeverity value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is LogSeverity other && Equals(other);
+        public bool Equals(LogSeverity other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
     [EnumType]
     public readonly struct Logging : IEquatable<Logging>
     {
@@ -48,4 +204,118 @@ namespace Pulumi.Command.Remote
 
         public override string ToString() => _value;
     }
+
+    [EnumType]
+    public readonly struct SymlinkPolicy : IEquatable<SymlinkPolicy>
+    {
+        private readonly string _value;
+
+        private SymlinkPolicy(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Keep symlinks as symlinks
+        /// </summary>
+        public static SymlinkPolicy Preserve { get; } = new SymlinkPolicy("preserve");
+        /// <summary>
+        /// Treat symlinks as the files or directories they point to
+        /// </summary>
+        public static SymlinkPolicy Follow { get; } = new SymlinkPolicy("follow");
+        /// <summary>
+        /// Ignore symlinks
+        /// </summary>
+        public static SymlinkPolicy Skip { get; } = new SymlinkPolicy("skip");
+
+        public static bool operator ==(SymlinkPolicy left, SymlinkPolicy right) => left.Equals(right);
+        public static bool operator !=(SymlinkPolicy left, SymlinkPolicy right) => !left.Equals(right);
+
+        public static explicit operator string(SymlinkPolicy value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is SymlinkPolicy other && Equals(other);
+        public bool Equals(SymlinkPolicy other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct Transport : IEquatable<Transport>
+    {
+        private readonly string _value;
+
+        private Transport(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Use the SFTP subsystem
+        /// </summary>
+        public static Transport Sftp { get; } = new Transport("sftp");
+        /// <summary>
+        /// Use the SCP protocol via `scp -t`
+        /// </summary>
+        public static Transport Scp { get; } = new Transport("scp");
+        /// <summary>
+        /// Stream a tar archive to `tar -x` on the remote host
+        /// </summary>
+        public static Transport TarOverExec { get; } = new Transport("tarOverExec");
+        /// <summary>
+        /// Use SFTP if the host supports it, and fall back to `tarOverExec` or `scp`
+        /// </summary>
+        public static Transport Auto { get; } = new Transport("auto");
+
+        public static bool operator ==(Transport left, Transport right) => left.Equals(right);
+        public static bool operator !=(Transport left, Transport right) => !left.Equals(right);
+
+        public static explicit operator string(Transport value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is Transport other && Equals(other);
+        public bool Equals(Transport other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct TunnelType : IEquatable<TunnelType>
+    {
+        private readonly string _value;
+
+        private TunnelType(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Forward the connections to a local port to the remote host, like `ssh -L`
+        /// </summary>
+        public static TunnelType Local { get; } = new TunnelType("local");
+        /// <summary>
+        /// Forward the connections to a port on the remote host to the local machine, like `ssh -R`
+        /// </summary>
+        public static TunnelType Remote { get; } = new TunnelType("remote");
+
+        public static bool operator ==(TunnelType left, TunnelType right) => left.Equals(right);
+        public static bool operator !=(TunnelType left, TunnelType right) => !left.Equals(right);
+
+        public static explicit operator string(TunnelType value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is TunnelType other && Equals(other);
+        public bool Equals(TunnelType other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
 }
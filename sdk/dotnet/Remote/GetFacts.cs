@@ -0,0 +1,188 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote
+{
+    public static class GetFacts
+    {
+        /// <summary>
+        /// Gathers facts about a remote host, like its operating system, architecture and resources.
+        /// Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+        /// </summary>
+        public static Task<GetFactsResult> InvokeAsync(GetFactsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetFactsResult>("command:remote:getFacts", args ?? new GetFactsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gathers facts about a remote host, like its operating system, architecture and resources.
+        /// Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+        /// </summary>
+        public static Output<GetFactsResult> Invoke(GetFactsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetFactsResult>("command:remote:getFacts", args ?? new GetFactsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gathers facts about a remote host, like its operating system, architecture and resources.
+        /// Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+        /// </summary>
+        public static Output<GetFactsResult> Invoke(GetFactsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetFactsResult>("command:remote:getFacts", args ?? new GetFactsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetFactsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("connection", required: true)]
+        private Inputs.Connection? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Inputs.Connection? Connection
+        {
+            get => _connection;
+            set => _connection = value;
+        }
+
+        public GetFactsArgs()
+        {
+        }
+        public static new GetFactsArgs Empty => new GetFactsArgs();
+    }
+
+    public sealed class GetFactsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("connection", required: true)]
+        private Input<Inputs.ConnectionArgs>? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Input<Inputs.ConnectionArgs>? Connection
+        {
+            get => _connection;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connection = Output.Tuple<Input<Inputs.ConnectionArgs>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        public GetFactsInvokeArgs()
+        {
+        }
+        public static new GetFactsInvokeArgs Empty => new GetFactsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetFactsResult
+    {
+        /// <summary>
+        /// The machine architecture as reported by `uname -m`, e.g. `x86_64` or `aarch64`.
+        /// </summary>
+        public readonly string? Arch;
+        /// <summary>
+        /// The number of online CPUs.
+        /// </summary>
+        public readonly int? CpuCount;
+        /// <summary>
+        /// The distribution, e.g. `ubuntu`, which is `ID` in `/etc/os-release`, or `macos`.
+        /// </summary>
+        public readonly string? Distro;
+        /// <summary>
+        /// The version of the distribution, e.g. `24.04`.
+        /// </summary>
+        public readonly string? DistroVersion;
+        /// <summary>
+        /// The errors of the probes that failed, keyed by the name of the probe: `uname` for `os`, `kernel` and `arch`, `osRelease` for `osFamily`, `distro` and `distroVersion`, and otherwise the name of the fact.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> Errors;
+        /// <summary>
+        /// The host name of the host.
+        /// </summary>
+        public readonly string? Hostname;
+        /// <summary>
+        /// The init system, `systemd`, `openrc`, `launchd` or `sysvinit`.
+        /// </summary>
+        public readonly string? InitSystem;
+        /// <summary>
+        /// The IP addresses of the host, without loopback and link-local addresses.
+        /// </summary>
+        public readonly ImmutableArray<string> IpAddresses;
+        /// <summary>
+        /// The kernel release as reported by `uname -r`.
+        /// </summary>
+        public readonly string? Kernel;
+        /// <summary>
+        /// The total memory in MiB.
+        /// </summary>
+        public readonly int? MemoryMb;
+        /// <summary>
+        /// The operating system as reported by `uname -s` in lower case, e.g. `linux` or `darwin`.
+        /// </summary>
+        public readonly string? Os;
+        /// <summary>
+        /// The family of the distribution, e.g. `debian` or `rhel`, which is the first entry of `ID_LIKE` in `/etc/os-release`, or `ID` if it's not set. Outside of Linux, the same as `os`.
+        /// </summary>
+        public readonly string? OsFamily;
+        /// <summary>
+        /// If the user can run commands with sudo without a password.
+        /// </summary>
+        public readonly bool? PasswordlessSudo;
+        /// <summary>
+        /// The login shell of the user.
+        /// </summary>
+        public readonly string? Shell;
+
+        [OutputConstructor]
+        private GetFactsResult(
+            string? arch,
+
+            int? cpuCount,
+
+            string? distro,
+
+            string? distroVersion,
+
+            ImmutableDictionary<string, string> errors,
+
+            string? hostname,
+
+            string? initSystem,
+
+            ImmutableArray<string> ipAddresses,
+
+            string? kernel,
+
+            int? memoryMb,
+
+            string? os,
+
+            string? osFamily,
+
+            bool? passwordlessSudo,
+
+            string? shell)
+        {
+            Arch = arch;
+            CpuCount = cpuCount;
+            Distro = distro;
+            DistroVersion = distroVersion;
+            Errors = errors;
+            Hostname = hostname;
+            InitSystem = initSystem;
+            IpAddresses = ipAddresses;
+            Kernel = kernel;
+            MemoryMb = memoryMb;
+            Os = os;
+            OsFamily = osFamily;
+            PasswordlessSudo = passwordlessSudo;
+            Shell = shell;
+        }
+    }
+}
@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote
+{
+    public static class GetHostKeys
+    {
+        /// <summary>
+        /// Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+        /// It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+        /// </summary>
+        public static Task<GetHostKeysResult> InvokeAsync(GetHostKeysArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetHostKeysResult>("command:remote:getHostKeys", args ?? new GetHostKeysArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+        /// It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+        /// </summary>
+        public static Output<GetHostKeysResult> Invoke(GetHostKeysInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetHostKeysResult>("command:remote:getHostKeys", args ?? new GetHostKeysInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+        /// It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+        /// </summary>
+        public static Output<GetHostKeysResult> Invoke(GetHostKeysInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetHostKeysResult>("command:remote:getHostKeys", args ?? new GetHostKeysInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetHostKeysArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("connection", required: true)]
+        private Inputs.Connection? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Inputs.Connection? Connection
+        {
+            get => _connection;
+            set => _connection = value;
+        }
+
+        public GetHostKeysArgs()
+        {
+        }
+        public static new GetHostKeysArgs Empty => new GetHostKeysArgs();
+    }
+
+    public sealed class GetHostKeysInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("connection", required: true)]
+        private Input<Inputs.ConnectionArgs>? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Input<Inputs.ConnectionArgs>? Connection
+        {
+            get => _connection;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connection = Output.Tuple<Input<Inputs.ConnectionArgs>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        public GetHostKeysInvokeArgs()
+        {
+        }
+        public static new GetHostKeysInvokeArgs Empty => new GetHostKeysInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetHostKeysResult
+    {
+        /// <summary>
+        /// The host keys that the host offers, one for each type.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.HostKey> Keys;
+
+        [OutputConstructor]
+        private GetHostKeysResult(ImmutableArray<Outputs.HostKey> keys)
+        {
+            Keys = keys;
+        }
+    }
+}
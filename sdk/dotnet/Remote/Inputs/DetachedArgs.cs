@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote.Inputs
+{
+
+    /// <summary>
+    /// Settings for running a command as a detached job.
+    /// </summary>
+    public sealed class DetachedArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The number of seconds between checks whether the job finished. Defaults to 5.
+        /// </summary>
+        [Input("pollInterval")]
+        public Input<int>? PollInterval { get; set; }
+
+        /// <summary>
+        /// The directory on the remote host that holds a subdirectory per job, with its pid,
+        /// exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+        /// directory of the user. Defaults to `~/.pulumi-command/jobs`.
+        /// </summary>
+        [Input("stateDir")]
+        public Input<string>? StateDir { get; set; }
+
+        public DetachedArgs()
+        {
+        }
+        public static new DetachedArgs Empty => new DetachedArgs();
+    }
+}
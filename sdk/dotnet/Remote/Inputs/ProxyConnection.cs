@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote.Inputs
+{
+
+    /// <summary>
+    /// Instructions for how to connect to a remote endpoint via a bastion host.
+    /// </summary>
+    public sealed class ProxyConnection : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        /// </summary>
+        [Input("agentSocketPath")]
+        public string? AgentSocketPath { get; set; }
+
+        /// <summary>
+        /// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        /// </summary>
+        [Input("dialErrorLimit")]
+        public int? DialErrorLimit { get; set; }
+
+        /// <summary>
+        /// The address of the bastion host to connect to.
+        /// </summary>
+        [Input("host", required: true)]
+        public string Host { get; set; } = null!;
+
+        /// <summary>
+        /// The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        /// </summary>
+        [Input("hostKey")]
+        public string? HostKey { get; set; }
+
+        [Input("password")]
+        private string? _password;
+
+        /// <summary>
+        /// The password we should use for the connection to the bastion host.
+        /// </summary>
+        public string? Password
+        {
+            get => _password;
+            set => _password = value;
+        }
+
+        /// <summary>
+        /// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        /// </summary>
+        [Input("perDialTimeout")]
+        public int? PerDialTimeout { get; set; }
+
+        /// <summary>
+        /// The port of the bastion host to connect to.
+        /// </summary>
+        [Input("port")]
+        public double? Port { get; set; }
+
+        [Input("privateKey")]
+        private string? _privateKey;
+
+        /// <summary>
+        /// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        /// </summary>
+        public string? PrivateKey
+        {
+            get => _privateKey;
+            set => _privateKey = value;
+        }
+
+        [Input("privateKeyPassword")]
+        private string? _privateKeyPassword;
+
+        /// <summary>
+        /// The password to use in case the private key is encrypted.
+        /// </summary>
+        public string? PrivateKeyPassword
+        {
+            get => _privateKeyPassword;
+            set => _privateKeyPassword = value;
+        }
+
+        /// <summary>
+        /// The user that we should use for the connection to the bastion host.
+        /// </summary>
+        [Input("user")]
+        public string? User { get; set; }
+
+        public ProxyConnection()
+        {
+            DialErrorLimit = 10;
+            PerDialTimeout = 15;
+            Port = 22;
+            User = "root";
+        }
+        public static new ProxyConnection Empty => new ProxyConnection();
+    }
+}
@@ -0,0 +1,701 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote
+{
+    /// <summary>
+    /// Runs the same command on several remote hosts, e.g. a fleet of identical servers. The
+    /// hosts are given either as 'connections', or as 'hosts' that share the settings of 'connection'. The command
+    /// is run on 'batchSize' hosts at a time, and stops after more than 'maxFailures' hosts failed. The output and
+    /// exit code of each host is available via the 'results' property.
+    /// 
+    /// On update, the 'delete' command is run on the hosts that were removed and the 'create' command on the hosts
+    /// that were added or that it didn't succeed on yet. The 'update' command, or 'create' if it's not set, is run on
+    /// the other hosts only if the command's inputs or the host's connection changed, or if it failed on the host
+    /// before. The output of each host is logged prefixed with the host.
+    /// </summary>
+    [CommandResourceType("command:remote:MultiCommand")]
+    public partial class MultiCommand : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// If the previous command's stdout and stderr (as generated by the prior create/update) is
+        /// injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+        /// Defaults to true.
+        /// </summary>
+        [Output("addPreviousOutputInEnv")]
+        public Output<bool?> AddPreviousOutputInEnv { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of hosts the command is run on at the same time. The next batch of
+        /// hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+        /// </summary>
+        [Output("batchSize")]
+        public Output<int?> BatchSize { get; private set; } = null!;
+
+        /// <summary>
+        /// Run the command as another user, root by default, via the escalation tool set in
+        /// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+        /// the quoting of the command intact and passes on the environment variables.
+        /// </summary>
+        [Output("become")]
+        public Output<bool?> Become { get; private set; } = null!;
+
+        /// <summary>
+        /// The escalation tool to use with `become`. Defaults to `sudo`.
+        /// </summary>
+        [Output("becomeMethod")]
+        public Output<Pulumi.Command.Remote.BecomeMethod?> BecomeMethod { get; private set; } = null!;
+
+        /// <summary>
+        /// The password to answer the prompt of the escalation tool with. Without a
+        /// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+        /// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+        /// password is never part of the command's output.
+        /// </summary>
+        [Output("becomePassword")]
+        public Output<string?> BecomePassword { get; private set; } = null!;
+
+        /// <summary>
+        /// The user to run the command as with `become`. Defaults to `root`.
+        /// </summary>
+        [Output("becomeUser")]
+        public Output<string?> BecomeUser { get; private set; } = null!;
+
+        /// <summary>
+        /// The connection settings shared by the hosts in 'hosts'.
+        /// </summary>
+        [Output("connection")]
+        public Output<Outputs.ConnectionTemplate?> Connection { get; private set; } = null!;
+
+        /// <summary>
+        /// The connections to the hosts to run the command on. Each host may only be
+        /// listed once. Either 'connections' or 'hosts' must be set.
+        /// </summary>
+        [Output("connections")]
+        public Output<ImmutableArray<Outputs.Connection>> Connections { get; private set; } = null!;
+
+        /// <summary>
+        /// The command to run once on resource creation.
+        /// 
+        /// If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+        /// 
+        /// Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+        /// 
+        /// Use `local.runOutput` if you need to run a command on every execution of your program.
+        /// </summary>
+        [Output("create")]
+        public Output<string?> Create { get; private set; } = null!;
+
+        /// <summary>
+        /// The command to run on resource deletion.
+        /// 
+        /// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+        /// </summary>
+        [Output("delete")]
+        public Output<string?> Delete { get; private set; } = null!;
+
+        /// <summary>
+        /// Run the command as a detached job that survives connection drops, e.g. for
+        /// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+        /// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+        /// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+        /// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+        /// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+        /// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+        /// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        /// </summary>
+        [Output("detached")]
+        public Output<Outputs.Detached?> Detached { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory on the remote host to run the command in. A leading '~' is the home
+        /// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+        /// on the remote host.
+        /// </summary>
+        [Output("dir")]
+        public Output<string?> Dir { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional environment variables available to the command's process.
+        /// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+        /// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+        /// </summary>
+        [Output("environment")]
+        public Output<ImmutableDictionary<string, string>?> Environment { get; private set; } = null!;
+
+        /// <summary>
+        /// How the environment variables, including PULUMI_COMMAND_STDOUT and
+        /// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+        /// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+        /// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+        /// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        /// </summary>
+        [Output("environmentMode")]
+        public Output<Pulumi.Command.Remote.EnvironmentMode?> EnvironmentMode { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Output("errorOutputLines")]
+        public Output<int?> ErrorOutputLines { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Output("heartbeatInterval")]
+        public Output<int?> HeartbeatInterval { get; private set; } = null!;
+
+        /// <summary>
+        /// The addresses of the hosts to run the command on, which are connected to with the
+        /// settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+        /// which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+        /// must be set.
+        /// </summary>
+        [Output("hosts")]
+        public Output<ImmutableArray<string>> Hosts { get; private set; } = null!;
+
+        /// <summary>
+        /// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+        /// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        /// </summary>
+        [Output("interpreter")]
+        public Output<ImmutableArray<string>> Interpreter { get; private set; } = null!;
+
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Output("logStreamPrefix")]
+        public Output<bool?> LogStreamPrefix { get; private set; } = null!;
+
+        /// <summary>
+        /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+        /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+        /// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        /// </summary>
+        [Output("logging")]
+        public Output<Pulumi.Command.Remote.Logging?> Logging { get; private set; } = null!;
+
+        /// <summary>
+        /// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+        /// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        /// </summary>
+        [Output("loginShell")]
+        public Output<bool?> LoginShell { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of hosts the command may fail on. Once more hosts failed, no further
+        /// batches are started and the resource fails. Defaults to 0.
+        /// </summary>
+        [Output("maxFailures")]
+        public Output<int?> MaxFailures { get; private set; } = null!;
+
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input.
+        /// </summary>
+        [Output("pty")]
+        public Output<Outputs.Pty?> Pty { get; private set; } = null!;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+        /// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        [Output("redact")]
+        public Output<ImmutableArray<string>> Redact { get; private set; } = null!;
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Output("redactOutputs")]
+        public Output<bool?> RedactOutputs { get; private set; } = null!;
+
+        /// <summary>
+        /// The result of the last command run on each host, keyed by the host's address,
+        /// followed by ':' and the port if that's not 22. A host that the command hasn't been run on yet, e.g. because an
+        /// earlier batch failed, has no result.
+        /// </summary>
+        [Output("results")]
+        public Output<ImmutableDictionary<string, Outputs.HostResult>> Results { get; private set; } = null!;
+
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Output("stderrSeverity")]
+        public Output<Pulumi.Command.Remote.LogSeverity?> StderrSeverity { get; private set; } = null!;
+
+        /// <summary>
+        /// Pass a string to the command's process as standard in
+        /// </summary>
+        [Output("stdin")]
+        public Output<string?> Stdin { get; private set; } = null!;
+
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Output("stdoutSeverity")]
+        public Output<Pulumi.Command.Remote.LogSeverity?> StdoutSeverity { get; private set; } = null!;
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Output("stripWorkflowCommands")]
+        public Output<bool?> StripWorkflowCommands { get; private set; } = null!;
+
+        /// <summary>
+        /// The maximum number of seconds the command may run. When it elapses, or the
+        /// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+        /// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+        /// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+        /// Defaults to no timeout.
+        /// </summary>
+        [Output("timeout")]
+        public Output<int?> Timeout { get; private set; } = null!;
+
+        /// <summary>
+        /// The resource will be updated (or replaced) if any of these values change.
+        /// 
+        /// The trigger values can be of any type.
+        /// 
+        /// If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+        /// 
+        /// Please see the resource documentation for examples.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableArray<object>> Triggers { get; private set; } = null!;
+
+        /// <summary>
+        /// The command to run when the resource is updated.
+        /// 
+        /// If empty, the create command will be executed instead.
+        /// 
+        /// Note that this command will not run if the resource's inputs are unchanged.
+        /// 
+        /// Use `local.runOutput` if you need to run a command on every execution of your program.
+        /// 
+        /// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        /// </summary>
+        [Output("update")]
+        public Output<string?> Update { get; private set; } = null!;
+
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Output("workflowCommands")]
+        public Output<bool?> WorkflowCommands { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a MultiCommand resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public MultiCommand(string name, MultiCommandArgs? args = null, CustomResourceOptions? options = null)
+            : base("command:remote:MultiCommand", name, args ?? new MultiCommandArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private MultiCommand(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("command:remote:MultiCommand", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "becomePassword",
+                    "connection",
+                    "connections",
+                    "redact",
+                },
+                ReplaceOnChanges =
+                {
+                    "triggers[*]",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing MultiCommand resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static MultiCommand Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new MultiCommand(name, id, options);
+        }
+    }
+
+    public sealed class MultiCommandArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// If the previous command's stdout and stderr (as generated by the prior create/update) is
+        /// injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+        /// Defaults to true.
+        /// </summary>
+        [Input("addPreviousOutputInEnv")]
+        public Input<bool>? AddPreviousOutputInEnv { get; set; }
+
+        /// <summary>
+        /// The number of hosts the command is run on at the same time. The next batch of
+        /// hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+        /// </summary>
+        [Input("batchSize")]
+        public Input<int>? BatchSize { get; set; }
+
+        /// <summary>
+        /// Run the command as another user, root by default, via the escalation tool set in
+        /// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+        /// the quoting of the command intact and passes on the environment variables.
+        /// </summary>
+        [Input("become")]
+        public Input<bool>? Become { get; set; }
+
+        /// <summary>
+        /// The escalation tool to use with `become`. Defaults to `sudo`.
+        /// </summary>
+        [Input("becomeMethod")]
+        public Input<Pulumi.Command.Remote.BecomeMethod>? BecomeMethod { get; set; }
+
+        [Input("becomePassword")]
+        private Input<string>? _becomePassword;
+
+        /// <summary>
+        /// The password to answer the prompt of the escalation tool with. Without a
+        /// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+        /// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+        /// password is never part of the command's output.
+        /// </summary>
+        public Input<string>? BecomePassword
+        {
+            get => _becomePassword;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _becomePassword = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The user to run the command as with `become`. Defaults to `root`.
+        /// </summary>
+        [Input("becomeUser")]
+        public Input<string>? BecomeUser { get; set; }
+
+        [Input("connection")]
+        private Input<Inputs.ConnectionTemplateArgs>? _connection;
+
+        /// <summary>
+        /// The connection settings shared by the hosts in 'hosts'.
+        /// </summary>
+        public Input<Inputs.ConnectionTemplateArgs>? Connection
+        {
+            get => _connection;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connection = Output.Tuple<Input<Inputs.ConnectionTemplateArgs>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("connections")]
+        private InputList<Inputs.ConnectionArgs>? _connections;
+
+        /// <summary>
+        /// The connections to the hosts to run the command on. Each host may only be
+        /// listed once. Either 'connections' or 'hosts' must be set.
+        /// </summary>
+        public InputList<Inputs.ConnectionArgs> Connections
+        {
+            get => _connections ?? (_connections = new InputList<Inputs.ConnectionArgs>());
+            set
+            {
+                var emptySecret = Output.CreateSecret(ImmutableArray.Create<Inputs.ConnectionArgs>());
+                _connections = Output.All(value, emptySecret).Apply(v => v[0]);
+            }
+        }
+
+        /// <summary>
+        /// The command to run once on resource creation.
+        /// 
+        /// If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+        /// 
+        /// Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+        /// 
+        /// Use `local.runOutput` if you need to run a command on every execution of your program.
+        /// </summary>
+        [Input("create")]
+        public Input<string>? Create { get; set; }
+
+        /// <summary>
+        /// The command to run on resource deletion.
+        /// 
+        /// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+        /// </summary>
+        [Input("delete")]
+        public Input<string>? Delete { get; set; }
+
+        /// <summary>
+        /// Run the command as a detached job that survives connection drops, e.g. for
+        /// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+        /// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+        /// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+        /// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+        /// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+        /// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+        /// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        /// </summary>
+        [Input("detached")]
+        public Input<Inputs.DetachedArgs>? Detached { get; set; }
+
+        /// <summary>
+        /// The directory on the remote host to run the command in. A leading '~' is the home
+        /// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+        /// on the remote host.
+        /// </summary>
+        [Input("dir")]
+        public Input<string>? Dir { get; set; }
+
+        [Input("environment")]
+        private InputMap<string>? _environment;
+
+        /// <summary>
+        /// Additional environment variables available to the command's process.
+        /// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+        /// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+        /// </summary>
+        public InputMap<string> Environment
+        {
+            get => _environment ?? (_environment = new InputMap<string>());
+            set => _environment = value;
+        }
+
+        /// <summary>
+        /// How the environment variables, including PULUMI_COMMAND_STDOUT and
+        /// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+        /// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+        /// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+        /// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        /// </summary>
+        [Input("environmentMode")]
+        public Input<Pulumi.Command.Remote.EnvironmentMode>? EnvironmentMode { get; set; }
+
+        /// <summary>
+        /// The number of lines at the end of stdout and stderr that the error of a
+        /// failed command includes. Defaults to 20.
+        /// </summary>
+        [Input("errorOutputLines")]
+        public Input<int>? ErrorOutputLines { get; set; }
+
+        /// <summary>
+        /// The number of seconds without output after which the status of the
+        /// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        /// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        /// Defaults to 60.
+        /// </summary>
+        [Input("heartbeatInterval")]
+        public Input<int>? HeartbeatInterval { get; set; }
+
+        [Input("hosts")]
+        private InputList<string>? _hosts;
+
+        /// <summary>
+        /// The addresses of the hosts to run the command on, which are connected to with the
+        /// settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+        /// which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+        /// must be set.
+        /// </summary>
+        public InputList<string> Hosts
+        {
+            get => _hosts ?? (_hosts = new InputList<string>());
+            set => _hosts = value;
+        }
+
+        [Input("interpreter")]
+        private InputList<string>? _interpreter;
+
+        /// <summary>
+        /// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+        /// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        /// </summary>
+        public InputList<string> Interpreter
+        {
+            get => _interpreter ?? (_interpreter = new InputList<string>());
+            set => _interpreter = value;
+        }
+
+        /// <summary>
+        /// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        /// </summary>
+        [Input("logStreamPrefix")]
+        public Input<bool>? LogStreamPrefix { get; set; }
+
+        /// <summary>
+        /// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+        /// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+        /// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        /// </summary>
+        [Input("logging")]
+        public Input<Pulumi.Command.Remote.Logging>? Logging { get; set; }
+
+        /// <summary>
+        /// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+        /// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        /// </summary>
+        [Input("loginShell")]
+        public Input<bool>? LoginShell { get; set; }
+
+        /// <summary>
+        /// The number of hosts the command may fail on. Once more hosts failed, no further
+        /// batches are started and the resource fails. Defaults to 0.
+        /// </summary>
+        [Input("maxFailures")]
+        public Input<int>? MaxFailures { get; set; }
+
+        /// <summary>
+        /// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        /// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        /// the terminal, without echo, followed by the end of input.
+        /// </summary>
+        [Input("pty")]
+        public Input<Inputs.PtyArgs>? Pty { get; set; }
+
+        [Input("redact")]
+        private InputList<string>? _redact;
+
+        /// <summary>
+        /// Additional values to mask as '[secret]' in the logged output and the error of
+        /// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        /// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+        /// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        /// </summary>
+        public InputList<string> Redact
+        {
+            get => _redact ?? (_redact = new InputList<string>());
+            set
+            {
+                var emptySecret = Output.CreateSecret(ImmutableArray.Create<string>());
+                _redact = Output.All(value, emptySecret).Apply(v => v[0]);
+            }
+        }
+
+        /// <summary>
+        /// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        /// to false, in which case outputs that might contain secrets can be marked as secret via
+        /// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        /// </summary>
+        [Input("redactOutputs")]
+        public Input<bool>? RedactOutputs { get; set; }
+
+        /// <summary>
+        /// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        /// </summary>
+        [Input("stderrSeverity")]
+        public Input<Pulumi.Command.Remote.LogSeverity>? StderrSeverity { get; set; }
+
+        /// <summary>
+        /// Pass a string to the command's process as standard in
+        /// </summary>
+        [Input("stdin")]
+        public Input<string>? Stdin { get; set; }
+
+        /// <summary>
+        /// The severity with which the lines of stdout are logged. Defaults to `info`.
+        /// </summary>
+        [Input("stdoutSeverity")]
+        public Input<Pulumi.Command.Remote.LogSeverity>? StdoutSeverity { get; set; }
+
+        /// <summary>
+        /// If the workflow commands are removed from the 'stdout' output. Defaults
+        /// to false.
+        /// </summary>
+        [Input("stripWorkflowCommands")]
+        public Input<bool>? StripWorkflowCommands { get; set; }
+
+        /// <summary>
+        /// The maximum number of seconds the command may run. When it elapses, or the
+        /// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+        /// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+        /// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+        /// Defaults to no timeout.
+        /// </summary>
+        [Input("timeout")]
+        public Input<int>? Timeout { get; set; }
+
+        [Input("triggers")]
+        private InputList<object>? _triggers;
+
+        /// <summary>
+        /// The resource will be updated (or replaced) if any of these values change.
+        /// 
+        /// The trigger values can be of any type.
+        /// 
+        /// If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+        /// 
+        /// Please see the resource documentation for examples.
+        /// </summary>
+        public InputList<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputList<object>());
+            set => _triggers = value;
+        }
+
+        /// <summary>
+        /// The command to run when the resource is updated.
+        /// 
+        /// If empty, the create command will be executed instead.
+        /// 
+        /// Note that this command will not run if the resource's inputs are unchanged.
+        /// 
+        /// Use `local.runOutput` if you need to run a command on every execution of your program.
+        /// 
+        /// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        /// </summary>
+        [Input("update")]
+        public Input<string>? Update { get; set; }
+
+        /// <summary>
+        /// Run the workflow commands among the lines of stdout, modeled on those of
+        /// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        /// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        /// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        /// </summary>
+        [Input("workflowCommands")]
+        public Input<bool>? WorkflowCommands { get; set; }
+
+        public MultiCommandArgs()
+        {
+        }
+        public static new MultiCommandArgs Empty => new MultiCommandArgs();
+    }
+}
@@ -0,0 +1,73 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote.Outputs
+{
+
+    /// <summary>
+    /// The result of a step of establishing a connection.
+    /// </summary>
+    [OutputType]
+    public sealed class ConnectionStage
+    {
+        /// <summary>
+        /// What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+        /// </summary>
+        public readonly string? Detail;
+        /// <summary>
+        /// How long the stage took in milliseconds.
+        /// </summary>
+        public readonly double DurationMs;
+        /// <summary>
+        /// Why the stage failed.
+        /// </summary>
+        public readonly string? Error;
+        /// <summary>
+        /// The host that the stage connects to, `proxy` or `host`.
+        /// </summary>
+        public readonly string Hop;
+        /// <summary>
+        /// The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+        /// </summary>
+        public readonly string? Method;
+        /// <summary>
+        /// The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+        /// </summary>
+        public readonly string Stage;
+        /// <summary>
+        /// If the stage succeeded.
+        /// </summary>
+        public readonly bool Success;
+
+        [OutputConstructor]
+        private ConnectionStage(
+            string? detail,
+
+            double durationMs,
+
+            string? error,
+
+            string hop,
+
+            string? method,
+
+            string stage,
+
+            bool success)
+        {
+            Detail = detail;
+            DurationMs = durationMs;
+            Error = error;
+            Hop = hop;
+            Method = method;
+            Stage = stage;
+            Success = success;
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote.Outputs
+{
+
+    /// <summary>
+    /// Settings for running a command as a detached job.
+    /// </summary>
+    [OutputType]
+    public sealed class Detached
+    {
+        /// <summary>
+        /// The number of seconds between checks whether the job finished. Defaults to 5.
+        /// </summary>
+        public readonly int? PollInterval;
+        /// <summary>
+        /// The directory on the remote host that holds a subdirectory per job, with its pid,
+        /// exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+        /// directory of the user. Defaults to `~/.pulumi-command/jobs`.
+        /// </summary>
+        public readonly string? StateDir;
+
+        [OutputConstructor]
+        private Detached(
+            int? pollInterval,
+
+            string? stateDir)
+        {
+            PollInterval = pollInterval;
+            StateDir = stateDir;
+        }
+    }
+}
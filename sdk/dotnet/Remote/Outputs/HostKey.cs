@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote.Outputs
+{
+
+    /// <summary>
+    /// A public key that a host offers to identify itself.
+    /// </summary>
+    [OutputType]
+    public sealed class HostKey
+    {
+        /// <summary>
+        /// The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+        /// </summary>
+        public readonly string Fingerprint;
+        /// <summary>
+        /// The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+        /// </summary>
+        public readonly string Key;
+        /// <summary>
+        /// The type of the key, e.g. `ssh-ed25519`.
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private HostKey(
+            string fingerprint,
+
+            string key,
+
+            string type)
+        {
+            Fingerprint = fingerprint;
+            Key = key;
+            Type = type;
+        }
+    }
+}
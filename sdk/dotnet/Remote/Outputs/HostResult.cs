@@ -0,0 +1,62 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote.Outputs
+{
+
+    /// <summary>
+    /// The result of running a command on a host.
+    /// </summary>
+    [OutputType]
+    public sealed class HostResult
+    {
+        /// <summary>
+        /// Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+        /// than 'update' is run on the host on update.
+        /// </summary>
+        public readonly bool Created;
+        /// <summary>
+        /// Why the command failed on the host, including its output. Unset if the command
+        /// succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.
+        /// </summary>
+        public readonly string? Error;
+        /// <summary>
+        /// The exit code of the command's process, or -1 if the command didn't exit, e.g.
+        /// because the host couldn't be reached.
+        /// </summary>
+        public readonly int ExitCode;
+        /// <summary>
+        /// The standard error of the command's process
+        /// </summary>
+        public readonly string Stderr;
+        /// <summary>
+        /// The standard output of the command's process
+        /// </summary>
+        public readonly string Stdout;
+
+        [OutputConstructor]
+        private HostResult(
+            bool created,
+
+            string? error,
+
+            int exitCode,
+
+            string stderr,
+
+            string stdout)
+        {
+            Created = created;
+            Error = error;
+            ExitCode = exitCode;
+            Stderr = stderr;
+            Stdout = stdout;
+        }
+    }
+}
@@ -0,0 +1,101 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote
+{
+    public static class TestConnection
+    {
+        /// <summary>
+        /// Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+        /// </summary>
+        public static Task<TestConnectionResult> InvokeAsync(TestConnectionArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<TestConnectionResult>("command:remote:testConnection", args ?? new TestConnectionArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+        /// </summary>
+        public static Output<TestConnectionResult> Invoke(TestConnectionInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<TestConnectionResult>("command:remote:testConnection", args ?? new TestConnectionInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+        /// </summary>
+        public static Output<TestConnectionResult> Invoke(TestConnectionInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<TestConnectionResult>("command:remote:testConnection", args ?? new TestConnectionInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class TestConnectionArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("connection", required: true)]
+        private Inputs.Connection? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Inputs.Connection? Connection
+        {
+            get => _connection;
+            set => _connection = value;
+        }
+
+        public TestConnectionArgs()
+        {
+        }
+        public static new TestConnectionArgs Empty => new TestConnectionArgs();
+    }
+
+    public sealed class TestConnectionInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("connection", required: true)]
+        private Input<Inputs.ConnectionArgs>? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Input<Inputs.ConnectionArgs>? Connection
+        {
+            get => _connection;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connection = Output.Tuple<Input<Inputs.ConnectionArgs>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        public TestConnectionInvokeArgs()
+        {
+        }
+        public static new TestConnectionInvokeArgs Empty => new TestConnectionInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class TestConnectionResult
+    {
+        /// <summary>
+        /// The stages of the connection in the order they were run. The stages after the first one that failed aren't run, except that all auth methods are tried until one succeeds.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ConnectionStage> Stages;
+        /// <summary>
+        /// If the connection to the host succeeded.
+        /// </summary>
+        public readonly bool Success;
+
+        [OutputConstructor]
+        private TestConnectionResult(
+            ImmutableArray<Outputs.ConnectionStage> stages,
+
+            bool success)
+        {
+            Stages = stages;
+            Success = success;
+        }
+    }
+}
@@ -0,0 +1,182 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote
+{
+    /// <summary>
+    /// Forwards a port over SSH for the duration of a deployment, e.g. to reach a private
+    /// database through a bastion host from other providers.
+    /// 
+    /// A 'local' tunnel listens on 'localHost' and 'localPort' on the machine running Pulumi, and forwards the
+    /// connections to 'remoteHost' and 'remotePort' as seen from the remote host. A 'remote' tunnel listens on
+    /// 'remoteHost' and 'remotePort' on the remote host, and forwards the connections to 'localHost' and 'localPort'
+    /// as seen from the machine running Pulumi. The listening address is available via 'address' and 'port'.
+    /// 
+    /// The tunnel is open while the provider runs, and is reopened on the same address on each deployment when the
+    /// Tunnel is checked for changes. Only if that address isn't free anymore, the Tunnel shows as updated, with a
+    /// new 'address' for a free port. Set the listening port to keep 'address' the same in any case. Resources that use
+    /// the tunnel should depend on it.
+    /// </summary>
+    [CommandResourceType("command:remote:Tunnel")]
+    public partial class Tunnel : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The address that the tunnel listens on as host and port, on the machine running
+        /// Pulumi for a 'local' tunnel and on the remote host for a 'remote' one.
+        /// </summary>
+        [Output("address")]
+        public Output<string> Address { get; private set; } = null!;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        [Output("connection")]
+        public Output<Outputs.Connection> Connection { get; private set; } = null!;
+
+        /// <summary>
+        /// The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+        /// </summary>
+        [Output("localHost")]
+        public Output<string?> LocalHost { get; private set; } = null!;
+
+        /// <summary>
+        /// The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+        /// if it's not set, while a 'remote' tunnel requires it.
+        /// </summary>
+        [Output("localPort")]
+        public Output<int?> LocalPort { get; private set; } = null!;
+
+        /// <summary>
+        /// The port that the tunnel listens on.
+        /// </summary>
+        [Output("port")]
+        public Output<int> Port { get; private set; } = null!;
+
+        /// <summary>
+        /// The address as seen from the remote host. Defaults to `localhost`.
+        /// </summary>
+        [Output("remoteHost")]
+        public Output<string?> RemoteHost { get; private set; } = null!;
+
+        /// <summary>
+        /// The port as seen from the remote host. A 'remote' tunnel listens on a port
+        /// chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+        /// </summary>
+        [Output("remotePort")]
+        public Output<int?> RemotePort { get; private set; } = null!;
+
+        /// <summary>
+        /// The direction of the tunnel. Defaults to `local`.
+        /// </summary>
+        [Output("type")]
+        public Output<Pulumi.Command.Remote.TunnelType?> Type { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Tunnel resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Tunnel(string name, TunnelArgs args, CustomResourceOptions? options = null)
+            : base("command:remote:Tunnel", name, args ?? new TunnelArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Tunnel(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("command:remote:Tunnel", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "connection",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Tunnel resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Tunnel Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Tunnel(name, id, options);
+        }
+    }
+
+    public sealed class TunnelArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("connection", required: true)]
+        private Input<Inputs.ConnectionArgs>? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host.
+        /// </summary>
+        public Input<Inputs.ConnectionArgs>? Connection
+        {
+            get => _connection;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connection = Output.Tuple<Input<Inputs.ConnectionArgs>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+        /// </summary>
+        [Input("localHost")]
+        public Input<string>? LocalHost { get; set; }
+
+        /// <summary>
+        /// The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+        /// if it's not set, while a 'remote' tunnel requires it.
+        /// </summary>
+        [Input("localPort")]
+        public Input<int>? LocalPort { get; set; }
+
+        /// <summary>
+        /// The address as seen from the remote host. Defaults to `localhost`.
+        /// </summary>
+        [Input("remoteHost")]
+        public Input<string>? RemoteHost { get; set; }
+
+        /// <summary>
+        /// The port as seen from the remote host. A 'remote' tunnel listens on a port
+        /// chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+        /// </summary>
+        [Input("remotePort")]
+        public Input<int>? RemotePort { get; set; }
+
+        /// <summary>
+        /// The direction of the tunnel. Defaults to `local`.
+        /// </summary>
+        [Input("type")]
+        public Input<Pulumi.Command.Remote.TunnelType>? Type { get; set; }
+
+        public TunnelArgs()
+        {
+        }
+        public static new TunnelArgs Empty => new TunnelArgs();
+    }
+}
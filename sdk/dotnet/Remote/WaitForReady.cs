@@ -0,0 +1,173 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Command.Remote
+{
+    /// <summary>
+    /// Waits until a remote host accepts SSH connections, e.g. after creating a VM, so that the
+    /// resources depending on it don't have to retry. Optionally, it also waits until a probe command succeeds on the
+    /// host, e.g. 'cloud-init status --wait'.
+    /// 
+    /// The host is waited for on creation, and again when any of the inputs change.
+    /// </summary>
+    [CommandResourceType("command:remote:WaitForReady")]
+    public partial class WaitForReady : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The number of milliseconds that the successful connection took to establish.
+        /// </summary>
+        [Output("connectLatency")]
+        public Output<int> ConnectLatency { get; private set; } = null!;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host. Each attempt dials
+        /// the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+        /// </summary>
+        [Output("connection")]
+        public Output<Outputs.Connection> Connection { get; private set; } = null!;
+
+        /// <summary>
+        /// The host key that the host presented on the successful connection, in the
+        /// authorized_keys format, e.g. to pin it as the 'hostKey' of later connections.
+        /// </summary>
+        [Output("hostKey")]
+        public Output<string> HostKey { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of seconds between the attempts to connect and run the probe.
+        /// Defaults to 5.
+        /// </summary>
+        [Output("interval")]
+        public Output<int?> Interval { get; private set; } = null!;
+
+        /// <summary>
+        /// A command that must succeed on the host for it to be ready, e.g.
+        /// 'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+        /// still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+        /// </summary>
+        [Output("probe")]
+        public Output<string?> Probe { get; private set; } = null!;
+
+        /// <summary>
+        /// The maximum number of seconds to wait for the host in total. Defaults to 600.
+        /// </summary>
+        [Output("timeout")]
+        public Output<int?> Timeout { get; private set; } = null!;
+
+        /// <summary>
+        /// Wait for the host again on changes to this input.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableArray<object>> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a WaitForReady resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public WaitForReady(string name, WaitForReadyArgs args, CustomResourceOptions? options = null)
+            : base("command:remote:WaitForReady", name, args ?? new WaitForReadyArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private WaitForReady(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("command:remote:WaitForReady", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "connection",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing WaitForReady resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static WaitForReady Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new WaitForReady(name, id, options);
+        }
+    }
+
+    public sealed class WaitForReadyArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("connection", required: true)]
+        private Input<Inputs.ConnectionArgs>? _connection;
+
+        /// <summary>
+        /// The parameters with which to connect to the remote host. Each attempt dials
+        /// the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+        /// </summary>
+        public Input<Inputs.ConnectionArgs>? Connection
+        {
+            get => _connection;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connection = Output.Tuple<Input<Inputs.ConnectionArgs>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The number of seconds between the attempts to connect and run the probe.
+        /// Defaults to 5.
+        /// </summary>
+        [Input("interval")]
+        public Input<int>? Interval { get; set; }
+
+        /// <summary>
+        /// A command that must succeed on the host for it to be ready, e.g.
+        /// 'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+        /// still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+        /// </summary>
+        [Input("probe")]
+        public Input<string>? Probe { get; set; }
+
+        /// <summary>
+        /// The maximum number of seconds to wait for the host in total. Defaults to 600.
+        /// </summary>
+        [Input("timeout")]
+        public Input<int>? Timeout { get; set; }
+
+        [Input("triggers")]
+        private InputList<object>? _triggers;
+
+        /// <summary>
+        /// Wait for the host again on changes to this input.
+        /// </summary>
+        public InputList<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputList<object>());
+            set => _triggers = value;
+        }
+
+        public WaitForReadyArgs()
+        {
+        }
+        public static new WaitForReadyArgs Empty => new WaitForReadyArgs();
+    }
+}
@@ -250,19 +250,56 @@ type Command struct {
 	Dir pulumi.StringPtrOutput `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
 	Environment pulumi.StringMapOutput `pulumi:"environment"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines pulumi.IntPtrOutput `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval pulumi.IntPtrOutput `pulumi:"heartbeatInterval"`
 	// The program and arguments to run the command.
 	// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix pulumi.BoolPtrOutput `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging LoggingPtrOutput `pulumi:"logging"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input. Not supported on Windows.
+	Pty PtyPtrOutput `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact pulumi.StringArrayOutput `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs pulumi.BoolPtrOutput `pulumi:"redactOutputs"`
 	// The standard error of the command's process
 	Stderr pulumi.StringOutput `pulumi:"stderr"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity LogSeverityPtrOutput `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
 	// The standard output of the command's process
 	Stdout pulumi.StringOutput `pulumi:"stdout"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity LogSeverityPtrOutput `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands pulumi.BoolPtrOutput `pulumi:"stripWorkflowCommands"`
+	// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+	// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+	// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+	// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+	// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+	// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+	// a directory isn't searched.
+	Symlinks SymlinkPolicyPtrOutput `pulumi:"symlinks"`
 	// The resource will be updated (or replaced) if any of these values change.
 	//
 	// The trigger values can be of any type.
@@ -281,6 +318,11 @@ type Command struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
 	Update pulumi.StringPtrOutput `pulumi:"update"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands pulumi.BoolPtrOutput `pulumi:"workflowCommands"`
 }
 
 // NewCommand registers a new resource with the given unique name, arguments, and options.
@@ -290,6 +332,13 @@ func NewCommand(ctx *pulumi.Context,
 		args = &CommandArgs{}
 	}
 
+	if args.Redact != nil {
+		args.Redact = pulumi.ToSecret(args.Redact).(pulumi.StringArrayInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"redact",
+	})
+	opts = append(opts, secrets)
 	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
 		"triggers[*]",
 	})
@@ -388,15 +437,52 @@ type commandArgs struct {
 	Dir *string `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
 	Environment map[string]string `pulumi:"environment"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines *int `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval *int `pulumi:"heartbeatInterval"`
 	// The program and arguments to run the command.
 	// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 	Interpreter []string `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix *bool `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging *Logging `pulumi:"logging"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input. Not supported on Windows.
+	Pty *Pty `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact []string `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs *bool `pulumi:"redactOutputs"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity *LogSeverity `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin *string `pulumi:"stdin"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity *LogSeverity `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands *bool `pulumi:"stripWorkflowCommands"`
+	// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+	// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+	// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+	// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+	// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+	// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+	// a directory isn't searched.
+	Symlinks *SymlinkPolicy `pulumi:"symlinks"`
 	// The resource will be updated (or replaced) if any of these values change.
 	//
 	// The trigger values can be of any type.
@@ -415,6 +501,11 @@ type commandArgs struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
 	Update *string `pulumi:"update"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands *bool `pulumi:"workflowCommands"`
 }
 
 // The set of arguments for constructing a Command resource.
@@ -480,15 +571,52 @@ type CommandArgs struct {
 	Dir pulumi.StringPtrInput
 	// Additional environment variables available to the command's process.
 	Environment pulumi.StringMapInput
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines pulumi.IntPtrInput
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval pulumi.IntPtrInput
 	// The program and arguments to run the command.
 	// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 	Interpreter pulumi.StringArrayInput
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix pulumi.BoolPtrInput
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging LoggingPtrInput
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input. Not supported on Windows.
+	Pty PtyPtrInput
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact pulumi.StringArrayInput
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs pulumi.BoolPtrInput
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity LogSeverityPtrInput
 	// Pass a string to the command's process as standard in
 	Stdin pulumi.StringPtrInput
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity LogSeverityPtrInput
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands pulumi.BoolPtrInput
+	// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+	// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+	// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+	// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+	// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+	// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+	// a directory isn't searched.
+	Symlinks SymlinkPolicyPtrInput
 	// The resource will be updated (or replaced) if any of these values change.
 	//
 	// The trigger values can be of any type.
@@ -507,6 +635,11 @@ type CommandArgs struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
 	Update pulumi.StringPtrInput
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands pulumi.BoolPtrInput
 }
 
 func (CommandArgs) ElementType() reflect.Type {
@@ -689,12 +822,31 @@ func (o CommandOutput) Environment() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringMapOutput { return v.Environment }).(pulumi.StringMapOutput)
 }
 
+// The number of lines at the end of stdout and stderr that the error of a
+// failed command includes. Defaults to 20.
+func (o CommandOutput) ErrorOutputLines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.IntPtrOutput { return v.ErrorOutputLines }).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds without output after which the status of the
+// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+// Defaults to 60.
+func (o CommandOutput) HeartbeatInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.IntPtrOutput { return v.HeartbeatInterval }).(pulumi.IntPtrOutput)
+}
+
 // The program and arguments to run the command.
 // On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 func (o CommandOutput) Interpreter() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
 }
 
+// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+func (o CommandOutput) LogStreamPrefix() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.LogStreamPrefix }).(pulumi.BoolPtrOutput)
+}
+
 // If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 // stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 // outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
@@ -702,11 +854,37 @@ func (o CommandOutput) Logging() LoggingPtrOutput {
 	return o.ApplyT(func(v *Command) LoggingPtrOutput { return v.Logging }).(LoggingPtrOutput)
 }
 
+// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+// the terminal, without echo, followed by the end of input. Not supported on Windows.
+func (o CommandOutput) Pty() PtyPtrOutput {
+	return o.ApplyT(func(v *Command) PtyPtrOutput { return v.Pty }).(PtyPtrOutput)
+}
+
+// Additional values to mask as '[secret]' in the logged output and the error of
+// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+func (o CommandOutput) Redact() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Command) pulumi.StringArrayOutput { return v.Redact }).(pulumi.StringArrayOutput)
+}
+
+// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+// to false, in which case outputs that might contain secrets can be marked as secret via
+// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+func (o CommandOutput) RedactOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.RedactOutputs }).(pulumi.BoolPtrOutput)
+}
+
 // The standard error of the command's process
 func (o CommandOutput) Stderr() pulumi.StringOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringOutput { return v.Stderr }).(pulumi.StringOutput)
 }
 
+// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+func (o CommandOutput) StderrSeverity() LogSeverityPtrOutput {
+	return o.ApplyT(func(v *Command) LogSeverityPtrOutput { return v.StderrSeverity }).(LogSeverityPtrOutput)
+}
+
 // Pass a string to the command's process as standard in
 func (o CommandOutput) Stdin() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
@@ -717,6 +895,28 @@ func (o CommandOutput) Stdout() pulumi.StringOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringOutput { return v.Stdout }).(pulumi.StringOutput)
 }
 
+// The severity with which the lines of stdout are logged. Defaults to `info`.
+func (o CommandOutput) StdoutSeverity() LogSeverityPtrOutput {
+	return o.ApplyT(func(v *Command) LogSeverityPtrOutput { return v.StdoutSeverity }).(LogSeverityPtrOutput)
+}
+
+// If the workflow commands are removed from the 'stdout' output. Defaults
+// to false.
+func (o CommandOutput) StripWorkflowCommands() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.StripWorkflowCommands }).(pulumi.BoolPtrOutput)
+}
+
+// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+// a directory isn't searched.
+func (o CommandOutput) Symlinks() SymlinkPolicyPtrOutput {
+	return o.ApplyT(func(v *Command) SymlinkPolicyPtrOutput { return v.Symlinks }).(SymlinkPolicyPtrOutput)
+}
+
 // The resource will be updated (or replaced) if any of these values change.
 //
 // The trigger values can be of any type.
@@ -741,6 +941,14 @@ func (o CommandOutput) Update() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.Update }).(pulumi.StringPtrOutput)
 }
 
+// Run the workflow commands among the lines of stdout, modeled on those of
+// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+func (o CommandOutput) WorkflowCommands() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.WorkflowCommands }).(pulumi.BoolPtrOutput)
+}
+
 type CommandArrayOutput struct{ *pulumi.OutputState }
 
 func (CommandArrayOutput) ElementType() reflect.Type {
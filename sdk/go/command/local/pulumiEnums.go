@@ -10,6 +10,179 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+type LogSeverity string
+
+const (
+	// Log the lines as debug messages
+	LogSeverityDebug = LogSeverity("debug")
+	// Log the lines as info messages
+	LogSeverityInfo = LogSeverity("info")
+	// Log the lines as warnings
+	LogSeverityWarning = LogSeverity("warning")
+	// Log the lines as errors
+	LogSeverityError = LogSeverity("error")
+)
+
+func (LogSeverity) ElementType() reflect.Type {
+	return reflect.TypeOf((*LogSeverity)(nil)).Elem()
+}
+
+func (e LogSeverity) ToLogSeverityOutput() LogSeverityOutput {
+	return pulumi.ToOutput(e).(LogSeverityOutput)
+}
+
+func (e LogSeverity) ToLogSeverityOutputWithContext(ctx context.Context) LogSeverityOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(LogSeverityOutput)
+}
+
+func (e LogSeverity) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return e.ToLogSeverityPtrOutputWithContext(context.Background())
+}
+
+func (e LogSeverity) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return LogSeverity(e).ToLogSeverityOutputWithContext(ctx).ToLogSeverityPtrOutputWithContext(ctx)
+}
+
+func (e LogSeverity) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e LogSeverity) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e LogSeverity) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e LogSeverity) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type LogSeverityOutput struct{ *pulumi.OutputState }
+
+func (LogSeverityOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LogSeverity)(nil)).Elem()
+}
+
+func (o LogSeverityOutput) ToLogSeverityOutput() LogSeverityOutput {
+	return o
+}
+
+func (o LogSeverityOutput) ToLogSeverityOutputWithContext(ctx context.Context) LogSeverityOutput {
+	return o
+}
+
+func (o LogSeverityOutput) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return o.ToLogSeverityPtrOutputWithContext(context.Background())
+}
+
+func (o LogSeverityOutput) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v LogSeverity) *LogSeverity {
+		return &v
+	}).(LogSeverityPtrOutput)
+}
+
+func (o LogSeverityOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o LogSeverityOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e LogSeverity) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o LogSeverityOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o LogSeverityOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e LogSeverity) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type LogSeverityPtrOutput struct{ *pulumi.OutputState }
+
+func (LogSeverityPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**LogSeverity)(nil)).Elem()
+}
+
+func (o LogSeverityPtrOutput) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return o
+}
+
+func (o LogSeverityPtrOutput) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return o
+}
+
+func (o LogSeverityPtrOutput) Elem() LogSeverityOutput {
+	return o.ApplyT(func(v *LogSeverity) LogSeverity {
+		if v != nil {
+			return *v
+		}
+		var ret LogSeverity
+		return ret
+	}).(LogSeverityOutput)
+}
+
+func (o LogSeverityPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o LogSeverityPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *LogSeverity) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// LogSeverityInput is an input type that accepts values of the LogSeverity enum
+// A concrete instance of `LogSeverityInput` can be one of the following:
+//
+//	LogSeverityDebug
+//	LogSeverityInfo
+//	LogSeverityWarning
+//	LogSeverityError
+type LogSeverityInput interface {
+	pulumi.Input
+
+	ToLogSeverityOutput() LogSeverityOutput
+	ToLogSeverityOutputWithContext(context.Context) LogSeverityOutput
+}
+
+var logSeverityPtrType = reflect.TypeOf((**LogSeverity)(nil)).Elem()
+
+type LogSeverityPtrInput interface {
+	pulumi.Input
+
+	ToLogSeverityPtrOutput() LogSeverityPtrOutput
+	ToLogSeverityPtrOutputWithContext(context.Context) LogSeverityPtrOutput
+}
+
+type logSeverityPtr string
+
+func LogSeverityPtr(v string) LogSeverityPtrInput {
+	return (*logSeverityPtr)(&v)
+}
+
+func (*logSeverityPtr) ElementType() reflect.Type {
+	return logSeverityPtrType
+}
+
+func (in *logSeverityPtr) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return pulumi.ToOutput(in).(LogSeverityPtrOutput)
+}
+
+func (in *logSeverityPtr) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(LogSeverityPtrOutput)
+}
+
 type Logging string
 
 const (
@@ -183,9 +356,187 @@ func (in *loggingPtr) ToLoggingPtrOutputWithContext(ctx context.Context) Logging
 	return pulumi.ToOutputWithContext(ctx, in).(LoggingPtrOutput)
 }
 
+type SymlinkPolicy string
+
+const (
+	// Keep symlinks as symlinks
+	SymlinkPolicyPreserve = SymlinkPolicy("preserve")
+	// Treat symlinks as the files or directories they point to
+	SymlinkPolicyFollow = SymlinkPolicy("follow")
+	// Ignore symlinks
+	SymlinkPolicySkip = SymlinkPolicy("skip")
+)
+
+func (SymlinkPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((*SymlinkPolicy)(nil)).Elem()
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyOutput() SymlinkPolicyOutput {
+	return pulumi.ToOutput(e).(SymlinkPolicyOutput)
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyOutputWithContext(ctx context.Context) SymlinkPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(SymlinkPolicyOutput)
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return e.ToSymlinkPolicyPtrOutputWithContext(context.Background())
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return SymlinkPolicy(e).ToSymlinkPolicyOutputWithContext(ctx).ToSymlinkPolicyPtrOutputWithContext(ctx)
+}
+
+func (e SymlinkPolicy) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SymlinkPolicy) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SymlinkPolicy) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e SymlinkPolicy) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type SymlinkPolicyOutput struct{ *pulumi.OutputState }
+
+func (SymlinkPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SymlinkPolicy)(nil)).Elem()
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyOutput() SymlinkPolicyOutput {
+	return o
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyOutputWithContext(ctx context.Context) SymlinkPolicyOutput {
+	return o
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return o.ToSymlinkPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SymlinkPolicy) *SymlinkPolicy {
+		return &v
+	}).(SymlinkPolicyPtrOutput)
+}
+
+func (o SymlinkPolicyOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e SymlinkPolicy) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o SymlinkPolicyOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e SymlinkPolicy) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type SymlinkPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (SymlinkPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SymlinkPolicy)(nil)).Elem()
+}
+
+func (o SymlinkPolicyPtrOutput) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return o
+}
+
+func (o SymlinkPolicyPtrOutput) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return o
+}
+
+func (o SymlinkPolicyPtrOutput) Elem() SymlinkPolicyOutput {
+	return o.ApplyT(func(v *SymlinkPolicy) SymlinkPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret SymlinkPolicy
+		return ret
+	}).(SymlinkPolicyOutput)
+}
+
+func (o SymlinkPolicyPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *SymlinkPolicy) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// SymlinkPolicyInput is an input type that accepts values of the SymlinkPolicy enum
+// A concrete instance of `SymlinkPolicyInput` can be one of the following:
+//
+//	SymlinkPolicyPreserve
+//	SymlinkPolicyFollow
+//	SymlinkPolicySkip
+type SymlinkPolicyInput interface {
+	pulumi.Input
+
+	ToSymlinkPolicyOutput() SymlinkPolicyOutput
+	ToSymlinkPolicyOutputWithContext(context.Context) SymlinkPolicyOutput
+}
+
+var symlinkPolicyPtrType = reflect.TypeOf((**SymlinkPolicy)(nil)).Elem()
+
+type SymlinkPolicyPtrInput interface {
+	pulumi.Input
+
+	ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput
+	ToSymlinkPolicyPtrOutputWithContext(context.Context) SymlinkPolicyPtrOutput
+}
+
+type symlinkPolicyPtr string
+
+func SymlinkPolicyPtr(v string) SymlinkPolicyPtrInput {
+	return (*symlinkPolicyPtr)(&v)
+}
+
+func (*symlinkPolicyPtr) ElementType() reflect.Type {
+	return symlinkPolicyPtrType
+}
+
+func (in *symlinkPolicyPtr) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return pulumi.ToOutput(in).(SymlinkPolicyPtrOutput)
+}
+
+func (in *symlinkPolicyPtr) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(SymlinkPolicyPtrOutput)
+}
+
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*LogSeverityInput)(nil)).Elem(), LogSeverity("debug"))
+	pulumi.RegisterInputType(reflect.TypeOf((*LogSeverityPtrInput)(nil)).Elem(), LogSeverity("debug"))
 	pulumi.RegisterInputType(reflect.TypeOf((*LoggingInput)(nil)).Elem(), Logging("stdout"))
 	pulumi.RegisterInputType(reflect.TypeOf((*LoggingPtrInput)(nil)).Elem(), Logging("stdout"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SymlinkPolicyInput)(nil)).Elem(), SymlinkPolicy("preserve"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SymlinkPolicyPtrInput)(nil)).Elem(), SymlinkPolicy("preserve"))
+	pulumi.RegisterOutputType(LogSeverityOutput{})
+	pulumi.RegisterOutputType(LogSeverityPtrOutput{})
 	pulumi.RegisterOutputType(LoggingOutput{})
 	pulumi.RegisterOutputType(LoggingPtrOutput{})
+	pulumi.RegisterOutputType(SymlinkPolicyOutput{})
+	pulumi.RegisterOutputType(SymlinkPolicyPtrOutput{})
 }
@@ -0,0 +1,222 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package local
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-command/sdk/go/command/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// The pseudo-terminal that a command is run in.
+type Pty struct {
+	// The width of the terminal in characters. Defaults to 80.
+	Cols *int `pulumi:"cols"`
+	// The height of the terminal in characters. Defaults to 24.
+	Rows *int `pulumi:"rows"`
+	// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+	// captured stdout. They are always part of the logged output. Defaults to false.
+	StripAnsi *bool `pulumi:"stripAnsi"`
+	// The terminal type, set as TERM. Defaults to `xterm`.
+	Term *string `pulumi:"term"`
+}
+
+// PtyInput is an input type that accepts PtyArgs and PtyOutput values.
+// You can construct a concrete instance of `PtyInput` via:
+//
+//	PtyArgs{...}
+type PtyInput interface {
+	pulumi.Input
+
+	ToPtyOutput() PtyOutput
+	ToPtyOutputWithContext(context.Context) PtyOutput
+}
+
+// The pseudo-terminal that a command is run in.
+type PtyArgs struct {
+	// The width of the terminal in characters. Defaults to 80.
+	Cols pulumi.IntPtrInput `pulumi:"cols"`
+	// The height of the terminal in characters. Defaults to 24.
+	Rows pulumi.IntPtrInput `pulumi:"rows"`
+	// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+	// captured stdout. They are always part of the logged output. Defaults to false.
+	StripAnsi pulumi.BoolPtrInput `pulumi:"stripAnsi"`
+	// The terminal type, set as TERM. Defaults to `xterm`.
+	Term pulumi.StringPtrInput `pulumi:"term"`
+}
+
+func (PtyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Pty)(nil)).Elem()
+}
+
+func (i PtyArgs) ToPtyOutput() PtyOutput {
+	return i.ToPtyOutputWithContext(context.Background())
+}
+
+func (i PtyArgs) ToPtyOutputWithContext(ctx context.Context) PtyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PtyOutput)
+}
+
+func (i PtyArgs) ToPtyPtrOutput() PtyPtrOutput {
+	return i.ToPtyPtrOutputWithContext(context.Background())
+}
+
+func (i PtyArgs) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PtyOutput).ToPtyPtrOutputWithContext(ctx)
+}
+
+// PtyPtrInput is an input type that accepts PtyArgs, PtyPtr and PtyPtrOutput values.
+// You can construct a concrete instance of `PtyPtrInput` via:
+//
+//	        PtyArgs{...}
+//
+//	or:
+//
+//	        nil
+type PtyPtrInput interface {
+	pulumi.Input
+
+	ToPtyPtrOutput() PtyPtrOutput
+	ToPtyPtrOutputWithContext(context.Context) PtyPtrOutput
+}
+
+type ptyPtrType PtyArgs
+
+func PtyPtr(v *PtyArgs) PtyPtrInput {
+	return (*ptyPtrType)(v)
+}
+
+func (*ptyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Pty)(nil)).Elem()
+}
+
+func (i *ptyPtrType) ToPtyPtrOutput() PtyPtrOutput {
+	return i.ToPtyPtrOutputWithContext(context.Background())
+}
+
+func (i *ptyPtrType) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PtyPtrOutput)
+}
+
+// The pseudo-terminal that a command is run in.
+type PtyOutput struct{ *pulumi.OutputState }
+
+func (PtyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Pty)(nil)).Elem()
+}
+
+func (o PtyOutput) ToPtyOutput() PtyOutput {
+	return o
+}
+
+func (o PtyOutput) ToPtyOutputWithContext(ctx context.Context) PtyOutput {
+	return o
+}
+
+func (o PtyOutput) ToPtyPtrOutput() PtyPtrOutput {
+	return o.ToPtyPtrOutputWithContext(context.Background())
+}
+
+func (o PtyOutput) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Pty) *Pty {
+		return &v
+	}).(PtyPtrOutput)
+}
+
+// The width of the terminal in characters. Defaults to 80.
+func (o PtyOutput) Cols() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Pty) *int { return v.Cols }).(pulumi.IntPtrOutput)
+}
+
+// The height of the terminal in characters. Defaults to 24.
+func (o PtyOutput) Rows() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Pty) *int { return v.Rows }).(pulumi.IntPtrOutput)
+}
+
+// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+// captured stdout. They are always part of the logged output. Defaults to false.
+func (o PtyOutput) StripAnsi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Pty) *bool { return v.StripAnsi }).(pulumi.BoolPtrOutput)
+}
+
+// The terminal type, set as TERM. Defaults to `xterm`.
+func (o PtyOutput) Term() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Pty) *string { return v.Term }).(pulumi.StringPtrOutput)
+}
+
+type PtyPtrOutput struct{ *pulumi.OutputState }
+
+func (PtyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Pty)(nil)).Elem()
+}
+
+func (o PtyPtrOutput) ToPtyPtrOutput() PtyPtrOutput {
+	return o
+}
+
+func (o PtyPtrOutput) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return o
+}
+
+func (o PtyPtrOutput) Elem() PtyOutput {
+	return o.ApplyT(func(v *Pty) Pty {
+		if v != nil {
+			return *v
+		}
+		var ret Pty
+		return ret
+	}).(PtyOutput)
+}
+
+// The width of the terminal in characters. Defaults to 80.
+func (o PtyPtrOutput) Cols() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Pty) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Cols
+	}).(pulumi.IntPtrOutput)
+}
+
+// The height of the terminal in characters. Defaults to 24.
+func (o PtyPtrOutput) Rows() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Pty) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Rows
+	}).(pulumi.IntPtrOutput)
+}
+
+// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+// captured stdout. They are always part of the logged output. Defaults to false.
+func (o PtyPtrOutput) StripAnsi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Pty) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.StripAnsi
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The terminal type, set as TERM. Defaults to `xterm`.
+func (o PtyPtrOutput) Term() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Pty) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Term
+	}).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*PtyInput)(nil)).Elem(), PtyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PtyPtrInput)(nil)).Elem(), PtyArgs{})
+	pulumi.RegisterOutputType(PtyOutput{})
+	pulumi.RegisterOutputType(PtyPtrOutput{})
+}
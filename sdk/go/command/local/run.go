@@ -75,15 +75,57 @@ type RunArgs struct {
 	Dir *string `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
 	Environment map[string]string `pulumi:"environment"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines *int `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval *int `pulumi:"heartbeatInterval"`
 	// The program and arguments to run the command.
 	// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 	Interpreter []string `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix *bool `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging *Logging `pulumi:"logging"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input. Not supported on Windows.
+	Pty *Pty `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact []string `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs *bool `pulumi:"redactOutputs"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity *LogSeverity `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin *string `pulumi:"stdin"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity *LogSeverity `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands *bool `pulumi:"stripWorkflowCommands"`
+	// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+	// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+	// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+	// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+	// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+	// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+	// a directory isn't searched.
+	Symlinks *SymlinkPolicy `pulumi:"symlinks"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands *bool `pulumi:"workflowCommands"`
 }
 
 type RunResult struct {
@@ -143,19 +185,61 @@ type RunResult struct {
 	Dir *string `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
 	Environment map[string]string `pulumi:"environment"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines *int `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval *int `pulumi:"heartbeatInterval"`
 	// The program and arguments to run the command.
 	// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 	Interpreter []string `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix *bool `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging *Logging `pulumi:"logging"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input. Not supported on Windows.
+	Pty *Pty `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact []string `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs *bool `pulumi:"redactOutputs"`
 	// The standard error of the command's process
 	Stderr string `pulumi:"stderr"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity *LogSeverity `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin *string `pulumi:"stdin"`
 	// The standard output of the command's process
 	Stdout string `pulumi:"stdout"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity *LogSeverity `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands *bool `pulumi:"stripWorkflowCommands"`
+	// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+	// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+	// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+	// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+	// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+	// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+	// a directory isn't searched.
+	Symlinks *SymlinkPolicy `pulumi:"symlinks"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands *bool `pulumi:"workflowCommands"`
 }
 
 func RunOutput(ctx *pulumi.Context, args RunOutputArgs, opts ...pulumi.InvokeOption) RunResultOutput {
@@ -215,15 +299,57 @@ type RunOutputArgs struct {
 	Dir pulumi.StringPtrInput `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
 	Environment pulumi.StringMapInput `pulumi:"environment"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines pulumi.IntPtrInput `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval pulumi.IntPtrInput `pulumi:"heartbeatInterval"`
 	// The program and arguments to run the command.
 	// On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 	Interpreter pulumi.StringArrayInput `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix pulumi.BoolPtrInput `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging LoggingPtrInput `pulumi:"logging"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input. Not supported on Windows.
+	Pty PtyPtrInput `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact pulumi.StringArrayInput `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs pulumi.BoolPtrInput `pulumi:"redactOutputs"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity LogSeverityPtrInput `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin pulumi.StringPtrInput `pulumi:"stdin"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity LogSeverityPtrInput `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands pulumi.BoolPtrInput `pulumi:"stripWorkflowCommands"`
+	// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+	// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+	// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+	// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+	// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+	// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+	// a directory isn't searched.
+	Symlinks SymlinkPolicyPtrInput `pulumi:"symlinks"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands pulumi.BoolPtrInput `pulumi:"workflowCommands"`
 }
 
 func (RunOutputArgs) ElementType() reflect.Type {
@@ -324,12 +450,31 @@ func (o RunResultOutput) Environment() pulumi.StringMapOutput {
 	return o.ApplyT(func(v RunResult) map[string]string { return v.Environment }).(pulumi.StringMapOutput)
 }
 
+// The number of lines at the end of stdout and stderr that the error of a
+// failed command includes. Defaults to 20.
+func (o RunResultOutput) ErrorOutputLines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v RunResult) *int { return v.ErrorOutputLines }).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds without output after which the status of the
+// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+// Defaults to 60.
+func (o RunResultOutput) HeartbeatInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v RunResult) *int { return v.HeartbeatInterval }).(pulumi.IntPtrOutput)
+}
+
 // The program and arguments to run the command.
 // On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
 func (o RunResultOutput) Interpreter() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v RunResult) []string { return v.Interpreter }).(pulumi.StringArrayOutput)
 }
 
+// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+func (o RunResultOutput) LogStreamPrefix() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RunResult) *bool { return v.LogStreamPrefix }).(pulumi.BoolPtrOutput)
+}
+
 // If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 // stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 // outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
@@ -337,11 +482,37 @@ func (o RunResultOutput) Logging() LoggingPtrOutput {
 	return o.ApplyT(func(v RunResult) *Logging { return v.Logging }).(LoggingPtrOutput)
 }
 
+// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+// the terminal, without echo, followed by the end of input. Not supported on Windows.
+func (o RunResultOutput) Pty() PtyPtrOutput {
+	return o.ApplyT(func(v RunResult) *Pty { return v.Pty }).(PtyPtrOutput)
+}
+
+// Additional values to mask as '[secret]' in the logged output and the error of
+// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+// environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+func (o RunResultOutput) Redact() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RunResult) []string { return v.Redact }).(pulumi.StringArrayOutput)
+}
+
+// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+// to false, in which case outputs that might contain secrets can be marked as secret via
+// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+func (o RunResultOutput) RedactOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RunResult) *bool { return v.RedactOutputs }).(pulumi.BoolPtrOutput)
+}
+
 // The standard error of the command's process
 func (o RunResultOutput) Stderr() pulumi.StringOutput {
 	return o.ApplyT(func(v RunResult) string { return v.Stderr }).(pulumi.StringOutput)
 }
 
+// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+func (o RunResultOutput) StderrSeverity() LogSeverityPtrOutput {
+	return o.ApplyT(func(v RunResult) *LogSeverity { return v.StderrSeverity }).(LogSeverityPtrOutput)
+}
+
 // Pass a string to the command's process as standard in
 func (o RunResultOutput) Stdin() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v RunResult) *string { return v.Stdin }).(pulumi.StringPtrOutput)
@@ -352,6 +523,36 @@ func (o RunResultOutput) Stdout() pulumi.StringOutput {
 	return o.ApplyT(func(v RunResult) string { return v.Stdout }).(pulumi.StringOutput)
 }
 
+// The severity with which the lines of stdout are logged. Defaults to `info`.
+func (o RunResultOutput) StdoutSeverity() LogSeverityPtrOutput {
+	return o.ApplyT(func(v RunResult) *LogSeverity { return v.StdoutSeverity }).(LogSeverityPtrOutput)
+}
+
+// If the workflow commands are removed from the 'stdout' output. Defaults
+// to false.
+func (o RunResultOutput) StripWorkflowCommands() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RunResult) *bool { return v.StripWorkflowCommands }).(pulumi.BoolPtrOutput)
+}
+
+// How symlinks are handled when matching `assetPaths` and `archivePaths`.
+// With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+// searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+// Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+// search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+// files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+// a directory isn't searched.
+func (o RunResultOutput) Symlinks() SymlinkPolicyPtrOutput {
+	return o.ApplyT(func(v RunResult) *SymlinkPolicy { return v.Symlinks }).(SymlinkPolicyPtrOutput)
+}
+
+// Run the workflow commands among the lines of stdout, modeled on those of
+// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+func (o RunResultOutput) WorkflowCommands() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RunResult) *bool { return v.WorkflowCommands }).(pulumi.BoolPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterOutputType(RunResultOutput{})
 }
@@ -120,6 +120,19 @@ type Command struct {
 	// injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
 	// Defaults to true.
 	AddPreviousOutputInEnv pulumi.BoolPtrOutput `pulumi:"addPreviousOutputInEnv"`
+	// Run the command as another user, root by default, via the escalation tool set in
+	// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+	// the quoting of the command intact and passes on the environment variables.
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
+	// The escalation tool to use with `become`. Defaults to `sudo`.
+	BecomeMethod BecomeMethodPtrOutput `pulumi:"becomeMethod"`
+	// The password to answer the prompt of the escalation tool with. Without a
+	// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+	// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+	// password is never part of the command's output.
+	BecomePassword pulumi.StringPtrOutput `pulumi:"becomePassword"`
+	// The user to run the command as with `become`. Defaults to `root`.
+	BecomeUser pulumi.StringPtrOutput `pulumi:"becomeUser"`
 	// The parameters with which to connect to the remote host.
 	Connection ConnectionOutput `pulumi:"connection"`
 	// The command to run once on resource creation.
@@ -134,21 +147,81 @@ type Command struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
 	Delete pulumi.StringPtrOutput `pulumi:"delete"`
+	// Run the command as a detached job that survives connection drops, e.g. for
+	// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+	// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+	// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+	// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+	// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+	// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+	// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+	Detached DetachedPtrOutput `pulumi:"detached"`
+	// The directory on the remote host to run the command in. A leading '~' is the home
+	// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+	// on the remote host.
+	Dir pulumi.StringPtrOutput `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
-	// Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-	// Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-	// with the variables in the form 'VAR=value command'.
+	// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+	// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
 	Environment pulumi.StringMapOutput `pulumi:"environment"`
+	// How the environment variables, including PULUMI_COMMAND_STDOUT and
+	// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+	// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+	// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+	// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+	EnvironmentMode EnvironmentModePtrOutput `pulumi:"environmentMode"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines pulumi.IntPtrOutput `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval pulumi.IntPtrOutput `pulumi:"heartbeatInterval"`
+	// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+	// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+	Interpreter pulumi.StringArrayOutput `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix pulumi.BoolPtrOutput `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging LoggingPtrOutput `pulumi:"logging"`
+	// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+	// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+	LoginShell pulumi.BoolPtrOutput `pulumi:"loginShell"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input.
+	Pty PtyPtrOutput `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+	// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact pulumi.StringArrayOutput `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs pulumi.BoolPtrOutput `pulumi:"redactOutputs"`
 	// The standard error of the command's process
 	Stderr pulumi.StringOutput `pulumi:"stderr"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity LogSeverityPtrOutput `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin pulumi.StringPtrOutput `pulumi:"stdin"`
 	// The standard output of the command's process
 	Stdout pulumi.StringOutput `pulumi:"stdout"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity LogSeverityPtrOutput `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands pulumi.BoolPtrOutput `pulumi:"stripWorkflowCommands"`
+	// The maximum number of seconds the command may run. When it elapses, or the
+	// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+	// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+	// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+	// Defaults to no timeout.
+	Timeout pulumi.IntPtrOutput `pulumi:"timeout"`
 	// The resource will be updated (or replaced) if any of these values change.
 	//
 	// The trigger values can be of any type.
@@ -167,6 +240,11 @@ type Command struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
 	Update pulumi.StringPtrOutput `pulumi:"update"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands pulumi.BoolPtrOutput `pulumi:"workflowCommands"`
 }
 
 // NewCommand registers a new resource with the given unique name, arguments, and options.
@@ -180,11 +258,19 @@ func NewCommand(ctx *pulumi.Context,
 		return nil, errors.New("invalid value for required argument 'Connection'")
 	}
 	args.Connection = args.Connection.ToConnectionOutput().ApplyT(func(v Connection) Connection { return *v.Defaults() }).(ConnectionOutput)
+	if args.BecomePassword != nil {
+		args.BecomePassword = pulumi.ToSecret(args.BecomePassword).(pulumi.StringPtrInput)
+	}
 	if args.Connection != nil {
 		args.Connection = pulumi.ToSecret(args.Connection).(ConnectionInput)
 	}
+	if args.Redact != nil {
+		args.Redact = pulumi.ToSecret(args.Redact).(pulumi.StringArrayInput)
+	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"becomePassword",
 		"connection",
+		"redact",
 	})
 	opts = append(opts, secrets)
 	replaceOnChanges := pulumi.ReplaceOnChanges([]string{
@@ -228,6 +314,19 @@ type commandArgs struct {
 	// injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
 	// Defaults to true.
 	AddPreviousOutputInEnv *bool `pulumi:"addPreviousOutputInEnv"`
+	// Run the command as another user, root by default, via the escalation tool set in
+	// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+	// the quoting of the command intact and passes on the environment variables.
+	Become *bool `pulumi:"become"`
+	// The escalation tool to use with `become`. Defaults to `sudo`.
+	BecomeMethod *BecomeMethod `pulumi:"becomeMethod"`
+	// The password to answer the prompt of the escalation tool with. Without a
+	// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+	// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+	// password is never part of the command's output.
+	BecomePassword *string `pulumi:"becomePassword"`
+	// The user to run the command as with `become`. Defaults to `root`.
+	BecomeUser *string `pulumi:"becomeUser"`
 	// The parameters with which to connect to the remote host.
 	Connection Connection `pulumi:"connection"`
 	// The command to run once on resource creation.
@@ -242,17 +341,77 @@ type commandArgs struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
 	Delete *string `pulumi:"delete"`
+	// Run the command as a detached job that survives connection drops, e.g. for
+	// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+	// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+	// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+	// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+	// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+	// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+	// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+	Detached *Detached `pulumi:"detached"`
+	// The directory on the remote host to run the command in. A leading '~' is the home
+	// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+	// on the remote host.
+	Dir *string `pulumi:"dir"`
 	// Additional environment variables available to the command's process.
-	// Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-	// Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-	// with the variables in the form 'VAR=value command'.
+	// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+	// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
 	Environment map[string]string `pulumi:"environment"`
+	// How the environment variables, including PULUMI_COMMAND_STDOUT and
+	// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+	// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+	// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+	// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+	EnvironmentMode *EnvironmentMode `pulumi:"environmentMode"`
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines *int `pulumi:"errorOutputLines"`
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval *int `pulumi:"heartbeatInterval"`
+	// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+	// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+	Interpreter []string `pulumi:"interpreter"`
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix *bool `pulumi:"logStreamPrefix"`
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging *Logging `pulumi:"logging"`
+	// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+	// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+	LoginShell *bool `pulumi:"loginShell"`
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input.
+	Pty *Pty `pulumi:"pty"`
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+	// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact []string `pulumi:"redact"`
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs *bool `pulumi:"redactOutputs"`
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity *LogSeverity `pulumi:"stderrSeverity"`
 	// Pass a string to the command's process as standard in
 	Stdin *string `pulumi:"stdin"`
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity *LogSeverity `pulumi:"stdoutSeverity"`
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands *bool `pulumi:"stripWorkflowCommands"`
+	// The maximum number of seconds the command may run. When it elapses, or the
+	// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+	// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+	// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+	// Defaults to no timeout.
+	Timeout *int `pulumi:"timeout"`
 	// The resource will be updated (or replaced) if any of these values change.
 	//
 	// The trigger values can be of any type.
@@ -271,6 +430,11 @@ type commandArgs struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
 	Update *string `pulumi:"update"`
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands *bool `pulumi:"workflowCommands"`
 }
 
 // The set of arguments for constructing a Command resource.
@@ -279,6 +443,19 @@ type CommandArgs struct {
 	// injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
 	// Defaults to true.
 	AddPreviousOutputInEnv pulumi.BoolPtrInput
+	// Run the command as another user, root by default, via the escalation tool set in
+	// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+	// the quoting of the command intact and passes on the environment variables.
+	Become pulumi.BoolPtrInput
+	// The escalation tool to use with `become`. Defaults to `sudo`.
+	BecomeMethod BecomeMethodPtrInput
+	// The password to answer the prompt of the escalation tool with. Without a
+	// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+	// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+	// password is never part of the command's output.
+	BecomePassword pulumi.StringPtrInput
+	// The user to run the command as with `become`. Defaults to `root`.
+	BecomeUser pulumi.StringPtrInput
 	// The parameters with which to connect to the remote host.
 	Connection ConnectionInput
 	// The command to run once on resource creation.
@@ -293,17 +470,77 @@ type CommandArgs struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
 	Delete pulumi.StringPtrInput
+	// Run the command as a detached job that survives connection drops, e.g. for
+	// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+	// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+	// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+	// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+	// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+	// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+	// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+	Detached DetachedPtrInput
+	// The directory on the remote host to run the command in. A leading '~' is the home
+	// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+	// on the remote host.
+	Dir pulumi.StringPtrInput
 	// Additional environment variables available to the command's process.
-	// Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-	// Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-	// with the variables in the form 'VAR=value command'.
+	// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+	// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
 	Environment pulumi.StringMapInput
+	// How the environment variables, including PULUMI_COMMAND_STDOUT and
+	// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+	// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+	// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+	// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+	EnvironmentMode EnvironmentModePtrInput
+	// The number of lines at the end of stdout and stderr that the error of a
+	// failed command includes. Defaults to 20.
+	ErrorOutputLines pulumi.IntPtrInput
+	// The number of seconds without output after which the status of the
+	// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+	// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+	// Defaults to 60.
+	HeartbeatInterval pulumi.IntPtrInput
+	// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+	// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+	Interpreter pulumi.StringArrayInput
+	// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+	LogStreamPrefix pulumi.BoolPtrInput
 	// If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 	// stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 	// outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
 	Logging LoggingPtrInput
+	// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+	// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+	LoginShell pulumi.BoolPtrInput
+	// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+	// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+	// the terminal, without echo, followed by the end of input.
+	Pty PtyPtrInput
+	// Additional values to mask as '[secret]' in the logged output and the error of
+	// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+	// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+	// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+	Redact pulumi.StringArrayInput
+	// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+	// to false, in which case outputs that might contain secrets can be marked as secret via
+	// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+	RedactOutputs pulumi.BoolPtrInput
+	// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+	StderrSeverity LogSeverityPtrInput
 	// Pass a string to the command's process as standard in
 	Stdin pulumi.StringPtrInput
+	// The severity with which the lines of stdout are logged. Defaults to `info`.
+	StdoutSeverity LogSeverityPtrInput
+	// If the workflow commands are removed from the 'stdout' output. Defaults
+	// to false.
+	StripWorkflowCommands pulumi.BoolPtrInput
+	// The maximum number of seconds the command may run. When it elapses, or the
+	// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+	// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+	// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+	// Defaults to no timeout.
+	Timeout pulumi.IntPtrInput
 	// The resource will be updated (or replaced) if any of these values change.
 	//
 	// The trigger values can be of any type.
@@ -322,6 +559,11 @@ type CommandArgs struct {
 	//
 	// The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
 	Update pulumi.StringPtrInput
+	// Run the workflow commands among the lines of stdout, modeled on those of
+	// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+	// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+	// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+	WorkflowCommands pulumi.BoolPtrInput
 }
 
 func (CommandArgs) ElementType() reflect.Type {
@@ -418,6 +660,31 @@ func (o CommandOutput) AddPreviousOutputInEnv() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.AddPreviousOutputInEnv }).(pulumi.BoolPtrOutput)
 }
 
+// Run the command as another user, root by default, via the escalation tool set in
+// 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+// the quoting of the command intact and passes on the environment variables.
+func (o CommandOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
+// The escalation tool to use with `become`. Defaults to `sudo`.
+func (o CommandOutput) BecomeMethod() BecomeMethodPtrOutput {
+	return o.ApplyT(func(v *Command) BecomeMethodPtrOutput { return v.BecomeMethod }).(BecomeMethodPtrOutput)
+}
+
+// The password to answer the prompt of the escalation tool with. Without a
+// password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+// the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+// password is never part of the command's output.
+func (o CommandOutput) BecomePassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.BecomePassword }).(pulumi.StringPtrOutput)
+}
+
+// The user to run the command as with `become`. Defaults to `root`.
+func (o CommandOutput) BecomeUser() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.BecomeUser }).(pulumi.StringPtrOutput)
+}
+
 // The parameters with which to connect to the remote host.
 func (o CommandOutput) Connection() ConnectionOutput {
 	return o.ApplyT(func(v *Command) ConnectionOutput { return v.Connection }).(ConnectionOutput)
@@ -441,14 +708,66 @@ func (o CommandOutput) Delete() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.Delete }).(pulumi.StringPtrOutput)
 }
 
+// Run the command as a detached job that survives connection drops, e.g. for
+// jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+// to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+// the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+// polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+// provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+// rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+// Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+func (o CommandOutput) Detached() DetachedPtrOutput {
+	return o.ApplyT(func(v *Command) DetachedPtrOutput { return v.Detached }).(DetachedPtrOutput)
+}
+
+// The directory on the remote host to run the command in. A leading '~' is the home
+// directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+// on the remote host.
+func (o CommandOutput) Dir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.Dir }).(pulumi.StringPtrOutput)
+}
+
 // Additional environment variables available to the command's process.
-// Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-// Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-// with the variables in the form 'VAR=value command'.
+// How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+// exported by the shell running as the become user instead, so AcceptEnv isn't needed.
 func (o CommandOutput) Environment() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringMapOutput { return v.Environment }).(pulumi.StringMapOutput)
 }
 
+// How the environment variables, including PULUMI_COMMAND_STDOUT and
+// PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+// the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+// them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+// 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+func (o CommandOutput) EnvironmentMode() EnvironmentModePtrOutput {
+	return o.ApplyT(func(v *Command) EnvironmentModePtrOutput { return v.EnvironmentMode }).(EnvironmentModePtrOutput)
+}
+
+// The number of lines at the end of stdout and stderr that the error of a
+// failed command includes. Defaults to 20.
+func (o CommandOutput) ErrorOutputLines() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.IntPtrOutput { return v.ErrorOutputLines }).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds without output after which the status of the
+// resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+// line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+// Defaults to 60.
+func (o CommandOutput) HeartbeatInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.IntPtrOutput { return v.HeartbeatInterval }).(pulumi.IntPtrOutput)
+}
+
+// The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+// The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+func (o CommandOutput) Interpreter() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Command) pulumi.StringArrayOutput { return v.Interpreter }).(pulumi.StringArrayOutput)
+}
+
+// If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+func (o CommandOutput) LogStreamPrefix() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.LogStreamPrefix }).(pulumi.BoolPtrOutput)
+}
+
 // If the command's stdout and stderr should be logged. This doesn't affect the capturing of
 // stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
 // outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
@@ -456,11 +775,44 @@ func (o CommandOutput) Logging() LoggingPtrOutput {
 	return o.ApplyT(func(v *Command) LoggingPtrOutput { return v.Logging }).(LoggingPtrOutput)
 }
 
+// Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+// ~/.profile are read, e.g. to set the PATH. Defaults to false.
+func (o CommandOutput) LoginShell() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.LoginShell }).(pulumi.BoolPtrOutput)
+}
+
+// Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+// terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+// the terminal, without echo, followed by the end of input.
+func (o CommandOutput) Pty() PtyPtrOutput {
+	return o.ApplyT(func(v *Command) PtyPtrOutput { return v.Pty }).(PtyPtrOutput)
+}
+
+// Additional values to mask as '[secret]' in the logged output and the error of
+// the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+// environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+// Values shorter than 4 characters aren't masked, and a warning is logged instead.
+func (o CommandOutput) Redact() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Command) pulumi.StringArrayOutput { return v.Redact }).(pulumi.StringArrayOutput)
+}
+
+// If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+// to false, in which case outputs that might contain secrets can be marked as secret via
+// 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+func (o CommandOutput) RedactOutputs() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.RedactOutputs }).(pulumi.BoolPtrOutput)
+}
+
 // The standard error of the command's process
 func (o CommandOutput) Stderr() pulumi.StringOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringOutput { return v.Stderr }).(pulumi.StringOutput)
 }
 
+// The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+func (o CommandOutput) StderrSeverity() LogSeverityPtrOutput {
+	return o.ApplyT(func(v *Command) LogSeverityPtrOutput { return v.StderrSeverity }).(LogSeverityPtrOutput)
+}
+
 // Pass a string to the command's process as standard in
 func (o CommandOutput) Stdin() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.Stdin }).(pulumi.StringPtrOutput)
@@ -471,6 +823,26 @@ func (o CommandOutput) Stdout() pulumi.StringOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringOutput { return v.Stdout }).(pulumi.StringOutput)
 }
 
+// The severity with which the lines of stdout are logged. Defaults to `info`.
+func (o CommandOutput) StdoutSeverity() LogSeverityPtrOutput {
+	return o.ApplyT(func(v *Command) LogSeverityPtrOutput { return v.StdoutSeverity }).(LogSeverityPtrOutput)
+}
+
+// If the workflow commands are removed from the 'stdout' output. Defaults
+// to false.
+func (o CommandOutput) StripWorkflowCommands() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.StripWorkflowCommands }).(pulumi.BoolPtrOutput)
+}
+
+// The maximum number of seconds the command may run. When it elapses, or the
+// deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+// doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+// which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+// Defaults to no timeout.
+func (o CommandOutput) Timeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.IntPtrOutput { return v.Timeout }).(pulumi.IntPtrOutput)
+}
+
 // The resource will be updated (or replaced) if any of these values change.
 //
 // The trigger values can be of any type.
@@ -495,6 +867,14 @@ func (o CommandOutput) Update() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Command) pulumi.StringPtrOutput { return v.Update }).(pulumi.StringPtrOutput)
 }
 
+// Run the workflow commands among the lines of stdout, modeled on those of
+// GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+// message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+// the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+func (o CommandOutput) WorkflowCommands() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Command) pulumi.BoolPtrOutput { return v.WorkflowCommands }).(pulumi.BoolPtrOutput)
+}
+
 type CommandArrayOutput struct{ *pulumi.OutputState }
 
 func (CommandArrayOutput) ElementType() reflect.Type {
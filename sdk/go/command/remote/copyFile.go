@@ -18,12 +18,16 @@ import (
 type CopyFile struct {
 	pulumi.CustomResourceState
 
+	// Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
 	// The parameters with which to connect to the remote host.
 	Connection ConnectionOutput `pulumi:"connection"`
 	// The path of the file to be copied.
 	LocalPath pulumi.StringOutput `pulumi:"localPath"`
 	// The destination path in the remote host.
 	RemotePath pulumi.StringOutput `pulumi:"remotePath"`
+	// How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+	Transport TransportPtrOutput `pulumi:"transport"`
 	// Trigger replacements on changes to this input.
 	Triggers pulumi.ArrayOutput `pulumi:"triggers"`
 }
@@ -85,24 +89,32 @@ func (CopyFileState) ElementType() reflect.Type {
 }
 
 type copyFileArgs struct {
+	// Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+	Become *bool `pulumi:"become"`
 	// The parameters with which to connect to the remote host.
 	Connection Connection `pulumi:"connection"`
 	// The path of the file to be copied.
 	LocalPath string `pulumi:"localPath"`
 	// The destination path in the remote host.
 	RemotePath string `pulumi:"remotePath"`
+	// How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+	Transport *Transport `pulumi:"transport"`
 	// Trigger replacements on changes to this input.
 	Triggers []interface{} `pulumi:"triggers"`
 }
 
 // The set of arguments for constructing a CopyFile resource.
 type CopyFileArgs struct {
+	// Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+	Become pulumi.BoolPtrInput
 	// The parameters with which to connect to the remote host.
 	Connection ConnectionInput
 	// The path of the file to be copied.
 	LocalPath pulumi.StringInput
 	// The destination path in the remote host.
 	RemotePath pulumi.StringInput
+	// How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+	Transport TransportPtrInput
 	// Trigger replacements on changes to this input.
 	Triggers pulumi.ArrayInput
 }
@@ -194,6 +206,11 @@ func (o CopyFileOutput) ToCopyFileOutputWithContext(ctx context.Context) CopyFil
 	return o
 }
 
+// Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+func (o CopyFileOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *CopyFile) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
 // The parameters with which to connect to the remote host.
 func (o CopyFileOutput) Connection() ConnectionOutput {
 	return o.ApplyT(func(v *CopyFile) ConnectionOutput { return v.Connection }).(ConnectionOutput)
@@ -209,6 +226,11 @@ func (o CopyFileOutput) RemotePath() pulumi.StringOutput {
 	return o.ApplyT(func(v *CopyFile) pulumi.StringOutput { return v.RemotePath }).(pulumi.StringOutput)
 }
 
+// How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+func (o CopyFileOutput) Transport() TransportPtrOutput {
+	return o.ApplyT(func(v *CopyFile) TransportPtrOutput { return v.Transport }).(TransportPtrOutput)
+}
+
 // Trigger replacements on changes to this input.
 func (o CopyFileOutput) Triggers() pulumi.ArrayOutput {
 	return o.ApplyT(func(v *CopyFile) pulumi.ArrayOutput { return v.Triggers }).(pulumi.ArrayOutput)
@@ -78,12 +78,34 @@ import (
 type CopyToRemote struct {
 	pulumi.CustomResourceState
 
+	// How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+	Atomic AtomicModePtrOutput `pulumi:"atomic"`
+	// Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+	Become pulumi.BoolPtrOutput `pulumi:"become"`
 	// The parameters with which to connect to the remote host.
 	Connection ConnectionOutput `pulumi:"connection"`
+	// The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+	Drift pulumi.StringArrayOutput `pulumi:"drift"`
+	// A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+	Exclude pulumi.StringArrayOutput `pulumi:"exclude"`
+	// If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+	Extract pulumi.BoolPtrOutput `pulumi:"extract"`
+	// If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+	Fsync pulumi.BoolPtrOutput `pulumi:"fsync"`
+	// A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+	Include pulumi.StringArrayOutput `pulumi:"include"`
+	// The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+	Manifest pulumi.StringMapOutput `pulumi:"manifest"`
+	// The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+	Parallelism pulumi.IntPtrOutput `pulumi:"parallelism"`
 	// The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
 	RemotePath pulumi.StringOutput `pulumi:"remotePath"`
-	// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+	// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
 	Source pulumi.AssetOrArchiveOutput `pulumi:"source"`
+	// How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+	Symlinks SymlinkPolicyPtrOutput `pulumi:"symlinks"`
+	// How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+	Transport TransportPtrOutput `pulumi:"transport"`
 	// Trigger replacements on changes to this input.
 	Triggers pulumi.ArrayOutput `pulumi:"triggers"`
 }
@@ -105,6 +127,9 @@ func NewCopyToRemote(ctx *pulumi.Context,
 		return nil, errors.New("invalid value for required argument 'Source'")
 	}
 	args.Connection = args.Connection.ToConnectionOutput().ApplyT(func(v Connection) Connection { return *v.Defaults() }).(ConnectionOutput)
+	if args.Parallelism == nil {
+		args.Parallelism = pulumi.IntPtr(1)
+	}
 	if args.Connection != nil {
 		args.Connection = pulumi.ToSecret(args.Connection).(ConnectionInput)
 	}
@@ -149,24 +174,60 @@ func (CopyToRemoteState) ElementType() reflect.Type {
 }
 
 type copyToRemoteArgs struct {
+	// How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+	Atomic *AtomicMode `pulumi:"atomic"`
+	// Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+	Become *bool `pulumi:"become"`
 	// The parameters with which to connect to the remote host.
 	Connection Connection `pulumi:"connection"`
+	// A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+	Exclude []string `pulumi:"exclude"`
+	// If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+	Extract *bool `pulumi:"extract"`
+	// If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+	Fsync *bool `pulumi:"fsync"`
+	// A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+	Include []string `pulumi:"include"`
+	// The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+	Parallelism *int `pulumi:"parallelism"`
 	// The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
 	RemotePath string `pulumi:"remotePath"`
-	// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+	// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
 	Source pulumi.AssetOrArchive `pulumi:"source"`
+	// How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+	Symlinks *SymlinkPolicy `pulumi:"symlinks"`
+	// How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+	Transport *Transport `pulumi:"transport"`
 	// Trigger replacements on changes to this input.
 	Triggers []interface{} `pulumi:"triggers"`
 }
 
 // The set of arguments for constructing a CopyToRemote resource.
 type CopyToRemoteArgs struct {
+	// How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+	Atomic AtomicModePtrInput
+	// Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+	Become pulumi.BoolPtrInput
 	// The parameters with which to connect to the remote host.
 	Connection ConnectionInput
+	// A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+	Exclude pulumi.StringArrayInput
+	// If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+	Extract pulumi.BoolPtrInput
+	// If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+	Fsync pulumi.BoolPtrInput
+	// A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+	Include pulumi.StringArrayInput
+	// The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+	Parallelism pulumi.IntPtrInput
 	// The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
 	RemotePath pulumi.StringInput
-	// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+	// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
 	Source pulumi.AssetOrArchiveInput
+	// How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+	Symlinks SymlinkPolicyPtrInput
+	// How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+	Transport TransportPtrInput
 	// Trigger replacements on changes to this input.
 	Triggers pulumi.ArrayInput
 }
@@ -258,21 +319,76 @@ func (o CopyToRemoteOutput) ToCopyToRemoteOutputWithContext(ctx context.Context)
 	return o
 }
 
+// How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+func (o CopyToRemoteOutput) Atomic() AtomicModePtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) AtomicModePtrOutput { return v.Atomic }).(AtomicModePtrOutput)
+}
+
+// Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+func (o CopyToRemoteOutput) Become() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.BoolPtrOutput { return v.Become }).(pulumi.BoolPtrOutput)
+}
+
 // The parameters with which to connect to the remote host.
 func (o CopyToRemoteOutput) Connection() ConnectionOutput {
 	return o.ApplyT(func(v *CopyToRemote) ConnectionOutput { return v.Connection }).(ConnectionOutput)
 }
 
+// The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+func (o CopyToRemoteOutput) Drift() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.StringArrayOutput { return v.Drift }).(pulumi.StringArrayOutput)
+}
+
+// A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+func (o CopyToRemoteOutput) Exclude() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.StringArrayOutput { return v.Exclude }).(pulumi.StringArrayOutput)
+}
+
+// If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+func (o CopyToRemoteOutput) Extract() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.BoolPtrOutput { return v.Extract }).(pulumi.BoolPtrOutput)
+}
+
+// If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+func (o CopyToRemoteOutput) Fsync() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.BoolPtrOutput { return v.Fsync }).(pulumi.BoolPtrOutput)
+}
+
+// A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+func (o CopyToRemoteOutput) Include() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.StringArrayOutput { return v.Include }).(pulumi.StringArrayOutput)
+}
+
+// The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+func (o CopyToRemoteOutput) Manifest() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.StringMapOutput { return v.Manifest }).(pulumi.StringMapOutput)
+}
+
+// The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+func (o CopyToRemoteOutput) Parallelism() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) pulumi.IntPtrOutput { return v.Parallelism }).(pulumi.IntPtrOutput)
+}
+
 // The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
 func (o CopyToRemoteOutput) RemotePath() pulumi.StringOutput {
 	return o.ApplyT(func(v *CopyToRemote) pulumi.StringOutput { return v.RemotePath }).(pulumi.StringOutput)
 }
 
-// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+// An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
 func (o CopyToRemoteOutput) Source() pulumi.AssetOrArchiveOutput {
 	return o.ApplyT(func(v *CopyToRemote) pulumi.AssetOrArchiveOutput { return v.Source }).(pulumi.AssetOrArchiveOutput)
 }
 
+// How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+func (o CopyToRemoteOutput) Symlinks() SymlinkPolicyPtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) SymlinkPolicyPtrOutput { return v.Symlinks }).(SymlinkPolicyPtrOutput)
+}
+
+// How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+func (o CopyToRemoteOutput) Transport() TransportPtrOutput {
+	return o.ApplyT(func(v *CopyToRemote) TransportPtrOutput { return v.Transport }).(TransportPtrOutput)
+}
+
 // Trigger replacements on changes to this input.
 func (o CopyToRemoteOutput) Triggers() pulumi.ArrayOutput {
 	return o.ApplyT(func(v *CopyToRemote) pulumi.ArrayOutput { return v.Triggers }).(pulumi.ArrayOutput)
@@ -27,6 +27,12 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &CopyFile{}
 	case "command:remote:CopyToRemote":
 		r = &CopyToRemote{}
+	case "command:remote:MultiCommand":
+		r = &MultiCommand{}
+	case "command:remote:Tunnel":
+		r = &Tunnel{}
+	case "command:remote:WaitForReady":
+		r = &WaitForReady{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -10,130 +10,1156 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+type AtomicMode string
+
+const (
+	// Write files in place
+	AtomicModeNone = AtomicMode("none")
+	// Write each file to a temporary file and rename it into place
+	AtomicModeFile = AtomicMode("file")
+	// Stage copied directories in full and swap them in via a symlink
+	AtomicModeDirectory = AtomicMode("directory")
+)
+
+func (AtomicMode) ElementType() reflect.Type {
+	return reflect.TypeOf((*AtomicMode)(nil)).Elem()
+}
+
+func (e AtomicMode) ToAtomicModeOutput() AtomicModeOutput {
+	return pulumi.ToOutput(e).(AtomicModeOutput)
+}
+
+func (e AtomicMode) ToAtomicModeOutputWithContext(ctx context.Context) AtomicModeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(AtomicModeOutput)
+}
+
+func (e AtomicMode) ToAtomicModePtrOutput() AtomicModePtrOutput {
+	return e.ToAtomicModePtrOutputWithContext(context.Background())
+}
+
+func (e AtomicMode) ToAtomicModePtrOutputWithContext(ctx context.Context) AtomicModePtrOutput {
+	return AtomicMode(e).ToAtomicModeOutputWithContext(ctx).ToAtomicModePtrOutputWithContext(ctx)
+}
+
+func (e AtomicMode) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e AtomicMode) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e AtomicMode) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e AtomicMode) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type AtomicModeOutput struct{ *pulumi.OutputState }
+
+func (AtomicModeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AtomicMode)(nil)).Elem()
+}
+
+func (o AtomicModeOutput) ToAtomicModeOutput() AtomicModeOutput {
+	return o
+}
+
+func (o AtomicModeOutput) ToAtomicModeOutputWithContext(ctx context.Context) AtomicModeOutput {
+	return o
+}
+
+func (o AtomicModeOutput) ToAtomicModePtrOutput() AtomicModePtrOutput {
+	return o.ToAtomicModePtrOutputWithContext(context.Background())
+}
+
+func (o AtomicModeOutput) ToAtomicModePtrOutputWithContext(ctx context.Context) AtomicModePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AtomicMode) *AtomicMode {
+		return &v
+	}).(AtomicModePtrOutput)
+}
+
+func (o AtomicModeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o AtomicModeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e AtomicMode) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o AtomicModeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o AtomicModeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e AtomicMode) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type AtomicModePtrOutput struct{ *pulumi.OutputState }
+
+func (AtomicModePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AtomicMode)(nil)).Elem()
+}
+
+func (o AtomicModePtrOutput) ToAtomicModePtrOutput() AtomicModePtrOutput {
+	return o
+}
+
+func (o AtomicModePtrOutput) ToAtomicModePtrOutputWithContext(ctx context.Context) AtomicModePtrOutput {
+	return o
+}
+
+func (o AtomicModePtrOutput) Elem() AtomicModeOutput {
+	return o.ApplyT(func(v *AtomicMode) AtomicMode {
+		if v != nil {
+			return *v
+		}
+		var ret AtomicMode
+		return ret
+	}).(AtomicModeOutput)
+}
+
+func (o AtomicModePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o AtomicModePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *AtomicMode) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// AtomicModeInput is an input type that accepts values of the AtomicMode enum
+// A concrete instance of `AtomicModeInput` can be one of the following:
+//
+//	AtomicModeNone
+//	AtomicModeFile
+//	AtomicModeDirectory
+type AtomicModeInput interface {
+	pulumi.Input
+
+	ToAtomicModeOutput() AtomicModeOutput
+	ToAtomicModeOutputWithContext(context.Context) AtomicModeOutput
+}
+
+var atomicModePtrType = reflect.TypeOf((**AtomicMode)(nil)).Elem()
+
+type AtomicModePtrInput interface {
+	pulumi.Input
+
+	ToAtomicModePtrOutput() AtomicModePtrOutput
+	ToAtomicModePtrOutputWithContext(context.Context) AtomicModePtrOutput
+}
+
+type atomicModePtr string
+
+func AtomicModePtr(v string) AtomicModePtrInput {
+	return (*atomicModePtr)(&v)
+}
+
+func (*atomicModePtr) ElementType() reflect.Type {
+	return atomicModePtrType
+}
+
+func (in *atomicModePtr) ToAtomicModePtrOutput() AtomicModePtrOutput {
+	return pulumi.ToOutput(in).(AtomicModePtrOutput)
+}
+
+func (in *atomicModePtr) ToAtomicModePtrOutputWithContext(ctx context.Context) AtomicModePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(AtomicModePtrOutput)
+}
+
+type BecomeMethod string
+
+const (
+	// Use `sudo`
+	BecomeMethodSudo = BecomeMethod("sudo")
+	// Use `su`
+	BecomeMethodSu = BecomeMethod("su")
+	// Use `doas`
+	BecomeMethodDoas = BecomeMethod("doas")
+)
+
+func (BecomeMethod) ElementType() reflect.Type {
+	return reflect.TypeOf((*BecomeMethod)(nil)).Elem()
+}
+
+func (e BecomeMethod) ToBecomeMethodOutput() BecomeMethodOutput {
+	return pulumi.ToOutput(e).(BecomeMethodOutput)
+}
+
+func (e BecomeMethod) ToBecomeMethodOutputWithContext(ctx context.Context) BecomeMethodOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(BecomeMethodOutput)
+}
+
+func (e BecomeMethod) ToBecomeMethodPtrOutput() BecomeMethodPtrOutput {
+	return e.ToBecomeMethodPtrOutputWithContext(context.Background())
+}
+
+func (e BecomeMethod) ToBecomeMethodPtrOutputWithContext(ctx context.Context) BecomeMethodPtrOutput {
+	return BecomeMethod(e).ToBecomeMethodOutputWithContext(ctx).ToBecomeMethodPtrOutputWithContext(ctx)
+}
+
+func (e BecomeMethod) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e BecomeMethod) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e BecomeMethod) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e BecomeMethod) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type BecomeMethodOutput struct{ *pulumi.OutputState }
+
+func (BecomeMethodOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BecomeMethod)(nil)).Elem()
+}
+
+func (o BecomeMethodOutput) ToBecomeMethodOutput() BecomeMethodOutput {
+	return o
+}
+
+func (o BecomeMethodOutput) ToBecomeMethodOutputWithContext(ctx context.Context) BecomeMethodOutput {
+	return o
+}
+
+func (o BecomeMethodOutput) ToBecomeMethodPtrOutput() BecomeMethodPtrOutput {
+	return o.ToBecomeMethodPtrOutputWithContext(context.Background())
+}
+
+func (o BecomeMethodOutput) ToBecomeMethodPtrOutputWithContext(ctx context.Context) BecomeMethodPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BecomeMethod) *BecomeMethod {
+		return &v
+	}).(BecomeMethodPtrOutput)
+}
+
+func (o BecomeMethodOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o BecomeMethodOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e BecomeMethod) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o BecomeMethodOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o BecomeMethodOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e BecomeMethod) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type BecomeMethodPtrOutput struct{ *pulumi.OutputState }
+
+func (BecomeMethodPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BecomeMethod)(nil)).Elem()
+}
+
+func (o BecomeMethodPtrOutput) ToBecomeMethodPtrOutput() BecomeMethodPtrOutput {
+	return o
+}
+
+func (o BecomeMethodPtrOutput) ToBecomeMethodPtrOutputWithContext(ctx context.Context) BecomeMethodPtrOutput {
+	return o
+}
+
+func (o BecomeMethodPtrOutput) Elem() BecomeMethodOutput {
+	return o.ApplyT(func(v *BecomeMethod) BecomeMethod {
+		if v != nil {
+			return *v
+		}
+		var ret BecomeMethod
+		return ret
+	}).(BecomeMethodOutput)
+}
+
+func (o BecomeMethodPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o BecomeMethodPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *BecomeMethod) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// BecomeMethodInput is an input type that accepts values of the BecomeMethod enum
+// A concrete instance of `BecomeMethodInput` can be one of the following:
+//
+//	BecomeMethodSudo
+//	BecomeMethodSu
+//	BecomeMethodDoas
+type BecomeMethodInput interface {
+	pulumi.Input
+
+	ToBecomeMethodOutput() BecomeMethodOutput
+	ToBecomeMethodOutputWithContext(context.Context) BecomeMethodOutput
+}
+
+var becomeMethodPtrType = reflect.TypeOf((**BecomeMethod)(nil)).Elem()
+
+type BecomeMethodPtrInput interface {
+	pulumi.Input
+
+	ToBecomeMethodPtrOutput() BecomeMethodPtrOutput
+	ToBecomeMethodPtrOutputWithContext(context.Context) BecomeMethodPtrOutput
+}
+
+type becomeMethodPtr string
+
+func BecomeMethodPtr(v string) BecomeMethodPtrInput {
+	return (*becomeMethodPtr)(&v)
+}
+
+func (*becomeMethodPtr) ElementType() reflect.Type {
+	return becomeMethodPtrType
+}
+
+func (in *becomeMethodPtr) ToBecomeMethodPtrOutput() BecomeMethodPtrOutput {
+	return pulumi.ToOutput(in).(BecomeMethodPtrOutput)
+}
+
+func (in *becomeMethodPtr) ToBecomeMethodPtrOutputWithContext(ctx context.Context) BecomeMethodPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(BecomeMethodPtrOutput)
+}
+
+type EnvironmentMode string
+
+const (
+	// Set the variables on the SSH session, which requires AcceptEnv on the server
+	EnvironmentModeSetenv = EnvironmentMode("setenv")
+	// Export the variables in the command, which requires a POSIX shell on the remote host
+	EnvironmentModeExport = EnvironmentMode("export")
+	// Set the variables on the SSH session, and export those that the server rejects
+	EnvironmentModeAuto = EnvironmentMode("auto")
+)
+
+func (EnvironmentMode) ElementType() reflect.Type {
+	return reflect.TypeOf((*EnvironmentMode)(nil)).Elem()
+}
+
+func (e EnvironmentMode) ToEnvironmentModeOutput() EnvironmentModeOutput {
+	return pulumi.ToOutput(e).(EnvironmentModeOutput)
+}
+
+func (e EnvironmentMode) ToEnvironmentModeOutputWithContext(ctx context.Context) EnvironmentModeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(EnvironmentModeOutput)
+}
+
+func (e EnvironmentMode) ToEnvironmentModePtrOutput() EnvironmentModePtrOutput {
+	return e.ToEnvironmentModePtrOutputWithContext(context.Background())
+}
+
+func (e EnvironmentMode) ToEnvironmentModePtrOutputWithContext(ctx context.Context) EnvironmentModePtrOutput {
+	return EnvironmentMode(e).ToEnvironmentModeOutputWithContext(ctx).ToEnvironmentModePtrOutputWithContext(ctx)
+}
+
+func (e EnvironmentMode) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e EnvironmentMode) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e EnvironmentMode) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e EnvironmentMode) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type EnvironmentModeOutput struct{ *pulumi.OutputState }
+
+func (EnvironmentModeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EnvironmentMode)(nil)).Elem()
+}
+
+func (o EnvironmentModeOutput) ToEnvironmentModeOutput() EnvironmentModeOutput {
+	return o
+}
+
+func (o EnvironmentModeOutput) ToEnvironmentModeOutputWithContext(ctx context.Context) EnvironmentModeOutput {
+	return o
+}
+
+func (o EnvironmentModeOutput) ToEnvironmentModePtrOutput() EnvironmentModePtrOutput {
+	return o.ToEnvironmentModePtrOutputWithContext(context.Background())
+}
+
+func (o EnvironmentModeOutput) ToEnvironmentModePtrOutputWithContext(ctx context.Context) EnvironmentModePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EnvironmentMode) *EnvironmentMode {
+		return &v
+	}).(EnvironmentModePtrOutput)
+}
+
+func (o EnvironmentModeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o EnvironmentModeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e EnvironmentMode) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o EnvironmentModeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o EnvironmentModeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e EnvironmentMode) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type EnvironmentModePtrOutput struct{ *pulumi.OutputState }
+
+func (EnvironmentModePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EnvironmentMode)(nil)).Elem()
+}
+
+func (o EnvironmentModePtrOutput) ToEnvironmentModePtrOutput() EnvironmentModePtrOutput {
+	return o
+}
+
+func (o EnvironmentModePtrOutput) ToEnvironmentModePtrOutputWithContext(ctx context.Context) EnvironmentModePtrOutput {
+	return o
+}
+
+func (o EnvironmentModePtrOutput) Elem() EnvironmentModeOutput {
+	return o.ApplyT(func(v *EnvironmentMode) EnvironmentMode {
+		if v != nil {
+			return *v
+		}
+		var ret EnvironmentMode
+		return ret
+	}).(EnvironmentModeOutput)
+}
+
+func (o EnvironmentModePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o EnvironmentModePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *EnvironmentMode) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// EnvironmentModeInput is an input type that accepts values of the EnvironmentMode enum
+// A concrete instance of `EnvironmentModeInput` can be one of the following:
+//
+//	EnvironmentModeSetenv
+//	EnvironmentModeExport
+//	EnvironmentModeAuto
+type EnvironmentModeInput interface {
+	pulumi.Input
+
+	ToEnvironmentModeOutput() EnvironmentModeOutput
+	ToEnvironmentModeOutputWithContext(context.Context) EnvironmentModeOutput
+}
+
+var environmentModePtrType = reflect.TypeOf((**EnvironmentMode)(nil)).Elem()
+
+type EnvironmentModePtrInput interface {
+	pulumi.Input
+
+	ToEnvironmentModePtrOutput() EnvironmentModePtrOutput
+	ToEnvironmentModePtrOutputWithContext(context.Context) EnvironmentModePtrOutput
+}
+
+type environmentModePtr string
+
+func EnvironmentModePtr(v string) EnvironmentModePtrInput {
+	return (*environmentModePtr)(&v)
+}
+
+func (*environmentModePtr) ElementType() reflect.Type {
+	return environmentModePtrType
+}
+
+func (in *environmentModePtr) ToEnvironmentModePtrOutput() EnvironmentModePtrOutput {
+	return pulumi.ToOutput(in).(EnvironmentModePtrOutput)
+}
+
+func (in *environmentModePtr) ToEnvironmentModePtrOutputWithContext(ctx context.Context) EnvironmentModePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(EnvironmentModePtrOutput)
+}
+
+type LogSeverity string
+
+const (
+	// Log the lines as debug messages
+	LogSeverityDebug = LogSeverity("debug")
+	// Log the lines as info messages
+	LogSeverityInfo = LogSeverity("info")
+	// Log the lines as warnings
+	LogSeverityWarning = LogSeverity("warning")
+	// Log the lines as errors
+	LogSeverityError = LogSeverity("error")
+)
+
+func (LogSeverity) ElementType() reflect.Type {
+	return reflect.TypeOf((*LogSeverity)(nil)).Elem()
+}
+
+func (e LogSeverity) ToLogSeverityOutput() LogSeverityOutput {
+	return pulumi.ToOutput(e).(LogSeverityOutput)
+}
+
+func (e LogSeverity) ToLogSeverityOutputWithContext(ctx context.Context) LogSeverityOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(LogSeverityOutput)
+}
+
+func (e LogSeverity) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return e.ToLogSeverityPtrOutputWithContext(context.Background())
+}
+
+func (e LogSeverity) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return LogSeverity(e).ToLogSeverityOutputWithContext(ctx).ToLogSeverityPtrOutputWithContext(ctx)
+}
+
+func (e LogSeverity) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e LogSeverity) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e LogSeverity) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e LogSeverity) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type LogSeverityOutput struct{ *pulumi.OutputState }
+
+func (LogSeverityOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LogSeverity)(nil)).Elem()
+}
+
+func (o LogSeverityOutput) ToLogSeverityOutput() LogSeverityOutput {
+	return o
+}
+
+func (o LogSeverityOutput) ToLogSeverityOutputWithContext(ctx context.Context) LogSeverityOutput {
+	return o
+}
+
+func (o LogSeverityOutput) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return o.ToLogSeverityPtrOutputWithContext(context.Background())
+}
+
+func (o LogSeverityOutput) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v LogSeverity) *LogSeverity {
+		return &v
+	}).(LogSeverityPtrOutput)
+}
+
+func (o LogSeverityOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o LogSeverityOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e LogSeverity) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o LogSeverityOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o LogSeverityOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e LogSeverity) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type LogSeverityPtrOutput struct{ *pulumi.OutputState }
+
+func (LogSeverityPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**LogSeverity)(nil)).Elem()
+}
+
+func (o LogSeverityPtrOutput) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return o
+}
+
+func (o LogSeverityPtrOutput) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return o
+}
+
+func (o LogSeverityPtrOutput) Elem() LogSeverityOutput {
+	return o.ApplyT(func(v *LogSeverity) LogSeverity {
+		if v != nil {
+			return *v
+		}
+		var ret LogSeverity
+		return ret
+	}).(LogSeverityOutput)
+}
+
+func (o LogSeverityPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o LogSeverityPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *LogSeverity) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// LogSeverityInput is an input type that accepts values of the LogSeverity enum
+// A concrete instance of `LogSeverityInput` can be one of the following:
+//
+//	LogSeverityDebug
+//	LogSeverityInfo
+//	LogSeverityWarning
+//	LogSeverityError
+type LogSeverityInput interface {
+	pulumi.Input
+
+	ToLogSeverityOutput() LogSeverityOutput
+	ToLogSeverityOutputWithContext(context.Context) LogSeverityOutput
+}
+
+var logSeverityPtrType = reflect.TypeOf((**LogSeverity)(nil)).Elem()
+
+type LogSeverityPtrInput interface {
+	pulumi.Input
+
+	ToLogSeverityPtrOutput() LogSeverityPtrOutput
+	ToLogSeverityPtrOutputWithContext(context.Context) LogSeverityPtrOutput
+}
+
+type logSeverityPtr string
+
+func LogSeverityPtr(v string) LogSeverityPtrInput {
+	return (*logSeverityPtr)(&v)
+}
+
+func (*logSeverityPtr) ElementType() reflect.Type {
+	return logSeverityPtrType
+}
+
+func (in *logSeverityPtr) ToLogSeverityPtrOutput() LogSeverityPtrOutput {
+	return pulumi.ToOutput(in).(LogSeverityPtrOutput)
+}
+
+func (in *logSeverityPtr) ToLogSeverityPtrOutputWithContext(ctx context.Context) LogSeverityPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(LogSeverityPtrOutput)
+}
+
 type Logging string
 
 const (
-	// Capture stdout in logs but not stderr
-	LoggingStdout = Logging("stdout")
-	// Capture stderr in logs but not stdout
-	LoggingStderr = Logging("stderr")
-	// Capture stdout and stderr in logs
-	LoggingStdoutAndStderr = Logging("stdoutAndStderr")
-	// Capture no logs
-	LoggingNone = Logging("none")
+	// Capture stdout in logs but not stderr
+	LoggingStdout = Logging("stdout")
+	// Capture stderr in logs but not stdout
+	LoggingStderr = Logging("stderr")
+	// Capture stdout and stderr in logs
+	LoggingStdoutAndStderr = Logging("stdoutAndStderr")
+	// Capture no logs
+	LoggingNone = Logging("none")
+)
+
+func (Logging) ElementType() reflect.Type {
+	return reflect.TypeOf((*Logging)(nil)).Elem()
+}
+
+func (e Logging) ToLoggingOutput() LoggingOutput {
+	return pulumi.ToOutput(e).(LoggingOutput)
+}
+
+func (e Logging) ToLoggingOutputWithContext(ctx context.Context) LoggingOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(LoggingOutput)
+}
+
+func (e Logging) ToLoggingPtrOutput() LoggingPtrOutput {
+	return e.ToLoggingPtrOutputWithContext(context.Background())
+}
+
+func (e Logging) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return Logging(e).ToLoggingOutputWithContext(ctx).ToLoggingPtrOutputWithContext(ctx)
+}
+
+func (e Logging) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Logging) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Logging) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e Logging) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type LoggingOutput struct{ *pulumi.OutputState }
+
+func (LoggingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Logging)(nil)).Elem()
+}
+
+func (o LoggingOutput) ToLoggingOutput() LoggingOutput {
+	return o
+}
+
+func (o LoggingOutput) ToLoggingOutputWithContext(ctx context.Context) LoggingOutput {
+	return o
+}
+
+func (o LoggingOutput) ToLoggingPtrOutput() LoggingPtrOutput {
+	return o.ToLoggingPtrOutputWithContext(context.Background())
+}
+
+func (o LoggingOutput) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Logging) *Logging {
+		return &v
+	}).(LoggingPtrOutput)
+}
+
+func (o LoggingOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o LoggingOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Logging) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o LoggingOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o LoggingOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Logging) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type LoggingPtrOutput struct{ *pulumi.OutputState }
+
+func (LoggingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Logging)(nil)).Elem()
+}
+
+func (o LoggingPtrOutput) ToLoggingPtrOutput() LoggingPtrOutput {
+	return o
+}
+
+func (o LoggingPtrOutput) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return o
+}
+
+func (o LoggingPtrOutput) Elem() LoggingOutput {
+	return o.ApplyT(func(v *Logging) Logging {
+		if v != nil {
+			return *v
+		}
+		var ret Logging
+		return ret
+	}).(LoggingOutput)
+}
+
+func (o LoggingPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o LoggingPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *Logging) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// LoggingInput is an input type that accepts values of the Logging enum
+// A concrete instance of `LoggingInput` can be one of the following:
+//
+//	LoggingStdout
+//	LoggingStderr
+//	LoggingStdoutAndStderr
+//	LoggingNone
+type LoggingInput interface {
+	pulumi.Input
+
+	ToLoggingOutput() LoggingOutput
+	ToLoggingOutputWithContext(context.Context) LoggingOutput
+}
+
+var loggingPtrType = reflect.TypeOf((**Logging)(nil)).Elem()
+
+type LoggingPtrInput interface {
+	pulumi.Input
+
+	ToLoggingPtrOutput() LoggingPtrOutput
+	ToLoggingPtrOutputWithContext(context.Context) LoggingPtrOutput
+}
+
+type loggingPtr string
+
+func LoggingPtr(v string) LoggingPtrInput {
+	return (*loggingPtr)(&v)
+}
+
+func (*loggingPtr) ElementType() reflect.Type {
+	return loggingPtrType
+}
+
+func (in *loggingPtr) ToLoggingPtrOutput() LoggingPtrOutput {
+	return pulumi.ToOutput(in).(LoggingPtrOutput)
+}
+
+func (in *loggingPtr) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(LoggingPtrOutput)
+}
+
+type SymlinkPolicy string
+
+const (
+	// Keep symlinks as symlinks
+	SymlinkPolicyPreserve = SymlinkPolicy("preserve")
+	// Treat symlinks as the files or directories they point to
+	SymlinkPolicyFollow = SymlinkPolicy("follow")
+	// Ignore symlinks
+	SymlinkPolicySkip = SymlinkPolicy("skip")
+)
+
+func (SymlinkPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((*SymlinkPolicy)(nil)).Elem()
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyOutput() SymlinkPolicyOutput {
+	return pulumi.ToOutput(e).(SymlinkPolicyOutput)
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyOutputWithContext(ctx context.Context) SymlinkPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(SymlinkPolicyOutput)
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return e.ToSymlinkPolicyPtrOutputWithContext(context.Background())
+}
+
+func (e SymlinkPolicy) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return SymlinkPolicy(e).ToSymlinkPolicyOutputWithContext(ctx).ToSymlinkPolicyPtrOutputWithContext(ctx)
+}
+
+func (e SymlinkPolicy) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SymlinkPolicy) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SymlinkPolicy) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e SymlinkPolicy) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type SymlinkPolicyOutput struct{ *pulumi.OutputState }
+
+func (SymlinkPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SymlinkPolicy)(nil)).Elem()
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyOutput() SymlinkPolicyOutput {
+	return o
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyOutputWithContext(ctx context.Context) SymlinkPolicyOutput {
+	return o
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return o.ToSymlinkPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyOutput) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SymlinkPolicy) *SymlinkPolicy {
+		return &v
+	}).(SymlinkPolicyPtrOutput)
+}
+
+func (o SymlinkPolicyOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e SymlinkPolicy) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o SymlinkPolicyOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e SymlinkPolicy) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type SymlinkPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (SymlinkPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SymlinkPolicy)(nil)).Elem()
+}
+
+func (o SymlinkPolicyPtrOutput) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return o
+}
+
+func (o SymlinkPolicyPtrOutput) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return o
+}
+
+func (o SymlinkPolicyPtrOutput) Elem() SymlinkPolicyOutput {
+	return o.ApplyT(func(v *SymlinkPolicy) SymlinkPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret SymlinkPolicy
+		return ret
+	}).(SymlinkPolicyOutput)
+}
+
+func (o SymlinkPolicyPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SymlinkPolicyPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *SymlinkPolicy) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// SymlinkPolicyInput is an input type that accepts values of the SymlinkPolicy enum
+// A concrete instance of `SymlinkPolicyInput` can be one of the following:
+//
+//	SymlinkPolicyPreserve
+//	SymlinkPolicyFollow
+//	SymlinkPolicySkip
+type SymlinkPolicyInput interface {
+	pulumi.Input
+
+	ToSymlinkPolicyOutput() SymlinkPolicyOutput
+	ToSymlinkPolicyOutputWithContext(context.Context) SymlinkPolicyOutput
+}
+
+var symlinkPolicyPtrType = reflect.TypeOf((**SymlinkPolicy)(nil)).Elem()
+
+type SymlinkPolicyPtrInput interface {
+	pulumi.Input
+
+	ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput
+	ToSymlinkPolicyPtrOutputWithContext(context.Context) SymlinkPolicyPtrOutput
+}
+
+type symlinkPolicyPtr string
+
+func SymlinkPolicyPtr(v string) SymlinkPolicyPtrInput {
+	return (*symlinkPolicyPtr)(&v)
+}
+
+func (*symlinkPolicyPtr) ElementType() reflect.Type {
+	return symlinkPolicyPtrType
+}
+
+func (in *symlinkPolicyPtr) ToSymlinkPolicyPtrOutput() SymlinkPolicyPtrOutput {
+	return pulumi.ToOutput(in).(SymlinkPolicyPtrOutput)
+}
+
+func (in *symlinkPolicyPtr) ToSymlinkPolicyPtrOutputWithContext(ctx context.Context) SymlinkPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(SymlinkPolicyPtrOutput)
+}
+
+type Transport string
+
+const (
+	// Use the SFTP subsystem
+	TransportSftp = Transport("sftp")
+	// Use the SCP protocol via `scp -t`
+	TransportScp = Transport("scp")
+	// Stream a tar archive to `tar -x` on the remote host
+	TransportTarOverExec = Transport("tarOverExec")
+	// Use SFTP if the host supports it, and fall back to `tarOverExec` or `scp`
+	TransportAuto = Transport("auto")
 )
 
-func (Logging) ElementType() reflect.Type {
-	return reflect.TypeOf((*Logging)(nil)).Elem()
+func (Transport) ElementType() reflect.Type {
+	return reflect.TypeOf((*Transport)(nil)).Elem()
 }
 
-func (e Logging) ToLoggingOutput() LoggingOutput {
-	return pulumi.ToOutput(e).(LoggingOutput)
+func (e Transport) ToTransportOutput() TransportOutput {
+	return pulumi.ToOutput(e).(TransportOutput)
 }
 
-func (e Logging) ToLoggingOutputWithContext(ctx context.Context) LoggingOutput {
-	return pulumi.ToOutputWithContext(ctx, e).(LoggingOutput)
+func (e Transport) ToTransportOutputWithContext(ctx context.Context) TransportOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(TransportOutput)
 }
 
-func (e Logging) ToLoggingPtrOutput() LoggingPtrOutput {
-	return e.ToLoggingPtrOutputWithContext(context.Background())
+func (e Transport) ToTransportPtrOutput() TransportPtrOutput {
+	return e.ToTransportPtrOutputWithContext(context.Background())
 }
 
-func (e Logging) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
-	return Logging(e).ToLoggingOutputWithContext(ctx).ToLoggingPtrOutputWithContext(ctx)
+func (e Transport) ToTransportPtrOutputWithContext(ctx context.Context) TransportPtrOutput {
+	return Transport(e).ToTransportOutputWithContext(ctx).ToTransportPtrOutputWithContext(ctx)
 }
 
-func (e Logging) ToStringOutput() pulumi.StringOutput {
+func (e Transport) ToStringOutput() pulumi.StringOutput {
 	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
 }
 
-func (e Logging) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+func (e Transport) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
 	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
 }
 
-func (e Logging) ToStringPtrOutput() pulumi.StringPtrOutput {
+func (e Transport) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
 }
 
-func (e Logging) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+func (e Transport) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
 	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
 }
 
-type LoggingOutput struct{ *pulumi.OutputState }
+type TransportOutput struct{ *pulumi.OutputState }
 
-func (LoggingOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*Logging)(nil)).Elem()
+func (TransportOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Transport)(nil)).Elem()
 }
 
-func (o LoggingOutput) ToLoggingOutput() LoggingOutput {
+func (o TransportOutput) ToTransportOutput() TransportOutput {
 	return o
 }
 
-func (o LoggingOutput) ToLoggingOutputWithContext(ctx context.Context) LoggingOutput {
+func (o TransportOutput) ToTransportOutputWithContext(ctx context.Context) TransportOutput {
 	return o
 }
 
-func (o LoggingOutput) ToLoggingPtrOutput() LoggingPtrOutput {
-	return o.ToLoggingPtrOutputWithContext(context.Background())
+func (o TransportOutput) ToTransportPtrOutput() TransportPtrOutput {
+	return o.ToTransportPtrOutputWithContext(context.Background())
 }
 
-func (o LoggingOutput) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Logging) *Logging {
+func (o TransportOutput) ToTransportPtrOutputWithContext(ctx context.Context) TransportPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Transport) *Transport {
 		return &v
-	}).(LoggingPtrOutput)
+	}).(TransportPtrOutput)
 }
 
-func (o LoggingOutput) ToStringOutput() pulumi.StringOutput {
+func (o TransportOutput) ToStringOutput() pulumi.StringOutput {
 	return o.ToStringOutputWithContext(context.Background())
 }
 
-func (o LoggingOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, e Logging) string {
+func (o TransportOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Transport) string {
 		return string(e)
 	}).(pulumi.StringOutput)
 }
 
-func (o LoggingOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+func (o TransportOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
 
-func (o LoggingOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, e Logging) *string {
+func (o TransportOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Transport) *string {
 		v := string(e)
 		return &v
 	}).(pulumi.StringPtrOutput)
 }
 
-type LoggingPtrOutput struct{ *pulumi.OutputState }
+type TransportPtrOutput struct{ *pulumi.OutputState }
 
-func (LoggingPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Logging)(nil)).Elem()
+func (TransportPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Transport)(nil)).Elem()
 }
 
-func (o LoggingPtrOutput) ToLoggingPtrOutput() LoggingPtrOutput {
+func (o TransportPtrOutput) ToTransportPtrOutput() TransportPtrOutput {
 	return o
 }
 
-func (o LoggingPtrOutput) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+func (o TransportPtrOutput) ToTransportPtrOutputWithContext(ctx context.Context) TransportPtrOutput {
 	return o
 }
 
-func (o LoggingPtrOutput) Elem() LoggingOutput {
-	return o.ApplyT(func(v *Logging) Logging {
+func (o TransportPtrOutput) Elem() TransportOutput {
+	return o.ApplyT(func(v *Transport) Transport {
 		if v != nil {
 			return *v
 		}
-		var ret Logging
+		var ret Transport
 		return ret
-	}).(LoggingOutput)
+	}).(TransportOutput)
 }
 
-func (o LoggingPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+func (o TransportPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
 	return o.ToStringPtrOutputWithContext(context.Background())
 }
 
-func (o LoggingPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, e *Logging) *string {
+func (o TransportPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *Transport) *string {
 		if e == nil {
 			return nil
 		}
@@ -142,50 +1168,245 @@ func (o LoggingPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulu
 	}).(pulumi.StringPtrOutput)
 }
 
-// LoggingInput is an input type that accepts values of the Logging enum
-// A concrete instance of `LoggingInput` can be one of the following:
+// TransportInput is an input type that accepts values of the Transport enum
+// A concrete instance of `TransportInput` can be one of the following:
 //
-//	LoggingStdout
-//	LoggingStderr
-//	LoggingStdoutAndStderr
-//	LoggingNone
-type LoggingInput interface {
+//	TransportSftp
+//	TransportScp
+//	TransportTarOverExec
+//	TransportAuto
+type TransportInput interface {
 	pulumi.Input
 
-	ToLoggingOutput() LoggingOutput
-	ToLoggingOutputWithContext(context.Context) LoggingOutput
+	ToTransportOutput() TransportOutput
+	ToTransportOutputWithContext(context.Context) TransportOutput
 }
 
-var loggingPtrType = reflect.TypeOf((**Logging)(nil)).Elem()
+var transportPtrType = reflect.TypeOf((**Transport)(nil)).Elem()
 
-type LoggingPtrInput interface {
+type TransportPtrInput interface {
 	pulumi.Input
 
-	ToLoggingPtrOutput() LoggingPtrOutput
-	ToLoggingPtrOutputWithContext(context.Context) LoggingPtrOutput
+	ToTransportPtrOutput() TransportPtrOutput
+	ToTransportPtrOutputWithContext(context.Context) TransportPtrOutput
 }
 
-type loggingPtr string
+type transportPtr string
 
-func LoggingPtr(v string) LoggingPtrInput {
-	return (*loggingPtr)(&v)
+func TransportPtr(v string) TransportPtrInput {
+	return (*transportPtr)(&v)
 }
 
-func (*loggingPtr) ElementType() reflect.Type {
-	return loggingPtrType
+func (*transportPtr) ElementType() reflect.Type {
+	return transportPtrType
 }
 
-func (in *loggingPtr) ToLoggingPtrOutput() LoggingPtrOutput {
-	return pulumi.ToOutput(in).(LoggingPtrOutput)
+func (in *transportPtr) ToTransportPtrOutput() TransportPtrOutput {
+	return pulumi.ToOutput(in).(TransportPtrOutput)
 }
 
-func (in *loggingPtr) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, in).(LoggingPtrOutput)
+func (in *transportPtr) ToTransportPtrOutputWithContext(ctx context.Context) TransportPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(TransportPtrOutput)
+}
+
+type TunnelType string
+
+const (
+	// Forward the connections to a local port to the remote host, like `ssh -L`
+	TunnelTypeLocal = TunnelType("local")
+	// Forward the connections to a port on the remote host to the local machine, like `ssh -R`
+	TunnelTypeRemote = TunnelType("remote")
+)
+
+func (TunnelType) ElementType() reflect.Type {
+	return reflect.TypeOf((*TunnelType)(nil)).Elem()
+}
+
+func (e TunnelType) ToTunnelTypeOutput() TunnelTypeOutput {
+	return pulumi.ToOutput(e).(TunnelTypeOutput)
+}
+
+func (e TunnelType) ToTunnelTypeOutputWithContext(ctx context.Context) TunnelTypeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(TunnelTypeOutput)
+}
+
+func (e TunnelType) ToTunnelTypePtrOutput() TunnelTypePtrOutput {
+	return e.ToTunnelTypePtrOutputWithContext(context.Background())
+}
+
+func (e TunnelType) ToTunnelTypePtrOutputWithContext(ctx context.Context) TunnelTypePtrOutput {
+	return TunnelType(e).ToTunnelTypeOutputWithContext(ctx).ToTunnelTypePtrOutputWithContext(ctx)
+}
+
+func (e TunnelType) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e TunnelType) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e TunnelType) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e TunnelType) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type TunnelTypeOutput struct{ *pulumi.OutputState }
+
+func (TunnelTypeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TunnelType)(nil)).Elem()
+}
+
+func (o TunnelTypeOutput) ToTunnelTypeOutput() TunnelTypeOutput {
+	return o
+}
+
+func (o TunnelTypeOutput) ToTunnelTypeOutputWithContext(ctx context.Context) TunnelTypeOutput {
+	return o
+}
+
+func (o TunnelTypeOutput) ToTunnelTypePtrOutput() TunnelTypePtrOutput {
+	return o.ToTunnelTypePtrOutputWithContext(context.Background())
+}
+
+func (o TunnelTypeOutput) ToTunnelTypePtrOutputWithContext(ctx context.Context) TunnelTypePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v TunnelType) *TunnelType {
+		return &v
+	}).(TunnelTypePtrOutput)
+}
+
+func (o TunnelTypeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o TunnelTypeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e TunnelType) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o TunnelTypeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o TunnelTypeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e TunnelType) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type TunnelTypePtrOutput struct{ *pulumi.OutputState }
+
+func (TunnelTypePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TunnelType)(nil)).Elem()
+}
+
+func (o TunnelTypePtrOutput) ToTunnelTypePtrOutput() TunnelTypePtrOutput {
+	return o
+}
+
+func (o TunnelTypePtrOutput) ToTunnelTypePtrOutputWithContext(ctx context.Context) TunnelTypePtrOutput {
+	return o
+}
+
+func (o TunnelTypePtrOutput) Elem() TunnelTypeOutput {
+	return o.ApplyT(func(v *TunnelType) TunnelType {
+		if v != nil {
+			return *v
+		}
+		var ret TunnelType
+		return ret
+	}).(TunnelTypeOutput)
+}
+
+func (o TunnelTypePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o TunnelTypePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *TunnelType) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// TunnelTypeInput is an input type that accepts values of the TunnelType enum
+// A concrete instance of `TunnelTypeInput` can be one of the following:
+//
+//	TunnelTypeLocal
+//	TunnelTypeRemote
+type TunnelTypeInput interface {
+	pulumi.Input
+
+	ToTunnelTypeOutput() TunnelTypeOutput
+	ToTunnelTypeOutputWithContext(context.Context) TunnelTypeOutput
+}
+
+var tunnelTypePtrType = reflect.TypeOf((**TunnelType)(nil)).Elem()
+
+type TunnelTypePtrInput interface {
+	pulumi.Input
+
+	ToTunnelTypePtrOutput() TunnelTypePtrOutput
+	ToTunnelTypePtrOutputWithContext(context.Context) TunnelTypePtrOutput
+}
+
+type tunnelTypePtr string
+
+func TunnelTypePtr(v string) TunnelTypePtrInput {
+	return (*tunnelTypePtr)(&v)
+}
+
+func (*tunnelTypePtr) ElementType() reflect.Type {
+	return tunnelTypePtrType
+}
+
+func (in *tunnelTypePtr) ToTunnelTypePtrOutput() TunnelTypePtrOutput {
+	return pulumi.ToOutput(in).(TunnelTypePtrOutput)
+}
+
+func (in *tunnelTypePtr) ToTunnelTypePtrOutputWithContext(ctx context.Context) TunnelTypePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(TunnelTypePtrOutput)
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AtomicModeInput)(nil)).Elem(), AtomicMode("none"))
+	pulumi.RegisterInputType(reflect.TypeOf((*AtomicModePtrInput)(nil)).Elem(), AtomicMode("none"))
+	pulumi.RegisterInputType(reflect.TypeOf((*BecomeMethodInput)(nil)).Elem(), BecomeMethod("sudo"))
+	pulumi.RegisterInputType(reflect.TypeOf((*BecomeMethodPtrInput)(nil)).Elem(), BecomeMethod("sudo"))
+	pulumi.RegisterInputType(reflect.TypeOf((*EnvironmentModeInput)(nil)).Elem(), EnvironmentMode("setenv"))
+	pulumi.RegisterInputType(reflect.TypeOf((*EnvironmentModePtrInput)(nil)).Elem(), EnvironmentMode("setenv"))
+	pulumi.RegisterInputType(reflect.TypeOf((*LogSeverityInput)(nil)).Elem(), LogSeverity("debug"))
+	pulumi.RegisterInputType(reflect.TypeOf((*LogSeverityPtrInput)(nil)).Elem(), LogSeverity("debug"))
 	pulumi.RegisterInputType(reflect.TypeOf((*LoggingInput)(nil)).Elem(), Logging("stdout"))
 	pulumi.RegisterInputType(reflect.TypeOf((*LoggingPtrInput)(nil)).Elem(), Logging("stdout"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SymlinkPolicyInput)(nil)).Elem(), SymlinkPolicy("preserve"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SymlinkPolicyPtrInput)(nil)).Elem(), SymlinkPolicy("preserve"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TransportInput)(nil)).Elem(), Transport("sftp"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TransportPtrInput)(nil)).Elem(), Transport("sftp"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TunnelTypeInput)(nil)).Elem(), TunnelType("local"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TunnelTypePtrInput)(nil)).Elem(), TunnelType("local"))
+	pulumi.RegisterOutputType(AtomicModeOutput{})
+	pulumi.RegisterOutputType(AtomicModePtrOutput{})
+	pulumi.RegisterOutputType(BecomeMethodOutput{})
+	pulumi.RegisterOutputType(BecomeMethodPtrOutput{})
+	pulumi.RegisterOutputType(EnvironmentModeOutput{})
+	pulumi.RegisterOutputType(EnvironmentModePtrOutput{})
+	pulumi.RegisterOutputType(LogSeverityOutput{})
+	pulumi.RegisterOutputType(LogSeverityPtrOutput{})
 	pulumi.RegisterOutputType(LoggingOutput{})
 	pulumi.RegisterOutputType(LoggingPtrOutput{})
+	pulumi.RegisterOutputType(SymlinkPolicyOutput{})
+	pulumi.RegisterOutputType(SymlinkPolicyPtrOutput{})
+	pulumi.RegisterOutputType(TransportOutput{})
+	pulumi.RegisterOutputType(TransportPtrOutput{})
+	pulumi.RegisterOutputType(TunnelTypeOutput{})
+	pulumi.RegisterOutputType(TunnelTypePtrOutput{})
 }
@@ -136,74 +136,854 @@ func (i ConnectionArgs) ToConnectionOutputWithContext(ctx context.Context) Conne
 	return pulumi.ToOutputWithContext(ctx, i).(ConnectionOutput)
 }
 
+// ConnectionArrayInput is an input type that accepts ConnectionArray and ConnectionArrayOutput values.
+// You can construct a concrete instance of `ConnectionArrayInput` via:
+//
+//	ConnectionArray{ ConnectionArgs{...} }
+type ConnectionArrayInput interface {
+	pulumi.Input
+
+	ToConnectionArrayOutput() ConnectionArrayOutput
+	ToConnectionArrayOutputWithContext(context.Context) ConnectionArrayOutput
+}
+
+type ConnectionArray []ConnectionInput
+
+func (ConnectionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Connection)(nil)).Elem()
+}
+
+func (i ConnectionArray) ToConnectionArrayOutput() ConnectionArrayOutput {
+	return i.ToConnectionArrayOutputWithContext(context.Background())
+}
+
+func (i ConnectionArray) ToConnectionArrayOutputWithContext(ctx context.Context) ConnectionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConnectionArrayOutput)
+}
+
 // Instructions for how to connect to a remote endpoint.
 type ConnectionOutput struct{ *pulumi.OutputState }
 
-func (ConnectionOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*Connection)(nil)).Elem()
+func (ConnectionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Connection)(nil)).Elem()
+}
+
+func (o ConnectionOutput) ToConnectionOutput() ConnectionOutput {
+	return o
+}
+
+func (o ConnectionOutput) ToConnectionOutputWithContext(ctx context.Context) ConnectionOutput {
+	return o
+}
+
+// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+func (o ConnectionOutput) AgentSocketPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Connection) *string { return v.AgentSocketPath }).(pulumi.StringPtrOutput)
+}
+
+// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+func (o ConnectionOutput) DialErrorLimit() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Connection) *int { return v.DialErrorLimit }).(pulumi.IntPtrOutput)
+}
+
+// The address of the resource to connect to.
+func (o ConnectionOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v Connection) string { return v.Host }).(pulumi.StringOutput)
+}
+
+// The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+func (o ConnectionOutput) HostKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Connection) *string { return v.HostKey }).(pulumi.StringPtrOutput)
+}
+
+// The password we should use for the connection.
+func (o ConnectionOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Connection) *string { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+func (o ConnectionOutput) PerDialTimeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Connection) *int { return v.PerDialTimeout }).(pulumi.IntPtrOutput)
+}
+
+// The port to connect to. Defaults to 22.
+func (o ConnectionOutput) Port() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v Connection) *float64 { return v.Port }).(pulumi.Float64PtrOutput)
+}
+
+// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+func (o ConnectionOutput) PrivateKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Connection) *string { return v.PrivateKey }).(pulumi.StringPtrOutput)
+}
+
+// The password to use in case the private key is encrypted.
+func (o ConnectionOutput) PrivateKeyPassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Connection) *string { return v.PrivateKeyPassword }).(pulumi.StringPtrOutput)
+}
+
+// The connection settings for the bastion/proxy host.
+func (o ConnectionOutput) Proxy() ProxyConnectionPtrOutput {
+	return o.ApplyT(func(v Connection) *ProxyConnection { return v.Proxy }).(ProxyConnectionPtrOutput)
+}
+
+// The user that we should use for the connection.
+func (o ConnectionOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Connection) *string { return v.User }).(pulumi.StringPtrOutput)
+}
+
+type ConnectionArrayOutput struct{ *pulumi.OutputState }
+
+func (ConnectionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Connection)(nil)).Elem()
+}
+
+func (o ConnectionArrayOutput) ToConnectionArrayOutput() ConnectionArrayOutput {
+	return o
+}
+
+func (o ConnectionArrayOutput) ToConnectionArrayOutputWithContext(ctx context.Context) ConnectionArrayOutput {
+	return o
+}
+
+func (o ConnectionArrayOutput) Index(i pulumi.IntInput) ConnectionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Connection {
+		return vs[0].([]Connection)[vs[1].(int)]
+	}).(ConnectionOutput)
+}
+
+// The result of a step of establishing a connection.
+type ConnectionStage struct {
+	// What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+	Detail *string `pulumi:"detail"`
+	// How long the stage took in milliseconds.
+	DurationMs float64 `pulumi:"durationMs"`
+	// Why the stage failed.
+	Error *string `pulumi:"error"`
+	// The host that the stage connects to, `proxy` or `host`.
+	Hop string `pulumi:"hop"`
+	// The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+	Method *string `pulumi:"method"`
+	// The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+	Stage string `pulumi:"stage"`
+	// If the stage succeeded.
+	Success bool `pulumi:"success"`
+}
+
+// The result of a step of establishing a connection.
+type ConnectionStageOutput struct{ *pulumi.OutputState }
+
+func (ConnectionStageOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ConnectionStage)(nil)).Elem()
+}
+
+func (o ConnectionStageOutput) ToConnectionStageOutput() ConnectionStageOutput {
+	return o
+}
+
+func (o ConnectionStageOutput) ToConnectionStageOutputWithContext(ctx context.Context) ConnectionStageOutput {
+	return o
+}
+
+// What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+func (o ConnectionStageOutput) Detail() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionStage) *string { return v.Detail }).(pulumi.StringPtrOutput)
+}
+
+// How long the stage took in milliseconds.
+func (o ConnectionStageOutput) DurationMs() pulumi.Float64Output {
+	return o.ApplyT(func(v ConnectionStage) float64 { return v.DurationMs }).(pulumi.Float64Output)
+}
+
+// Why the stage failed.
+func (o ConnectionStageOutput) Error() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionStage) *string { return v.Error }).(pulumi.StringPtrOutput)
+}
+
+// The host that the stage connects to, `proxy` or `host`.
+func (o ConnectionStageOutput) Hop() pulumi.StringOutput {
+	return o.ApplyT(func(v ConnectionStage) string { return v.Hop }).(pulumi.StringOutput)
+}
+
+// The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+func (o ConnectionStageOutput) Method() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionStage) *string { return v.Method }).(pulumi.StringPtrOutput)
+}
+
+// The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+func (o ConnectionStageOutput) Stage() pulumi.StringOutput {
+	return o.ApplyT(func(v ConnectionStage) string { return v.Stage }).(pulumi.StringOutput)
+}
+
+// If the stage succeeded.
+func (o ConnectionStageOutput) Success() pulumi.BoolOutput {
+	return o.ApplyT(func(v ConnectionStage) bool { return v.Success }).(pulumi.BoolOutput)
+}
+
+type ConnectionStageArrayOutput struct{ *pulumi.OutputState }
+
+func (ConnectionStageArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ConnectionStage)(nil)).Elem()
+}
+
+func (o ConnectionStageArrayOutput) ToConnectionStageArrayOutput() ConnectionStageArrayOutput {
+	return o
+}
+
+func (o ConnectionStageArrayOutput) ToConnectionStageArrayOutputWithContext(ctx context.Context) ConnectionStageArrayOutput {
+	return o
+}
+
+func (o ConnectionStageArrayOutput) Index(i pulumi.IntInput) ConnectionStageOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ConnectionStage {
+		return vs[0].([]ConnectionStage)[vs[1].(int)]
+	}).(ConnectionStageOutput)
+}
+
+// Instructions for how to connect to several remote endpoints with the same settings.
+type ConnectionTemplate struct {
+	// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+	AgentSocketPath *string `pulumi:"agentSocketPath"`
+	// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+	DialErrorLimit *int `pulumi:"dialErrorLimit"`
+	// The password we should use for the connection.
+	Password *string `pulumi:"password"`
+	// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+	PerDialTimeout *int `pulumi:"perDialTimeout"`
+	// The port to connect to. Defaults to 22.
+	Port *float64 `pulumi:"port"`
+	// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+	PrivateKey *string `pulumi:"privateKey"`
+	// The password to use in case the private key is encrypted.
+	PrivateKeyPassword *string `pulumi:"privateKeyPassword"`
+	// The connection settings for the bastion/proxy host.
+	Proxy *ProxyConnection `pulumi:"proxy"`
+	// The user that we should use for the connection.
+	User *string `pulumi:"user"`
+}
+
+// Defaults sets the appropriate defaults for ConnectionTemplate
+func (val *ConnectionTemplate) Defaults() *ConnectionTemplate {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.DialErrorLimit == nil {
+		dialErrorLimit_ := 10
+		tmp.DialErrorLimit = &dialErrorLimit_
+	}
+	if tmp.PerDialTimeout == nil {
+		perDialTimeout_ := 15
+		tmp.PerDialTimeout = &perDialTimeout_
+	}
+	if tmp.Port == nil {
+		port_ := 22.0
+		tmp.Port = &port_
+	}
+	tmp.Proxy = tmp.Proxy.Defaults()
+
+	if tmp.User == nil {
+		user_ := "root"
+		tmp.User = &user_
+	}
+	return &tmp
+}
+
+// ConnectionTemplateInput is an input type that accepts ConnectionTemplateArgs and ConnectionTemplateOutput values.
+// You can construct a concrete instance of `ConnectionTemplateInput` via:
+//
+//	ConnectionTemplateArgs{...}
+type ConnectionTemplateInput interface {
+	pulumi.Input
+
+	ToConnectionTemplateOutput() ConnectionTemplateOutput
+	ToConnectionTemplateOutputWithContext(context.Context) ConnectionTemplateOutput
+}
+
+// Instructions for how to connect to several remote endpoints with the same settings.
+type ConnectionTemplateArgs struct {
+	// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+	AgentSocketPath pulumi.StringPtrInput `pulumi:"agentSocketPath"`
+	// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+	DialErrorLimit pulumi.IntPtrInput `pulumi:"dialErrorLimit"`
+	// The password we should use for the connection.
+	Password pulumi.StringPtrInput `pulumi:"password"`
+	// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+	PerDialTimeout pulumi.IntPtrInput `pulumi:"perDialTimeout"`
+	// The port to connect to. Defaults to 22.
+	Port pulumi.Float64PtrInput `pulumi:"port"`
+	// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+	PrivateKey pulumi.StringPtrInput `pulumi:"privateKey"`
+	// The password to use in case the private key is encrypted.
+	PrivateKeyPassword pulumi.StringPtrInput `pulumi:"privateKeyPassword"`
+	// The connection settings for the bastion/proxy host.
+	Proxy ProxyConnectionPtrInput `pulumi:"proxy"`
+	// The user that we should use for the connection.
+	User pulumi.StringPtrInput `pulumi:"user"`
+}
+
+// Defaults sets the appropriate defaults for ConnectionTemplateArgs
+func (val *ConnectionTemplateArgs) Defaults() *ConnectionTemplateArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.DialErrorLimit == nil {
+		tmp.DialErrorLimit = pulumi.IntPtr(10)
+	}
+	if tmp.PerDialTimeout == nil {
+		tmp.PerDialTimeout = pulumi.IntPtr(15)
+	}
+	if tmp.Port == nil {
+		tmp.Port = pulumi.Float64Ptr(22.0)
+	}
+
+	if tmp.User == nil {
+		tmp.User = pulumi.StringPtr("root")
+	}
+	return &tmp
+}
+func (ConnectionTemplateArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ConnectionTemplate)(nil)).Elem()
+}
+
+func (i ConnectionTemplateArgs) ToConnectionTemplateOutput() ConnectionTemplateOutput {
+	return i.ToConnectionTemplateOutputWithContext(context.Background())
+}
+
+func (i ConnectionTemplateArgs) ToConnectionTemplateOutputWithContext(ctx context.Context) ConnectionTemplateOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConnectionTemplateOutput)
+}
+
+func (i ConnectionTemplateArgs) ToConnectionTemplatePtrOutput() ConnectionTemplatePtrOutput {
+	return i.ToConnectionTemplatePtrOutputWithContext(context.Background())
+}
+
+func (i ConnectionTemplateArgs) ToConnectionTemplatePtrOutputWithContext(ctx context.Context) ConnectionTemplatePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConnectionTemplateOutput).ToConnectionTemplatePtrOutputWithContext(ctx)
+}
+
+// ConnectionTemplatePtrInput is an input type that accepts ConnectionTemplateArgs, ConnectionTemplatePtr and ConnectionTemplatePtrOutput values.
+// You can construct a concrete instance of `ConnectionTemplatePtrInput` via:
+//
+//	        ConnectionTemplateArgs{...}
+//
+//	or:
+//
+//	        nil
+type ConnectionTemplatePtrInput interface {
+	pulumi.Input
+
+	ToConnectionTemplatePtrOutput() ConnectionTemplatePtrOutput
+	ToConnectionTemplatePtrOutputWithContext(context.Context) ConnectionTemplatePtrOutput
+}
+
+type connectionTemplatePtrType ConnectionTemplateArgs
+
+func ConnectionTemplatePtr(v *ConnectionTemplateArgs) ConnectionTemplatePtrInput {
+	return (*connectionTemplatePtrType)(v)
+}
+
+func (*connectionTemplatePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ConnectionTemplate)(nil)).Elem()
+}
+
+func (i *connectionTemplatePtrType) ToConnectionTemplatePtrOutput() ConnectionTemplatePtrOutput {
+	return i.ToConnectionTemplatePtrOutputWithContext(context.Background())
+}
+
+func (i *connectionTemplatePtrType) ToConnectionTemplatePtrOutputWithContext(ctx context.Context) ConnectionTemplatePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConnectionTemplatePtrOutput)
+}
+
+// Instructions for how to connect to several remote endpoints with the same settings.
+type ConnectionTemplateOutput struct{ *pulumi.OutputState }
+
+func (ConnectionTemplateOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ConnectionTemplate)(nil)).Elem()
+}
+
+func (o ConnectionTemplateOutput) ToConnectionTemplateOutput() ConnectionTemplateOutput {
+	return o
+}
+
+func (o ConnectionTemplateOutput) ToConnectionTemplateOutputWithContext(ctx context.Context) ConnectionTemplateOutput {
+	return o
+}
+
+func (o ConnectionTemplateOutput) ToConnectionTemplatePtrOutput() ConnectionTemplatePtrOutput {
+	return o.ToConnectionTemplatePtrOutputWithContext(context.Background())
+}
+
+func (o ConnectionTemplateOutput) ToConnectionTemplatePtrOutputWithContext(ctx context.Context) ConnectionTemplatePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ConnectionTemplate) *ConnectionTemplate {
+		return &v
+	}).(ConnectionTemplatePtrOutput)
+}
+
+// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+func (o ConnectionTemplateOutput) AgentSocketPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *string { return v.AgentSocketPath }).(pulumi.StringPtrOutput)
+}
+
+// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+func (o ConnectionTemplateOutput) DialErrorLimit() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *int { return v.DialErrorLimit }).(pulumi.IntPtrOutput)
+}
+
+// The password we should use for the connection.
+func (o ConnectionTemplateOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *string { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+func (o ConnectionTemplateOutput) PerDialTimeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *int { return v.PerDialTimeout }).(pulumi.IntPtrOutput)
+}
+
+// The port to connect to. Defaults to 22.
+func (o ConnectionTemplateOutput) Port() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *float64 { return v.Port }).(pulumi.Float64PtrOutput)
+}
+
+// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+func (o ConnectionTemplateOutput) PrivateKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *string { return v.PrivateKey }).(pulumi.StringPtrOutput)
+}
+
+// The password to use in case the private key is encrypted.
+func (o ConnectionTemplateOutput) PrivateKeyPassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *string { return v.PrivateKeyPassword }).(pulumi.StringPtrOutput)
+}
+
+// The connection settings for the bastion/proxy host.
+func (o ConnectionTemplateOutput) Proxy() ProxyConnectionPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *ProxyConnection { return v.Proxy }).(ProxyConnectionPtrOutput)
+}
+
+// The user that we should use for the connection.
+func (o ConnectionTemplateOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ConnectionTemplate) *string { return v.User }).(pulumi.StringPtrOutput)
+}
+
+type ConnectionTemplatePtrOutput struct{ *pulumi.OutputState }
+
+func (ConnectionTemplatePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ConnectionTemplate)(nil)).Elem()
+}
+
+func (o ConnectionTemplatePtrOutput) ToConnectionTemplatePtrOutput() ConnectionTemplatePtrOutput {
+	return o
+}
+
+func (o ConnectionTemplatePtrOutput) ToConnectionTemplatePtrOutputWithContext(ctx context.Context) ConnectionTemplatePtrOutput {
+	return o
+}
+
+func (o ConnectionTemplatePtrOutput) Elem() ConnectionTemplateOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) ConnectionTemplate {
+		if v != nil {
+			return *v
+		}
+		var ret ConnectionTemplate
+		return ret
+	}).(ConnectionTemplateOutput)
+}
+
+// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+func (o ConnectionTemplatePtrOutput) AgentSocketPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AgentSocketPath
+	}).(pulumi.StringPtrOutput)
+}
+
+// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+func (o ConnectionTemplatePtrOutput) DialErrorLimit() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *int {
+		if v == nil {
+			return nil
+		}
+		return v.DialErrorLimit
+	}).(pulumi.IntPtrOutput)
+}
+
+// The password we should use for the connection.
+func (o ConnectionTemplatePtrOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Password
+	}).(pulumi.StringPtrOutput)
+}
+
+// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+func (o ConnectionTemplatePtrOutput) PerDialTimeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *int {
+		if v == nil {
+			return nil
+		}
+		return v.PerDialTimeout
+	}).(pulumi.IntPtrOutput)
+}
+
+// The port to connect to. Defaults to 22.
+func (o ConnectionTemplatePtrOutput) Port() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *float64 {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.Float64PtrOutput)
+}
+
+// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+func (o ConnectionTemplatePtrOutput) PrivateKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PrivateKey
+	}).(pulumi.StringPtrOutput)
+}
+
+// The password to use in case the private key is encrypted.
+func (o ConnectionTemplatePtrOutput) PrivateKeyPassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PrivateKeyPassword
+	}).(pulumi.StringPtrOutput)
+}
+
+// The connection settings for the bastion/proxy host.
+func (o ConnectionTemplatePtrOutput) Proxy() ProxyConnectionPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *ProxyConnection {
+		if v == nil {
+			return nil
+		}
+		return v.Proxy
+	}).(ProxyConnectionPtrOutput)
+}
+
+// The user that we should use for the connection.
+func (o ConnectionTemplatePtrOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ConnectionTemplate) *string {
+		if v == nil {
+			return nil
+		}
+		return v.User
+	}).(pulumi.StringPtrOutput)
 }
 
-func (o ConnectionOutput) ToConnectionOutput() ConnectionOutput {
+// Settings for running a command as a detached job.
+type Detached struct {
+	// The number of seconds between checks whether the job finished. Defaults to 5.
+	PollInterval *int `pulumi:"pollInterval"`
+	// The directory on the remote host that holds a subdirectory per job, with its pid,
+	// exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+	// directory of the user. Defaults to `~/.pulumi-command/jobs`.
+	StateDir *string `pulumi:"stateDir"`
+}
+
+// DetachedInput is an input type that accepts DetachedArgs and DetachedOutput values.
+// You can construct a concrete instance of `DetachedInput` via:
+//
+//	DetachedArgs{...}
+type DetachedInput interface {
+	pulumi.Input
+
+	ToDetachedOutput() DetachedOutput
+	ToDetachedOutputWithContext(context.Context) DetachedOutput
+}
+
+// Settings for running a command as a detached job.
+type DetachedArgs struct {
+	// The number of seconds between checks whether the job finished. Defaults to 5.
+	PollInterval pulumi.IntPtrInput `pulumi:"pollInterval"`
+	// The directory on the remote host that holds a subdirectory per job, with its pid,
+	// exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+	// directory of the user. Defaults to `~/.pulumi-command/jobs`.
+	StateDir pulumi.StringPtrInput `pulumi:"stateDir"`
+}
+
+func (DetachedArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Detached)(nil)).Elem()
+}
+
+func (i DetachedArgs) ToDetachedOutput() DetachedOutput {
+	return i.ToDetachedOutputWithContext(context.Background())
+}
+
+func (i DetachedArgs) ToDetachedOutputWithContext(ctx context.Context) DetachedOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DetachedOutput)
+}
+
+func (i DetachedArgs) ToDetachedPtrOutput() DetachedPtrOutput {
+	return i.ToDetachedPtrOutputWithContext(context.Background())
+}
+
+func (i DetachedArgs) ToDetachedPtrOutputWithContext(ctx context.Context) DetachedPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DetachedOutput).ToDetachedPtrOutputWithContext(ctx)
+}
+
+// DetachedPtrInput is an input type that accepts DetachedArgs, DetachedPtr and DetachedPtrOutput values.
+// You can construct a concrete instance of `DetachedPtrInput` via:
+//
+//	        DetachedArgs{...}
+//
+//	or:
+//
+//	        nil
+type DetachedPtrInput interface {
+	pulumi.Input
+
+	ToDetachedPtrOutput() DetachedPtrOutput
+	ToDetachedPtrOutputWithContext(context.Context) DetachedPtrOutput
+}
+
+type detachedPtrType DetachedArgs
+
+func DetachedPtr(v *DetachedArgs) DetachedPtrInput {
+	return (*detachedPtrType)(v)
+}
+
+func (*detachedPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Detached)(nil)).Elem()
+}
+
+func (i *detachedPtrType) ToDetachedPtrOutput() DetachedPtrOutput {
+	return i.ToDetachedPtrOutputWithContext(context.Background())
+}
+
+func (i *detachedPtrType) ToDetachedPtrOutputWithContext(ctx context.Context) DetachedPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DetachedPtrOutput)
+}
+
+// Settings for running a command as a detached job.
+type DetachedOutput struct{ *pulumi.OutputState }
+
+func (DetachedOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Detached)(nil)).Elem()
+}
+
+func (o DetachedOutput) ToDetachedOutput() DetachedOutput {
 	return o
 }
 
-func (o ConnectionOutput) ToConnectionOutputWithContext(ctx context.Context) ConnectionOutput {
+func (o DetachedOutput) ToDetachedOutputWithContext(ctx context.Context) DetachedOutput {
 	return o
 }
 
-// SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
-func (o ConnectionOutput) AgentSocketPath() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Connection) *string { return v.AgentSocketPath }).(pulumi.StringPtrOutput)
+func (o DetachedOutput) ToDetachedPtrOutput() DetachedPtrOutput {
+	return o.ToDetachedPtrOutputWithContext(context.Background())
 }
 
-// Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
-func (o ConnectionOutput) DialErrorLimit() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v Connection) *int { return v.DialErrorLimit }).(pulumi.IntPtrOutput)
+func (o DetachedOutput) ToDetachedPtrOutputWithContext(ctx context.Context) DetachedPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Detached) *Detached {
+		return &v
+	}).(DetachedPtrOutput)
 }
 
-// The address of the resource to connect to.
-func (o ConnectionOutput) Host() pulumi.StringOutput {
-	return o.ApplyT(func(v Connection) string { return v.Host }).(pulumi.StringOutput)
+// The number of seconds between checks whether the job finished. Defaults to 5.
+func (o DetachedOutput) PollInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Detached) *int { return v.PollInterval }).(pulumi.IntPtrOutput)
 }
 
-// The expected host key to verify the server's identity. If not provided, the host key will be ignored.
-func (o ConnectionOutput) HostKey() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Connection) *string { return v.HostKey }).(pulumi.StringPtrOutput)
+// The directory on the remote host that holds a subdirectory per job, with its pid,
+// exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+// directory of the user. Defaults to `~/.pulumi-command/jobs`.
+func (o DetachedOutput) StateDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Detached) *string { return v.StateDir }).(pulumi.StringPtrOutput)
 }
 
-// The password we should use for the connection.
-func (o ConnectionOutput) Password() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Connection) *string { return v.Password }).(pulumi.StringPtrOutput)
+type DetachedPtrOutput struct{ *pulumi.OutputState }
+
+func (DetachedPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Detached)(nil)).Elem()
 }
 
-// Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
-func (o ConnectionOutput) PerDialTimeout() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v Connection) *int { return v.PerDialTimeout }).(pulumi.IntPtrOutput)
+func (o DetachedPtrOutput) ToDetachedPtrOutput() DetachedPtrOutput {
+	return o
 }
 
-// The port to connect to. Defaults to 22.
-func (o ConnectionOutput) Port() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v Connection) *float64 { return v.Port }).(pulumi.Float64PtrOutput)
+func (o DetachedPtrOutput) ToDetachedPtrOutputWithContext(ctx context.Context) DetachedPtrOutput {
+	return o
 }
 
-// The contents of an SSH key to use for the connection. This takes preference over the password if provided.
-func (o ConnectionOutput) PrivateKey() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Connection) *string { return v.PrivateKey }).(pulumi.StringPtrOutput)
+func (o DetachedPtrOutput) Elem() DetachedOutput {
+	return o.ApplyT(func(v *Detached) Detached {
+		if v != nil {
+			return *v
+		}
+		var ret Detached
+		return ret
+	}).(DetachedOutput)
 }
 
-// The password to use in case the private key is encrypted.
-func (o ConnectionOutput) PrivateKeyPassword() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Connection) *string { return v.PrivateKeyPassword }).(pulumi.StringPtrOutput)
+// The number of seconds between checks whether the job finished. Defaults to 5.
+func (o DetachedPtrOutput) PollInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Detached) *int {
+		if v == nil {
+			return nil
+		}
+		return v.PollInterval
+	}).(pulumi.IntPtrOutput)
 }
 
-// The connection settings for the bastion/proxy host.
-func (o ConnectionOutput) Proxy() ProxyConnectionPtrOutput {
-	return o.ApplyT(func(v Connection) *ProxyConnection { return v.Proxy }).(ProxyConnectionPtrOutput)
+// The directory on the remote host that holds a subdirectory per job, with its pid,
+// exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+// directory of the user. Defaults to `~/.pulumi-command/jobs`.
+func (o DetachedPtrOutput) StateDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Detached) *string {
+		if v == nil {
+			return nil
+		}
+		return v.StateDir
+	}).(pulumi.StringPtrOutput)
 }
 
-// The user that we should use for the connection.
-func (o ConnectionOutput) User() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Connection) *string { return v.User }).(pulumi.StringPtrOutput)
+// A public key that a host offers to identify itself.
+type HostKey struct {
+	// The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+	Fingerprint string `pulumi:"fingerprint"`
+	// The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+	Key string `pulumi:"key"`
+	// The type of the key, e.g. `ssh-ed25519`.
+	Type string `pulumi:"type"`
+}
+
+// A public key that a host offers to identify itself.
+type HostKeyOutput struct{ *pulumi.OutputState }
+
+func (HostKeyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*HostKey)(nil)).Elem()
+}
+
+func (o HostKeyOutput) ToHostKeyOutput() HostKeyOutput {
+	return o
+}
+
+func (o HostKeyOutput) ToHostKeyOutputWithContext(ctx context.Context) HostKeyOutput {
+	return o
+}
+
+// The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+func (o HostKeyOutput) Fingerprint() pulumi.StringOutput {
+	return o.ApplyT(func(v HostKey) string { return v.Fingerprint }).(pulumi.StringOutput)
+}
+
+// The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+func (o HostKeyOutput) Key() pulumi.StringOutput {
+	return o.ApplyT(func(v HostKey) string { return v.Key }).(pulumi.StringOutput)
+}
+
+// The type of the key, e.g. `ssh-ed25519`.
+func (o HostKeyOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v HostKey) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type HostKeyArrayOutput struct{ *pulumi.OutputState }
+
+func (HostKeyArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]HostKey)(nil)).Elem()
+}
+
+func (o HostKeyArrayOutput) ToHostKeyArrayOutput() HostKeyArrayOutput {
+	return o
+}
+
+func (o HostKeyArrayOutput) ToHostKeyArrayOutputWithContext(ctx context.Context) HostKeyArrayOutput {
+	return o
+}
+
+func (o HostKeyArrayOutput) Index(i pulumi.IntInput) HostKeyOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) HostKey {
+		return vs[0].([]HostKey)[vs[1].(int)]
+	}).(HostKeyOutput)
+}
+
+// The result of running a command on a host.
+type HostResult struct {
+	// Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+	// than 'update' is run on the host on update.
+	Created bool `pulumi:"created"`
+	// Why the command failed on the host, including its output. Unset if the command
+	// succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.
+	Error *string `pulumi:"error"`
+	// The exit code of the command's process, or -1 if the command didn't exit, e.g.
+	// because the host couldn't be reached.
+	ExitCode int `pulumi:"exitCode"`
+	// The standard error of the command's process
+	Stderr string `pulumi:"stderr"`
+	// The standard output of the command's process
+	Stdout string `pulumi:"stdout"`
+}
+
+// The result of running a command on a host.
+type HostResultOutput struct{ *pulumi.OutputState }
+
+func (HostResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*HostResult)(nil)).Elem()
+}
+
+func (o HostResultOutput) ToHostResultOutput() HostResultOutput {
+	return o
+}
+
+func (o HostResultOutput) ToHostResultOutputWithContext(ctx context.Context) HostResultOutput {
+	return o
+}
+
+// Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+// than 'update' is run on the host on update.
+func (o HostResultOutput) Created() pulumi.BoolOutput {
+	return o.ApplyT(func(v HostResult) bool { return v.Created }).(pulumi.BoolOutput)
+}
+
+// Why the command failed on the host, including its output. Unset if the command
+// succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.
+func (o HostResultOutput) Error() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v HostResult) *string { return v.Error }).(pulumi.StringPtrOutput)
+}
+
+// The exit code of the command's process, or -1 if the command didn't exit, e.g.
+// because the host couldn't be reached.
+func (o HostResultOutput) ExitCode() pulumi.IntOutput {
+	return o.ApplyT(func(v HostResult) int { return v.ExitCode }).(pulumi.IntOutput)
+}
+
+// The standard error of the command's process
+func (o HostResultOutput) Stderr() pulumi.StringOutput {
+	return o.ApplyT(func(v HostResult) string { return v.Stderr }).(pulumi.StringOutput)
+}
+
+// The standard output of the command's process
+func (o HostResultOutput) Stdout() pulumi.StringOutput {
+	return o.ApplyT(func(v HostResult) string { return v.Stdout }).(pulumi.StringOutput)
+}
+
+type HostResultMapOutput struct{ *pulumi.OutputState }
+
+func (HostResultMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]HostResult)(nil)).Elem()
+}
+
+func (o HostResultMapOutput) ToHostResultMapOutput() HostResultMapOutput {
+	return o
+}
+
+func (o HostResultMapOutput) ToHostResultMapOutputWithContext(ctx context.Context) HostResultMapOutput {
+	return o
+}
+
+func (o HostResultMapOutput) MapIndex(k pulumi.StringInput) HostResultOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) HostResult {
+		return vs[0].(map[string]HostResult)[vs[1].(string)]
+	}).(HostResultOutput)
 }
 
 // Instructions for how to connect to a remote endpoint via a bastion host.
@@ -562,11 +1342,232 @@ func (o ProxyConnectionPtrOutput) User() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+// The pseudo-terminal that a command is run in.
+type Pty struct {
+	// The width of the terminal in characters. Defaults to 80.
+	Cols *int `pulumi:"cols"`
+	// The height of the terminal in characters. Defaults to 24.
+	Rows *int `pulumi:"rows"`
+	// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+	// captured stdout. They are always part of the logged output. Defaults to false.
+	StripAnsi *bool `pulumi:"stripAnsi"`
+	// The terminal type, set as TERM. Defaults to `xterm`.
+	Term *string `pulumi:"term"`
+}
+
+// PtyInput is an input type that accepts PtyArgs and PtyOutput values.
+// You can construct a concrete instance of `PtyInput` via:
+//
+//	PtyArgs{...}
+type PtyInput interface {
+	pulumi.Input
+
+	ToPtyOutput() PtyOutput
+	ToPtyOutputWithContext(context.Context) PtyOutput
+}
+
+// The pseudo-terminal that a command is run in.
+type PtyArgs struct {
+	// The width of the terminal in characters. Defaults to 80.
+	Cols pulumi.IntPtrInput `pulumi:"cols"`
+	// The height of the terminal in characters. Defaults to 24.
+	Rows pulumi.IntPtrInput `pulumi:"rows"`
+	// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+	// captured stdout. They are always part of the logged output. Defaults to false.
+	StripAnsi pulumi.BoolPtrInput `pulumi:"stripAnsi"`
+	// The terminal type, set as TERM. Defaults to `xterm`.
+	Term pulumi.StringPtrInput `pulumi:"term"`
+}
+
+func (PtyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Pty)(nil)).Elem()
+}
+
+func (i PtyArgs) ToPtyOutput() PtyOutput {
+	return i.ToPtyOutputWithContext(context.Background())
+}
+
+func (i PtyArgs) ToPtyOutputWithContext(ctx context.Context) PtyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PtyOutput)
+}
+
+func (i PtyArgs) ToPtyPtrOutput() PtyPtrOutput {
+	return i.ToPtyPtrOutputWithContext(context.Background())
+}
+
+func (i PtyArgs) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PtyOutput).ToPtyPtrOutputWithContext(ctx)
+}
+
+// PtyPtrInput is an input type that accepts PtyArgs, PtyPtr and PtyPtrOutput values.
+// You can construct a concrete instance of `PtyPtrInput` via:
+//
+//	        PtyArgs{...}
+//
+//	or:
+//
+//	        nil
+type PtyPtrInput interface {
+	pulumi.Input
+
+	ToPtyPtrOutput() PtyPtrOutput
+	ToPtyPtrOutputWithContext(context.Context) PtyPtrOutput
+}
+
+type ptyPtrType PtyArgs
+
+func PtyPtr(v *PtyArgs) PtyPtrInput {
+	return (*ptyPtrType)(v)
+}
+
+func (*ptyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Pty)(nil)).Elem()
+}
+
+func (i *ptyPtrType) ToPtyPtrOutput() PtyPtrOutput {
+	return i.ToPtyPtrOutputWithContext(context.Background())
+}
+
+func (i *ptyPtrType) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PtyPtrOutput)
+}
+
+// The pseudo-terminal that a command is run in.
+type PtyOutput struct{ *pulumi.OutputState }
+
+func (PtyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Pty)(nil)).Elem()
+}
+
+func (o PtyOutput) ToPtyOutput() PtyOutput {
+	return o
+}
+
+func (o PtyOutput) ToPtyOutputWithContext(ctx context.Context) PtyOutput {
+	return o
+}
+
+func (o PtyOutput) ToPtyPtrOutput() PtyPtrOutput {
+	return o.ToPtyPtrOutputWithContext(context.Background())
+}
+
+func (o PtyOutput) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Pty) *Pty {
+		return &v
+	}).(PtyPtrOutput)
+}
+
+// The width of the terminal in characters. Defaults to 80.
+func (o PtyOutput) Cols() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Pty) *int { return v.Cols }).(pulumi.IntPtrOutput)
+}
+
+// The height of the terminal in characters. Defaults to 24.
+func (o PtyOutput) Rows() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Pty) *int { return v.Rows }).(pulumi.IntPtrOutput)
+}
+
+// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+// captured stdout. They are always part of the logged output. Defaults to false.
+func (o PtyOutput) StripAnsi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Pty) *bool { return v.StripAnsi }).(pulumi.BoolPtrOutput)
+}
+
+// The terminal type, set as TERM. Defaults to `xterm`.
+func (o PtyOutput) Term() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Pty) *string { return v.Term }).(pulumi.StringPtrOutput)
+}
+
+type PtyPtrOutput struct{ *pulumi.OutputState }
+
+func (PtyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Pty)(nil)).Elem()
+}
+
+func (o PtyPtrOutput) ToPtyPtrOutput() PtyPtrOutput {
+	return o
+}
+
+func (o PtyPtrOutput) ToPtyPtrOutputWithContext(ctx context.Context) PtyPtrOutput {
+	return o
+}
+
+func (o PtyPtrOutput) Elem() PtyOutput {
+	return o.ApplyT(func(v *Pty) Pty {
+		if v != nil {
+			return *v
+		}
+		var ret Pty
+		return ret
+	}).(PtyOutput)
+}
+
+// The width of the terminal in characters. Defaults to 80.
+func (o PtyPtrOutput) Cols() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Pty) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Cols
+	}).(pulumi.IntPtrOutput)
+}
+
+// The height of the terminal in characters. Defaults to 24.
+func (o PtyPtrOutput) Rows() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Pty) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Rows
+	}).(pulumi.IntPtrOutput)
+}
+
+// If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+// captured stdout. They are always part of the logged output. Defaults to false.
+func (o PtyPtrOutput) StripAnsi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Pty) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.StripAnsi
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The terminal type, set as TERM. Defaults to `xterm`.
+func (o PtyPtrOutput) Term() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Pty) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Term
+	}).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ConnectionInput)(nil)).Elem(), ConnectionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ConnectionArrayInput)(nil)).Elem(), ConnectionArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ConnectionTemplateInput)(nil)).Elem(), ConnectionTemplateArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ConnectionTemplatePtrInput)(nil)).Elem(), ConnectionTemplateArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DetachedInput)(nil)).Elem(), DetachedArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DetachedPtrInput)(nil)).Elem(), DetachedArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ProxyConnectionInput)(nil)).Elem(), ProxyConnectionArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ProxyConnectionPtrInput)(nil)).Elem(), ProxyConnectionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PtyInput)(nil)).Elem(), PtyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PtyPtrInput)(nil)).Elem(), PtyArgs{})
 	pulumi.RegisterOutputType(ConnectionOutput{})
+	pulumi.RegisterOutputType(ConnectionArrayOutput{})
+	pulumi.RegisterOutputType(ConnectionStageOutput{})
+	pulumi.RegisterOutputType(ConnectionStageArrayOutput{})
+	pulumi.RegisterOutputType(ConnectionTemplateOutput{})
+	pulumi.RegisterOutputType(ConnectionTemplatePtrOutput{})
+	pulumi.RegisterOutputType(DetachedOutput{})
+	pulumi.RegisterOutputType(DetachedPtrOutput{})
+	pulumi.RegisterOutputType(HostKeyOutput{})
+	pulumi.RegisterOutputType(HostKeyArrayOutput{})
+	pulumi.RegisterOutputType(HostResultOutput{})
+	pulumi.RegisterOutputType(HostResultMapOutput{})
 	pulumi.RegisterOutputType(ProxyConnectionOutput{})
 	pulumi.RegisterOutputType(ProxyConnectionPtrOutput{})
+	pulumi.RegisterOutputType(PtyOutput{})
+	pulumi.RegisterOutputType(PtyPtrOutput{})
 }
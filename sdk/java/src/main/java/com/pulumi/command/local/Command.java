@@ -7,12 +7,16 @@ import com.pulumi.asset.Archive;
 import com.pulumi.asset.AssetOrArchive;
 import com.pulumi.command.Utilities;
 import com.pulumi.command.local.CommandArgs;
+import com.pulumi.command.local.enums.LogSeverity;
 import com.pulumi.command.local.enums.Logging;
+import com.pulumi.command.local.enums.SymlinkPolicy;
+import com.pulumi.command.local.outputs.Pty;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Export;
 import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
 import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
@@ -392,6 +396,42 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<Map<String,String>>> environment() {
         return Codegen.optional(this.environment);
     }
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    @Export(name="errorOutputLines", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> errorOutputLines;
+
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    public Output<Optional<Integer>> errorOutputLines() {
+        return Codegen.optional(this.errorOutputLines);
+    }
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    @Export(name="heartbeatInterval", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> heartbeatInterval;
+
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    public Output<Optional<Integer>> heartbeatInterval() {
+        return Codegen.optional(this.heartbeatInterval);
+    }
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
@@ -408,6 +448,20 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<List<String>>> interpreter() {
         return Codegen.optional(this.interpreter);
     }
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    @Export(name="logStreamPrefix", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> logStreamPrefix;
+
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    public Output<Optional<Boolean>> logStreamPrefix() {
+        return Codegen.optional(this.logStreamPrefix);
+    }
     /**
      * If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -426,6 +480,60 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<Logging>> logging() {
         return Codegen.optional(this.logging);
     }
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    @Export(name="pty", refs={Pty.class}, tree="[0]")
+    private Output</* @Nullable */ Pty> pty;
+
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    public Output<Optional<Pty>> pty() {
+        return Codegen.optional(this.pty);
+    }
+    /**
+     * Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    @Export(name="redact", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> redact;
+
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    public Output<Optional<List<String>>> redact() {
+        return Codegen.optional(this.redact);
+    }
+    /**
+     * If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    @Export(name="redactOutputs", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> redactOutputs;
+
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    public Output<Optional<Boolean>> redactOutputs() {
+        return Codegen.optional(this.redactOutputs);
+    }
     /**
      * The standard error of the command&#39;s process
      * 
@@ -440,6 +548,20 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<String> stderr() {
         return this.stderr;
     }
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    @Export(name="stderrSeverity", refs={LogSeverity.class}, tree="[0]")
+    private Output</* @Nullable */ LogSeverity> stderrSeverity;
+
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    public Output<Optional<LogSeverity>> stderrSeverity() {
+        return Codegen.optional(this.stderrSeverity);
+    }
     /**
      * Pass a string to the command&#39;s process as standard in
      * 
@@ -468,6 +590,62 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<String> stdout() {
         return this.stdout;
     }
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    @Export(name="stdoutSeverity", refs={LogSeverity.class}, tree="[0]")
+    private Output</* @Nullable */ LogSeverity> stdoutSeverity;
+
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    public Output<Optional<LogSeverity>> stdoutSeverity() {
+        return Codegen.optional(this.stdoutSeverity);
+    }
+    /**
+     * If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    @Export(name="stripWorkflowCommands", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> stripWorkflowCommands;
+
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    public Output<Optional<Boolean>> stripWorkflowCommands() {
+        return Codegen.optional(this.stripWorkflowCommands);
+    }
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    @Export(name="symlinks", refs={SymlinkPolicy.class}, tree="[0]")
+    private Output</* @Nullable */ SymlinkPolicy> symlinks;
+
+    /**
+     * @return How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    public Output<Optional<SymlinkPolicy>> symlinks() {
+        return Codegen.optional(this.symlinks);
+    }
     /**
      * The resource will be updated (or replaced) if any of these values change.
      * 
@@ -524,6 +702,26 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<String>> update() {
         return Codegen.optional(this.update);
     }
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    @Export(name="workflowCommands", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> workflowCommands;
+
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    public Output<Optional<Boolean>> workflowCommands() {
+        return Codegen.optional(this.workflowCommands);
+    }
 
     /**
      *
@@ -564,6 +762,9 @@ public class Command extends com.pulumi.resources.CustomResource {
     private static com.pulumi.resources.CustomResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.CustomResourceOptions options, @Nullable Output<java.lang.String> id) {
         var defaultOptions = com.pulumi.resources.CustomResourceOptions.builder()
             .version(Utilities.getVersion())
+            .additionalSecretOutputs(List.of(
+                "redact"
+            ))
             .replaceOnChanges(List.of(
                 "triggers[*]"
             ))
@@ -3,10 +3,14 @@
 
 package com.pulumi.command.local;
 
+import com.pulumi.command.local.enums.LogSeverity;
 import com.pulumi.command.local.enums.Logging;
+import com.pulumi.command.local.enums.SymlinkPolicy;
+import com.pulumi.command.local.inputs.PtyArgs;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
 import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
@@ -219,6 +223,44 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.environment);
     }
 
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    @Import(name="errorOutputLines")
+    private @Nullable Output<Integer> errorOutputLines;
+
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    public Optional<Output<Integer>> errorOutputLines() {
+        return Optional.ofNullable(this.errorOutputLines);
+    }
+
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    @Import(name="heartbeatInterval")
+    private @Nullable Output<Integer> heartbeatInterval;
+
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    public Optional<Output<Integer>> heartbeatInterval() {
+        return Optional.ofNullable(this.heartbeatInterval);
+    }
+
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
@@ -236,6 +278,21 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.interpreter);
     }
 
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    @Import(name="logStreamPrefix")
+    private @Nullable Output<Boolean> logStreamPrefix;
+
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> logStreamPrefix() {
+        return Optional.ofNullable(this.logStreamPrefix);
+    }
+
     /**
      * If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -255,6 +312,78 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.logging);
     }
 
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    @Import(name="pty")
+    private @Nullable Output<PtyArgs> pty;
+
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    public Optional<Output<PtyArgs>> pty() {
+        return Optional.ofNullable(this.pty);
+    }
+
+    /**
+     * Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    @Import(name="redact")
+    private @Nullable Output<List<String>> redact;
+
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    public Optional<Output<List<String>>> redact() {
+        return Optional.ofNullable(this.redact);
+    }
+
+    /**
+     * If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    @Import(name="redactOutputs")
+    private @Nullable Output<Boolean> redactOutputs;
+
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    public Optional<Output<Boolean>> redactOutputs() {
+        return Optional.ofNullable(this.redactOutputs);
+    }
+
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    @Import(name="stderrSeverity")
+    private @Nullable Output<LogSeverity> stderrSeverity;
+
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    public Optional<Output<LogSeverity>> stderrSeverity() {
+        return Optional.ofNullable(this.stderrSeverity);
+    }
+
     /**
      * Pass a string to the command&#39;s process as standard in
      * 
@@ -270,6 +399,65 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.stdin);
     }
 
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    @Import(name="stdoutSeverity")
+    private @Nullable Output<LogSeverity> stdoutSeverity;
+
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    public Optional<Output<LogSeverity>> stdoutSeverity() {
+        return Optional.ofNullable(this.stdoutSeverity);
+    }
+
+    /**
+     * If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    @Import(name="stripWorkflowCommands")
+    private @Nullable Output<Boolean> stripWorkflowCommands;
+
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    public Optional<Output<Boolean>> stripWorkflowCommands() {
+        return Optional.ofNullable(this.stripWorkflowCommands);
+    }
+
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    @Import(name="symlinks")
+    private @Nullable Output<SymlinkPolicy> symlinks;
+
+    /**
+     * @return How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    public Optional<Output<SymlinkPolicy>> symlinks() {
+        return Optional.ofNullable(this.symlinks);
+    }
+
     /**
      * The resource will be updated (or replaced) if any of these values change.
      * 
@@ -328,6 +516,27 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
         return Optional.ofNullable(this.update);
     }
 
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    @Import(name="workflowCommands")
+    private @Nullable Output<Boolean> workflowCommands;
+
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> workflowCommands() {
+        return Optional.ofNullable(this.workflowCommands);
+    }
+
     private CommandArgs() {}
 
     private CommandArgs(CommandArgs $) {
@@ -338,11 +547,22 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
         this.delete = $.delete;
         this.dir = $.dir;
         this.environment = $.environment;
+        this.errorOutputLines = $.errorOutputLines;
+        this.heartbeatInterval = $.heartbeatInterval;
         this.interpreter = $.interpreter;
+        this.logStreamPrefix = $.logStreamPrefix;
         this.logging = $.logging;
+        this.pty = $.pty;
+        this.redact = $.redact;
+        this.redactOutputs = $.redactOutputs;
+        this.stderrSeverity = $.stderrSeverity;
         this.stdin = $.stdin;
+        this.stdoutSeverity = $.stdoutSeverity;
+        this.stripWorkflowCommands = $.stripWorkflowCommands;
+        this.symlinks = $.symlinks;
         this.triggers = $.triggers;
         this.update = $.update;
+        this.workflowCommands = $.workflowCommands;
     }
 
     public static Builder builder() {
@@ -660,6 +880,56 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
             return environment(Output.of(environment));
         }
 
+        /**
+         * @param errorOutputLines The number of lines at the end of stdout and stderr that the error of a
+         * failed command includes. Defaults to 20.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder errorOutputLines(@Nullable Output<Integer> errorOutputLines) {
+            $.errorOutputLines = errorOutputLines;
+            return this;
+        }
+
+        /**
+         * @param errorOutputLines The number of lines at the end of stdout and stderr that the error of a
+         * failed command includes. Defaults to 20.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder errorOutputLines(Integer errorOutputLines) {
+            return errorOutputLines(Output.of(errorOutputLines));
+        }
+
+        /**
+         * @param heartbeatInterval The number of seconds without output after which the status of the
+         * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+         * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+         * Defaults to 60.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatInterval(@Nullable Output<Integer> heartbeatInterval) {
+            $.heartbeatInterval = heartbeatInterval;
+            return this;
+        }
+
+        /**
+         * @param heartbeatInterval The number of seconds without output after which the status of the
+         * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+         * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+         * Defaults to 60.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatInterval(Integer heartbeatInterval) {
+            return heartbeatInterval(Output.of(heartbeatInterval));
+        }
+
         /**
          * @param interpreter The program and arguments to run the command.
          * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
@@ -694,6 +964,27 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
             return interpreter(List.of(interpreter));
         }
 
+        /**
+         * @param logStreamPrefix If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logStreamPrefix(@Nullable Output<Boolean> logStreamPrefix) {
+            $.logStreamPrefix = logStreamPrefix;
+            return this;
+        }
+
+        /**
+         * @param logStreamPrefix If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logStreamPrefix(Boolean logStreamPrefix) {
+            return logStreamPrefix(Output.of(logStreamPrefix));
+        }
+
         /**
          * @param logging If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
          * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -719,6 +1010,114 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
             return logging(Output.of(logging));
         }
 
+        /**
+         * @param pty Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+         * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+         * the terminal, without echo, followed by the end of input. Not supported on Windows.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pty(@Nullable Output<PtyArgs> pty) {
+            $.pty = pty;
+            return this;
+        }
+
+        /**
+         * @param pty Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+         * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+         * the terminal, without echo, followed by the end of input. Not supported on Windows.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pty(PtyArgs pty) {
+            return pty(Output.of(pty));
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(@Nullable Output<List<String>> redact) {
+            $.redact = redact;
+            return this;
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(List<String> redact) {
+            return redact(Output.of(redact));
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(String... redact) {
+            return redact(List.of(redact));
+        }
+
+        /**
+         * @param redactOutputs If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+         * to false, in which case outputs that might contain secrets can be marked as secret via
+         * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redactOutputs(@Nullable Output<Boolean> redactOutputs) {
+            $.redactOutputs = redactOutputs;
+            return this;
+        }
+
+        /**
+         * @param redactOutputs If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+         * to false, in which case outputs that might contain secrets can be marked as secret via
+         * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redactOutputs(Boolean redactOutputs) {
+            return redactOutputs(Output.of(redactOutputs));
+        }
+
+        /**
+         * @param stderrSeverity The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stderrSeverity(@Nullable Output<LogSeverity> stderrSeverity) {
+            $.stderrSeverity = stderrSeverity;
+            return this;
+        }
+
+        /**
+         * @param stderrSeverity The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stderrSeverity(LogSeverity stderrSeverity) {
+            return stderrSeverity(Output.of(stderrSeverity));
+        }
+
         /**
          * @param stdin Pass a string to the command&#39;s process as standard in
          * 
@@ -740,6 +1139,83 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
             return stdin(Output.of(stdin));
         }
 
+        /**
+         * @param stdoutSeverity The severity with which the lines of stdout are logged. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdoutSeverity(@Nullable Output<LogSeverity> stdoutSeverity) {
+            $.stdoutSeverity = stdoutSeverity;
+            return this;
+        }
+
+        /**
+         * @param stdoutSeverity The severity with which the lines of stdout are logged. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdoutSeverity(LogSeverity stdoutSeverity) {
+            return stdoutSeverity(Output.of(stdoutSeverity));
+        }
+
+        /**
+         * @param stripWorkflowCommands If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+         * to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripWorkflowCommands(@Nullable Output<Boolean> stripWorkflowCommands) {
+            $.stripWorkflowCommands = stripWorkflowCommands;
+            return this;
+        }
+
+        /**
+         * @param stripWorkflowCommands If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+         * to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripWorkflowCommands(Boolean stripWorkflowCommands) {
+            return stripWorkflowCommands(Output.of(stripWorkflowCommands));
+        }
+
+        /**
+         * @param symlinks How symlinks are handled when matching `assetPaths` and `archivePaths`.
+         * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+         * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+         * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+         * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+         * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+         * a directory isn&#39;t searched.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder symlinks(@Nullable Output<SymlinkPolicy> symlinks) {
+            $.symlinks = symlinks;
+            return this;
+        }
+
+        /**
+         * @param symlinks How symlinks are handled when matching `assetPaths` and `archivePaths`.
+         * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+         * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+         * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+         * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+         * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+         * a directory isn&#39;t searched.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder symlinks(SymlinkPolicy symlinks) {
+            return symlinks(Output.of(symlinks));
+        }
+
         /**
          * @param triggers The resource will be updated (or replaced) if any of these values change.
          * 
@@ -826,6 +1302,33 @@ public final class CommandArgs extends com.pulumi.resources.ResourceArgs {
             return update(Output.of(update));
         }
 
+        /**
+         * @param workflowCommands Run the workflow commands among the lines of stdout, modeled on those of
+         * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+         * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+         * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workflowCommands(@Nullable Output<Boolean> workflowCommands) {
+            $.workflowCommands = workflowCommands;
+            return this;
+        }
+
+        /**
+         * @param workflowCommands Run the workflow commands among the lines of stdout, modeled on those of
+         * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+         * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+         * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workflowCommands(Boolean workflowCommands) {
+            return workflowCommands(Output.of(workflowCommands));
+        }
+
         public CommandArgs build() {
             return $;
         }
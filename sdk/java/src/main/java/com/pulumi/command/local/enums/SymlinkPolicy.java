@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.local.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum SymlinkPolicy {
+        /**
+         * Keep symlinks as symlinks
+         * 
+         */
+        Preserve("preserve"),
+        /**
+         * Treat symlinks as the files or directories they point to
+         * 
+         */
+        Follow("follow"),
+        /**
+         * Ignore symlinks
+         * 
+         */
+        Skip("skip");
+
+        private final String value;
+
+        SymlinkPolicy(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "SymlinkPolicy[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.local.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * The pseudo-terminal that a command is run in.
+ * 
+ */
+public final class Pty extends com.pulumi.resources.InvokeArgs {
+
+    public static final Pty Empty = new Pty();
+
+    /**
+     * The width of the terminal in characters. Defaults to 80.
+     * 
+     */
+    @Import(name="cols")
+    private @Nullable Integer cols;
+
+    /**
+     * @return The width of the terminal in characters. Defaults to 80.
+     * 
+     */
+    public Optional<Integer> cols() {
+        return Optional.ofNullable(this.cols);
+    }
+
+    /**
+     * The height of the terminal in characters. Defaults to 24.
+     * 
+     */
+    @Import(name="rows")
+    private @Nullable Integer rows;
+
+    /**
+     * @return The height of the terminal in characters. Defaults to 24.
+     * 
+     */
+    public Optional<Integer> rows() {
+        return Optional.ofNullable(this.rows);
+    }
+
+    /**
+     * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+     * captured stdout. They are always part of the logged output. Defaults to false.
+     * 
+     */
+    @Import(name="stripAnsi")
+    private @Nullable Boolean stripAnsi;
+
+    /**
+     * @return If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+     * captured stdout. They are always part of the logged output. Defaults to false.
+     * 
+     */
+    public Optional<Boolean> stripAnsi() {
+        return Optional.ofNullable(this.stripAnsi);
+    }
+
+    /**
+     * The terminal type, set as TERM. Defaults to `xterm`.
+     * 
+     */
+    @Import(name="term")
+    private @Nullable String term;
+
+    /**
+     * @return The terminal type, set as TERM. Defaults to `xterm`.
+     * 
+     */
+    public Optional<String> term() {
+        return Optional.ofNullable(this.term);
+    }
+
+    private Pty() {}
+
+    private Pty(Pty $) {
+        this.cols = $.cols;
+        this.rows = $.rows;
+        this.stripAnsi = $.stripAnsi;
+        this.term = $.term;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Pty defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Pty $;
+
+        public Builder() {
+            $ = new Pty();
+        }
+
+        public Builder(Pty defaults) {
+            $ = new Pty(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param cols The width of the terminal in characters. Defaults to 80.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cols(@Nullable Integer cols) {
+            $.cols = cols;
+            return this;
+        }
+
+        /**
+         * @param rows The height of the terminal in characters. Defaults to 24.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder rows(@Nullable Integer rows) {
+            $.rows = rows;
+            return this;
+        }
+
+        /**
+         * @param stripAnsi If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripAnsi(@Nullable Boolean stripAnsi) {
+            $.stripAnsi = stripAnsi;
+            return this;
+        }
+
+        /**
+         * @param term The terminal type, set as TERM. Defaults to `xterm`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder term(@Nullable String term) {
+            $.term = term;
+            return this;
+        }
+
+        public Pty build() {
+            return $;
+        }
+    }
+
+}
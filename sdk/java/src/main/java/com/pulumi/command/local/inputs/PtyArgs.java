@@ -0,0 +1,204 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.local.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * The pseudo-terminal that a command is run in.
+ * 
+ */
+public final class PtyArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final PtyArgs Empty = new PtyArgs();
+
+    /**
+     * The width of the terminal in characters. Defaults to 80.
+     * 
+     */
+    @Import(name="cols")
+    private @Nullable Output<Integer> cols;
+
+    /**
+     * @return The width of the terminal in characters. Defaults to 80.
+     * 
+     */
+    public Optional<Output<Integer>> cols() {
+        return Optional.ofNullable(this.cols);
+    }
+
+    /**
+     * The height of the terminal in characters. Defaults to 24.
+     * 
+     */
+    @Import(name="rows")
+    private @Nullable Output<Integer> rows;
+
+    /**
+     * @return The height of the terminal in characters. Defaults to 24.
+     * 
+     */
+    public Optional<Output<Integer>> rows() {
+        return Optional.ofNullable(this.rows);
+    }
+
+    /**
+     * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+     * captured stdout. They are always part of the logged output. Defaults to false.
+     * 
+     */
+    @Import(name="stripAnsi")
+    private @Nullable Output<Boolean> stripAnsi;
+
+    /**
+     * @return If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+     * captured stdout. They are always part of the logged output. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> stripAnsi() {
+        return Optional.ofNullable(this.stripAnsi);
+    }
+
+    /**
+     * The terminal type, set as TERM. Defaults to `xterm`.
+     * 
+     */
+    @Import(name="term")
+    private @Nullable Output<String> term;
+
+    /**
+     * @return The terminal type, set as TERM. Defaults to `xterm`.
+     * 
+     */
+    public Optional<Output<String>> term() {
+        return Optional.ofNullable(this.term);
+    }
+
+    private PtyArgs() {}
+
+    private PtyArgs(PtyArgs $) {
+        this.cols = $.cols;
+        this.rows = $.rows;
+        this.stripAnsi = $.stripAnsi;
+        this.term = $.term;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(PtyArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private PtyArgs $;
+
+        public Builder() {
+            $ = new PtyArgs();
+        }
+
+        public Builder(PtyArgs defaults) {
+            $ = new PtyArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param cols The width of the terminal in characters. Defaults to 80.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cols(@Nullable Output<Integer> cols) {
+            $.cols = cols;
+            return this;
+        }
+
+        /**
+         * @param cols The width of the terminal in characters. Defaults to 80.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cols(Integer cols) {
+            return cols(Output.of(cols));
+        }
+
+        /**
+         * @param rows The height of the terminal in characters. Defaults to 24.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder rows(@Nullable Output<Integer> rows) {
+            $.rows = rows;
+            return this;
+        }
+
+        /**
+         * @param rows The height of the terminal in characters. Defaults to 24.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder rows(Integer rows) {
+            return rows(Output.of(rows));
+        }
+
+        /**
+         * @param stripAnsi If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripAnsi(@Nullable Output<Boolean> stripAnsi) {
+            $.stripAnsi = stripAnsi;
+            return this;
+        }
+
+        /**
+         * @param stripAnsi If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripAnsi(Boolean stripAnsi) {
+            return stripAnsi(Output.of(stripAnsi));
+        }
+
+        /**
+         * @param term The terminal type, set as TERM. Defaults to `xterm`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder term(@Nullable Output<String> term) {
+            $.term = term;
+            return this;
+        }
+
+        /**
+         * @param term The terminal type, set as TERM. Defaults to `xterm`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder term(String term) {
+            return term(Output.of(term));
+        }
+
+        public PtyArgs build() {
+            return $;
+        }
+    }
+
+}
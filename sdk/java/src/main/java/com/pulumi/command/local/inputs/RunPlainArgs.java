@@ -3,10 +3,14 @@
 
 package com.pulumi.command.local.inputs;
 
+import com.pulumi.command.local.enums.LogSeverity;
 import com.pulumi.command.local.enums.Logging;
+import com.pulumi.command.local.enums.SymlinkPolicy;
+import com.pulumi.command.local.inputs.Pty;
 import com.pulumi.core.annotations.Import;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.String;
 import java.util.List;
 import java.util.Map;
@@ -187,6 +191,44 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
         return Optional.ofNullable(this.environment);
     }
 
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    @Import(name="errorOutputLines")
+    private @Nullable Integer errorOutputLines;
+
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    public Optional<Integer> errorOutputLines() {
+        return Optional.ofNullable(this.errorOutputLines);
+    }
+
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    @Import(name="heartbeatInterval")
+    private @Nullable Integer heartbeatInterval;
+
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    public Optional<Integer> heartbeatInterval() {
+        return Optional.ofNullable(this.heartbeatInterval);
+    }
+
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
@@ -204,6 +246,21 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
         return Optional.ofNullable(this.interpreter);
     }
 
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    @Import(name="logStreamPrefix")
+    private @Nullable Boolean logStreamPrefix;
+
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    public Optional<Boolean> logStreamPrefix() {
+        return Optional.ofNullable(this.logStreamPrefix);
+    }
+
     /**
      * If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -223,6 +280,78 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
         return Optional.ofNullable(this.logging);
     }
 
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    @Import(name="pty")
+    private @Nullable Pty pty;
+
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    public Optional<Pty> pty() {
+        return Optional.ofNullable(this.pty);
+    }
+
+    /**
+     * Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    @Import(name="redact")
+    private @Nullable List<String> redact;
+
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    public Optional<List<String>> redact() {
+        return Optional.ofNullable(this.redact);
+    }
+
+    /**
+     * If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    @Import(name="redactOutputs")
+    private @Nullable Boolean redactOutputs;
+
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    public Optional<Boolean> redactOutputs() {
+        return Optional.ofNullable(this.redactOutputs);
+    }
+
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    @Import(name="stderrSeverity")
+    private @Nullable LogSeverity stderrSeverity;
+
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    public Optional<LogSeverity> stderrSeverity() {
+        return Optional.ofNullable(this.stderrSeverity);
+    }
+
     /**
      * Pass a string to the command&#39;s process as standard in
      * 
@@ -238,6 +367,86 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
         return Optional.ofNullable(this.stdin);
     }
 
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    @Import(name="stdoutSeverity")
+    private @Nullable LogSeverity stdoutSeverity;
+
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    public Optional<LogSeverity> stdoutSeverity() {
+        return Optional.ofNullable(this.stdoutSeverity);
+    }
+
+    /**
+     * If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    @Import(name="stripWorkflowCommands")
+    private @Nullable Boolean stripWorkflowCommands;
+
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    public Optional<Boolean> stripWorkflowCommands() {
+        return Optional.ofNullable(this.stripWorkflowCommands);
+    }
+
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    @Import(name="symlinks")
+    private @Nullable SymlinkPolicy symlinks;
+
+    /**
+     * @return How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    public Optional<SymlinkPolicy> symlinks() {
+        return Optional.ofNullable(this.symlinks);
+    }
+
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    @Import(name="workflowCommands")
+    private @Nullable Boolean workflowCommands;
+
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    public Optional<Boolean> workflowCommands() {
+        return Optional.ofNullable(this.workflowCommands);
+    }
+
     private RunPlainArgs() {}
 
     private RunPlainArgs(RunPlainArgs $) {
@@ -247,9 +456,20 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
         this.command = $.command;
         this.dir = $.dir;
         this.environment = $.environment;
+        this.errorOutputLines = $.errorOutputLines;
+        this.heartbeatInterval = $.heartbeatInterval;
         this.interpreter = $.interpreter;
+        this.logStreamPrefix = $.logStreamPrefix;
         this.logging = $.logging;
+        this.pty = $.pty;
+        this.redact = $.redact;
+        this.redactOutputs = $.redactOutputs;
+        this.stderrSeverity = $.stderrSeverity;
         this.stdin = $.stdin;
+        this.stdoutSeverity = $.stdoutSeverity;
+        this.stripWorkflowCommands = $.stripWorkflowCommands;
+        this.symlinks = $.symlinks;
+        this.workflowCommands = $.workflowCommands;
     }
 
     public static Builder builder() {
@@ -431,6 +651,32 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
             return this;
         }
 
+        /**
+         * @param errorOutputLines The number of lines at the end of stdout and stderr that the error of a
+         * failed command includes. Defaults to 20.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder errorOutputLines(@Nullable Integer errorOutputLines) {
+            $.errorOutputLines = errorOutputLines;
+            return this;
+        }
+
+        /**
+         * @param heartbeatInterval The number of seconds without output after which the status of the
+         * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+         * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+         * Defaults to 60.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatInterval(@Nullable Integer heartbeatInterval) {
+            $.heartbeatInterval = heartbeatInterval;
+            return this;
+        }
+
         /**
          * @param interpreter The program and arguments to run the command.
          * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
@@ -454,6 +700,17 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
             return interpreter(List.of(interpreter));
         }
 
+        /**
+         * @param logStreamPrefix If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logStreamPrefix(@Nullable Boolean logStreamPrefix) {
+            $.logStreamPrefix = logStreamPrefix;
+            return this;
+        }
+
         /**
          * @param logging If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
          * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -467,6 +724,68 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
             return this;
         }
 
+        /**
+         * @param pty Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+         * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+         * the terminal, without echo, followed by the end of input. Not supported on Windows.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pty(@Nullable Pty pty) {
+            $.pty = pty;
+            return this;
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(@Nullable List<String> redact) {
+            $.redact = redact;
+            return this;
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(String... redact) {
+            return redact(List.of(redact));
+        }
+
+        /**
+         * @param redactOutputs If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+         * to false, in which case outputs that might contain secrets can be marked as secret via
+         * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redactOutputs(@Nullable Boolean redactOutputs) {
+            $.redactOutputs = redactOutputs;
+            return this;
+        }
+
+        /**
+         * @param stderrSeverity The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stderrSeverity(@Nullable LogSeverity stderrSeverity) {
+            $.stderrSeverity = stderrSeverity;
+            return this;
+        }
+
         /**
          * @param stdin Pass a string to the command&#39;s process as standard in
          * 
@@ -478,6 +797,60 @@ public final class RunPlainArgs extends com.pulumi.resources.InvokeArgs {
             return this;
         }
 
+        /**
+         * @param stdoutSeverity The severity with which the lines of stdout are logged. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdoutSeverity(@Nullable LogSeverity stdoutSeverity) {
+            $.stdoutSeverity = stdoutSeverity;
+            return this;
+        }
+
+        /**
+         * @param stripWorkflowCommands If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+         * to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripWorkflowCommands(@Nullable Boolean stripWorkflowCommands) {
+            $.stripWorkflowCommands = stripWorkflowCommands;
+            return this;
+        }
+
+        /**
+         * @param symlinks How symlinks are handled when matching `assetPaths` and `archivePaths`.
+         * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+         * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+         * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+         * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+         * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+         * a directory isn&#39;t searched.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder symlinks(@Nullable SymlinkPolicy symlinks) {
+            $.symlinks = symlinks;
+            return this;
+        }
+
+        /**
+         * @param workflowCommands Run the workflow commands among the lines of stdout, modeled on those of
+         * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+         * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+         * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workflowCommands(@Nullable Boolean workflowCommands) {
+            $.workflowCommands = workflowCommands;
+            return this;
+        }
+
         public RunPlainArgs build() {
             if ($.command == null) {
                 throw new MissingRequiredPropertyException("RunPlainArgs", "command");
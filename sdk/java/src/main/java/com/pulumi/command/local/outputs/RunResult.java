@@ -5,10 +5,14 @@ package com.pulumi.command.local.outputs;
 
 import com.pulumi.asset.Archive;
 import com.pulumi.asset.AssetOrArchive;
+import com.pulumi.command.local.enums.LogSeverity;
 import com.pulumi.command.local.enums.Logging;
+import com.pulumi.command.local.enums.SymlinkPolicy;
+import com.pulumi.command.local.outputs.Pty;
 import com.pulumi.core.annotations.CustomType;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
 import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.String;
 import java.util.List;
 import java.util.Map;
@@ -98,12 +102,31 @@ public final class RunResult {
      * 
      */
     private @Nullable Map<String,String> environment;
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    private @Nullable Integer errorOutputLines;
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    private @Nullable Integer heartbeatInterval;
     /**
      * @return The program and arguments to run the command.
      * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
      * 
      */
     private @Nullable List<String> interpreter;
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    private @Nullable Boolean logStreamPrefix;
     /**
      * @return If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -111,11 +134,37 @@ public final class RunResult {
      * 
      */
     private @Nullable Logging logging;
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    private @Nullable Pty pty;
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    private @Nullable List<String> redact;
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    private @Nullable Boolean redactOutputs;
     /**
      * @return The standard error of the command&#39;s process
      * 
      */
     private String stderr;
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    private @Nullable LogSeverity stderrSeverity;
     /**
      * @return Pass a string to the command&#39;s process as standard in
      * 
@@ -126,6 +175,36 @@ public final class RunResult {
      * 
      */
     private String stdout;
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    private @Nullable LogSeverity stdoutSeverity;
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    private @Nullable Boolean stripWorkflowCommands;
+    /**
+     * @return How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    private @Nullable SymlinkPolicy symlinks;
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    private @Nullable Boolean workflowCommands;
 
     private RunResult() {}
     /**
@@ -224,6 +303,24 @@ public final class RunResult {
     public Map<String,String> environment() {
         return this.environment == null ? Map.of() : this.environment;
     }
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    public Optional<Integer> errorOutputLines() {
+        return Optional.ofNullable(this.errorOutputLines);
+    }
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    public Optional<Integer> heartbeatInterval() {
+        return Optional.ofNullable(this.heartbeatInterval);
+    }
     /**
      * @return The program and arguments to run the command.
      * On Linux and macOS, defaults to: `[&#34;/bin/sh&#34;, &#34;-c&#34;]`. On Windows, defaults to: `[&#34;cmd&#34;, &#34;/C&#34;]`
@@ -232,6 +329,13 @@ public final class RunResult {
     public List<String> interpreter() {
         return this.interpreter == null ? List.of() : this.interpreter;
     }
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    public Optional<Boolean> logStreamPrefix() {
+        return Optional.ofNullable(this.logStreamPrefix);
+    }
     /**
      * @return If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -241,6 +345,33 @@ public final class RunResult {
     public Optional<Logging> logging() {
         return Optional.ofNullable(this.logging);
     }
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     * 
+     */
+    public Optional<Pty> pty() {
+        return Optional.ofNullable(this.pty);
+    }
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    public List<String> redact() {
+        return this.redact == null ? List.of() : this.redact;
+    }
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    public Optional<Boolean> redactOutputs() {
+        return Optional.ofNullable(this.redactOutputs);
+    }
     /**
      * @return The standard error of the command&#39;s process
      * 
@@ -248,6 +379,13 @@ public final class RunResult {
     public String stderr() {
         return this.stderr;
     }
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    public Optional<LogSeverity> stderrSeverity() {
+        return Optional.ofNullable(this.stderrSeverity);
+    }
     /**
      * @return Pass a string to the command&#39;s process as standard in
      * 
@@ -262,6 +400,44 @@ public final class RunResult {
     public String stdout() {
         return this.stdout;
     }
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    public Optional<LogSeverity> stdoutSeverity() {
+        return Optional.ofNullable(this.stdoutSeverity);
+    }
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    public Optional<Boolean> stripWorkflowCommands() {
+        return Optional.ofNullable(this.stripWorkflowCommands);
+    }
+    /**
+     * @return How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can&#39;t represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn&#39;t
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn&#39;t searched.
+     * 
+     */
+    public Optional<SymlinkPolicy> symlinks() {
+        return Optional.ofNullable(this.symlinks);
+    }
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    public Optional<Boolean> workflowCommands() {
+        return Optional.ofNullable(this.workflowCommands);
+    }
 
     public static Builder builder() {
         return new Builder();
@@ -280,11 +456,22 @@ public final class RunResult {
         private String command;
         private @Nullable String dir;
         private @Nullable Map<String,String> environment;
+        private @Nullable Integer errorOutputLines;
+        private @Nullable Integer heartbeatInterval;
         private @Nullable List<String> interpreter;
+        private @Nullable Boolean logStreamPrefix;
         private @Nullable Logging logging;
+        private @Nullable Pty pty;
+        private @Nullable List<String> redact;
+        private @Nullable Boolean redactOutputs;
         private String stderr;
+        private @Nullable LogSeverity stderrSeverity;
         private @Nullable String stdin;
         private String stdout;
+        private @Nullable LogSeverity stdoutSeverity;
+        private @Nullable Boolean stripWorkflowCommands;
+        private @Nullable SymlinkPolicy symlinks;
+        private @Nullable Boolean workflowCommands;
         public Builder() {}
         public Builder(RunResult defaults) {
     	      Objects.requireNonNull(defaults);
@@ -296,11 +483,22 @@ public final class RunResult {
     	      this.command = defaults.command;
     	      this.dir = defaults.dir;
     	      this.environment = defaults.environment;
+    	      this.errorOutputLines = defaults.errorOutputLines;
+    	      this.heartbeatInterval = defaults.heartbeatInterval;
     	      this.interpreter = defaults.interpreter;
+    	      this.logStreamPrefix = defaults.logStreamPrefix;
     	      this.logging = defaults.logging;
+    	      this.pty = defaults.pty;
+    	      this.redact = defaults.redact;
+    	      this.redactOutputs = defaults.redactOutputs;
     	      this.stderr = defaults.stderr;
+    	      this.stderrSeverity = defaults.stderrSeverity;
     	      this.stdin = defaults.stdin;
     	      this.stdout = defaults.stdout;
+    	      this.stdoutSeverity = defaults.stdoutSeverity;
+    	      this.stripWorkflowCommands = defaults.stripWorkflowCommands;
+    	      this.symlinks = defaults.symlinks;
+    	      this.workflowCommands = defaults.workflowCommands;
         }
 
         @CustomType.Setter
@@ -360,6 +558,18 @@ public final class RunResult {
             return this;
         }
         @CustomType.Setter
+        public Builder errorOutputLines(@Nullable Integer errorOutputLines) {
+
+            this.errorOutputLines = errorOutputLines;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder heartbeatInterval(@Nullable Integer heartbeatInterval) {
+
+            this.heartbeatInterval = heartbeatInterval;
+            return this;
+        }
+        @CustomType.Setter
         public Builder interpreter(@Nullable List<String> interpreter) {
 
             this.interpreter = interpreter;
@@ -369,12 +579,39 @@ public final class RunResult {
             return interpreter(List.of(interpreter));
         }
         @CustomType.Setter
+        public Builder logStreamPrefix(@Nullable Boolean logStreamPrefix) {
+
+            this.logStreamPrefix = logStreamPrefix;
+            return this;
+        }
+        @CustomType.Setter
         public Builder logging(@Nullable Logging logging) {
 
             this.logging = logging;
             return this;
         }
         @CustomType.Setter
+        public Builder pty(@Nullable Pty pty) {
+
+            this.pty = pty;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder redact(@Nullable List<String> redact) {
+
+            this.redact = redact;
+            return this;
+        }
+        public Builder redact(String... redact) {
+            return redact(List.of(redact));
+        }
+        @CustomType.Setter
+        public Builder redactOutputs(@Nullable Boolean redactOutputs) {
+
+            this.redactOutputs = redactOutputs;
+            return this;
+        }
+        @CustomType.Setter
         public Builder stderr(String stderr) {
             if (stderr == null) {
               throw new MissingRequiredPropertyException("RunResult", "stderr");
@@ -383,6 +620,12 @@ public final class RunResult {
             return this;
         }
         @CustomType.Setter
+        public Builder stderrSeverity(@Nullable LogSeverity stderrSeverity) {
+
+            this.stderrSeverity = stderrSeverity;
+            return this;
+        }
+        @CustomType.Setter
         public Builder stdin(@Nullable String stdin) {
 
             this.stdin = stdin;
@@ -396,6 +639,30 @@ public final class RunResult {
             this.stdout = stdout;
             return this;
         }
+        @CustomType.Setter
+        public Builder stdoutSeverity(@Nullable LogSeverity stdoutSeverity) {
+
+            this.stdoutSeverity = stdoutSeverity;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder stripWorkflowCommands(@Nullable Boolean stripWorkflowCommands) {
+
+            this.stripWorkflowCommands = stripWorkflowCommands;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder symlinks(@Nullable SymlinkPolicy symlinks) {
+
+            this.symlinks = symlinks;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder workflowCommands(@Nullable Boolean workflowCommands) {
+
+            this.workflowCommands = workflowCommands;
+            return this;
+        }
         public RunResult build() {
             final var _resultValue = new RunResult();
             _resultValue.addPreviousOutputInEnv = addPreviousOutputInEnv;
@@ -406,11 +673,22 @@ public final class RunResult {
             _resultValue.command = command;
             _resultValue.dir = dir;
             _resultValue.environment = environment;
+            _resultValue.errorOutputLines = errorOutputLines;
+            _resultValue.heartbeatInterval = heartbeatInterval;
             _resultValue.interpreter = interpreter;
+            _resultValue.logStreamPrefix = logStreamPrefix;
             _resultValue.logging = logging;
+            _resultValue.pty = pty;
+            _resultValue.redact = redact;
+            _resultValue.redactOutputs = redactOutputs;
             _resultValue.stderr = stderr;
+            _resultValue.stderrSeverity = stderrSeverity;
             _resultValue.stdin = stdin;
             _resultValue.stdout = stdout;
+            _resultValue.stdoutSeverity = stdoutSeverity;
+            _resultValue.stripWorkflowCommands = stripWorkflowCommands;
+            _resultValue.symlinks = symlinks;
+            _resultValue.workflowCommands = workflowCommands;
             return _resultValue;
         }
     }
@@ -5,13 +5,19 @@ package com.pulumi.command.remote;
 
 import com.pulumi.command.Utilities;
 import com.pulumi.command.remote.CommandArgs;
+import com.pulumi.command.remote.enums.BecomeMethod;
+import com.pulumi.command.remote.enums.EnvironmentMode;
+import com.pulumi.command.remote.enums.LogSeverity;
 import com.pulumi.command.remote.enums.Logging;
 import com.pulumi.command.remote.outputs.Connection;
+import com.pulumi.command.remote.outputs.Detached;
+import com.pulumi.command.remote.outputs.Pty;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Export;
 import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
 import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
@@ -123,6 +129,72 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<Boolean>> addPreviousOutputInEnv() {
         return Codegen.optional(this.addPreviousOutputInEnv);
     }
+    /**
+     * Run the command as another user, root by default, via the escalation tool set in
+     * &#39;becomeMethod&#39;. The command is run by &#39;sh&#39; as that user. Unlike prefixing the command with &#39;sudo&#39;, this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     * 
+     */
+    @Export(name="become", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> become;
+
+    /**
+     * @return Run the command as another user, root by default, via the escalation tool set in
+     * &#39;becomeMethod&#39;. The command is run by &#39;sh&#39; as that user. Unlike prefixing the command with &#39;sudo&#39;, this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     * 
+     */
+    public Output<Optional<Boolean>> become() {
+        return Codegen.optional(this.become);
+    }
+    /**
+     * The escalation tool to use with `become`. Defaults to `sudo`.
+     * 
+     */
+    @Export(name="becomeMethod", refs={BecomeMethod.class}, tree="[0]")
+    private Output</* @Nullable */ BecomeMethod> becomeMethod;
+
+    /**
+     * @return The escalation tool to use with `become`. Defaults to `sudo`.
+     * 
+     */
+    public Output<Optional<BecomeMethod>> becomeMethod() {
+        return Codegen.optional(this.becomeMethod);
+    }
+    /**
+     * The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command&#39;s output.
+     * 
+     */
+    @Export(name="becomePassword", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> becomePassword;
+
+    /**
+     * @return The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command&#39;s output.
+     * 
+     */
+    public Output<Optional<String>> becomePassword() {
+        return Codegen.optional(this.becomePassword);
+    }
+    /**
+     * The user to run the command as with `become`. Defaults to `root`.
+     * 
+     */
+    @Export(name="becomeUser", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> becomeUser;
+
+    /**
+     * @return The user to run the command as with `become`. Defaults to `root`.
+     * 
+     */
+    public Output<Optional<String>> becomeUser() {
+        return Codegen.optional(this.becomeUser);
+    }
     /**
      * The parameters with which to connect to the remote host.
      * 
@@ -181,11 +253,56 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<String>> delete() {
         return Codegen.optional(this.delete);
     }
+    /**
+     * Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of &#39;dialErrorLimit&#39; and &#39;perDialTimeout&#39;. The output is logged as it&#39;s
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a &#39;timeout&#39; still stops it.
+     * Requires a POSIX shell on the remote host, and can&#39;t be combined with &#39;pty&#39; or &#39;becomePassword&#39;.
+     * 
+     */
+    @Export(name="detached", refs={Detached.class}, tree="[0]")
+    private Output</* @Nullable */ Detached> detached;
+
+    /**
+     * @return Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of &#39;dialErrorLimit&#39; and &#39;perDialTimeout&#39;. The output is logged as it&#39;s
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a &#39;timeout&#39; still stops it.
+     * Requires a POSIX shell on the remote host, and can&#39;t be combined with &#39;pty&#39; or &#39;becomePassword&#39;.
+     * 
+     */
+    public Output<Optional<Detached>> detached() {
+        return Codegen.optional(this.detached);
+    }
+    /**
+     * The directory on the remote host to run the command in. A leading &#39;~&#39; is the home
+     * directory of the user. If &#39;dir&#39; doesn&#39;t exist, the command isn&#39;t run and &#39;Command&#39; fails. Requires a POSIX shell
+     * on the remote host.
+     * 
+     */
+    @Export(name="dir", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> dir;
+
+    /**
+     * @return The directory on the remote host to run the command in. A leading &#39;~&#39; is the home
+     * directory of the user. If &#39;dir&#39; doesn&#39;t exist, the command isn&#39;t run and &#39;Command&#39; fails. Requires a POSIX shell
+     * on the remote host.
+     * 
+     */
+    public Output<Optional<String>> dir() {
+        return Codegen.optional(this.dir);
+    }
     /**
      * Additional environment variables available to the command&#39;s process.
-     * Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-     * Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-     * with the variables in the form &#39;VAR=value command&#39;.
+     * How they are passed to the command is set by &#39;environmentMode&#39;. With &#39;become&#39;, the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn&#39;t needed.
      * 
      */
     @Export(name="environment", refs={Map.class,String.class}, tree="[0,1,1]")
@@ -193,14 +310,101 @@ public class Command extends com.pulumi.resources.CustomResource {
 
     /**
      * @return Additional environment variables available to the command&#39;s process.
-     * Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-     * Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-     * with the variables in the form &#39;VAR=value command&#39;.
+     * How they are passed to the command is set by &#39;environmentMode&#39;. With &#39;become&#39;, the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn&#39;t needed.
      * 
      */
     public Output<Optional<Map<String,String>>> environment() {
         return Codegen.optional(this.environment);
     }
+    /**
+     * How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. &#39;setenv&#39; sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. &#39;export&#39; quotes the variables and prepends
+     * them to the command as &#39;export&#39; statements, which requires a POSIX shell on the remote host. &#39;auto&#39; tries
+     * &#39;setenv&#39; and exports the variables that the server rejects. Defaults to &#39;setenv&#39;.
+     * 
+     */
+    @Export(name="environmentMode", refs={EnvironmentMode.class}, tree="[0]")
+    private Output</* @Nullable */ EnvironmentMode> environmentMode;
+
+    /**
+     * @return How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. &#39;setenv&#39; sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. &#39;export&#39; quotes the variables and prepends
+     * them to the command as &#39;export&#39; statements, which requires a POSIX shell on the remote host. &#39;auto&#39; tries
+     * &#39;setenv&#39; and exports the variables that the server rejects. Defaults to &#39;setenv&#39;.
+     * 
+     */
+    public Output<Optional<EnvironmentMode>> environmentMode() {
+        return Codegen.optional(this.environmentMode);
+    }
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    @Export(name="errorOutputLines", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> errorOutputLines;
+
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    public Output<Optional<Integer>> errorOutputLines() {
+        return Codegen.optional(this.errorOutputLines);
+    }
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    @Export(name="heartbeatInterval", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> heartbeatInterval;
+
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    public Output<Optional<Integer>> heartbeatInterval() {
+        return Codegen.optional(this.heartbeatInterval);
+    }
+    /**
+     * The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     * 
+     */
+    @Export(name="interpreter", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> interpreter;
+
+    /**
+     * @return The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     * 
+     */
+    public Output<Optional<List<String>>> interpreter() {
+        return Codegen.optional(this.interpreter);
+    }
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    @Export(name="logStreamPrefix", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> logStreamPrefix;
+
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    public Output<Optional<Boolean>> logStreamPrefix() {
+        return Codegen.optional(this.logStreamPrefix);
+    }
     /**
      * If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
@@ -219,6 +423,78 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<Logging>> logging() {
         return Codegen.optional(this.logging);
     }
+    /**
+     * Run the command in a login shell, i.e. &#39;$SHELL -l -c&#39;, so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     * 
+     */
+    @Export(name="loginShell", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> loginShell;
+
+    /**
+     * @return Run the command in a login shell, i.e. &#39;$SHELL -l -c&#39;, so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     * 
+     */
+    public Output<Optional<Boolean>> loginShell() {
+        return Codegen.optional(this.loginShell);
+    }
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     * 
+     */
+    @Export(name="pty", refs={Pty.class}, tree="[0]")
+    private Output</* @Nullable */ Pty> pty;
+
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     * 
+     */
+    public Output<Optional<Pty>> pty() {
+        return Codegen.optional(this.pty);
+    }
+    /**
+     * Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+     * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    @Export(name="redact", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> redact;
+
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+     * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    public Output<Optional<List<String>>> redact() {
+        return Codegen.optional(this.redact);
+    }
+    /**
+     * If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    @Export(name="redactOutputs", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> redactOutputs;
+
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    public Output<Optional<Boolean>> redactOutputs() {
+        return Codegen.optional(this.redactOutputs);
+    }
     /**
      * The standard error of the command&#39;s process
      * 
@@ -233,6 +509,20 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<String> stderr() {
         return this.stderr;
     }
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    @Export(name="stderrSeverity", refs={LogSeverity.class}, tree="[0]")
+    private Output</* @Nullable */ LogSeverity> stderrSeverity;
+
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    public Output<Optional<LogSeverity>> stderrSeverity() {
+        return Codegen.optional(this.stderrSeverity);
+    }
     /**
      * Pass a string to the command&#39;s process as standard in
      * 
@@ -261,6 +551,58 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<String> stdout() {
         return this.stdout;
     }
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    @Export(name="stdoutSeverity", refs={LogSeverity.class}, tree="[0]")
+    private Output</* @Nullable */ LogSeverity> stdoutSeverity;
+
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    public Output<Optional<LogSeverity>> stdoutSeverity() {
+        return Codegen.optional(this.stdoutSeverity);
+    }
+    /**
+     * If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    @Export(name="stripWorkflowCommands", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> stripWorkflowCommands;
+
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    public Output<Optional<Boolean>> stripWorkflowCommands() {
+        return Codegen.optional(this.stripWorkflowCommands);
+    }
+    /**
+     * The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn&#39;t exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A &#39;detached&#39; command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     * 
+     */
+    @Export(name="timeout", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> timeout;
+
+    /**
+     * @return The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn&#39;t exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A &#39;detached&#39; command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     * 
+     */
+    public Output<Optional<Integer>> timeout() {
+        return Codegen.optional(this.timeout);
+    }
     /**
      * The resource will be updated (or replaced) if any of these values change.
      * 
@@ -317,6 +659,26 @@ public class Command extends com.pulumi.resources.CustomResource {
     public Output<Optional<String>> update() {
         return Codegen.optional(this.update);
     }
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    @Export(name="workflowCommands", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> workflowCommands;
+
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    public Output<Optional<Boolean>> workflowCommands() {
+        return Codegen.optional(this.workflowCommands);
+    }
 
     /**
      *
@@ -358,7 +720,9 @@ public class Command extends com.pulumi.resources.CustomResource {
         var defaultOptions = com.pulumi.resources.CustomResourceOptions.builder()
             .version(Utilities.getVersion())
             .additionalSecretOutputs(List.of(
-                "connection"
+                "becomePassword",
+                "connection",
+                "redact"
             ))
             .replaceOnChanges(List.of(
                 "triggers[*]"
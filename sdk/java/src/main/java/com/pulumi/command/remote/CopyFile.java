@@ -5,11 +5,13 @@ package com.pulumi.command.remote;
 
 import com.pulumi.command.Utilities;
 import com.pulumi.command.remote.CopyFileArgs;
+import com.pulumi.command.remote.enums.Transport;
 import com.pulumi.command.remote.outputs.Connection;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Export;
 import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
+import java.lang.Boolean;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
@@ -26,6 +28,20 @@ import javax.annotation.Nullable;
 @Deprecated /* This resource is deprecated and will be removed in a future release. Please use the `CopyToRemote` resource instead. */
 @ResourceType(type="command:remote:CopyFile")
 public class CopyFile extends com.pulumi.resources.CustomResource {
+    /**
+     * Write the file as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+     * 
+     */
+    @Export(name="become", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> become;
+
+    /**
+     * @return Write the file as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+     * 
+     */
+    public Output<Optional<Boolean>> become() {
+        return Codegen.optional(this.become);
+    }
     /**
      * The parameters with which to connect to the remote host.
      * 
@@ -68,6 +84,20 @@ public class CopyFile extends com.pulumi.resources.CustomResource {
     public Output<String> remotePath() {
         return this.remotePath;
     }
+    /**
+     * How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+     * 
+     */
+    @Export(name="transport", refs={Transport.class}, tree="[0]")
+    private Output</* @Nullable */ Transport> transport;
+
+    /**
+     * @return How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+     * 
+     */
+    public Output<Optional<Transport>> transport() {
+        return Codegen.optional(this.transport);
+    }
     /**
      * Trigger replacements on changes to this input.
      * 
@@ -3,10 +3,12 @@
 
 package com.pulumi.command.remote;
 
+import com.pulumi.command.remote.enums.Transport;
 import com.pulumi.command.remote.inputs.ConnectionArgs;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
@@ -19,6 +21,21 @@ public final class CopyFileArgs extends com.pulumi.resources.ResourceArgs {
 
     public static final CopyFileArgs Empty = new CopyFileArgs();
 
+    /**
+     * Write the file as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+     * 
+     */
+    @Import(name="become")
+    private @Nullable Output<Boolean> become;
+
+    /**
+     * @return Write the file as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+     * 
+     */
+    public Optional<Output<Boolean>> become() {
+        return Optional.ofNullable(this.become);
+    }
+
     /**
      * The parameters with which to connect to the remote host.
      * 
@@ -64,6 +81,21 @@ public final class CopyFileArgs extends com.pulumi.resources.ResourceArgs {
         return this.remotePath;
     }
 
+    /**
+     * How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+     * 
+     */
+    @Import(name="transport")
+    private @Nullable Output<Transport> transport;
+
+    /**
+     * @return How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+     * 
+     */
+    public Optional<Output<Transport>> transport() {
+        return Optional.ofNullable(this.transport);
+    }
+
     /**
      * Trigger replacements on changes to this input.
      * 
@@ -82,9 +114,11 @@ public final class CopyFileArgs extends com.pulumi.resources.ResourceArgs {
     private CopyFileArgs() {}
 
     private CopyFileArgs(CopyFileArgs $) {
+        this.become = $.become;
         this.connection = $.connection;
         this.localPath = $.localPath;
         this.remotePath = $.remotePath;
+        this.transport = $.transport;
         this.triggers = $.triggers;
     }
 
@@ -106,6 +140,27 @@ public final class CopyFileArgs extends com.pulumi.resources.ResourceArgs {
             $ = new CopyFileArgs(Objects.requireNonNull(defaults));
         }
 
+        /**
+         * @param become Write the file as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder become(@Nullable Output<Boolean> become) {
+            $.become = become;
+            return this;
+        }
+
+        /**
+         * @param become Write the file as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder become(Boolean become) {
+            return become(Output.of(become));
+        }
+
         /**
          * @param connection The parameters with which to connect to the remote host.
          * 
@@ -169,6 +224,27 @@ public final class CopyFileArgs extends com.pulumi.resources.ResourceArgs {
             return remotePath(Output.of(remotePath));
         }
 
+        /**
+         * @param transport How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder transport(@Nullable Output<Transport> transport) {
+            $.transport = transport;
+            return this;
+        }
+
+        /**
+         * @param transport How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder transport(Transport transport) {
+            return transport(Output.of(transport));
+        }
+
         /**
          * @param triggers Trigger replacements on changes to this input.
          * 
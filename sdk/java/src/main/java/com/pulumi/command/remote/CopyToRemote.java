@@ -6,14 +6,20 @@ package com.pulumi.command.remote;
 import com.pulumi.asset.AssetOrArchive;
 import com.pulumi.command.Utilities;
 import com.pulumi.command.remote.CopyToRemoteArgs;
+import com.pulumi.command.remote.enums.AtomicMode;
+import com.pulumi.command.remote.enums.SymlinkPolicy;
+import com.pulumi.command.remote.enums.Transport;
 import com.pulumi.command.remote.outputs.Connection;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Export;
 import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
+import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
+import java.util.Map;
 import java.util.Optional;
 import javax.annotation.Nullable;
 
@@ -93,6 +99,34 @@ import javax.annotation.Nullable;
  */
 @ResourceType(type="command:remote:CopyToRemote")
 public class CopyToRemote extends com.pulumi.resources.CustomResource {
+    /**
+     * How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+     * 
+     */
+    @Export(name="atomic", refs={AtomicMode.class}, tree="[0]")
+    private Output</* @Nullable */ AtomicMode> atomic;
+
+    /**
+     * @return How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+     * 
+     */
+    public Output<Optional<AtomicMode>> atomic() {
+        return Codegen.optional(this.atomic);
+    }
+    /**
+     * Write the files as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server&#39;s subsystem. The copied files are owned by root.
+     * 
+     */
+    @Export(name="become", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> become;
+
+    /**
+     * @return Write the files as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server&#39;s subsystem. The copied files are owned by root.
+     * 
+     */
+    public Output<Optional<Boolean>> become() {
+        return Codegen.optional(this.become);
+    }
     /**
      * The parameters with which to connect to the remote host.
      * 
@@ -107,6 +141,104 @@ public class CopyToRemote extends com.pulumi.resources.CustomResource {
     public Output<Connection> connection() {
         return this.connection;
     }
+    /**
+     * The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+     * 
+     */
+    @Export(name="drift", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> drift;
+
+    /**
+     * @return The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+     * 
+     */
+    public Output<Optional<List<String>>> drift() {
+        return Codegen.optional(this.drift);
+    }
+    /**
+     * A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+     * 
+     */
+    @Export(name="exclude", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> exclude;
+
+    /**
+     * @return A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+     * 
+     */
+    public Output<Optional<List<String>>> exclude() {
+        return Codegen.optional(this.exclude);
+    }
+    /**
+     * If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+     * 
+     */
+    @Export(name="extract", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> extract;
+
+    /**
+     * @return If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+     * 
+     */
+    public Output<Optional<Boolean>> extract() {
+        return Codegen.optional(this.extract);
+    }
+    /**
+     * If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync{@literal @}openssh.com extension.
+     * 
+     */
+    @Export(name="fsync", refs={Boolean.class}, tree="[0]")
+    private Output</* @Nullable */ Boolean> fsync;
+
+    /**
+     * @return If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync{@literal @}openssh.com extension.
+     * 
+     */
+    public Output<Optional<Boolean>> fsync() {
+        return Codegen.optional(this.fsync);
+    }
+    /**
+     * A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+     * 
+     */
+    @Export(name="include", refs={List.class,String.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<String>> include;
+
+    /**
+     * @return A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+     * 
+     */
+    public Output<Optional<List<String>>> include() {
+        return Codegen.optional(this.include);
+    }
+    /**
+     * The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+     * 
+     */
+    @Export(name="manifest", refs={Map.class,String.class}, tree="[0,1,1]")
+    private Output</* @Nullable */ Map<String,String>> manifest;
+
+    /**
+     * @return The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+     * 
+     */
+    public Output<Optional<Map<String,String>>> manifest() {
+        return Codegen.optional(this.manifest);
+    }
+    /**
+     * The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+     * 
+     */
+    @Export(name="parallelism", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> parallelism;
+
+    /**
+     * @return The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+     * 
+     */
+    public Output<Optional<Integer>> parallelism() {
+        return Codegen.optional(this.parallelism);
+    }
     /**
      * The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
      * 
@@ -122,19 +254,47 @@ public class CopyToRemote extends com.pulumi.resources.CustomResource {
         return this.remotePath;
     }
     /**
-     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
      * 
      */
     @Export(name="source", refs={AssetOrArchive.class}, tree="[0]")
     private Output<AssetOrArchive> source;
 
     /**
-     * @return An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+     * @return An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
      * 
      */
     public Output<AssetOrArchive> source() {
         return this.source;
     }
+    /**
+     * How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren&#39;t copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+     * 
+     */
+    @Export(name="symlinks", refs={SymlinkPolicy.class}, tree="[0]")
+    private Output</* @Nullable */ SymlinkPolicy> symlinks;
+
+    /**
+     * @return How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren&#39;t copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+     * 
+     */
+    public Output<Optional<SymlinkPolicy>> symlinks() {
+        return Codegen.optional(this.symlinks);
+    }
+    /**
+     * How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can&#39;t create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don&#39;t support `atomic` copies, and `parallelism` is ignored. Files copied with them aren&#39;t recorded in the `manifest`, so their drift isn&#39;t detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+     * 
+     */
+    @Export(name="transport", refs={Transport.class}, tree="[0]")
+    private Output</* @Nullable */ Transport> transport;
+
+    /**
+     * @return How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can&#39;t create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don&#39;t support `atomic` copies, and `parallelism` is ignored. Files copied with them aren&#39;t recorded in the `manifest`, so their drift isn&#39;t detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+     * 
+     */
+    public Output<Optional<Transport>> transport() {
+        return Codegen.optional(this.transport);
+    }
     /**
      * Trigger replacements on changes to this input.
      * 
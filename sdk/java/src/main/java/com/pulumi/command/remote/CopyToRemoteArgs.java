@@ -4,10 +4,16 @@
 package com.pulumi.command.remote;
 
 import com.pulumi.asset.AssetOrArchive;
+import com.pulumi.command.remote.enums.AtomicMode;
+import com.pulumi.command.remote.enums.SymlinkPolicy;
+import com.pulumi.command.remote.enums.Transport;
 import com.pulumi.command.remote.inputs.ConnectionArgs;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Import;
+import com.pulumi.core.internal.Codegen;
 import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.lang.Integer;
 import java.lang.Object;
 import java.lang.String;
 import java.util.List;
@@ -20,6 +26,36 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
 
     public static final CopyToRemoteArgs Empty = new CopyToRemoteArgs();
 
+    /**
+     * How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+     * 
+     */
+    @Import(name="atomic")
+    private @Nullable Output<AtomicMode> atomic;
+
+    /**
+     * @return How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+     * 
+     */
+    public Optional<Output<AtomicMode>> atomic() {
+        return Optional.ofNullable(this.atomic);
+    }
+
+    /**
+     * Write the files as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server&#39;s subsystem. The copied files are owned by root.
+     * 
+     */
+    @Import(name="become")
+    private @Nullable Output<Boolean> become;
+
+    /**
+     * @return Write the files as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server&#39;s subsystem. The copied files are owned by root.
+     * 
+     */
+    public Optional<Output<Boolean>> become() {
+        return Optional.ofNullable(this.become);
+    }
+
     /**
      * The parameters with which to connect to the remote host.
      * 
@@ -35,6 +71,81 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
         return this.connection;
     }
 
+    /**
+     * A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+     * 
+     */
+    @Import(name="exclude")
+    private @Nullable Output<List<String>> exclude;
+
+    /**
+     * @return A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+     * 
+     */
+    public Optional<Output<List<String>>> exclude() {
+        return Optional.ofNullable(this.exclude);
+    }
+
+    /**
+     * If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+     * 
+     */
+    @Import(name="extract")
+    private @Nullable Output<Boolean> extract;
+
+    /**
+     * @return If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> extract() {
+        return Optional.ofNullable(this.extract);
+    }
+
+    /**
+     * If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync{@literal @}openssh.com extension.
+     * 
+     */
+    @Import(name="fsync")
+    private @Nullable Output<Boolean> fsync;
+
+    /**
+     * @return If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync{@literal @}openssh.com extension.
+     * 
+     */
+    public Optional<Output<Boolean>> fsync() {
+        return Optional.ofNullable(this.fsync);
+    }
+
+    /**
+     * A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+     * 
+     */
+    @Import(name="include")
+    private @Nullable Output<List<String>> include;
+
+    /**
+     * @return A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+     * 
+     */
+    public Optional<Output<List<String>>> include() {
+        return Optional.ofNullable(this.include);
+    }
+
+    /**
+     * The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+     * 
+     */
+    @Import(name="parallelism")
+    private @Nullable Output<Integer> parallelism;
+
+    /**
+     * @return The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+     * 
+     */
+    public Optional<Output<Integer>> parallelism() {
+        return Optional.ofNullable(this.parallelism);
+    }
+
     /**
      * The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
      * 
@@ -51,20 +162,50 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
     }
 
     /**
-     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
      * 
      */
     @Import(name="source", required=true)
     private Output<AssetOrArchive> source;
 
     /**
-     * @return An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+     * @return An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
      * 
      */
     public Output<AssetOrArchive> source() {
         return this.source;
     }
 
+    /**
+     * How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren&#39;t copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+     * 
+     */
+    @Import(name="symlinks")
+    private @Nullable Output<SymlinkPolicy> symlinks;
+
+    /**
+     * @return How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren&#39;t copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+     * 
+     */
+    public Optional<Output<SymlinkPolicy>> symlinks() {
+        return Optional.ofNullable(this.symlinks);
+    }
+
+    /**
+     * How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can&#39;t create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don&#39;t support `atomic` copies, and `parallelism` is ignored. Files copied with them aren&#39;t recorded in the `manifest`, so their drift isn&#39;t detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+     * 
+     */
+    @Import(name="transport")
+    private @Nullable Output<Transport> transport;
+
+    /**
+     * @return How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can&#39;t create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don&#39;t support `atomic` copies, and `parallelism` is ignored. Files copied with them aren&#39;t recorded in the `manifest`, so their drift isn&#39;t detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+     * 
+     */
+    public Optional<Output<Transport>> transport() {
+        return Optional.ofNullable(this.transport);
+    }
+
     /**
      * Trigger replacements on changes to this input.
      * 
@@ -83,9 +224,18 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
     private CopyToRemoteArgs() {}
 
     private CopyToRemoteArgs(CopyToRemoteArgs $) {
+        this.atomic = $.atomic;
+        this.become = $.become;
         this.connection = $.connection;
+        this.exclude = $.exclude;
+        this.extract = $.extract;
+        this.fsync = $.fsync;
+        this.include = $.include;
+        this.parallelism = $.parallelism;
         this.remotePath = $.remotePath;
         this.source = $.source;
+        this.symlinks = $.symlinks;
+        this.transport = $.transport;
         this.triggers = $.triggers;
     }
 
@@ -107,6 +257,48 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
             $ = new CopyToRemoteArgs(Objects.requireNonNull(defaults));
         }
 
+        /**
+         * @param atomic How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder atomic(@Nullable Output<AtomicMode> atomic) {
+            $.atomic = atomic;
+            return this;
+        }
+
+        /**
+         * @param atomic How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder atomic(AtomicMode atomic) {
+            return atomic(Output.of(atomic));
+        }
+
+        /**
+         * @param become Write the files as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server&#39;s subsystem. The copied files are owned by root.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder become(@Nullable Output<Boolean> become) {
+            $.become = become;
+            return this;
+        }
+
+        /**
+         * @param become Write the files as root via `sudo -n`, for destinations the login user can&#39;t write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server&#39;s subsystem. The copied files are owned by root.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder become(Boolean become) {
+            return become(Output.of(become));
+        }
+
         /**
          * @param connection The parameters with which to connect to the remote host.
          * 
@@ -128,6 +320,131 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
             return connection(Output.of(connection));
         }
 
+        /**
+         * @param exclude A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder exclude(@Nullable Output<List<String>> exclude) {
+            $.exclude = exclude;
+            return this;
+        }
+
+        /**
+         * @param exclude A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder exclude(List<String> exclude) {
+            return exclude(Output.of(exclude));
+        }
+
+        /**
+         * @param exclude A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder exclude(String... exclude) {
+            return exclude(List.of(exclude));
+        }
+
+        /**
+         * @param extract If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder extract(@Nullable Output<Boolean> extract) {
+            $.extract = extract;
+            return this;
+        }
+
+        /**
+         * @param extract If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder extract(Boolean extract) {
+            return extract(Output.of(extract));
+        }
+
+        /**
+         * @param fsync If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync{@literal @}openssh.com extension.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder fsync(@Nullable Output<Boolean> fsync) {
+            $.fsync = fsync;
+            return this;
+        }
+
+        /**
+         * @param fsync If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync{@literal @}openssh.com extension.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder fsync(Boolean fsync) {
+            return fsync(Output.of(fsync));
+        }
+
+        /**
+         * @param include A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder include(@Nullable Output<List<String>> include) {
+            $.include = include;
+            return this;
+        }
+
+        /**
+         * @param include A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder include(List<String> include) {
+            return include(Output.of(include));
+        }
+
+        /**
+         * @param include A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`&#39;s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder include(String... include) {
+            return include(List.of(include));
+        }
+
+        /**
+         * @param parallelism The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder parallelism(@Nullable Output<Integer> parallelism) {
+            $.parallelism = parallelism;
+            return this;
+        }
+
+        /**
+         * @param parallelism The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder parallelism(Integer parallelism) {
+            return parallelism(Output.of(parallelism));
+        }
+
         /**
          * @param remotePath The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
          * 
@@ -150,7 +467,7 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
         }
 
         /**
-         * @param source An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+         * @param source An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
          * 
          * @return builder
          * 
@@ -161,7 +478,7 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
         }
 
         /**
-         * @param source An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+         * @param source An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
          * 
          * @return builder
          * 
@@ -170,6 +487,48 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
             return source(Output.of(source));
         }
 
+        /**
+         * @param symlinks How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren&#39;t copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder symlinks(@Nullable Output<SymlinkPolicy> symlinks) {
+            $.symlinks = symlinks;
+            return this;
+        }
+
+        /**
+         * @param symlinks How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren&#39;t copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder symlinks(SymlinkPolicy symlinks) {
+            return symlinks(Output.of(symlinks));
+        }
+
+        /**
+         * @param transport How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can&#39;t create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don&#39;t support `atomic` copies, and `parallelism` is ignored. Files copied with them aren&#39;t recorded in the `manifest`, so their drift isn&#39;t detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder transport(@Nullable Output<Transport> transport) {
+            $.transport = transport;
+            return this;
+        }
+
+        /**
+         * @param transport How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can&#39;t create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don&#39;t support `atomic` copies, and `parallelism` is ignored. Files copied with them aren&#39;t recorded in the `manifest`, so their drift isn&#39;t detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder transport(Transport transport) {
+            return transport(Output.of(transport));
+        }
+
         /**
          * @param triggers Trigger replacements on changes to this input.
          * 
@@ -205,6 +564,7 @@ public final class CopyToRemoteArgs extends com.pulumi.resources.ResourceArgs {
             if ($.connection == null) {
                 throw new MissingRequiredPropertyException("CopyToRemoteArgs", "connection");
             }
+            $.parallelism = Codegen.integerProp("parallelism").output().arg($.parallelism).def(1).getNullable();
             if ($.remotePath == null) {
                 throw new MissingRequiredPropertyException("CopyToRemoteArgs", "remotePath");
             }
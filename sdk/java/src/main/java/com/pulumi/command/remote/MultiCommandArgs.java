@@ -0,0 +1,1636 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote;
+
+import com.pulumi.command.remote.enums.BecomeMethod;
+import com.pulumi.command.remote.enums.EnvironmentMode;
+import com.pulumi.command.remote.enums.LogSeverity;
+import com.pulumi.command.remote.enums.Logging;
+import com.pulumi.command.remote.inputs.ConnectionArgs;
+import com.pulumi.command.remote.inputs.ConnectionTemplateArgs;
+import com.pulumi.command.remote.inputs.DetachedArgs;
+import com.pulumi.command.remote.inputs.PtyArgs;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.Object;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class MultiCommandArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final MultiCommandArgs Empty = new MultiCommandArgs();
+
+    /**
+     * If the previous command&#39;s stdout and stderr (as generated by the prior create/update) is
+     * injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+     * Defaults to true.
+     * 
+     */
+    @Import(name="addPreviousOutputInEnv")
+    private @Nullable Output<Boolean> addPreviousOutputInEnv;
+
+    /**
+     * @return If the previous command&#39;s stdout and stderr (as generated by the prior create/update) is
+     * injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+     * Defaults to true.
+     * 
+     */
+    public Optional<Output<Boolean>> addPreviousOutputInEnv() {
+        return Optional.ofNullable(this.addPreviousOutputInEnv);
+    }
+
+    /**
+     * The number of hosts the command is run on at the same time. The next batch of
+     * hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+     * 
+     */
+    @Import(name="batchSize")
+    private @Nullable Output<Integer> batchSize;
+
+    /**
+     * @return The number of hosts the command is run on at the same time. The next batch of
+     * hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+     * 
+     */
+    public Optional<Output<Integer>> batchSize() {
+        return Optional.ofNullable(this.batchSize);
+    }
+
+    /**
+     * Run the command as another user, root by default, via the escalation tool set in
+     * &#39;becomeMethod&#39;. The command is run by &#39;sh&#39; as that user. Unlike prefixing the command with &#39;sudo&#39;, this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     * 
+     */
+    @Import(name="become")
+    private @Nullable Output<Boolean> become;
+
+    /**
+     * @return Run the command as another user, root by default, via the escalation tool set in
+     * &#39;becomeMethod&#39;. The command is run by &#39;sh&#39; as that user. Unlike prefixing the command with &#39;sudo&#39;, this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     * 
+     */
+    public Optional<Output<Boolean>> become() {
+        return Optional.ofNullable(this.become);
+    }
+
+    /**
+     * The escalation tool to use with `become`. Defaults to `sudo`.
+     * 
+     */
+    @Import(name="becomeMethod")
+    private @Nullable Output<BecomeMethod> becomeMethod;
+
+    /**
+     * @return The escalation tool to use with `become`. Defaults to `sudo`.
+     * 
+     */
+    public Optional<Output<BecomeMethod>> becomeMethod() {
+        return Optional.ofNullable(this.becomeMethod);
+    }
+
+    /**
+     * The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command&#39;s output.
+     * 
+     */
+    @Import(name="becomePassword")
+    private @Nullable Output<String> becomePassword;
+
+    /**
+     * @return The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command&#39;s output.
+     * 
+     */
+    public Optional<Output<String>> becomePassword() {
+        return Optional.ofNullable(this.becomePassword);
+    }
+
+    /**
+     * The user to run the command as with `become`. Defaults to `root`.
+     * 
+     */
+    @Import(name="becomeUser")
+    private @Nullable Output<String> becomeUser;
+
+    /**
+     * @return The user to run the command as with `become`. Defaults to `root`.
+     * 
+     */
+    public Optional<Output<String>> becomeUser() {
+        return Optional.ofNullable(this.becomeUser);
+    }
+
+    /**
+     * The connection settings shared by the hosts in &#39;hosts&#39;.
+     * 
+     */
+    @Import(name="connection")
+    private @Nullable Output<ConnectionTemplateArgs> connection;
+
+    /**
+     * @return The connection settings shared by the hosts in &#39;hosts&#39;.
+     * 
+     */
+    public Optional<Output<ConnectionTemplateArgs>> connection() {
+        return Optional.ofNullable(this.connection);
+    }
+
+    /**
+     * The connections to the hosts to run the command on. Each host may only be
+     * listed once. Either &#39;connections&#39; or &#39;hosts&#39; must be set.
+     * 
+     */
+    @Import(name="connections")
+    private @Nullable Output<List<ConnectionArgs>> connections;
+
+    /**
+     * @return The connections to the hosts to run the command on. Each host may only be
+     * listed once. Either &#39;connections&#39; or &#39;hosts&#39; must be set.
+     * 
+     */
+    public Optional<Output<List<ConnectionArgs>>> connections() {
+        return Optional.ofNullable(this.connections);
+    }
+
+    /**
+     * The command to run once on resource creation.
+     * 
+     * If an `update` command isn&#39;t provided, then `create` will also be run when the resource&#39;s inputs are modified.
+     * 
+     * Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+     * 
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     * 
+     */
+    @Import(name="create")
+    private @Nullable Output<String> create;
+
+    /**
+     * @return The command to run once on resource creation.
+     * 
+     * If an `update` command isn&#39;t provided, then `create` will also be run when the resource&#39;s inputs are modified.
+     * 
+     * Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+     * 
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     * 
+     */
+    public Optional<Output<String>> create() {
+        return Optional.ofNullable(this.create);
+    }
+
+    /**
+     * The command to run on resource deletion.
+     * 
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+     * 
+     */
+    @Import(name="delete")
+    private @Nullable Output<String> delete;
+
+    /**
+     * @return The command to run on resource deletion.
+     * 
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+     * 
+     */
+    public Optional<Output<String>> delete() {
+        return Optional.ofNullable(this.delete);
+    }
+
+    /**
+     * Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of &#39;dialErrorLimit&#39; and &#39;perDialTimeout&#39;. The output is logged as it&#39;s
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a &#39;timeout&#39; still stops it.
+     * Requires a POSIX shell on the remote host, and can&#39;t be combined with &#39;pty&#39; or &#39;becomePassword&#39;.
+     * 
+     */
+    @Import(name="detached")
+    private @Nullable Output<DetachedArgs> detached;
+
+    /**
+     * @return Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of &#39;dialErrorLimit&#39; and &#39;perDialTimeout&#39;. The output is logged as it&#39;s
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a &#39;timeout&#39; still stops it.
+     * Requires a POSIX shell on the remote host, and can&#39;t be combined with &#39;pty&#39; or &#39;becomePassword&#39;.
+     * 
+     */
+    public Optional<Output<DetachedArgs>> detached() {
+        return Optional.ofNullable(this.detached);
+    }
+
+    /**
+     * The directory on the remote host to run the command in. A leading &#39;~&#39; is the home
+     * directory of the user. If &#39;dir&#39; doesn&#39;t exist, the command isn&#39;t run and &#39;Command&#39; fails. Requires a POSIX shell
+     * on the remote host.
+     * 
+     */
+    @Import(name="dir")
+    private @Nullable Output<String> dir;
+
+    /**
+     * @return The directory on the remote host to run the command in. A leading &#39;~&#39; is the home
+     * directory of the user. If &#39;dir&#39; doesn&#39;t exist, the command isn&#39;t run and &#39;Command&#39; fails. Requires a POSIX shell
+     * on the remote host.
+     * 
+     */
+    public Optional<Output<String>> dir() {
+        return Optional.ofNullable(this.dir);
+    }
+
+    /**
+     * Additional environment variables available to the command&#39;s process.
+     * How they are passed to the command is set by &#39;environmentMode&#39;. With &#39;become&#39;, the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn&#39;t needed.
+     * 
+     */
+    @Import(name="environment")
+    private @Nullable Output<Map<String,String>> environment;
+
+    /**
+     * @return Additional environment variables available to the command&#39;s process.
+     * How they are passed to the command is set by &#39;environmentMode&#39;. With &#39;become&#39;, the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn&#39;t needed.
+     * 
+     */
+    public Optional<Output<Map<String,String>>> environment() {
+        return Optional.ofNullable(this.environment);
+    }
+
+    /**
+     * How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. &#39;setenv&#39; sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. &#39;export&#39; quotes the variables and prepends
+     * them to the command as &#39;export&#39; statements, which requires a POSIX shell on the remote host. &#39;auto&#39; tries
+     * &#39;setenv&#39; and exports the variables that the server rejects. Defaults to &#39;setenv&#39;.
+     * 
+     */
+    @Import(name="environmentMode")
+    private @Nullable Output<EnvironmentMode> environmentMode;
+
+    /**
+     * @return How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. &#39;setenv&#39; sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. &#39;export&#39; quotes the variables and prepends
+     * them to the command as &#39;export&#39; statements, which requires a POSIX shell on the remote host. &#39;auto&#39; tries
+     * &#39;setenv&#39; and exports the variables that the server rejects. Defaults to &#39;setenv&#39;.
+     * 
+     */
+    public Optional<Output<EnvironmentMode>> environmentMode() {
+        return Optional.ofNullable(this.environmentMode);
+    }
+
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    @Import(name="errorOutputLines")
+    private @Nullable Output<Integer> errorOutputLines;
+
+    /**
+     * @return The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     * 
+     */
+    public Optional<Output<Integer>> errorOutputLines() {
+        return Optional.ofNullable(this.errorOutputLines);
+    }
+
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    @Import(name="heartbeatInterval")
+    private @Nullable Output<Integer> heartbeatInterval;
+
+    /**
+     * @return The number of seconds without output after which the status of the
+     * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+     * Defaults to 60.
+     * 
+     */
+    public Optional<Output<Integer>> heartbeatInterval() {
+        return Optional.ofNullable(this.heartbeatInterval);
+    }
+
+    /**
+     * The addresses of the hosts to run the command on, which are connected to with the
+     * settings of &#39;connection&#39;. An address may be followed by &#39;:&#39; and a port, e.g. &#39;10.0.0.5:2222&#39; or &#39;[::1]:2222&#39;,
+     * which overrides the port of &#39;connection&#39;. Each host may only be listed once. Either &#39;connections&#39; or &#39;hosts&#39;
+     * must be set.
+     * 
+     */
+    @Import(name="hosts")
+    private @Nullable Output<List<String>> hosts;
+
+    /**
+     * @return The addresses of the hosts to run the command on, which are connected to with the
+     * settings of &#39;connection&#39;. An address may be followed by &#39;:&#39; and a port, e.g. &#39;10.0.0.5:2222&#39; or &#39;[::1]:2222&#39;,
+     * which overrides the port of &#39;connection&#39;. Each host may only be listed once. Either &#39;connections&#39; or &#39;hosts&#39;
+     * must be set.
+     * 
+     */
+    public Optional<Output<List<String>>> hosts() {
+        return Optional.ofNullable(this.hosts);
+    }
+
+    /**
+     * The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     * 
+     */
+    @Import(name="interpreter")
+    private @Nullable Output<List<String>> interpreter;
+
+    /**
+     * @return The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     * 
+     */
+    public Optional<Output<List<String>>> interpreter() {
+        return Optional.ofNullable(this.interpreter);
+    }
+
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    @Import(name="logStreamPrefix")
+    private @Nullable Output<Boolean> logStreamPrefix;
+
+    /**
+     * @return If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> logStreamPrefix() {
+        return Optional.ofNullable(this.logStreamPrefix);
+    }
+
+    /**
+     * If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
+     * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+     * outputs as secret via &#39;additionalSecretOutputs&#39;. Defaults to logging both stdout and stderr.
+     * 
+     */
+    @Import(name="logging")
+    private @Nullable Output<Logging> logging;
+
+    /**
+     * @return If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
+     * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+     * outputs as secret via &#39;additionalSecretOutputs&#39;. Defaults to logging both stdout and stderr.
+     * 
+     */
+    public Optional<Output<Logging>> logging() {
+        return Optional.ofNullable(this.logging);
+    }
+
+    /**
+     * Run the command in a login shell, i.e. &#39;$SHELL -l -c&#39;, so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     * 
+     */
+    @Import(name="loginShell")
+    private @Nullable Output<Boolean> loginShell;
+
+    /**
+     * @return Run the command in a login shell, i.e. &#39;$SHELL -l -c&#39;, so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> loginShell() {
+        return Optional.ofNullable(this.loginShell);
+    }
+
+    /**
+     * The number of hosts the command may fail on. Once more hosts failed, no further
+     * batches are started and the resource fails. Defaults to 0.
+     * 
+     */
+    @Import(name="maxFailures")
+    private @Nullable Output<Integer> maxFailures;
+
+    /**
+     * @return The number of hosts the command may fail on. Once more hosts failed, no further
+     * batches are started and the resource fails. Defaults to 0.
+     * 
+     */
+    public Optional<Output<Integer>> maxFailures() {
+        return Optional.ofNullable(this.maxFailures);
+    }
+
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     * 
+     */
+    @Import(name="pty")
+    private @Nullable Output<PtyArgs> pty;
+
+    /**
+     * @return Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     * 
+     */
+    public Optional<Output<PtyArgs>> pty() {
+        return Optional.ofNullable(this.pty);
+    }
+
+    /**
+     * Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+     * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    @Import(name="redact")
+    private @Nullable Output<List<String>> redact;
+
+    /**
+     * @return Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+     * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+     * 
+     */
+    public Optional<Output<List<String>>> redact() {
+        return Optional.ofNullable(this.redact);
+    }
+
+    /**
+     * If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    @Import(name="redactOutputs")
+    private @Nullable Output<Boolean> redactOutputs;
+
+    /**
+     * @return If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+     * 
+     */
+    public Optional<Output<Boolean>> redactOutputs() {
+        return Optional.ofNullable(this.redactOutputs);
+    }
+
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    @Import(name="stderrSeverity")
+    private @Nullable Output<LogSeverity> stderrSeverity;
+
+    /**
+     * @return The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     * 
+     */
+    public Optional<Output<LogSeverity>> stderrSeverity() {
+        return Optional.ofNullable(this.stderrSeverity);
+    }
+
+    /**
+     * Pass a string to the command&#39;s process as standard in
+     * 
+     */
+    @Import(name="stdin")
+    private @Nullable Output<String> stdin;
+
+    /**
+     * @return Pass a string to the command&#39;s process as standard in
+     * 
+     */
+    public Optional<Output<String>> stdin() {
+        return Optional.ofNullable(this.stdin);
+    }
+
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    @Import(name="stdoutSeverity")
+    private @Nullable Output<LogSeverity> stdoutSeverity;
+
+    /**
+     * @return The severity with which the lines of stdout are logged. Defaults to `info`.
+     * 
+     */
+    public Optional<Output<LogSeverity>> stdoutSeverity() {
+        return Optional.ofNullable(this.stdoutSeverity);
+    }
+
+    /**
+     * If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    @Import(name="stripWorkflowCommands")
+    private @Nullable Output<Boolean> stripWorkflowCommands;
+
+    /**
+     * @return If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+     * to false.
+     * 
+     */
+    public Optional<Output<Boolean>> stripWorkflowCommands() {
+        return Optional.ofNullable(this.stripWorkflowCommands);
+    }
+
+    /**
+     * The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn&#39;t exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A &#39;detached&#39; command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     * 
+     */
+    @Import(name="timeout")
+    private @Nullable Output<Integer> timeout;
+
+    /**
+     * @return The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn&#39;t exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A &#39;detached&#39; command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     * 
+     */
+    public Optional<Output<Integer>> timeout() {
+        return Optional.ofNullable(this.timeout);
+    }
+
+    /**
+     * The resource will be updated (or replaced) if any of these values change.
+     * 
+     * The trigger values can be of any type.
+     * 
+     * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+     * 
+     * Please see the resource documentation for examples.
+     * 
+     */
+    @Import(name="triggers")
+    private @Nullable Output<List<Object>> triggers;
+
+    /**
+     * @return The resource will be updated (or replaced) if any of these values change.
+     * 
+     * The trigger values can be of any type.
+     * 
+     * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+     * 
+     * Please see the resource documentation for examples.
+     * 
+     */
+    public Optional<Output<List<Object>>> triggers() {
+        return Optional.ofNullable(this.triggers);
+    }
+
+    /**
+     * The command to run when the resource is updated.
+     * 
+     * If empty, the create command will be executed instead.
+     * 
+     * Note that this command will not run if the resource&#39;s inputs are unchanged.
+     * 
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     * 
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+     * 
+     */
+    @Import(name="update")
+    private @Nullable Output<String> update;
+
+    /**
+     * @return The command to run when the resource is updated.
+     * 
+     * If empty, the create command will be executed instead.
+     * 
+     * Note that this command will not run if the resource&#39;s inputs are unchanged.
+     * 
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     * 
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+     * 
+     */
+    public Optional<Output<String>> update() {
+        return Optional.ofNullable(this.update);
+    }
+
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    @Import(name="workflowCommands")
+    private @Nullable Output<Boolean> workflowCommands;
+
+    /**
+     * @return Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+     * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+     * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+     * 
+     */
+    public Optional<Output<Boolean>> workflowCommands() {
+        return Optional.ofNullable(this.workflowCommands);
+    }
+
+    private MultiCommandArgs() {}
+
+    private MultiCommandArgs(MultiCommandArgs $) {
+        this.addPreviousOutputInEnv = $.addPreviousOutputInEnv;
+        this.batchSize = $.batchSize;
+        this.become = $.become;
+        this.becomeMethod = $.becomeMethod;
+        this.becomePassword = $.becomePassword;
+        this.becomeUser = $.becomeUser;
+        this.connection = $.connection;
+        this.connections = $.connections;
+        this.create = $.create;
+        this.delete = $.delete;
+        this.detached = $.detached;
+        this.dir = $.dir;
+        this.environment = $.environment;
+        this.environmentMode = $.environmentMode;
+        this.errorOutputLines = $.errorOutputLines;
+        this.heartbeatInterval = $.heartbeatInterval;
+        this.hosts = $.hosts;
+        this.interpreter = $.interpreter;
+        this.logStreamPrefix = $.logStreamPrefix;
+        this.logging = $.logging;
+        this.loginShell = $.loginShell;
+        this.maxFailures = $.maxFailures;
+        this.pty = $.pty;
+        this.redact = $.redact;
+        this.redactOutputs = $.redactOutputs;
+        this.stderrSeverity = $.stderrSeverity;
+        this.stdin = $.stdin;
+        this.stdoutSeverity = $.stdoutSeverity;
+        this.stripWorkflowCommands = $.stripWorkflowCommands;
+        this.timeout = $.timeout;
+        this.triggers = $.triggers;
+        this.update = $.update;
+        this.workflowCommands = $.workflowCommands;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(MultiCommandArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private MultiCommandArgs $;
+
+        public Builder() {
+            $ = new MultiCommandArgs();
+        }
+
+        public Builder(MultiCommandArgs defaults) {
+            $ = new MultiCommandArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param addPreviousOutputInEnv If the previous command&#39;s stdout and stderr (as generated by the prior create/update) is
+         * injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+         * Defaults to true.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder addPreviousOutputInEnv(@Nullable Output<Boolean> addPreviousOutputInEnv) {
+            $.addPreviousOutputInEnv = addPreviousOutputInEnv;
+            return this;
+        }
+
+        /**
+         * @param addPreviousOutputInEnv If the previous command&#39;s stdout and stderr (as generated by the prior create/update) is
+         * injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+         * Defaults to true.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder addPreviousOutputInEnv(Boolean addPreviousOutputInEnv) {
+            return addPreviousOutputInEnv(Output.of(addPreviousOutputInEnv));
+        }
+
+        /**
+         * @param batchSize The number of hosts the command is run on at the same time. The next batch of
+         * hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder batchSize(@Nullable Output<Integer> batchSize) {
+            $.batchSize = batchSize;
+            return this;
+        }
+
+        /**
+         * @param batchSize The number of hosts the command is run on at the same time. The next batch of
+         * hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder batchSize(Integer batchSize) {
+            return batchSize(Output.of(batchSize));
+        }
+
+        /**
+         * @param become Run the command as another user, root by default, via the escalation tool set in
+         * &#39;becomeMethod&#39;. The command is run by &#39;sh&#39; as that user. Unlike prefixing the command with &#39;sudo&#39;, this keeps
+         * the quoting of the command intact and passes on the environment variables.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder become(@Nullable Output<Boolean> become) {
+            $.become = become;
+            return this;
+        }
+
+        /**
+         * @param become Run the command as another user, root by default, via the escalation tool set in
+         * &#39;becomeMethod&#39;. The command is run by &#39;sh&#39; as that user. Unlike prefixing the command with &#39;sudo&#39;, this keeps
+         * the quoting of the command intact and passes on the environment variables.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder become(Boolean become) {
+            return become(Output.of(become));
+        }
+
+        /**
+         * @param becomeMethod The escalation tool to use with `become`. Defaults to `sudo`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder becomeMethod(@Nullable Output<BecomeMethod> becomeMethod) {
+            $.becomeMethod = becomeMethod;
+            return this;
+        }
+
+        /**
+         * @param becomeMethod The escalation tool to use with `become`. Defaults to `sudo`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder becomeMethod(BecomeMethod becomeMethod) {
+            return becomeMethod(Output.of(becomeMethod));
+        }
+
+        /**
+         * @param becomePassword The password to answer the prompt of the escalation tool with. Without a
+         * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+         * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+         * password is never part of the command&#39;s output.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder becomePassword(@Nullable Output<String> becomePassword) {
+            $.becomePassword = becomePassword;
+            return this;
+        }
+
+        /**
+         * @param becomePassword The password to answer the prompt of the escalation tool with. Without a
+         * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+         * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+         * password is never part of the command&#39;s output.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder becomePassword(String becomePassword) {
+            return becomePassword(Output.of(becomePassword));
+        }
+
+        /**
+         * @param becomeUser The user to run the command as with `become`. Defaults to `root`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder becomeUser(@Nullable Output<String> becomeUser) {
+            $.becomeUser = becomeUser;
+            return this;
+        }
+
+        /**
+         * @param becomeUser The user to run the command as with `become`. Defaults to `root`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder becomeUser(String becomeUser) {
+            return becomeUser(Output.of(becomeUser));
+        }
+
+        /**
+         * @param connection The connection settings shared by the hosts in &#39;hosts&#39;.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(@Nullable Output<ConnectionTemplateArgs> connection) {
+            $.connection = connection;
+            return this;
+        }
+
+        /**
+         * @param connection The connection settings shared by the hosts in &#39;hosts&#39;.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(ConnectionTemplateArgs connection) {
+            return connection(Output.of(connection));
+        }
+
+        /**
+         * @param connections The connections to the hosts to run the command on. Each host may only be
+         * listed once. Either &#39;connections&#39; or &#39;hosts&#39; must be set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connections(@Nullable Output<List<ConnectionArgs>> connections) {
+            $.connections = connections;
+            return this;
+        }
+
+        /**
+         * @param connections The connections to the hosts to run the command on. Each host may only be
+         * listed once. Either &#39;connections&#39; or &#39;hosts&#39; must be set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connections(List<ConnectionArgs> connections) {
+            return connections(Output.of(connections));
+        }
+
+        /**
+         * @param connections The connections to the hosts to run the command on. Each host may only be
+         * listed once. Either &#39;connections&#39; or &#39;hosts&#39; must be set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connections(ConnectionArgs... connections) {
+            return connections(List.of(connections));
+        }
+
+        /**
+         * @param create The command to run once on resource creation.
+         * 
+         * If an `update` command isn&#39;t provided, then `create` will also be run when the resource&#39;s inputs are modified.
+         * 
+         * Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+         * 
+         * Use `local.runOutput` if you need to run a command on every execution of your program.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder create(@Nullable Output<String> create) {
+            $.create = create;
+            return this;
+        }
+
+        /**
+         * @param create The command to run once on resource creation.
+         * 
+         * If an `update` command isn&#39;t provided, then `create` will also be run when the resource&#39;s inputs are modified.
+         * 
+         * Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+         * 
+         * Use `local.runOutput` if you need to run a command on every execution of your program.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder create(String create) {
+            return create(Output.of(create));
+        }
+
+        /**
+         * @param delete The command to run on resource deletion.
+         * 
+         * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder delete(@Nullable Output<String> delete) {
+            $.delete = delete;
+            return this;
+        }
+
+        /**
+         * @param delete The command to run on resource deletion.
+         * 
+         * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder delete(String delete) {
+            return delete(Output.of(delete));
+        }
+
+        /**
+         * @param detached Run the command as a detached job that survives connection drops, e.g. for
+         * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+         * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+         * the connection drops, within the limits of &#39;dialErrorLimit&#39; and &#39;perDialTimeout&#39;. The output is logged as it&#39;s
+         * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+         * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+         * rather than starting it again. A canceled job therefore keeps running, while a &#39;timeout&#39; still stops it.
+         * Requires a POSIX shell on the remote host, and can&#39;t be combined with &#39;pty&#39; or &#39;becomePassword&#39;.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder detached(@Nullable Output<DetachedArgs> detached) {
+            $.detached = detached;
+            return this;
+        }
+
+        /**
+         * @param detached Run the command as a detached job that survives connection drops, e.g. for
+         * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+         * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+         * the connection drops, within the limits of &#39;dialErrorLimit&#39; and &#39;perDialTimeout&#39;. The output is logged as it&#39;s
+         * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+         * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+         * rather than starting it again. A canceled job therefore keeps running, while a &#39;timeout&#39; still stops it.
+         * Requires a POSIX shell on the remote host, and can&#39;t be combined with &#39;pty&#39; or &#39;becomePassword&#39;.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder detached(DetachedArgs detached) {
+            return detached(Output.of(detached));
+        }
+
+        /**
+         * @param dir The directory on the remote host to run the command in. A leading &#39;~&#39; is the home
+         * directory of the user. If &#39;dir&#39; doesn&#39;t exist, the command isn&#39;t run and &#39;Command&#39; fails. Requires a POSIX shell
+         * on the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder dir(@Nullable Output<String> dir) {
+            $.dir = dir;
+            return this;
+        }
+
+        /**
+         * @param dir The directory on the remote host to run the command in. A leading &#39;~&#39; is the home
+         * directory of the user. If &#39;dir&#39; doesn&#39;t exist, the command isn&#39;t run and &#39;Command&#39; fails. Requires a POSIX shell
+         * on the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder dir(String dir) {
+            return dir(Output.of(dir));
+        }
+
+        /**
+         * @param environment Additional environment variables available to the command&#39;s process.
+         * How they are passed to the command is set by &#39;environmentMode&#39;. With &#39;become&#39;, the variables are
+         * exported by the shell running as the become user instead, so AcceptEnv isn&#39;t needed.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environment(@Nullable Output<Map<String,String>> environment) {
+            $.environment = environment;
+            return this;
+        }
+
+        /**
+         * @param environment Additional environment variables available to the command&#39;s process.
+         * How they are passed to the command is set by &#39;environmentMode&#39;. With &#39;become&#39;, the variables are
+         * exported by the shell running as the become user instead, so AcceptEnv isn&#39;t needed.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environment(Map<String,String> environment) {
+            return environment(Output.of(environment));
+        }
+
+        /**
+         * @param environmentMode How the environment variables, including PULUMI_COMMAND_STDOUT and
+         * PULUMI_COMMAND_STDERR, are passed to the command. &#39;setenv&#39; sets them on the SSH session, which only works if
+         * the SSH server is configured to accept these variables via AcceptEnv. &#39;export&#39; quotes the variables and prepends
+         * them to the command as &#39;export&#39; statements, which requires a POSIX shell on the remote host. &#39;auto&#39; tries
+         * &#39;setenv&#39; and exports the variables that the server rejects. Defaults to &#39;setenv&#39;.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environmentMode(@Nullable Output<EnvironmentMode> environmentMode) {
+            $.environmentMode = environmentMode;
+            return this;
+        }
+
+        /**
+         * @param environmentMode How the environment variables, including PULUMI_COMMAND_STDOUT and
+         * PULUMI_COMMAND_STDERR, are passed to the command. &#39;setenv&#39; sets them on the SSH session, which only works if
+         * the SSH server is configured to accept these variables via AcceptEnv. &#39;export&#39; quotes the variables and prepends
+         * them to the command as &#39;export&#39; statements, which requires a POSIX shell on the remote host. &#39;auto&#39; tries
+         * &#39;setenv&#39; and exports the variables that the server rejects. Defaults to &#39;setenv&#39;.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environmentMode(EnvironmentMode environmentMode) {
+            return environmentMode(Output.of(environmentMode));
+        }
+
+        /**
+         * @param errorOutputLines The number of lines at the end of stdout and stderr that the error of a
+         * failed command includes. Defaults to 20.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder errorOutputLines(@Nullable Output<Integer> errorOutputLines) {
+            $.errorOutputLines = errorOutputLines;
+            return this;
+        }
+
+        /**
+         * @param errorOutputLines The number of lines at the end of stdout and stderr that the error of a
+         * failed command includes. Defaults to 20.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder errorOutputLines(Integer errorOutputLines) {
+            return errorOutputLines(Output.of(errorOutputLines));
+        }
+
+        /**
+         * @param heartbeatInterval The number of seconds without output after which the status of the
+         * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+         * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+         * Defaults to 60.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatInterval(@Nullable Output<Integer> heartbeatInterval) {
+            $.heartbeatInterval = heartbeatInterval;
+            return this;
+        }
+
+        /**
+         * @param heartbeatInterval The number of seconds without output after which the status of the
+         * resource is set to &#39;still running&#39; with the elapsed time, the time since the last output and the last logged
+         * line, and again after each such interval, so that a long-running command doesn&#39;t look stuck. 0 disables it.
+         * Defaults to 60.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder heartbeatInterval(Integer heartbeatInterval) {
+            return heartbeatInterval(Output.of(heartbeatInterval));
+        }
+
+        /**
+         * @param hosts The addresses of the hosts to run the command on, which are connected to with the
+         * settings of &#39;connection&#39;. An address may be followed by &#39;:&#39; and a port, e.g. &#39;10.0.0.5:2222&#39; or &#39;[::1]:2222&#39;,
+         * which overrides the port of &#39;connection&#39;. Each host may only be listed once. Either &#39;connections&#39; or &#39;hosts&#39;
+         * must be set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hosts(@Nullable Output<List<String>> hosts) {
+            $.hosts = hosts;
+            return this;
+        }
+
+        /**
+         * @param hosts The addresses of the hosts to run the command on, which are connected to with the
+         * settings of &#39;connection&#39;. An address may be followed by &#39;:&#39; and a port, e.g. &#39;10.0.0.5:2222&#39; or &#39;[::1]:2222&#39;,
+         * which overrides the port of &#39;connection&#39;. Each host may only be listed once. Either &#39;connections&#39; or &#39;hosts&#39;
+         * must be set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hosts(List<String> hosts) {
+            return hosts(Output.of(hosts));
+        }
+
+        /**
+         * @param hosts The addresses of the hosts to run the command on, which are connected to with the
+         * settings of &#39;connection&#39;. An address may be followed by &#39;:&#39; and a port, e.g. &#39;10.0.0.5:2222&#39; or &#39;[::1]:2222&#39;,
+         * which overrides the port of &#39;connection&#39;. Each host may only be listed once. Either &#39;connections&#39; or &#39;hosts&#39;
+         * must be set.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hosts(String... hosts) {
+            return hosts(List.of(hosts));
+        }
+
+        /**
+         * @param interpreter The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+         * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder interpreter(@Nullable Output<List<String>> interpreter) {
+            $.interpreter = interpreter;
+            return this;
+        }
+
+        /**
+         * @param interpreter The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+         * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder interpreter(List<String> interpreter) {
+            return interpreter(Output.of(interpreter));
+        }
+
+        /**
+         * @param interpreter The program and arguments to run the command with, e.g. `[&#34;bash&#34;, &#34;-euo&#34;, &#34;pipefail&#34;, &#34;-c&#34;]`.
+         * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder interpreter(String... interpreter) {
+            return interpreter(List.of(interpreter));
+        }
+
+        /**
+         * @param logStreamPrefix If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logStreamPrefix(@Nullable Output<Boolean> logStreamPrefix) {
+            $.logStreamPrefix = logStreamPrefix;
+            return this;
+        }
+
+        /**
+         * @param logStreamPrefix If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logStreamPrefix(Boolean logStreamPrefix) {
+            return logStreamPrefix(Output.of(logStreamPrefix));
+        }
+
+        /**
+         * @param logging If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
+         * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+         * outputs as secret via &#39;additionalSecretOutputs&#39;. Defaults to logging both stdout and stderr.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logging(@Nullable Output<Logging> logging) {
+            $.logging = logging;
+            return this;
+        }
+
+        /**
+         * @param logging If the command&#39;s stdout and stderr should be logged. This doesn&#39;t affect the capturing of
+         * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+         * outputs as secret via &#39;additionalSecretOutputs&#39;. Defaults to logging both stdout and stderr.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder logging(Logging logging) {
+            return logging(Output.of(logging));
+        }
+
+        /**
+         * @param loginShell Run the command in a login shell, i.e. &#39;$SHELL -l -c&#39;, so that profile files like
+         * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder loginShell(@Nullable Output<Boolean> loginShell) {
+            $.loginShell = loginShell;
+            return this;
+        }
+
+        /**
+         * @param loginShell Run the command in a login shell, i.e. &#39;$SHELL -l -c&#39;, so that profile files like
+         * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder loginShell(Boolean loginShell) {
+            return loginShell(Output.of(loginShell));
+        }
+
+        /**
+         * @param maxFailures The number of hosts the command may fail on. Once more hosts failed, no further
+         * batches are started and the resource fails. Defaults to 0.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder maxFailures(@Nullable Output<Integer> maxFailures) {
+            $.maxFailures = maxFailures;
+            return this;
+        }
+
+        /**
+         * @param maxFailures The number of hosts the command may fail on. Once more hosts failed, no further
+         * batches are started and the resource fails. Defaults to 0.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder maxFailures(Integer maxFailures) {
+            return maxFailures(Output.of(maxFailures));
+        }
+
+        /**
+         * @param pty Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+         * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+         * the terminal, without echo, followed by the end of input.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pty(@Nullable Output<PtyArgs> pty) {
+            $.pty = pty;
+            return this;
+        }
+
+        /**
+         * @param pty Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+         * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+         * the terminal, without echo, followed by the end of input.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pty(PtyArgs pty) {
+            return pty(Output.of(pty));
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+         * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(@Nullable Output<List<String>> redact) {
+            $.redact = redact;
+            return this;
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+         * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(List<String> redact) {
+            return redact(Output.of(redact));
+        }
+
+        /**
+         * @param redact Additional values to mask as &#39;[secret]&#39; in the logged output and the error of
+         * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+         * environment variables, and the passwords and private keys of the connection and &#39;becomePassword&#39; are masked.
+         * Values shorter than 4 characters aren&#39;t masked, and a warning is logged instead.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redact(String... redact) {
+            return redact(List.of(redact));
+        }
+
+        /**
+         * @param redactOutputs If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+         * to false, in which case outputs that might contain secrets can be marked as secret via
+         * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redactOutputs(@Nullable Output<Boolean> redactOutputs) {
+            $.redactOutputs = redactOutputs;
+            return this;
+        }
+
+        /**
+         * @param redactOutputs If the secrets are also masked in the &#39;stdout&#39; and &#39;stderr&#39; outputs. Defaults
+         * to false, in which case outputs that might contain secrets can be marked as secret via
+         * &#39;additionalSecretOutputs&#39;. As in the logs, values shorter than 4 characters aren&#39;t masked.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder redactOutputs(Boolean redactOutputs) {
+            return redactOutputs(Output.of(redactOutputs));
+        }
+
+        /**
+         * @param stderrSeverity The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stderrSeverity(@Nullable Output<LogSeverity> stderrSeverity) {
+            $.stderrSeverity = stderrSeverity;
+            return this;
+        }
+
+        /**
+         * @param stderrSeverity The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stderrSeverity(LogSeverity stderrSeverity) {
+            return stderrSeverity(Output.of(stderrSeverity));
+        }
+
+        /**
+         * @param stdin Pass a string to the command&#39;s process as standard in
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdin(@Nullable Output<String> stdin) {
+            $.stdin = stdin;
+            return this;
+        }
+
+        /**
+         * @param stdin Pass a string to the command&#39;s process as standard in
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdin(String stdin) {
+            return stdin(Output.of(stdin));
+        }
+
+        /**
+         * @param stdoutSeverity The severity with which the lines of stdout are logged. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdoutSeverity(@Nullable Output<LogSeverity> stdoutSeverity) {
+            $.stdoutSeverity = stdoutSeverity;
+            return this;
+        }
+
+        /**
+         * @param stdoutSeverity The severity with which the lines of stdout are logged. Defaults to `info`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stdoutSeverity(LogSeverity stdoutSeverity) {
+            return stdoutSeverity(Output.of(stdoutSeverity));
+        }
+
+        /**
+         * @param stripWorkflowCommands If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+         * to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripWorkflowCommands(@Nullable Output<Boolean> stripWorkflowCommands) {
+            $.stripWorkflowCommands = stripWorkflowCommands;
+            return this;
+        }
+
+        /**
+         * @param stripWorkflowCommands If the workflow commands are removed from the &#39;stdout&#39; output. Defaults
+         * to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stripWorkflowCommands(Boolean stripWorkflowCommands) {
+            return stripWorkflowCommands(Output.of(stripWorkflowCommands));
+        }
+
+        /**
+         * @param timeout The maximum number of seconds the command may run. When it elapses, or the
+         * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+         * doesn&#39;t exit. The error then includes the output captured so far. Signals require support by the SSH server,
+         * which recent versions of OpenSSH have. A &#39;detached&#39; command keeps running when the deployment is canceled.
+         * Defaults to no timeout.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder timeout(@Nullable Output<Integer> timeout) {
+            $.timeout = timeout;
+            return this;
+        }
+
+        /**
+         * @param timeout The maximum number of seconds the command may run. When it elapses, or the
+         * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+         * doesn&#39;t exit. The error then includes the output captured so far. Signals require support by the SSH server,
+         * which recent versions of OpenSSH have. A &#39;detached&#39; command keeps running when the deployment is canceled.
+         * Defaults to no timeout.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder timeout(Integer timeout) {
+            return timeout(Output.of(timeout));
+        }
+
+        /**
+         * @param triggers The resource will be updated (or replaced) if any of these values change.
+         * 
+         * The trigger values can be of any type.
+         * 
+         * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+         * 
+         * Please see the resource documentation for examples.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder triggers(@Nullable Output<List<Object>> triggers) {
+            $.triggers = triggers;
+            return this;
+        }
+
+        /**
+         * @param triggers The resource will be updated (or replaced) if any of these values change.
+         * 
+         * The trigger values can be of any type.
+         * 
+         * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+         * 
+         * Please see the resource documentation for examples.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder triggers(List<Object> triggers) {
+            return triggers(Output.of(triggers));
+        }
+
+        /**
+         * @param triggers The resource will be updated (or replaced) if any of these values change.
+         * 
+         * The trigger values can be of any type.
+         * 
+         * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+         * 
+         * Please see the resource documentation for examples.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder triggers(Object... triggers) {
+            return triggers(List.of(triggers));
+        }
+
+        /**
+         * @param update The command to run when the resource is updated.
+         * 
+         * If empty, the create command will be executed instead.
+         * 
+         * Note that this command will not run if the resource&#39;s inputs are unchanged.
+         * 
+         * Use `local.runOutput` if you need to run a command on every execution of your program.
+         * 
+         * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder update(@Nullable Output<String> update) {
+            $.update = update;
+            return this;
+        }
+
+        /**
+         * @param update The command to run when the resource is updated.
+         * 
+         * If empty, the create command will be executed instead.
+         * 
+         * Note that this command will not run if the resource&#39;s inputs are unchanged.
+         * 
+         * Use `local.runOutput` if you need to run a command on every execution of your program.
+         * 
+         * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder update(String update) {
+            return update(Output.of(update));
+        }
+
+        /**
+         * @param workflowCommands Run the workflow commands among the lines of stdout, modeled on those of
+         * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+         * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+         * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workflowCommands(@Nullable Output<Boolean> workflowCommands) {
+            $.workflowCommands = workflowCommands;
+            return this;
+        }
+
+        /**
+         * @param workflowCommands Run the workflow commands among the lines of stdout, modeled on those of
+         * GitHub Actions: &#39;::debug::message&#39;, &#39;::notice::message&#39;, &#39;::warning::message&#39; and &#39;::error::message&#39; log the
+         * message as a diagnostic with the severity, and &#39;::add-mask::value&#39; masks the value like the secret inputs in
+         * the later output. The commands aren&#39;t logged, even if the logging of stdout is disabled. Defaults to false.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workflowCommands(Boolean workflowCommands) {
+            return workflowCommands(Output.of(workflowCommands));
+        }
+
+        public MultiCommandArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,139 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote;
+
+import com.pulumi.command.Utilities;
+import com.pulumi.command.remote.inputs.GetFactsArgs;
+import com.pulumi.command.remote.inputs.GetFactsPlainArgs;
+import com.pulumi.command.remote.inputs.GetHostKeysArgs;
+import com.pulumi.command.remote.inputs.GetHostKeysPlainArgs;
+import com.pulumi.command.remote.inputs.TestConnectionArgs;
+import com.pulumi.command.remote.inputs.TestConnectionPlainArgs;
+import com.pulumi.command.remote.outputs.GetFactsResult;
+import com.pulumi.command.remote.outputs.GetHostKeysResult;
+import com.pulumi.command.remote.outputs.TestConnectionResult;
+import com.pulumi.core.Output;
+import com.pulumi.core.TypeShape;
+import com.pulumi.deployment.Deployment;
+import com.pulumi.deployment.InvokeOptions;
+import com.pulumi.deployment.InvokeOutputOptions;
+import java.util.concurrent.CompletableFuture;
+
+public final class RemoteFunctions {
+    /**
+     * Gathers facts about a remote host, like its operating system, architecture and resources.
+     * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+     * 
+     */
+    public static Output<GetFactsResult> getFacts(GetFactsArgs args) {
+        return getFacts(args, InvokeOptions.Empty);
+    }
+    /**
+     * Gathers facts about a remote host, like its operating system, architecture and resources.
+     * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+     * 
+     */
+    public static CompletableFuture<GetFactsResult> getFactsPlain(GetFactsPlainArgs args) {
+        return getFactsPlain(args, InvokeOptions.Empty);
+    }
+    /**
+     * Gathers facts about a remote host, like its operating system, architecture and resources.
+     * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+     * 
+     */
+    public static Output<GetFactsResult> getFacts(GetFactsArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invoke("command:remote:getFacts", TypeShape.of(GetFactsResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Gathers facts about a remote host, like its operating system, architecture and resources.
+     * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+     * 
+     */
+    public static Output<GetFactsResult> getFacts(GetFactsArgs args, InvokeOutputOptions options) {
+        return Deployment.getInstance().invoke("command:remote:getFacts", TypeShape.of(GetFactsResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Gathers facts about a remote host, like its operating system, architecture and resources.
+     * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+     * 
+     */
+    public static CompletableFuture<GetFactsResult> getFactsPlain(GetFactsPlainArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invokeAsync("command:remote:getFacts", TypeShape.of(GetFactsResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+     * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+     * 
+     */
+    public static Output<GetHostKeysResult> getHostKeys(GetHostKeysArgs args) {
+        return getHostKeys(args, InvokeOptions.Empty);
+    }
+    /**
+     * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+     * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+     * 
+     */
+    public static CompletableFuture<GetHostKeysResult> getHostKeysPlain(GetHostKeysPlainArgs args) {
+        return getHostKeysPlain(args, InvokeOptions.Empty);
+    }
+    /**
+     * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+     * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+     * 
+     */
+    public static Output<GetHostKeysResult> getHostKeys(GetHostKeysArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invoke("command:remote:getHostKeys", TypeShape.of(GetHostKeysResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+     * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+     * 
+     */
+    public static Output<GetHostKeysResult> getHostKeys(GetHostKeysArgs args, InvokeOutputOptions options) {
+        return Deployment.getInstance().invoke("command:remote:getHostKeys", TypeShape.of(GetHostKeysResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+     * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+     * 
+     */
+    public static CompletableFuture<GetHostKeysResult> getHostKeysPlain(GetHostKeysPlainArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invokeAsync("command:remote:getHostKeys", TypeShape.of(GetHostKeysResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+     * 
+     */
+    public static Output<TestConnectionResult> testConnection(TestConnectionArgs args) {
+        return testConnection(args, InvokeOptions.Empty);
+    }
+    /**
+     * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+     * 
+     */
+    public static CompletableFuture<TestConnectionResult> testConnectionPlain(TestConnectionPlainArgs args) {
+        return testConnectionPlain(args, InvokeOptions.Empty);
+    }
+    /**
+     * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+     * 
+     */
+    public static Output<TestConnectionResult> testConnection(TestConnectionArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invoke("command:remote:testConnection", TypeShape.of(TestConnectionResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+     * 
+     */
+    public static Output<TestConnectionResult> testConnection(TestConnectionArgs args, InvokeOutputOptions options) {
+        return Deployment.getInstance().invoke("command:remote:testConnection", TypeShape.of(TestConnectionResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+     * 
+     */
+    public static CompletableFuture<TestConnectionResult> testConnectionPlain(TestConnectionPlainArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invokeAsync("command:remote:testConnection", TypeShape.of(TestConnectionResult.class), args, Utilities.withVersion(options));
+    }
+}
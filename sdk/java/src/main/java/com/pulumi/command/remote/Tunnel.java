@@ -0,0 +1,213 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote;
+
+import com.pulumi.command.Utilities;
+import com.pulumi.command.remote.TunnelArgs;
+import com.pulumi.command.remote.enums.TunnelType;
+import com.pulumi.command.remote.outputs.Connection;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+/**
+ * Forwards a port over SSH for the duration of a deployment, e.g. to reach a private
+ * database through a bastion host from other providers.
+ * 
+ * A &#39;local&#39; tunnel listens on &#39;localHost&#39; and &#39;localPort&#39; on the machine running Pulumi, and forwards the
+ * connections to &#39;remoteHost&#39; and &#39;remotePort&#39; as seen from the remote host. A &#39;remote&#39; tunnel listens on
+ * &#39;remoteHost&#39; and &#39;remotePort&#39; on the remote host, and forwards the connections to &#39;localHost&#39; and &#39;localPort&#39;
+ * as seen from the machine running Pulumi. The listening address is available via &#39;address&#39; and &#39;port&#39;.
+ * 
+ * The tunnel is open while the provider runs, and is reopened on the same address on each deployment when the
+ * Tunnel is checked for changes. Only if that address isn&#39;t free anymore, the Tunnel shows as updated, with a
+ * new &#39;address&#39; for a free port. Set the listening port to keep &#39;address&#39; the same in any case. Resources that use
+ * the tunnel should depend on it.
+ * 
+ */
+@ResourceType(type="command:remote:Tunnel")
+public class Tunnel extends com.pulumi.resources.CustomResource {
+    /**
+     * The address that the tunnel listens on as host and port, on the machine running
+     * Pulumi for a &#39;local&#39; tunnel and on the remote host for a &#39;remote&#39; one.
+     * 
+     */
+    @Export(name="address", refs={String.class}, tree="[0]")
+    private Output<String> address;
+
+    /**
+     * @return The address that the tunnel listens on as host and port, on the machine running
+     * Pulumi for a &#39;local&#39; tunnel and on the remote host for a &#39;remote&#39; one.
+     * 
+     */
+    public Output<String> address() {
+        return this.address;
+    }
+    /**
+     * The parameters with which to connect to the remote host.
+     * 
+     */
+    @Export(name="connection", refs={Connection.class}, tree="[0]")
+    private Output<Connection> connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host.
+     * 
+     */
+    public Output<Connection> connection() {
+        return this.connection;
+    }
+    /**
+     * The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+     * 
+     */
+    @Export(name="localHost", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> localHost;
+
+    /**
+     * @return The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+     * 
+     */
+    public Output<Optional<String>> localHost() {
+        return Codegen.optional(this.localHost);
+    }
+    /**
+     * The port on the machine running Pulumi. A &#39;local&#39; tunnel listens on a free port
+     * if it&#39;s not set, while a &#39;remote&#39; tunnel requires it.
+     * 
+     */
+    @Export(name="localPort", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> localPort;
+
+    /**
+     * @return The port on the machine running Pulumi. A &#39;local&#39; tunnel listens on a free port
+     * if it&#39;s not set, while a &#39;remote&#39; tunnel requires it.
+     * 
+     */
+    public Output<Optional<Integer>> localPort() {
+        return Codegen.optional(this.localPort);
+    }
+    /**
+     * The port that the tunnel listens on.
+     * 
+     */
+    @Export(name="port", refs={Integer.class}, tree="[0]")
+    private Output<Integer> port;
+
+    /**
+     * @return The port that the tunnel listens on.
+     * 
+     */
+    public Output<Integer> port() {
+        return this.port;
+    }
+    /**
+     * The address as seen from the remote host. Defaults to `localhost`.
+     * 
+     */
+    @Export(name="remoteHost", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> remoteHost;
+
+    /**
+     * @return The address as seen from the remote host. Defaults to `localhost`.
+     * 
+     */
+    public Output<Optional<String>> remoteHost() {
+        return Codegen.optional(this.remoteHost);
+    }
+    /**
+     * The port as seen from the remote host. A &#39;remote&#39; tunnel listens on a port
+     * chosen by the SSH server if it&#39;s not set, while a &#39;local&#39; tunnel requires it.
+     * 
+     */
+    @Export(name="remotePort", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> remotePort;
+
+    /**
+     * @return The port as seen from the remote host. A &#39;remote&#39; tunnel listens on a port
+     * chosen by the SSH server if it&#39;s not set, while a &#39;local&#39; tunnel requires it.
+     * 
+     */
+    public Output<Optional<Integer>> remotePort() {
+        return Codegen.optional(this.remotePort);
+    }
+    /**
+     * The direction of the tunnel. Defaults to `local`.
+     * 
+     */
+    @Export(name="type", refs={TunnelType.class}, tree="[0]")
+    private Output</* @Nullable */ TunnelType> type;
+
+    /**
+     * @return The direction of the tunnel. Defaults to `local`.
+     * 
+     */
+    public Output<Optional<TunnelType>> type() {
+        return Codegen.optional(this.type);
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public Tunnel(java.lang.String name) {
+        this(name, TunnelArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public Tunnel(java.lang.String name, TunnelArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public Tunnel(java.lang.String name, TunnelArgs args, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        super("command:remote:Tunnel", name, makeArgs(args, options), makeResourceOptions(options, Codegen.empty()), false);
+    }
+
+    private Tunnel(java.lang.String name, Output<java.lang.String> id, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        super("command:remote:Tunnel", name, null, makeResourceOptions(options, id), false);
+    }
+
+    private static TunnelArgs makeArgs(TunnelArgs args, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        if (options != null && options.getUrn().isPresent()) {
+            return null;
+        }
+        return args == null ? TunnelArgs.Empty : args;
+    }
+
+    private static com.pulumi.resources.CustomResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.CustomResourceOptions options, @Nullable Output<java.lang.String> id) {
+        var defaultOptions = com.pulumi.resources.CustomResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .additionalSecretOutputs(List.of(
+                "connection"
+            ))
+            .build();
+        return com.pulumi.resources.CustomResourceOptions.merge(defaultOptions, options, id);
+    }
+
+    /**
+     * Get an existing Host resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param options Optional settings to control the behavior of the CustomResource.
+     */
+    public static Tunnel get(java.lang.String name, Output<java.lang.String> id, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        return new Tunnel(name, id, options);
+    }
+}
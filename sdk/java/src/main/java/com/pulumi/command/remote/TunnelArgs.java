@@ -0,0 +1,283 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote;
+
+import com.pulumi.command.remote.enums.TunnelType;
+import com.pulumi.command.remote.inputs.ConnectionArgs;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class TunnelArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final TunnelArgs Empty = new TunnelArgs();
+
+    /**
+     * The parameters with which to connect to the remote host.
+     * 
+     */
+    @Import(name="connection", required=true)
+    private Output<ConnectionArgs> connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host.
+     * 
+     */
+    public Output<ConnectionArgs> connection() {
+        return this.connection;
+    }
+
+    /**
+     * The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+     * 
+     */
+    @Import(name="localHost")
+    private @Nullable Output<String> localHost;
+
+    /**
+     * @return The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+     * 
+     */
+    public Optional<Output<String>> localHost() {
+        return Optional.ofNullable(this.localHost);
+    }
+
+    /**
+     * The port on the machine running Pulumi. A &#39;local&#39; tunnel listens on a free port
+     * if it&#39;s not set, while a &#39;remote&#39; tunnel requires it.
+     * 
+     */
+    @Import(name="localPort")
+    private @Nullable Output<Integer> localPort;
+
+    /**
+     * @return The port on the machine running Pulumi. A &#39;local&#39; tunnel listens on a free port
+     * if it&#39;s not set, while a &#39;remote&#39; tunnel requires it.
+     * 
+     */
+    public Optional<Output<Integer>> localPort() {
+        return Optional.ofNullable(this.localPort);
+    }
+
+    /**
+     * The address as seen from the remote host. Defaults to `localhost`.
+     * 
+     */
+    @Import(name="remoteHost")
+    private @Nullable Output<String> remoteHost;
+
+    /**
+     * @return The address as seen from the remote host. Defaults to `localhost`.
+     * 
+     */
+    public Optional<Output<String>> remoteHost() {
+        return Optional.ofNullable(this.remoteHost);
+    }
+
+    /**
+     * The port as seen from the remote host. A &#39;remote&#39; tunnel listens on a port
+     * chosen by the SSH server if it&#39;s not set, while a &#39;local&#39; tunnel requires it.
+     * 
+     */
+    @Import(name="remotePort")
+    private @Nullable Output<Integer> remotePort;
+
+    /**
+     * @return The port as seen from the remote host. A &#39;remote&#39; tunnel listens on a port
+     * chosen by the SSH server if it&#39;s not set, while a &#39;local&#39; tunnel requires it.
+     * 
+     */
+    public Optional<Output<Integer>> remotePort() {
+        return Optional.ofNullable(this.remotePort);
+    }
+
+    /**
+     * The direction of the tunnel. Defaults to `local`.
+     * 
+     */
+    @Import(name="type")
+    private @Nullable Output<TunnelType> type;
+
+    /**
+     * @return The direction of the tunnel. Defaults to `local`.
+     * 
+     */
+    public Optional<Output<TunnelType>> type() {
+        return Optional.ofNullable(this.type);
+    }
+
+    private TunnelArgs() {}
+
+    private TunnelArgs(TunnelArgs $) {
+        this.connection = $.connection;
+        this.localHost = $.localHost;
+        this.localPort = $.localPort;
+        this.remoteHost = $.remoteHost;
+        this.remotePort = $.remotePort;
+        this.type = $.type;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(TunnelArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private TunnelArgs $;
+
+        public Builder() {
+            $ = new TunnelArgs();
+        }
+
+        public Builder(TunnelArgs defaults) {
+            $ = new TunnelArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(Output<ConnectionArgs> connection) {
+            $.connection = connection;
+            return this;
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(ConnectionArgs connection) {
+            return connection(Output.of(connection));
+        }
+
+        /**
+         * @param localHost The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder localHost(@Nullable Output<String> localHost) {
+            $.localHost = localHost;
+            return this;
+        }
+
+        /**
+         * @param localHost The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder localHost(String localHost) {
+            return localHost(Output.of(localHost));
+        }
+
+        /**
+         * @param localPort The port on the machine running Pulumi. A &#39;local&#39; tunnel listens on a free port
+         * if it&#39;s not set, while a &#39;remote&#39; tunnel requires it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder localPort(@Nullable Output<Integer> localPort) {
+            $.localPort = localPort;
+            return this;
+        }
+
+        /**
+         * @param localPort The port on the machine running Pulumi. A &#39;local&#39; tunnel listens on a free port
+         * if it&#39;s not set, while a &#39;remote&#39; tunnel requires it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder localPort(Integer localPort) {
+            return localPort(Output.of(localPort));
+        }
+
+        /**
+         * @param remoteHost The address as seen from the remote host. Defaults to `localhost`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder remoteHost(@Nullable Output<String> remoteHost) {
+            $.remoteHost = remoteHost;
+            return this;
+        }
+
+        /**
+         * @param remoteHost The address as seen from the remote host. Defaults to `localhost`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder remoteHost(String remoteHost) {
+            return remoteHost(Output.of(remoteHost));
+        }
+
+        /**
+         * @param remotePort The port as seen from the remote host. A &#39;remote&#39; tunnel listens on a port
+         * chosen by the SSH server if it&#39;s not set, while a &#39;local&#39; tunnel requires it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder remotePort(@Nullable Output<Integer> remotePort) {
+            $.remotePort = remotePort;
+            return this;
+        }
+
+        /**
+         * @param remotePort The port as seen from the remote host. A &#39;remote&#39; tunnel listens on a port
+         * chosen by the SSH server if it&#39;s not set, while a &#39;local&#39; tunnel requires it.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder remotePort(Integer remotePort) {
+            return remotePort(Output.of(remotePort));
+        }
+
+        /**
+         * @param type The direction of the tunnel. Defaults to `local`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder type(@Nullable Output<TunnelType> type) {
+            $.type = type;
+            return this;
+        }
+
+        /**
+         * @param type The direction of the tunnel. Defaults to `local`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder type(TunnelType type) {
+            return type(Output.of(type));
+        }
+
+        public TunnelArgs build() {
+            if ($.connection == null) {
+                throw new MissingRequiredPropertyException("TunnelArgs", "connection");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,196 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote;
+
+import com.pulumi.command.Utilities;
+import com.pulumi.command.remote.WaitForReadyArgs;
+import com.pulumi.command.remote.outputs.Connection;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import java.lang.Integer;
+import java.lang.Object;
+import java.lang.String;
+import java.util.List;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+/**
+ * Waits until a remote host accepts SSH connections, e.g. after creating a VM, so that the
+ * resources depending on it don&#39;t have to retry. Optionally, it also waits until a probe command succeeds on the
+ * host, e.g. &#39;cloud-init status --wait&#39;.
+ * 
+ * The host is waited for on creation, and again when any of the inputs change.
+ * 
+ */
+@ResourceType(type="command:remote:WaitForReady")
+public class WaitForReady extends com.pulumi.resources.CustomResource {
+    /**
+     * The number of milliseconds that the successful connection took to establish.
+     * 
+     */
+    @Export(name="connectLatency", refs={Integer.class}, tree="[0]")
+    private Output<Integer> connectLatency;
+
+    /**
+     * @return The number of milliseconds that the successful connection took to establish.
+     * 
+     */
+    public Output<Integer> connectLatency() {
+        return this.connectLatency;
+    }
+    /**
+     * The parameters with which to connect to the remote host. Each attempt dials
+     * the host once, within &#39;perDialTimeout&#39;, and &#39;dialErrorLimit&#39; doesn&#39;t apply.
+     * 
+     */
+    @Export(name="connection", refs={Connection.class}, tree="[0]")
+    private Output<Connection> connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host. Each attempt dials
+     * the host once, within &#39;perDialTimeout&#39;, and &#39;dialErrorLimit&#39; doesn&#39;t apply.
+     * 
+     */
+    public Output<Connection> connection() {
+        return this.connection;
+    }
+    /**
+     * The host key that the host presented on the successful connection, in the
+     * authorized_keys format, e.g. to pin it as the &#39;hostKey&#39; of later connections.
+     * 
+     */
+    @Export(name="hostKey", refs={String.class}, tree="[0]")
+    private Output<String> hostKey;
+
+    /**
+     * @return The host key that the host presented on the successful connection, in the
+     * authorized_keys format, e.g. to pin it as the &#39;hostKey&#39; of later connections.
+     * 
+     */
+    public Output<String> hostKey() {
+        return this.hostKey;
+    }
+    /**
+     * The number of seconds between the attempts to connect and run the probe.
+     * Defaults to 5.
+     * 
+     */
+    @Export(name="interval", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> interval;
+
+    /**
+     * @return The number of seconds between the attempts to connect and run the probe.
+     * Defaults to 5.
+     * 
+     */
+    public Output<Optional<Integer>> interval() {
+        return Codegen.optional(this.interval);
+    }
+    /**
+     * A command that must succeed on the host for it to be ready, e.g.
+     * &#39;cloud-init status --wait&#39;. It&#39;s run on each attempt once the host accepts the connection. A probe that&#39;s
+     * still running when &#39;timeout&#39; elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+     * 
+     */
+    @Export(name="probe", refs={String.class}, tree="[0]")
+    private Output</* @Nullable */ String> probe;
+
+    /**
+     * @return A command that must succeed on the host for it to be ready, e.g.
+     * &#39;cloud-init status --wait&#39;. It&#39;s run on each attempt once the host accepts the connection. A probe that&#39;s
+     * still running when &#39;timeout&#39; elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+     * 
+     */
+    public Output<Optional<String>> probe() {
+        return Codegen.optional(this.probe);
+    }
+    /**
+     * The maximum number of seconds to wait for the host in total. Defaults to 600.
+     * 
+     */
+    @Export(name="timeout", refs={Integer.class}, tree="[0]")
+    private Output</* @Nullable */ Integer> timeout;
+
+    /**
+     * @return The maximum number of seconds to wait for the host in total. Defaults to 600.
+     * 
+     */
+    public Output<Optional<Integer>> timeout() {
+        return Codegen.optional(this.timeout);
+    }
+    /**
+     * Wait for the host again on changes to this input.
+     * 
+     */
+    @Export(name="triggers", refs={List.class,Object.class}, tree="[0,1]")
+    private Output</* @Nullable */ List<Object>> triggers;
+
+    /**
+     * @return Wait for the host again on changes to this input.
+     * 
+     */
+    public Output<Optional<List<Object>>> triggers() {
+        return Codegen.optional(this.triggers);
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public WaitForReady(java.lang.String name) {
+        this(name, WaitForReadyArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public WaitForReady(java.lang.String name, WaitForReadyArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public WaitForReady(java.lang.String name, WaitForReadyArgs args, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        super("command:remote:WaitForReady", name, makeArgs(args, options), makeResourceOptions(options, Codegen.empty()), false);
+    }
+
+    private WaitForReady(java.lang.String name, Output<java.lang.String> id, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        super("command:remote:WaitForReady", name, null, makeResourceOptions(options, id), false);
+    }
+
+    private static WaitForReadyArgs makeArgs(WaitForReadyArgs args, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        if (options != null && options.getUrn().isPresent()) {
+            return null;
+        }
+        return args == null ? WaitForReadyArgs.Empty : args;
+    }
+
+    private static com.pulumi.resources.CustomResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.CustomResourceOptions options, @Nullable Output<java.lang.String> id) {
+        var defaultOptions = com.pulumi.resources.CustomResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .additionalSecretOutputs(List.of(
+                "connection"
+            ))
+            .build();
+        return com.pulumi.resources.CustomResourceOptions.merge(defaultOptions, options, id);
+    }
+
+    /**
+     * Get an existing Host resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param options Optional settings to control the behavior of the CustomResource.
+     */
+    public static WaitForReady get(java.lang.String name, Output<java.lang.String> id, @Nullable com.pulumi.resources.CustomResourceOptions options) {
+        return new WaitForReady(name, id, options);
+    }
+}
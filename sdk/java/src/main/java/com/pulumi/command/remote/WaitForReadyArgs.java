@@ -0,0 +1,265 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote;
+
+import com.pulumi.command.remote.inputs.ConnectionArgs;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Integer;
+import java.lang.Object;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class WaitForReadyArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final WaitForReadyArgs Empty = new WaitForReadyArgs();
+
+    /**
+     * The parameters with which to connect to the remote host. Each attempt dials
+     * the host once, within &#39;perDialTimeout&#39;, and &#39;dialErrorLimit&#39; doesn&#39;t apply.
+     * 
+     */
+    @Import(name="connection", required=true)
+    private Output<ConnectionArgs> connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host. Each attempt dials
+     * the host once, within &#39;perDialTimeout&#39;, and &#39;dialErrorLimit&#39; doesn&#39;t apply.
+     * 
+     */
+    public Output<ConnectionArgs> connection() {
+        return this.connection;
+    }
+
+    /**
+     * The number of seconds between the attempts to connect and run the probe.
+     * Defaults to 5.
+     * 
+     */
+    @Import(name="interval")
+    private @Nullable Output<Integer> interval;
+
+    /**
+     * @return The number of seconds between the attempts to connect and run the probe.
+     * Defaults to 5.
+     * 
+     */
+    public Optional<Output<Integer>> interval() {
+        return Optional.ofNullable(this.interval);
+    }
+
+    /**
+     * A command that must succeed on the host for it to be ready, e.g.
+     * &#39;cloud-init status --wait&#39;. It&#39;s run on each attempt once the host accepts the connection. A probe that&#39;s
+     * still running when &#39;timeout&#39; elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+     * 
+     */
+    @Import(name="probe")
+    private @Nullable Output<String> probe;
+
+    /**
+     * @return A command that must succeed on the host for it to be ready, e.g.
+     * &#39;cloud-init status --wait&#39;. It&#39;s run on each attempt once the host accepts the connection. A probe that&#39;s
+     * still running when &#39;timeout&#39; elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+     * 
+     */
+    public Optional<Output<String>> probe() {
+        return Optional.ofNullable(this.probe);
+    }
+
+    /**
+     * The maximum number of seconds to wait for the host in total. Defaults to 600.
+     * 
+     */
+    @Import(name="timeout")
+    private @Nullable Output<Integer> timeout;
+
+    /**
+     * @return The maximum number of seconds to wait for the host in total. Defaults to 600.
+     * 
+     */
+    public Optional<Output<Integer>> timeout() {
+        return Optional.ofNullable(this.timeout);
+    }
+
+    /**
+     * Wait for the host again on changes to this input.
+     * 
+     */
+    @Import(name="triggers")
+    private @Nullable Output<List<Object>> triggers;
+
+    /**
+     * @return Wait for the host again on changes to this input.
+     * 
+     */
+    public Optional<Output<List<Object>>> triggers() {
+        return Optional.ofNullable(this.triggers);
+    }
+
+    private WaitForReadyArgs() {}
+
+    private WaitForReadyArgs(WaitForReadyArgs $) {
+        this.connection = $.connection;
+        this.interval = $.interval;
+        this.probe = $.probe;
+        this.timeout = $.timeout;
+        this.triggers = $.triggers;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(WaitForReadyArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private WaitForReadyArgs $;
+
+        public Builder() {
+            $ = new WaitForReadyArgs();
+        }
+
+        public Builder(WaitForReadyArgs defaults) {
+            $ = new WaitForReadyArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host. Each attempt dials
+         * the host once, within &#39;perDialTimeout&#39;, and &#39;dialErrorLimit&#39; doesn&#39;t apply.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(Output<ConnectionArgs> connection) {
+            $.connection = connection;
+            return this;
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host. Each attempt dials
+         * the host once, within &#39;perDialTimeout&#39;, and &#39;dialErrorLimit&#39; doesn&#39;t apply.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(ConnectionArgs connection) {
+            return connection(Output.of(connection));
+        }
+
+        /**
+         * @param interval The number of seconds between the attempts to connect and run the probe.
+         * Defaults to 5.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder interval(@Nullable Output<Integer> interval) {
+            $.interval = interval;
+            return this;
+        }
+
+        /**
+         * @param interval The number of seconds between the attempts to connect and run the probe.
+         * Defaults to 5.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder interval(Integer interval) {
+            return interval(Output.of(interval));
+        }
+
+        /**
+         * @param probe A command that must succeed on the host for it to be ready, e.g.
+         * &#39;cloud-init status --wait&#39;. It&#39;s run on each attempt once the host accepts the connection. A probe that&#39;s
+         * still running when &#39;timeout&#39; elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder probe(@Nullable Output<String> probe) {
+            $.probe = probe;
+            return this;
+        }
+
+        /**
+         * @param probe A command that must succeed on the host for it to be ready, e.g.
+         * &#39;cloud-init status --wait&#39;. It&#39;s run on each attempt once the host accepts the connection. A probe that&#39;s
+         * still running when &#39;timeout&#39; elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder probe(String probe) {
+            return probe(Output.of(probe));
+        }
+
+        /**
+         * @param timeout The maximum number of seconds to wait for the host in total. Defaults to 600.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder timeout(@Nullable Output<Integer> timeout) {
+            $.timeout = timeout;
+            return this;
+        }
+
+        /**
+         * @param timeout The maximum number of seconds to wait for the host in total. Defaults to 600.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder timeout(Integer timeout) {
+            return timeout(Output.of(timeout));
+        }
+
+        /**
+         * @param triggers Wait for the host again on changes to this input.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder triggers(@Nullable Output<List<Object>> triggers) {
+            $.triggers = triggers;
+            return this;
+        }
+
+        /**
+         * @param triggers Wait for the host again on changes to this input.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder triggers(List<Object> triggers) {
+            return triggers(Output.of(triggers));
+        }
+
+        /**
+         * @param triggers Wait for the host again on changes to this input.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder triggers(Object... triggers) {
+            return triggers(List.of(triggers));
+        }
+
+        public WaitForReadyArgs build() {
+            if ($.connection == null) {
+                throw new MissingRequiredPropertyException("WaitForReadyArgs", "connection");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum AtomicMode {
+        /**
+         * Write files in place
+         * 
+         */
+        None("none"),
+        /**
+         * Write each file to a temporary file and rename it into place
+         * 
+         */
+        File("file"),
+        /**
+         * Stage copied directories in full and swap them in via a symlink
+         * 
+         */
+        Directory("directory");
+
+        private final String value;
+
+        AtomicMode(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "AtomicMode[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
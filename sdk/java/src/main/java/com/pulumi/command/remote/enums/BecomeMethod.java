@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum BecomeMethod {
+        /**
+         * Use `sudo`
+         * 
+         */
+        Sudo("sudo"),
+        /**
+         * Use `su`
+         * 
+         */
+        Su("su"),
+        /**
+         * Use `doas`
+         * 
+         */
+        Doas("doas");
+
+        private final String value;
+
+        BecomeMethod(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "BecomeMethod[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
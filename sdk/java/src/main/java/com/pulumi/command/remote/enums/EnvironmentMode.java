@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum EnvironmentMode {
+        /**
+         * Set the variables on the SSH session, which requires AcceptEnv on the server
+         * 
+         */
+        Setenv("setenv"),
+        /**
+         * Export the variables in the command, which requires a POSIX shell on the remote host
+         * 
+         */
+        Export("export"),
+        /**
+         * Set the variables on the SSH session, and export those that the server rejects
+         * 
+         */
+        Auto("auto");
+
+        private final String value;
+
+        EnvironmentMode(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "EnvironmentMode[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
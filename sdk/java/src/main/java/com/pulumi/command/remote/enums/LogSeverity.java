@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum LogSeverity {
+        /**
+         * Log the lines as debug messages
+         * 
+         */
+        Debug("debug"),
+        /**
+         * Log the lines as info messages
+         * 
+         */
+        Info("info"),
+        /**
+         * Log the lines as warnings
+         * 
+         */
+        Warning("warning"),
+        /**
+         * Log the lines as errors
+         * 
+         */
+        Error("error");
+
+        private final String value;
+
+        LogSeverity(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "LogSeverity[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum Transport {
+        /**
+         * Use the SFTP subsystem
+         * 
+         */
+        Sftp("sftp"),
+        /**
+         * Use the SCP protocol via `scp -t`
+         * 
+         */
+        Scp("scp"),
+        /**
+         * Stream a tar archive to `tar -x` on the remote host
+         * 
+         */
+        TarOverExec("tarOverExec"),
+        /**
+         * Use SFTP if the host supports it, and fall back to `tarOverExec` or `scp`
+         * 
+         */
+        Auto("auto");
+
+        private final String value;
+
+        Transport(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "Transport[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
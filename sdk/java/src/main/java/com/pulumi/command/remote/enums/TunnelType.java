@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    @EnumType
+    public enum TunnelType {
+        /**
+         * Forward the connections to a local port to the remote host, like `ssh -L`
+         * 
+         */
+        Local("local"),
+        /**
+         * Forward the connections to a port on the remote host to the local machine, like `ssh -R`
+         * 
+         */
+        Remote("remote");
+
+        private final String value;
+
+        TunnelType(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public java.lang.String toString() {
+            return new StringJoiner(", ", "TunnelType[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
@@ -0,0 +1,133 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Settings for running a command as a detached job.
+ * 
+ */
+public final class DetachedArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final DetachedArgs Empty = new DetachedArgs();
+
+    /**
+     * The number of seconds between checks whether the job finished. Defaults to 5.
+     * 
+     */
+    @Import(name="pollInterval")
+    private @Nullable Output<Integer> pollInterval;
+
+    /**
+     * @return The number of seconds between checks whether the job finished. Defaults to 5.
+     * 
+     */
+    public Optional<Output<Integer>> pollInterval() {
+        return Optional.ofNullable(this.pollInterval);
+    }
+
+    /**
+     * The directory on the remote host that holds a subdirectory per job, with its pid,
+     * exit code, standard input and output. A job&#39;s directory is removed once it finished. A leading &#39;~&#39; is the home
+     * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+     * 
+     */
+    @Import(name="stateDir")
+    private @Nullable Output<String> stateDir;
+
+    /**
+     * @return The directory on the remote host that holds a subdirectory per job, with its pid,
+     * exit code, standard input and output. A job&#39;s directory is removed once it finished. A leading &#39;~&#39; is the home
+     * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+     * 
+     */
+    public Optional<Output<String>> stateDir() {
+        return Optional.ofNullable(this.stateDir);
+    }
+
+    private DetachedArgs() {}
+
+    private DetachedArgs(DetachedArgs $) {
+        this.pollInterval = $.pollInterval;
+        this.stateDir = $.stateDir;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(DetachedArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private DetachedArgs $;
+
+        public Builder() {
+            $ = new DetachedArgs();
+        }
+
+        public Builder(DetachedArgs defaults) {
+            $ = new DetachedArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param pollInterval The number of seconds between checks whether the job finished. Defaults to 5.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pollInterval(@Nullable Output<Integer> pollInterval) {
+            $.pollInterval = pollInterval;
+            return this;
+        }
+
+        /**
+         * @param pollInterval The number of seconds between checks whether the job finished. Defaults to 5.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pollInterval(Integer pollInterval) {
+            return pollInterval(Output.of(pollInterval));
+        }
+
+        /**
+         * @param stateDir The directory on the remote host that holds a subdirectory per job, with its pid,
+         * exit code, standard input and output. A job&#39;s directory is removed once it finished. A leading &#39;~&#39; is the home
+         * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stateDir(@Nullable Output<String> stateDir) {
+            $.stateDir = stateDir;
+            return this;
+        }
+
+        /**
+         * @param stateDir The directory on the remote host that holds a subdirectory per job, with its pid,
+         * exit code, standard input and output. A job&#39;s directory is removed once it finished. A leading &#39;~&#39; is the home
+         * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder stateDir(String stateDir) {
+            return stateDir(Output.of(stateDir));
+        }
+
+        public DetachedArgs build() {
+            return $;
+        }
+    }
+
+}
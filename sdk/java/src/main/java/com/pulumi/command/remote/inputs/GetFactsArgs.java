@@ -0,0 +1,85 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.inputs;
+
+import com.pulumi.command.remote.inputs.ConnectionArgs;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.util.Objects;
+
+
+public final class GetFactsArgs extends com.pulumi.resources.InvokeArgs {
+
+    public static final GetFactsArgs Empty = new GetFactsArgs();
+
+    /**
+     * The parameters with which to connect to the remote host.
+     * 
+     */
+    @Import(name="connection", required=true)
+    private Output<ConnectionArgs> connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host.
+     * 
+     */
+    public Output<ConnectionArgs> connection() {
+        return this.connection;
+    }
+
+    private GetFactsArgs() {}
+
+    private GetFactsArgs(GetFactsArgs $) {
+        this.connection = $.connection;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(GetFactsArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private GetFactsArgs $;
+
+        public Builder() {
+            $ = new GetFactsArgs();
+        }
+
+        public Builder(GetFactsArgs defaults) {
+            $ = new GetFactsArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(Output<ConnectionArgs> connection) {
+            $.connection = connection;
+            return this;
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(ConnectionArgs connection) {
+            return connection(Output.of(connection));
+        }
+
+        public GetFactsArgs build() {
+            if ($.connection == null) {
+                throw new MissingRequiredPropertyException("GetFactsArgs", "connection");
+            }
+            return $;
+        }
+    }
+
+}
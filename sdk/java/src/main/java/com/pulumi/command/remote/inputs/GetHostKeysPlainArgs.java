@@ -0,0 +1,74 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.inputs;
+
+import com.pulumi.command.remote.inputs.Connection;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.util.Objects;
+
+
+public final class GetHostKeysPlainArgs extends com.pulumi.resources.InvokeArgs {
+
+    public static final GetHostKeysPlainArgs Empty = new GetHostKeysPlainArgs();
+
+    /**
+     * The parameters with which to connect to the remote host.
+     * 
+     */
+    @Import(name="connection", required=true)
+    private Connection connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host.
+     * 
+     */
+    public Connection connection() {
+        return this.connection;
+    }
+
+    private GetHostKeysPlainArgs() {}
+
+    private GetHostKeysPlainArgs(GetHostKeysPlainArgs $) {
+        this.connection = $.connection;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(GetHostKeysPlainArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private GetHostKeysPlainArgs $;
+
+        public Builder() {
+            $ = new GetHostKeysPlainArgs();
+        }
+
+        public Builder(GetHostKeysPlainArgs defaults) {
+            $ = new GetHostKeysPlainArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(Connection connection) {
+            $.connection = connection;
+            return this;
+        }
+
+        public GetHostKeysPlainArgs build() {
+            if ($.connection == null) {
+                throw new MissingRequiredPropertyException("GetHostKeysPlainArgs", "connection");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,330 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.inputs;
+
+import com.pulumi.core.annotations.Import;
+import com.pulumi.core.internal.Codegen;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Double;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Instructions for how to connect to a remote endpoint via a bastion host.
+ * 
+ */
+public final class ProxyConnection extends com.pulumi.resources.InvokeArgs {
+
+    public static final ProxyConnection Empty = new ProxyConnection();
+
+    /**
+     * SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+     * 
+     */
+    @Import(name="agentSocketPath")
+    private @Nullable String agentSocketPath;
+
+    /**
+     * @return SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+     * 
+     */
+    public Optional<String> agentSocketPath() {
+        return Optional.ofNullable(this.agentSocketPath);
+    }
+
+    /**
+     * Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+     * 
+     */
+    @Import(name="dialErrorLimit")
+    private @Nullable Integer dialErrorLimit;
+
+    /**
+     * @return Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+     * 
+     */
+    public Optional<Integer> dialErrorLimit() {
+        return Optional.ofNullable(this.dialErrorLimit);
+    }
+
+    /**
+     * The address of the bastion host to connect to.
+     * 
+     */
+    @Import(name="host", required=true)
+    private String host;
+
+    /**
+     * @return The address of the bastion host to connect to.
+     * 
+     */
+    public String host() {
+        return this.host;
+    }
+
+    /**
+     * The expected host key to verify the server&#39;s identity. If not provided, the host key will be ignored.
+     * 
+     */
+    @Import(name="hostKey")
+    private @Nullable String hostKey;
+
+    /**
+     * @return The expected host key to verify the server&#39;s identity. If not provided, the host key will be ignored.
+     * 
+     */
+    public Optional<String> hostKey() {
+        return Optional.ofNullable(this.hostKey);
+    }
+
+    /**
+     * The password we should use for the connection to the bastion host.
+     * 
+     */
+    @Import(name="password")
+    private @Nullable String password;
+
+    /**
+     * @return The password we should use for the connection to the bastion host.
+     * 
+     */
+    public Optional<String> password() {
+        return Optional.ofNullable(this.password);
+    }
+
+    /**
+     * Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+     * 
+     */
+    @Import(name="perDialTimeout")
+    private @Nullable Integer perDialTimeout;
+
+    /**
+     * @return Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+     * 
+     */
+    public Optional<Integer> perDialTimeout() {
+        return Optional.ofNullable(this.perDialTimeout);
+    }
+
+    /**
+     * The port of the bastion host to connect to.
+     * 
+     */
+    @Import(name="port")
+    private @Nullable Double port;
+
+    /**
+     * @return The port of the bastion host to connect to.
+     * 
+     */
+    public Optional<Double> port() {
+        return Optional.ofNullable(this.port);
+    }
+
+    /**
+     * The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+     * 
+     */
+    @Import(name="privateKey")
+    private @Nullable String privateKey;
+
+    /**
+     * @return The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+     * 
+     */
+    public Optional<String> privateKey() {
+        return Optional.ofNullable(this.privateKey);
+    }
+
+    /**
+     * The password to use in case the private key is encrypted.
+     * 
+     */
+    @Import(name="privateKeyPassword")
+    private @Nullable String privateKeyPassword;
+
+    /**
+     * @return The password to use in case the private key is encrypted.
+     * 
+     */
+    public Optional<String> privateKeyPassword() {
+        return Optional.ofNullable(this.privateKeyPassword);
+    }
+
+    /**
+     * The user that we should use for the connection to the bastion host.
+     * 
+     */
+    @Import(name="user")
+    private @Nullable String user;
+
+    /**
+     * @return The user that we should use for the connection to the bastion host.
+     * 
+     */
+    public Optional<String> user() {
+        return Optional.ofNullable(this.user);
+    }
+
+    private ProxyConnection() {}
+
+    private ProxyConnection(ProxyConnection $) {
+        this.agentSocketPath = $.agentSocketPath;
+        this.dialErrorLimit = $.dialErrorLimit;
+        this.host = $.host;
+        this.hostKey = $.hostKey;
+        this.password = $.password;
+        this.perDialTimeout = $.perDialTimeout;
+        this.port = $.port;
+        this.privateKey = $.privateKey;
+        this.privateKeyPassword = $.privateKeyPassword;
+        this.user = $.user;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(ProxyConnection defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private ProxyConnection $;
+
+        public Builder() {
+            $ = new ProxyConnection();
+        }
+
+        public Builder(ProxyConnection defaults) {
+            $ = new ProxyConnection(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param agentSocketPath SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder agentSocketPath(@Nullable String agentSocketPath) {
+            $.agentSocketPath = agentSocketPath;
+            return this;
+        }
+
+        /**
+         * @param dialErrorLimit Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder dialErrorLimit(@Nullable Integer dialErrorLimit) {
+            $.dialErrorLimit = dialErrorLimit;
+            return this;
+        }
+
+        /**
+         * @param host The address of the bastion host to connect to.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder host(String host) {
+            $.host = host;
+            return this;
+        }
+
+        /**
+         * @param hostKey The expected host key to verify the server&#39;s identity. If not provided, the host key will be ignored.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hostKey(@Nullable String hostKey) {
+            $.hostKey = hostKey;
+            return this;
+        }
+
+        /**
+         * @param password The password we should use for the connection to the bastion host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder password(@Nullable String password) {
+            $.password = password;
+            return this;
+        }
+
+        /**
+         * @param perDialTimeout Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder perDialTimeout(@Nullable Integer perDialTimeout) {
+            $.perDialTimeout = perDialTimeout;
+            return this;
+        }
+
+        /**
+         * @param port The port of the bastion host to connect to.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(@Nullable Double port) {
+            $.port = port;
+            return this;
+        }
+
+        /**
+         * @param privateKey The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder privateKey(@Nullable String privateKey) {
+            $.privateKey = privateKey;
+            return this;
+        }
+
+        /**
+         * @param privateKeyPassword The password to use in case the private key is encrypted.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder privateKeyPassword(@Nullable String privateKeyPassword) {
+            $.privateKeyPassword = privateKeyPassword;
+            return this;
+        }
+
+        /**
+         * @param user The user that we should use for the connection to the bastion host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder user(@Nullable String user) {
+            $.user = user;
+            return this;
+        }
+
+        public ProxyConnection build() {
+            $.dialErrorLimit = Codegen.integerProp("dialErrorLimit").arg($.dialErrorLimit).def(10).getNullable();
+            if ($.host == null) {
+                throw new MissingRequiredPropertyException("ProxyConnection", "host");
+            }
+            $.perDialTimeout = Codegen.integerProp("perDialTimeout").arg($.perDialTimeout).def(15).getNullable();
+            $.port = Codegen.doubleProp("port").arg($.port).def(2.2e+01).getNullable();
+            $.user = Codegen.stringProp("user").arg($.user).def("root").getNullable();
+            return $;
+        }
+    }
+
+}
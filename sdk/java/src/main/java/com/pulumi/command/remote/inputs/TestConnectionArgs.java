@@ -0,0 +1,85 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.inputs;
+
+import com.pulumi.command.remote.inputs.ConnectionArgs;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.util.Objects;
+
+
+public final class TestConnectionArgs extends com.pulumi.resources.InvokeArgs {
+
+    public static final TestConnectionArgs Empty = new TestConnectionArgs();
+
+    /**
+     * The parameters with which to connect to the remote host.
+     * 
+     */
+    @Import(name="connection", required=true)
+    private Output<ConnectionArgs> connection;
+
+    /**
+     * @return The parameters with which to connect to the remote host.
+     * 
+     */
+    public Output<ConnectionArgs> connection() {
+        return this.connection;
+    }
+
+    private TestConnectionArgs() {}
+
+    private TestConnectionArgs(TestConnectionArgs $) {
+        this.connection = $.connection;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(TestConnectionArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private TestConnectionArgs $;
+
+        public Builder() {
+            $ = new TestConnectionArgs();
+        }
+
+        public Builder(TestConnectionArgs defaults) {
+            $ = new TestConnectionArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(Output<ConnectionArgs> connection) {
+            $.connection = connection;
+            return this;
+        }
+
+        /**
+         * @param connection The parameters with which to connect to the remote host.
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connection(ConnectionArgs connection) {
+            return connection(Output.of(connection));
+        }
+
+        public TestConnectionArgs build() {
+            if ($.connection == null) {
+                throw new MissingRequiredPropertyException("TestConnectionArgs", "connection");
+            }
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,194 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.lang.Double;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class ConnectionStage {
+    /**
+     * @return What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+     * 
+     */
+    private @Nullable String detail;
+    /**
+     * @return How long the stage took in milliseconds.
+     * 
+     */
+    private Double durationMs;
+    /**
+     * @return Why the stage failed.
+     * 
+     */
+    private @Nullable String error;
+    /**
+     * @return The host that the stage connects to, `proxy` or `host`.
+     * 
+     */
+    private String hop;
+    /**
+     * @return The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+     * 
+     */
+    private @Nullable String method;
+    /**
+     * @return The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+     * 
+     */
+    private String stage;
+    /**
+     * @return If the stage succeeded.
+     * 
+     */
+    private Boolean success;
+
+    private ConnectionStage() {}
+    /**
+     * @return What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+     * 
+     */
+    public Optional<String> detail() {
+        return Optional.ofNullable(this.detail);
+    }
+    /**
+     * @return How long the stage took in milliseconds.
+     * 
+     */
+    public Double durationMs() {
+        return this.durationMs;
+    }
+    /**
+     * @return Why the stage failed.
+     * 
+     */
+    public Optional<String> error() {
+        return Optional.ofNullable(this.error);
+    }
+    /**
+     * @return The host that the stage connects to, `proxy` or `host`.
+     * 
+     */
+    public String hop() {
+        return this.hop;
+    }
+    /**
+     * @return The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+     * 
+     */
+    public Optional<String> method() {
+        return Optional.ofNullable(this.method);
+    }
+    /**
+     * @return The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+     * 
+     */
+    public String stage() {
+        return this.stage;
+    }
+    /**
+     * @return If the stage succeeded.
+     * 
+     */
+    public Boolean success() {
+        return this.success;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(ConnectionStage defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String detail;
+        private Double durationMs;
+        private @Nullable String error;
+        private String hop;
+        private @Nullable String method;
+        private String stage;
+        private Boolean success;
+        public Builder() {}
+        public Builder(ConnectionStage defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.detail = defaults.detail;
+    	      this.durationMs = defaults.durationMs;
+    	      this.error = defaults.error;
+    	      this.hop = defaults.hop;
+    	      this.method = defaults.method;
+    	      this.stage = defaults.stage;
+    	      this.success = defaults.success;
+        }
+
+        @CustomType.Setter
+        public Builder detail(@Nullable String detail) {
+
+            this.detail = detail;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder durationMs(Double durationMs) {
+            if (durationMs == null) {
+              throw new MissingRequiredPropertyException("ConnectionStage", "durationMs");
+            }
+            this.durationMs = durationMs;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder error(@Nullable String error) {
+
+            this.error = error;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder hop(String hop) {
+            if (hop == null) {
+              throw new MissingRequiredPropertyException("ConnectionStage", "hop");
+            }
+            this.hop = hop;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder method(@Nullable String method) {
+
+            this.method = method;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder stage(String stage) {
+            if (stage == null) {
+              throw new MissingRequiredPropertyException("ConnectionStage", "stage");
+            }
+            this.stage = stage;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder success(Boolean success) {
+            if (success == null) {
+              throw new MissingRequiredPropertyException("ConnectionStage", "success");
+            }
+            this.success = success;
+            return this;
+        }
+        public ConnectionStage build() {
+            final var _resultValue = new ConnectionStage();
+            _resultValue.detail = detail;
+            _resultValue.durationMs = durationMs;
+            _resultValue.error = error;
+            _resultValue.hop = hop;
+            _resultValue.method = method;
+            _resultValue.stage = stage;
+            _resultValue.success = success;
+            return _resultValue;
+        }
+    }
+}
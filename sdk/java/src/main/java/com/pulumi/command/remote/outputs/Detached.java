@@ -0,0 +1,83 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class Detached {
+    /**
+     * @return The number of seconds between checks whether the job finished. Defaults to 5.
+     * 
+     */
+    private @Nullable Integer pollInterval;
+    /**
+     * @return The directory on the remote host that holds a subdirectory per job, with its pid,
+     * exit code, standard input and output. A job&#39;s directory is removed once it finished. A leading &#39;~&#39; is the home
+     * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+     * 
+     */
+    private @Nullable String stateDir;
+
+    private Detached() {}
+    /**
+     * @return The number of seconds between checks whether the job finished. Defaults to 5.
+     * 
+     */
+    public Optional<Integer> pollInterval() {
+        return Optional.ofNullable(this.pollInterval);
+    }
+    /**
+     * @return The directory on the remote host that holds a subdirectory per job, with its pid,
+     * exit code, standard input and output. A job&#39;s directory is removed once it finished. A leading &#39;~&#39; is the home
+     * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+     * 
+     */
+    public Optional<String> stateDir() {
+        return Optional.ofNullable(this.stateDir);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Detached defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Integer pollInterval;
+        private @Nullable String stateDir;
+        public Builder() {}
+        public Builder(Detached defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.pollInterval = defaults.pollInterval;
+    	      this.stateDir = defaults.stateDir;
+        }
+
+        @CustomType.Setter
+        public Builder pollInterval(@Nullable Integer pollInterval) {
+
+            this.pollInterval = pollInterval;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder stateDir(@Nullable String stateDir) {
+
+            this.stateDir = stateDir;
+            return this;
+        }
+        public Detached build() {
+            final var _resultValue = new Detached();
+            _resultValue.pollInterval = pollInterval;
+            _resultValue.stateDir = stateDir;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,340 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class GetFactsResult {
+    /**
+     * @return The machine architecture as reported by `uname -m`, e.g. `x86_64` or `aarch64`.
+     * 
+     */
+    private @Nullable String arch;
+    /**
+     * @return The number of online CPUs.
+     * 
+     */
+    private @Nullable Integer cpuCount;
+    /**
+     * @return The distribution, e.g. `ubuntu`, which is `ID` in `/etc/os-release`, or `macos`.
+     * 
+     */
+    private @Nullable String distro;
+    /**
+     * @return The version of the distribution, e.g. `24.04`.
+     * 
+     */
+    private @Nullable String distroVersion;
+    /**
+     * @return The errors of the probes that failed, keyed by the name of the probe: `uname` for `os`, `kernel` and `arch`, `osRelease` for `osFamily`, `distro` and `distroVersion`, and otherwise the name of the fact.
+     * 
+     */
+    private Map<String,String> errors;
+    /**
+     * @return The host name of the host.
+     * 
+     */
+    private @Nullable String hostname;
+    /**
+     * @return The init system, `systemd`, `openrc`, `launchd` or `sysvinit`.
+     * 
+     */
+    private @Nullable String initSystem;
+    /**
+     * @return The IP addresses of the host, without loopback and link-local addresses.
+     * 
+     */
+    private @Nullable List<String> ipAddresses;
+    /**
+     * @return The kernel release as reported by `uname -r`.
+     * 
+     */
+    private @Nullable String kernel;
+    /**
+     * @return The total memory in MiB.
+     * 
+     */
+    private @Nullable Integer memoryMb;
+    /**
+     * @return The operating system as reported by `uname -s` in lower case, e.g. `linux` or `darwin`.
+     * 
+     */
+    private @Nullable String os;
+    /**
+     * @return The family of the distribution, e.g. `debian` or `rhel`, which is the first entry of `ID_LIKE` in `/etc/os-release`, or `ID` if it&#39;s not set. Outside of Linux, the same as `os`.
+     * 
+     */
+    private @Nullable String osFamily;
+    /**
+     * @return If the user can run commands with sudo without a password.
+     * 
+     */
+    private @Nullable Boolean passwordlessSudo;
+    /**
+     * @return The login shell of the user.
+     * 
+     */
+    private @Nullable String shell;
+
+    private GetFactsResult() {}
+    /**
+     * @return The machine architecture as reported by `uname -m`, e.g. `x86_64` or `aarch64`.
+     * 
+     */
+    public Optional<String> arch() {
+        return Optional.ofNullable(this.arch);
+    }
+    /**
+     * @return The number of online CPUs.
+     * 
+     */
+    public Optional<Integer> cpuCount() {
+        return Optional.ofNullable(this.cpuCount);
+    }
+    /**
+     * @return The distribution, e.g. `ubuntu`, which is `ID` in `/etc/os-release`, or `macos`.
+     * 
+     */
+    public Optional<String> distro() {
+        return Optional.ofNullable(this.distro);
+    }
+    /**
+     * @return The version of the distribution, e.g. `24.04`.
+     * 
+     */
+    public Optional<String> distroVersion() {
+        return Optional.ofNullable(this.distroVersion);
+    }
+    /**
+     * @return The errors of the probes that failed, keyed by the name of the probe: `uname` for `os`, `kernel` and `arch`, `osRelease` for `osFamily`, `distro` and `distroVersion`, and otherwise the name of the fact.
+     * 
+     */
+    public Map<String,String> errors() {
+        return this.errors;
+    }
+    /**
+     * @return The host name of the host.
+     * 
+     */
+    public Optional<String> hostname() {
+        return Optional.ofNullable(this.hostname);
+    }
+    /**
+     * @return The init system, `systemd`, `openrc`, `launchd` or `sysvinit`.
+     * 
+     */
+    public Optional<String> initSystem() {
+        return Optional.ofNullable(this.initSystem);
+    }
+    /**
+     * @return The IP addresses of the host, without loopback and link-local addresses.
+     * 
+     */
+    public List<String> ipAddresses() {
+        return this.ipAddresses == null ? List.of() : this.ipAddresses;
+    }
+    /**
+     * @return The kernel release as reported by `uname -r`.
+     * 
+     */
+    public Optional<String> kernel() {
+        return Optional.ofNullable(this.kernel);
+    }
+    /**
+     * @return The total memory in MiB.
+     * 
+     */
+    public Optional<Integer> memoryMb() {
+        return Optional.ofNullable(this.memoryMb);
+    }
+    /**
+     * @return The operating system as reported by `uname -s` in lower case, e.g. `linux` or `darwin`.
+     * 
+     */
+    public Optional<String> os() {
+        return Optional.ofNullable(this.os);
+    }
+    /**
+     * @return The family of the distribution, e.g. `debian` or `rhel`, which is the first entry of `ID_LIKE` in `/etc/os-release`, or `ID` if it&#39;s not set. Outside of Linux, the same as `os`.
+     * 
+     */
+    public Optional<String> osFamily() {
+        return Optional.ofNullable(this.osFamily);
+    }
+    /**
+     * @return If the user can run commands with sudo without a password.
+     * 
+     */
+    public Optional<Boolean> passwordlessSudo() {
+        return Optional.ofNullable(this.passwordlessSudo);
+    }
+    /**
+     * @return The login shell of the user.
+     * 
+     */
+    public Optional<String> shell() {
+        return Optional.ofNullable(this.shell);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(GetFactsResult defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable String arch;
+        private @Nullable Integer cpuCount;
+        private @Nullable String distro;
+        private @Nullable String distroVersion;
+        private Map<String,String> errors;
+        private @Nullable String hostname;
+        private @Nullable String initSystem;
+        private @Nullable List<String> ipAddresses;
+        private @Nullable String kernel;
+        private @Nullable Integer memoryMb;
+        private @Nullable String os;
+        private @Nullable String osFamily;
+        private @Nullable Boolean passwordlessSudo;
+        private @Nullable String shell;
+        public Builder() {}
+        public Builder(GetFactsResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.arch = defaults.arch;
+    	      this.cpuCount = defaults.cpuCount;
+    	      this.distro = defaults.distro;
+    	      this.distroVersion = defaults.distroVersion;
+    	      this.errors = defaults.errors;
+    	      this.hostname = defaults.hostname;
+    	      this.initSystem = defaults.initSystem;
+    	      this.ipAddresses = defaults.ipAddresses;
+    	      this.kernel = defaults.kernel;
+    	      this.memoryMb = defaults.memoryMb;
+    	      this.os = defaults.os;
+    	      this.osFamily = defaults.osFamily;
+    	      this.passwordlessSudo = defaults.passwordlessSudo;
+    	      this.shell = defaults.shell;
+        }
+
+        @CustomType.Setter
+        public Builder arch(@Nullable String arch) {
+
+            this.arch = arch;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder cpuCount(@Nullable Integer cpuCount) {
+
+            this.cpuCount = cpuCount;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder distro(@Nullable String distro) {
+
+            this.distro = distro;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder distroVersion(@Nullable String distroVersion) {
+
+            this.distroVersion = distroVersion;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder errors(Map<String,String> errors) {
+            if (errors == null) {
+              throw new MissingRequiredPropertyException("GetFactsResult", "errors");
+            }
+            this.errors = errors;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder hostname(@Nullable String hostname) {
+
+            this.hostname = hostname;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder initSystem(@Nullable String initSystem) {
+
+            this.initSystem = initSystem;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder ipAddresses(@Nullable List<String> ipAddresses) {
+
+            this.ipAddresses = ipAddresses;
+            return this;
+        }
+        public Builder ipAddresses(String... ipAddresses) {
+            return ipAddresses(List.of(ipAddresses));
+        }
+        @CustomType.Setter
+        public Builder kernel(@Nullable String kernel) {
+
+            this.kernel = kernel;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder memoryMb(@Nullable Integer memoryMb) {
+
+            this.memoryMb = memoryMb;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder os(@Nullable String os) {
+
+            this.os = os;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder osFamily(@Nullable String osFamily) {
+
+            this.osFamily = osFamily;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder passwordlessSudo(@Nullable Boolean passwordlessSudo) {
+
+            this.passwordlessSudo = passwordlessSudo;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder shell(@Nullable String shell) {
+
+            this.shell = shell;
+            return this;
+        }
+        public GetFactsResult build() {
+            final var _resultValue = new GetFactsResult();
+            _resultValue.arch = arch;
+            _resultValue.cpuCount = cpuCount;
+            _resultValue.distro = distro;
+            _resultValue.distroVersion = distroVersion;
+            _resultValue.errors = errors;
+            _resultValue.hostname = hostname;
+            _resultValue.initSystem = initSystem;
+            _resultValue.ipAddresses = ipAddresses;
+            _resultValue.kernel = kernel;
+            _resultValue.memoryMb = memoryMb;
+            _resultValue.os = os;
+            _resultValue.osFamily = osFamily;
+            _resultValue.passwordlessSudo = passwordlessSudo;
+            _resultValue.shell = shell;
+            return _resultValue;
+        }
+    }
+}
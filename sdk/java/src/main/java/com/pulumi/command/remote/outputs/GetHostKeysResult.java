@@ -0,0 +1,62 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.command.remote.outputs.HostKey;
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.util.List;
+import java.util.Objects;
+
+@CustomType
+public final class GetHostKeysResult {
+    /**
+     * @return The host keys that the host offers, one for each type.
+     * 
+     */
+    private List<HostKey> keys;
+
+    private GetHostKeysResult() {}
+    /**
+     * @return The host keys that the host offers, one for each type.
+     * 
+     */
+    public List<HostKey> keys() {
+        return this.keys;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(GetHostKeysResult defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private List<HostKey> keys;
+        public Builder() {}
+        public Builder(GetHostKeysResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.keys = defaults.keys;
+        }
+
+        @CustomType.Setter
+        public Builder keys(List<HostKey> keys) {
+            if (keys == null) {
+              throw new MissingRequiredPropertyException("GetHostKeysResult", "keys");
+            }
+            this.keys = keys;
+            return this;
+        }
+        public Builder keys(HostKey... keys) {
+            return keys(List.of(keys));
+        }
+        public GetHostKeysResult build() {
+            final var _resultValue = new GetHostKeysResult();
+            _resultValue.keys = keys;
+            return _resultValue;
+        }
+    }
+}
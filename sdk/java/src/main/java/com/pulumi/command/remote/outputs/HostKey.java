@@ -0,0 +1,104 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.String;
+import java.util.Objects;
+
+@CustomType
+public final class HostKey {
+    /**
+     * @return The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+     * 
+     */
+    private String fingerprint;
+    /**
+     * @return The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+     * 
+     */
+    private String key;
+    /**
+     * @return The type of the key, e.g. `ssh-ed25519`.
+     * 
+     */
+    private String type;
+
+    private HostKey() {}
+    /**
+     * @return The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+     * 
+     */
+    public String fingerprint() {
+        return this.fingerprint;
+    }
+    /**
+     * @return The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+     * 
+     */
+    public String key() {
+        return this.key;
+    }
+    /**
+     * @return The type of the key, e.g. `ssh-ed25519`.
+     * 
+     */
+    public String type() {
+        return this.type;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(HostKey defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private String fingerprint;
+        private String key;
+        private String type;
+        public Builder() {}
+        public Builder(HostKey defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.fingerprint = defaults.fingerprint;
+    	      this.key = defaults.key;
+    	      this.type = defaults.type;
+        }
+
+        @CustomType.Setter
+        public Builder fingerprint(String fingerprint) {
+            if (fingerprint == null) {
+              throw new MissingRequiredPropertyException("HostKey", "fingerprint");
+            }
+            this.fingerprint = fingerprint;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder key(String key) {
+            if (key == null) {
+              throw new MissingRequiredPropertyException("HostKey", "key");
+            }
+            this.key = key;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder type(String type) {
+            if (type == null) {
+              throw new MissingRequiredPropertyException("HostKey", "type");
+            }
+            this.type = type;
+            return this;
+        }
+        public HostKey build() {
+            final var _resultValue = new HostKey();
+            _resultValue.fingerprint = fingerprint;
+            _resultValue.key = key;
+            _resultValue.type = type;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,158 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class HostResult {
+    /**
+     * @return Whether the &#39;create&#39; command succeeded on the host. Until it did, &#39;create&#39; rather
+     * than &#39;update&#39; is run on the host on update.
+     * 
+     */
+    private Boolean created;
+    /**
+     * @return Why the command failed on the host, including its output. Unset if the command
+     * succeeded. If it failed, &#39;stdout&#39; and &#39;stderr&#39; hold the last &#39;errorOutputLines&#39; lines of its output.
+     * 
+     */
+    private @Nullable String error;
+    /**
+     * @return The exit code of the command&#39;s process, or -1 if the command didn&#39;t exit, e.g.
+     * because the host couldn&#39;t be reached.
+     * 
+     */
+    private Integer exitCode;
+    /**
+     * @return The standard error of the command&#39;s process
+     * 
+     */
+    private String stderr;
+    /**
+     * @return The standard output of the command&#39;s process
+     * 
+     */
+    private String stdout;
+
+    private HostResult() {}
+    /**
+     * @return Whether the &#39;create&#39; command succeeded on the host. Until it did, &#39;create&#39; rather
+     * than &#39;update&#39; is run on the host on update.
+     * 
+     */
+    public Boolean created() {
+        return this.created;
+    }
+    /**
+     * @return Why the command failed on the host, including its output. Unset if the command
+     * succeeded. If it failed, &#39;stdout&#39; and &#39;stderr&#39; hold the last &#39;errorOutputLines&#39; lines of its output.
+     * 
+     */
+    public Optional<String> error() {
+        return Optional.ofNullable(this.error);
+    }
+    /**
+     * @return The exit code of the command&#39;s process, or -1 if the command didn&#39;t exit, e.g.
+     * because the host couldn&#39;t be reached.
+     * 
+     */
+    public Integer exitCode() {
+        return this.exitCode;
+    }
+    /**
+     * @return The standard error of the command&#39;s process
+     * 
+     */
+    public String stderr() {
+        return this.stderr;
+    }
+    /**
+     * @return The standard output of the command&#39;s process
+     * 
+     */
+    public String stdout() {
+        return this.stdout;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(HostResult defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private Boolean created;
+        private @Nullable String error;
+        private Integer exitCode;
+        private String stderr;
+        private String stdout;
+        public Builder() {}
+        public Builder(HostResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.created = defaults.created;
+    	      this.error = defaults.error;
+    	      this.exitCode = defaults.exitCode;
+    	      this.stderr = defaults.stderr;
+    	      this.stdout = defaults.stdout;
+        }
+
+        @CustomType.Setter
+        public Builder created(Boolean created) {
+            if (created == null) {
+              throw new MissingRequiredPropertyException("HostResult", "created");
+            }
+            this.created = created;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder error(@Nullable String error) {
+
+            this.error = error;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder exitCode(Integer exitCode) {
+            if (exitCode == null) {
+              throw new MissingRequiredPropertyException("HostResult", "exitCode");
+            }
+            this.exitCode = exitCode;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder stderr(String stderr) {
+            if (stderr == null) {
+              throw new MissingRequiredPropertyException("HostResult", "stderr");
+            }
+            this.stderr = stderr;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder stdout(String stdout) {
+            if (stdout == null) {
+              throw new MissingRequiredPropertyException("HostResult", "stdout");
+            }
+            this.stdout = stdout;
+            return this;
+        }
+        public HostResult build() {
+            final var _resultValue = new HostResult();
+            _resultValue.created = created;
+            _resultValue.error = error;
+            _resultValue.exitCode = exitCode;
+            _resultValue.stderr = stderr;
+            _resultValue.stdout = stdout;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,124 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class Pty {
+    /**
+     * @return The width of the terminal in characters. Defaults to 80.
+     * 
+     */
+    private @Nullable Integer cols;
+    /**
+     * @return The height of the terminal in characters. Defaults to 24.
+     * 
+     */
+    private @Nullable Integer rows;
+    /**
+     * @return If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+     * captured stdout. They are always part of the logged output. Defaults to false.
+     * 
+     */
+    private @Nullable Boolean stripAnsi;
+    /**
+     * @return The terminal type, set as TERM. Defaults to `xterm`.
+     * 
+     */
+    private @Nullable String term;
+
+    private Pty() {}
+    /**
+     * @return The width of the terminal in characters. Defaults to 80.
+     * 
+     */
+    public Optional<Integer> cols() {
+        return Optional.ofNullable(this.cols);
+    }
+    /**
+     * @return The height of the terminal in characters. Defaults to 24.
+     * 
+     */
+    public Optional<Integer> rows() {
+        return Optional.ofNullable(this.rows);
+    }
+    /**
+     * @return If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+     * captured stdout. They are always part of the logged output. Defaults to false.
+     * 
+     */
+    public Optional<Boolean> stripAnsi() {
+        return Optional.ofNullable(this.stripAnsi);
+    }
+    /**
+     * @return The terminal type, set as TERM. Defaults to `xterm`.
+     * 
+     */
+    public Optional<String> term() {
+        return Optional.ofNullable(this.term);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Pty defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private @Nullable Integer cols;
+        private @Nullable Integer rows;
+        private @Nullable Boolean stripAnsi;
+        private @Nullable String term;
+        public Builder() {}
+        public Builder(Pty defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.cols = defaults.cols;
+    	      this.rows = defaults.rows;
+    	      this.stripAnsi = defaults.stripAnsi;
+    	      this.term = defaults.term;
+        }
+
+        @CustomType.Setter
+        public Builder cols(@Nullable Integer cols) {
+
+            this.cols = cols;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder rows(@Nullable Integer rows) {
+
+            this.rows = rows;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder stripAnsi(@Nullable Boolean stripAnsi) {
+
+            this.stripAnsi = stripAnsi;
+            return this;
+        }
+        @CustomType.Setter
+        public Builder term(@Nullable String term) {
+
+            this.term = term;
+            return this;
+        }
+        public Pty build() {
+            final var _resultValue = new Pty();
+            _resultValue.cols = cols;
+            _resultValue.rows = rows;
+            _resultValue.stripAnsi = stripAnsi;
+            _resultValue.term = term;
+            return _resultValue;
+        }
+    }
+}
@@ -0,0 +1,86 @@
+// *** WARNING: this file was generated by pulumi-language-java. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.pulumi.command.remote.outputs;
+
+import com.pulumi.command.remote.outputs.ConnectionStage;
+import com.pulumi.core.annotations.CustomType;
+import com.pulumi.exceptions.MissingRequiredPropertyException;
+import java.lang.Boolean;
+import java.util.List;
+import java.util.Objects;
+
+@CustomType
+public final class TestConnectionResult {
+    /**
+     * @return The stages of the connection in the order they were run. The stages after the first one that failed aren&#39;t run, except that all auth methods are tried until one succeeds.
+     * 
+     */
+    private List<ConnectionStage> stages;
+    /**
+     * @return If the connection to the host succeeded.
+     * 
+     */
+    private Boolean success;
+
+    private TestConnectionResult() {}
+    /**
+     * @return The stages of the connection in the order they were run. The stages after the first one that failed aren&#39;t run, except that all auth methods are tried until one succeeds.
+     * 
+     */
+    public List<ConnectionStage> stages() {
+        return this.stages;
+    }
+    /**
+     * @return If the connection to the host succeeded.
+     * 
+     */
+    public Boolean success() {
+        return this.success;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(TestConnectionResult defaults) {
+        return new Builder(defaults);
+    }
+    @CustomType.Builder
+    public static final class Builder {
+        private List<ConnectionStage> stages;
+        private Boolean success;
+        public Builder() {}
+        public Builder(TestConnectionResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.stages = defaults.stages;
+    	      this.success = defaults.success;
+        }
+
+        @CustomType.Setter
+        public Builder stages(List<ConnectionStage> stages) {
+            if (stages == null) {
+              throw new MissingRequiredPropertyException("TestConnectionResult", "stages");
+            }
+            this.stages = stages;
+            return this;
+        }
+        public Builder stages(ConnectionStage... stages) {
+            return stages(List.of(stages));
+        }
+        @CustomType.Setter
+        public Builder success(Boolean success) {
+            if (success == null) {
+              throw new MissingRequiredPropertyException("TestConnectionResult", "success");
+            }
+            this.success = success;
+            return this;
+        }
+        public TestConnectionResult build() {
+            final var _resultValue = new TestConnectionResult();
+            _resultValue.stages = stages;
+            _resultValue.success = success;
+            return _resultValue;
+        }
+    }
+}
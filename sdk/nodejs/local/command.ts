@@ -234,21 +234,59 @@ export class Command extends pulumi.CustomResource {
      * Additional environment variables available to the command's process.
      */
     declare public readonly environment: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    declare public readonly errorOutputLines: pulumi.Output<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    declare public readonly heartbeatInterval: pulumi.Output<number | undefined>;
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
      */
     declare public readonly interpreter: pulumi.Output<string[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    declare public readonly logStreamPrefix: pulumi.Output<boolean | undefined>;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     declare public readonly logging: pulumi.Output<enums.local.Logging | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     */
+    declare public readonly pty: pulumi.Output<outputs.local.Pty | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    declare public readonly redact: pulumi.Output<string[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    declare public readonly redactOutputs: pulumi.Output<boolean | undefined>;
     /**
      * The standard error of the command's process
      */
     declare public /*out*/ readonly stderr: pulumi.Output<string>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    declare public readonly stderrSeverity: pulumi.Output<enums.local.LogSeverity | undefined>;
     /**
      * Pass a string to the command's process as standard in
      */
@@ -257,6 +295,25 @@ export class Command extends pulumi.CustomResource {
      * The standard output of the command's process
      */
     declare public /*out*/ readonly stdout: pulumi.Output<string>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    declare public readonly stdoutSeverity: pulumi.Output<enums.local.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    declare public readonly stripWorkflowCommands: pulumi.Output<boolean | undefined>;
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn't searched.
+     */
+    declare public readonly symlinks: pulumi.Output<enums.local.SymlinkPolicy | undefined>;
     /**
      * The resource will be updated (or replaced) if any of these values change.
      *
@@ -279,6 +336,13 @@ export class Command extends pulumi.CustomResource {
      * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
      */
     declare public readonly update: pulumi.Output<string | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    declare public readonly workflowCommands: pulumi.Output<boolean | undefined>;
 
     /**
      * Create a Command resource with the given unique name, arguments, and options.
@@ -298,11 +362,22 @@ export class Command extends pulumi.CustomResource {
             resourceInputs["delete"] = args?.delete;
             resourceInputs["dir"] = args?.dir;
             resourceInputs["environment"] = args?.environment;
+            resourceInputs["errorOutputLines"] = args?.errorOutputLines;
+            resourceInputs["heartbeatInterval"] = args?.heartbeatInterval;
             resourceInputs["interpreter"] = args?.interpreter;
+            resourceInputs["logStreamPrefix"] = args?.logStreamPrefix;
             resourceInputs["logging"] = args?.logging;
+            resourceInputs["pty"] = args?.pty;
+            resourceInputs["redact"] = args?.redact ? pulumi.secret(args.redact) : undefined;
+            resourceInputs["redactOutputs"] = args?.redactOutputs;
+            resourceInputs["stderrSeverity"] = args?.stderrSeverity;
             resourceInputs["stdin"] = args?.stdin;
+            resourceInputs["stdoutSeverity"] = args?.stdoutSeverity;
+            resourceInputs["stripWorkflowCommands"] = args?.stripWorkflowCommands;
+            resourceInputs["symlinks"] = args?.symlinks;
             resourceInputs["triggers"] = args?.triggers;
             resourceInputs["update"] = args?.update;
+            resourceInputs["workflowCommands"] = args?.workflowCommands;
             resourceInputs["archive"] = undefined /*out*/;
             resourceInputs["assets"] = undefined /*out*/;
             resourceInputs["stderr"] = undefined /*out*/;
@@ -317,15 +392,28 @@ export class Command extends pulumi.CustomResource {
             resourceInputs["delete"] = undefined /*out*/;
             resourceInputs["dir"] = undefined /*out*/;
             resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["errorOutputLines"] = undefined /*out*/;
+            resourceInputs["heartbeatInterval"] = undefined /*out*/;
             resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["logStreamPrefix"] = undefined /*out*/;
             resourceInputs["logging"] = undefined /*out*/;
+            resourceInputs["pty"] = undefined /*out*/;
+            resourceInputs["redact"] = undefined /*out*/;
+            resourceInputs["redactOutputs"] = undefined /*out*/;
             resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stderrSeverity"] = undefined /*out*/;
             resourceInputs["stdin"] = undefined /*out*/;
             resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["stdoutSeverity"] = undefined /*out*/;
+            resourceInputs["stripWorkflowCommands"] = undefined /*out*/;
+            resourceInputs["symlinks"] = undefined /*out*/;
             resourceInputs["triggers"] = undefined /*out*/;
             resourceInputs["update"] = undefined /*out*/;
+            resourceInputs["workflowCommands"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["redact"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         const replaceOnChanges = { replaceOnChanges: ["triggers[*]"] };
         opts = pulumi.mergeOptions(opts, replaceOnChanges);
         super(Command.__pulumiType, name, resourceInputs, opts);
@@ -449,21 +537,78 @@ export interface CommandArgs {
      * Additional environment variables available to the command's process.
      */
     environment?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    errorOutputLines?: pulumi.Input<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    heartbeatInterval?: pulumi.Input<number | undefined>;
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
      */
     interpreter?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    logStreamPrefix?: pulumi.Input<boolean | undefined>;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     logging?: pulumi.Input<enums.local.Logging | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     */
+    pty?: pulumi.Input<inputs.local.PtyArgs | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    redact?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    redactOutputs?: pulumi.Input<boolean | undefined>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    stderrSeverity?: pulumi.Input<enums.local.LogSeverity | undefined>;
     /**
      * Pass a string to the command's process as standard in
      */
     stdin?: pulumi.Input<string | undefined>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    stdoutSeverity?: pulumi.Input<enums.local.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    stripWorkflowCommands?: pulumi.Input<boolean | undefined>;
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn't searched.
+     */
+    symlinks?: pulumi.Input<enums.local.SymlinkPolicy | undefined>;
     /**
      * The resource will be updated (or replaced) if any of these values change.
      *
@@ -486,4 +631,11 @@ export interface CommandArgs {
      * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
      */
     update?: pulumi.Input<string | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    workflowCommands?: pulumi.Input<boolean | undefined>;
 }
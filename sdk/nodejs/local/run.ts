@@ -20,9 +20,20 @@ export function run(args: RunArgs, opts?: pulumi.InvokeOptions): Promise<RunResu
         "command": args.command,
         "dir": args.dir,
         "environment": args.environment,
+        "errorOutputLines": args.errorOutputLines,
+        "heartbeatInterval": args.heartbeatInterval,
         "interpreter": args.interpreter,
+        "logStreamPrefix": args.logStreamPrefix,
         "logging": args.logging,
+        "pty": args.pty,
+        "redact": args.redact,
+        "redactOutputs": args.redactOutputs,
+        "stderrSeverity": args.stderrSeverity,
         "stdin": args.stdin,
+        "stdoutSeverity": args.stdoutSeverity,
+        "stripWorkflowCommands": args.stripWorkflowCommands,
+        "symlinks": args.symlinks,
+        "workflowCommands": args.workflowCommands,
     }, opts);
 }
 
@@ -128,21 +139,85 @@ export interface RunArgs {
      * Additional environment variables available to the command's process.
      */
     environment?: {[key: string]: string};
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    errorOutputLines?: number;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    heartbeatInterval?: number;
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
      */
     interpreter?: string[];
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    logStreamPrefix?: boolean;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     logging?: enums.local.Logging;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     */
+    pty?: inputs.local.Pty;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    redact?: string[];
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    redactOutputs?: boolean;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    stderrSeverity?: enums.local.LogSeverity;
     /**
      * Pass a string to the command's process as standard in
      */
     stdin?: string;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    stdoutSeverity?: enums.local.LogSeverity;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    stripWorkflowCommands?: boolean;
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn't searched.
+     */
+    symlinks?: enums.local.SymlinkPolicy;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    workflowCommands?: boolean;
 }
 
 export interface RunResult {
@@ -256,21 +331,59 @@ export interface RunResult {
      * Additional environment variables available to the command's process.
      */
     readonly environment?: {[key: string]: string};
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    readonly errorOutputLines?: number;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    readonly heartbeatInterval?: number;
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
      */
     readonly interpreter?: string[];
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    readonly logStreamPrefix?: boolean;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     readonly logging?: enums.local.Logging;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     */
+    readonly pty?: outputs.local.Pty;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    readonly redact?: string[];
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    readonly redactOutputs?: boolean;
     /**
      * The standard error of the command's process
      */
     readonly stderr: string;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    readonly stderrSeverity?: enums.local.LogSeverity;
     /**
      * Pass a string to the command's process as standard in
      */
@@ -279,6 +392,32 @@ export interface RunResult {
      * The standard output of the command's process
      */
     readonly stdout: string;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    readonly stdoutSeverity?: enums.local.LogSeverity;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    readonly stripWorkflowCommands?: boolean;
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn't searched.
+     */
+    readonly symlinks?: enums.local.SymlinkPolicy;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    readonly workflowCommands?: boolean;
 }
 /**
  * A local command to be executed unconditionally.
@@ -293,9 +432,20 @@ export function runOutput(args: RunOutputArgs, opts?: pulumi.InvokeOutputOptions
         "command": args.command,
         "dir": args.dir,
         "environment": args.environment,
+        "errorOutputLines": args.errorOutputLines,
+        "heartbeatInterval": args.heartbeatInterval,
         "interpreter": args.interpreter,
+        "logStreamPrefix": args.logStreamPrefix,
         "logging": args.logging,
+        "pty": args.pty,
+        "redact": args.redact,
+        "redactOutputs": args.redactOutputs,
+        "stderrSeverity": args.stderrSeverity,
         "stdin": args.stdin,
+        "stdoutSeverity": args.stdoutSeverity,
+        "stripWorkflowCommands": args.stripWorkflowCommands,
+        "symlinks": args.symlinks,
+        "workflowCommands": args.workflowCommands,
     }, opts);
 }
 
@@ -401,19 +551,83 @@ export interface RunOutputArgs {
      * Additional environment variables available to the command's process.
      */
     environment?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    errorOutputLines?: pulumi.Input<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    heartbeatInterval?: pulumi.Input<number | undefined>;
     /**
      * The program and arguments to run the command.
      * On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
      */
     interpreter?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    logStreamPrefix?: pulumi.Input<boolean | undefined>;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     logging?: pulumi.Input<enums.local.Logging | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input. Not supported on Windows.
+     */
+    pty?: pulumi.Input<inputs.local.PtyArgs | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    redact?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    redactOutputs?: pulumi.Input<boolean | undefined>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    stderrSeverity?: pulumi.Input<enums.local.LogSeverity | undefined>;
     /**
      * Pass a string to the command's process as standard in
      */
     stdin?: pulumi.Input<string | undefined>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    stdoutSeverity?: pulumi.Input<enums.local.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    stripWorkflowCommands?: pulumi.Input<boolean | undefined>;
+    /**
+     * How symlinks are handled when matching `assetPaths` and `archivePaths`.
+     * With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+     * searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+     * Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+     * search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+     * files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+     * a directory isn't searched.
+     */
+    symlinks?: pulumi.Input<enums.local.SymlinkPolicy | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    workflowCommands?: pulumi.Input<boolean | undefined>;
 }
@@ -99,6 +99,27 @@ export class Command extends pulumi.CustomResource {
      * Defaults to true.
      */
     declare public readonly addPreviousOutputInEnv: pulumi.Output<boolean | undefined>;
+    /**
+     * Run the command as another user, root by default, via the escalation tool set in
+     * 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     */
+    declare public readonly become: pulumi.Output<boolean | undefined>;
+    /**
+     * The escalation tool to use with `become`. Defaults to `sudo`.
+     */
+    declare public readonly becomeMethod: pulumi.Output<enums.remote.BecomeMethod | undefined>;
+    /**
+     * The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command's output.
+     */
+    declare public readonly becomePassword: pulumi.Output<string | undefined>;
+    /**
+     * The user to run the command as with `become`. Defaults to `root`.
+     */
+    declare public readonly becomeUser: pulumi.Output<string | undefined>;
     /**
      * The parameters with which to connect to the remote host.
      */
@@ -119,23 +140,96 @@ export class Command extends pulumi.CustomResource {
      * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
      */
     declare public readonly delete: pulumi.Output<string | undefined>;
+    /**
+     * Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+     * Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+     */
+    declare public readonly detached: pulumi.Output<outputs.remote.Detached | undefined>;
+    /**
+     * The directory on the remote host to run the command in. A leading '~' is the home
+     * directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+     * on the remote host.
+     */
+    declare public readonly dir: pulumi.Output<string | undefined>;
     /**
      * Additional environment variables available to the command's process.
-     * Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-     * Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-     * with the variables in the form 'VAR=value command'.
+     * How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn't needed.
      */
     declare public readonly environment: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+     * them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+     * 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+     */
+    declare public readonly environmentMode: pulumi.Output<enums.remote.EnvironmentMode | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    declare public readonly errorOutputLines: pulumi.Output<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    declare public readonly heartbeatInterval: pulumi.Output<number | undefined>;
+    /**
+     * The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     */
+    declare public readonly interpreter: pulumi.Output<string[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    declare public readonly logStreamPrefix: pulumi.Output<boolean | undefined>;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     declare public readonly logging: pulumi.Output<enums.remote.Logging | undefined>;
+    /**
+     * Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     */
+    declare public readonly loginShell: pulumi.Output<boolean | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     */
+    declare public readonly pty: pulumi.Output<outputs.remote.Pty | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+     * Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    declare public readonly redact: pulumi.Output<string[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    declare public readonly redactOutputs: pulumi.Output<boolean | undefined>;
     /**
      * The standard error of the command's process
      */
     declare public /*out*/ readonly stderr: pulumi.Output<string>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    declare public readonly stderrSeverity: pulumi.Output<enums.remote.LogSeverity | undefined>;
     /**
      * Pass a string to the command's process as standard in
      */
@@ -144,6 +238,23 @@ export class Command extends pulumi.CustomResource {
      * The standard output of the command's process
      */
     declare public /*out*/ readonly stdout: pulumi.Output<string>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    declare public readonly stdoutSeverity: pulumi.Output<enums.remote.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    declare public readonly stripWorkflowCommands: pulumi.Output<boolean | undefined>;
+    /**
+     * The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     */
+    declare public readonly timeout: pulumi.Output<number | undefined>;
     /**
      * The resource will be updated (or replaced) if any of these values change.
      *
@@ -166,6 +277,13 @@ export class Command extends pulumi.CustomResource {
      * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
      */
     declare public readonly update: pulumi.Output<string | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    declare public readonly workflowCommands: pulumi.Output<boolean | undefined>;
 
     /**
      * Create a Command resource with the given unique name, arguments, and options.
@@ -182,31 +300,71 @@ export class Command extends pulumi.CustomResource {
                 throw new Error("Missing required property 'connection'");
             }
             resourceInputs["addPreviousOutputInEnv"] = args?.addPreviousOutputInEnv;
+            resourceInputs["become"] = args?.become;
+            resourceInputs["becomeMethod"] = args?.becomeMethod;
+            resourceInputs["becomePassword"] = args?.becomePassword ? pulumi.secret(args.becomePassword) : undefined;
+            resourceInputs["becomeUser"] = args?.becomeUser;
             resourceInputs["connection"] = args?.connection ? pulumi.secret(pulumi.output(args.connection).apply(inputs.remote.connectionArgsProvideDefaults)) : undefined;
             resourceInputs["create"] = args?.create;
             resourceInputs["delete"] = args?.delete;
+            resourceInputs["detached"] = args?.detached;
+            resourceInputs["dir"] = args?.dir;
             resourceInputs["environment"] = args?.environment;
+            resourceInputs["environmentMode"] = args?.environmentMode;
+            resourceInputs["errorOutputLines"] = args?.errorOutputLines;
+            resourceInputs["heartbeatInterval"] = args?.heartbeatInterval;
+            resourceInputs["interpreter"] = args?.interpreter;
+            resourceInputs["logStreamPrefix"] = args?.logStreamPrefix;
             resourceInputs["logging"] = args?.logging;
+            resourceInputs["loginShell"] = args?.loginShell;
+            resourceInputs["pty"] = args?.pty;
+            resourceInputs["redact"] = args?.redact ? pulumi.secret(args.redact) : undefined;
+            resourceInputs["redactOutputs"] = args?.redactOutputs;
+            resourceInputs["stderrSeverity"] = args?.stderrSeverity;
             resourceInputs["stdin"] = args?.stdin;
+            resourceInputs["stdoutSeverity"] = args?.stdoutSeverity;
+            resourceInputs["stripWorkflowCommands"] = args?.stripWorkflowCommands;
+            resourceInputs["timeout"] = args?.timeout;
             resourceInputs["triggers"] = args?.triggers;
             resourceInputs["update"] = args?.update;
+            resourceInputs["workflowCommands"] = args?.workflowCommands;
             resourceInputs["stderr"] = undefined /*out*/;
             resourceInputs["stdout"] = undefined /*out*/;
         } else {
             resourceInputs["addPreviousOutputInEnv"] = undefined /*out*/;
+            resourceInputs["become"] = undefined /*out*/;
+            resourceInputs["becomeMethod"] = undefined /*out*/;
+            resourceInputs["becomePassword"] = undefined /*out*/;
+            resourceInputs["becomeUser"] = undefined /*out*/;
             resourceInputs["connection"] = undefined /*out*/;
             resourceInputs["create"] = undefined /*out*/;
             resourceInputs["delete"] = undefined /*out*/;
+            resourceInputs["detached"] = undefined /*out*/;
+            resourceInputs["dir"] = undefined /*out*/;
             resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["environmentMode"] = undefined /*out*/;
+            resourceInputs["errorOutputLines"] = undefined /*out*/;
+            resourceInputs["heartbeatInterval"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["logStreamPrefix"] = undefined /*out*/;
             resourceInputs["logging"] = undefined /*out*/;
+            resourceInputs["loginShell"] = undefined /*out*/;
+            resourceInputs["pty"] = undefined /*out*/;
+            resourceInputs["redact"] = undefined /*out*/;
+            resourceInputs["redactOutputs"] = undefined /*out*/;
             resourceInputs["stderr"] = undefined /*out*/;
+            resourceInputs["stderrSeverity"] = undefined /*out*/;
             resourceInputs["stdin"] = undefined /*out*/;
             resourceInputs["stdout"] = undefined /*out*/;
+            resourceInputs["stdoutSeverity"] = undefined /*out*/;
+            resourceInputs["stripWorkflowCommands"] = undefined /*out*/;
+            resourceInputs["timeout"] = undefined /*out*/;
             resourceInputs["triggers"] = undefined /*out*/;
             resourceInputs["update"] = undefined /*out*/;
+            resourceInputs["workflowCommands"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
-        const secretOpts = { additionalSecretOutputs: ["connection"] };
+        const secretOpts = { additionalSecretOutputs: ["becomePassword", "connection", "redact"] };
         opts = pulumi.mergeOptions(opts, secretOpts);
         const replaceOnChanges = { replaceOnChanges: ["triggers[*]"] };
         opts = pulumi.mergeOptions(opts, replaceOnChanges);
@@ -224,6 +382,27 @@ export interface CommandArgs {
      * Defaults to true.
      */
     addPreviousOutputInEnv?: pulumi.Input<boolean | undefined>;
+    /**
+     * Run the command as another user, root by default, via the escalation tool set in
+     * 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     */
+    become?: pulumi.Input<boolean | undefined>;
+    /**
+     * The escalation tool to use with `become`. Defaults to `sudo`.
+     */
+    becomeMethod?: pulumi.Input<enums.remote.BecomeMethod | undefined>;
+    /**
+     * The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command's output.
+     */
+    becomePassword?: pulumi.Input<string | undefined>;
+    /**
+     * The user to run the command as with `become`. Defaults to `root`.
+     */
+    becomeUser?: pulumi.Input<string | undefined>;
     /**
      * The parameters with which to connect to the remote host.
      */
@@ -244,23 +423,113 @@ export interface CommandArgs {
      * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
      */
     delete?: pulumi.Input<string | undefined>;
+    /**
+     * Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+     * Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+     */
+    detached?: pulumi.Input<inputs.remote.DetachedArgs | undefined>;
+    /**
+     * The directory on the remote host to run the command in. A leading '~' is the home
+     * directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+     * on the remote host.
+     */
+    dir?: pulumi.Input<string | undefined>;
     /**
      * Additional environment variables available to the command's process.
-     * Note that this only works if the SSH server is configured to accept these variables via AcceptEnv.
-     * Alternatively, if a Bash-like shell runs the command on the remote host, you could prefix the command itself
-     * with the variables in the form 'VAR=value command'.
+     * How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn't needed.
      */
     environment?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+     * them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+     * 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+     */
+    environmentMode?: pulumi.Input<enums.remote.EnvironmentMode | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    errorOutputLines?: pulumi.Input<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    heartbeatInterval?: pulumi.Input<number | undefined>;
+    /**
+     * The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     */
+    interpreter?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    logStreamPrefix?: pulumi.Input<boolean | undefined>;
     /**
      * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
      * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
      * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
      */
     logging?: pulumi.Input<enums.remote.Logging | undefined>;
+    /**
+     * Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     */
+    loginShell?: pulumi.Input<boolean | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     */
+    pty?: pulumi.Input<inputs.remote.PtyArgs | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+     * Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    redact?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    redactOutputs?: pulumi.Input<boolean | undefined>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    stderrSeverity?: pulumi.Input<enums.remote.LogSeverity | undefined>;
     /**
      * Pass a string to the command's process as standard in
      */
     stdin?: pulumi.Input<string | undefined>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    stdoutSeverity?: pulumi.Input<enums.remote.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    stripWorkflowCommands?: pulumi.Input<boolean | undefined>;
+    /**
+     * The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     */
+    timeout?: pulumi.Input<number | undefined>;
     /**
      * The resource will be updated (or replaced) if any of these values change.
      *
@@ -283,4 +552,11 @@ export interface CommandArgs {
      * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
      */
     update?: pulumi.Input<string | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    workflowCommands?: pulumi.Input<boolean | undefined>;
 }
@@ -40,6 +40,10 @@ export class CopyFile extends pulumi.CustomResource {
         return obj['__pulumiType'] === CopyFile.__pulumiType;
     }
 
+    /**
+     * Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+     */
+    declare public readonly become: pulumi.Output<boolean | undefined>;
     /**
      * The parameters with which to connect to the remote host.
      */
@@ -52,6 +56,10 @@ export class CopyFile extends pulumi.CustomResource {
      * The destination path in the remote host.
      */
     declare public readonly remotePath: pulumi.Output<string>;
+    /**
+     * How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+     */
+    declare public readonly transport: pulumi.Output<enums.remote.Transport | undefined>;
     /**
      * Trigger replacements on changes to this input.
      */
@@ -79,14 +87,18 @@ export class CopyFile extends pulumi.CustomResource {
             if (args?.remotePath === undefined && !opts.urn) {
                 throw new Error("Missing required property 'remotePath'");
             }
+            resourceInputs["become"] = args?.become;
             resourceInputs["connection"] = args?.connection ? pulumi.secret(pulumi.output(args.connection).apply(inputs.remote.connectionArgsProvideDefaults)) : undefined;
             resourceInputs["localPath"] = args?.localPath;
             resourceInputs["remotePath"] = args?.remotePath;
+            resourceInputs["transport"] = args?.transport;
             resourceInputs["triggers"] = args?.triggers;
         } else {
+            resourceInputs["become"] = undefined /*out*/;
             resourceInputs["connection"] = undefined /*out*/;
             resourceInputs["localPath"] = undefined /*out*/;
             resourceInputs["remotePath"] = undefined /*out*/;
+            resourceInputs["transport"] = undefined /*out*/;
             resourceInputs["triggers"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
@@ -100,6 +112,10 @@ export class CopyFile extends pulumi.CustomResource {
  * The set of arguments for constructing a CopyFile resource.
  */
 export interface CopyFileArgs {
+    /**
+     * Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+     */
+    become?: pulumi.Input<boolean | undefined>;
     /**
      * The parameters with which to connect to the remote host.
      */
@@ -112,6 +128,10 @@ export interface CopyFileArgs {
      * The destination path in the remote host.
      */
     remotePath: pulumi.Input<string>;
+    /**
+     * How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+     */
+    transport?: pulumi.Input<enums.remote.Transport | undefined>;
     /**
      * Trigger replacements on changes to this input.
      */
@@ -95,18 +95,62 @@ export class CopyToRemote extends pulumi.CustomResource {
         return obj['__pulumiType'] === CopyToRemote.__pulumiType;
     }
 
+    /**
+     * How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+     */
+    declare public readonly atomic: pulumi.Output<enums.remote.AtomicMode | undefined>;
+    /**
+     * Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+     */
+    declare public readonly become: pulumi.Output<boolean | undefined>;
     /**
      * The parameters with which to connect to the remote host.
      */
     declare public readonly connection: pulumi.Output<outputs.remote.Connection>;
+    /**
+     * The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+     */
+    declare public /*out*/ readonly drift: pulumi.Output<string[] | undefined>;
+    /**
+     * A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+     */
+    declare public readonly exclude: pulumi.Output<string[] | undefined>;
+    /**
+     * If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+     */
+    declare public readonly extract: pulumi.Output<boolean | undefined>;
+    /**
+     * If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+     */
+    declare public readonly fsync: pulumi.Output<boolean | undefined>;
+    /**
+     * A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+     */
+    declare public readonly include: pulumi.Output<string[] | undefined>;
+    /**
+     * The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+     */
+    declare public /*out*/ readonly manifest: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+     */
+    declare public readonly parallelism: pulumi.Output<number | undefined>;
     /**
      * The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
      */
     declare public readonly remotePath: pulumi.Output<string>;
     /**
-     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
      */
     declare public readonly source: pulumi.Output<pulumi.asset.Asset | pulumi.asset.Archive>;
+    /**
+     * How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+     */
+    declare public readonly symlinks: pulumi.Output<enums.remote.SymlinkPolicy | undefined>;
+    /**
+     * How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+     */
+    declare public readonly transport: pulumi.Output<enums.remote.Transport | undefined>;
     /**
      * Trigger replacements on changes to this input.
      */
@@ -132,14 +176,36 @@ export class CopyToRemote extends pulumi.CustomResource {
             if (args?.source === undefined && !opts.urn) {
                 throw new Error("Missing required property 'source'");
             }
+            resourceInputs["atomic"] = args?.atomic;
+            resourceInputs["become"] = args?.become;
             resourceInputs["connection"] = args?.connection ? pulumi.secret(pulumi.output(args.connection).apply(inputs.remote.connectionArgsProvideDefaults)) : undefined;
+            resourceInputs["exclude"] = args?.exclude;
+            resourceInputs["extract"] = args?.extract;
+            resourceInputs["fsync"] = args?.fsync;
+            resourceInputs["include"] = args?.include;
+            resourceInputs["parallelism"] = (args?.parallelism) ?? 1;
             resourceInputs["remotePath"] = args?.remotePath;
             resourceInputs["source"] = args?.source;
+            resourceInputs["symlinks"] = args?.symlinks;
+            resourceInputs["transport"] = args?.transport;
             resourceInputs["triggers"] = args?.triggers;
+            resourceInputs["drift"] = undefined /*out*/;
+            resourceInputs["manifest"] = undefined /*out*/;
         } else {
+            resourceInputs["atomic"] = undefined /*out*/;
+            resourceInputs["become"] = undefined /*out*/;
             resourceInputs["connection"] = undefined /*out*/;
+            resourceInputs["drift"] = undefined /*out*/;
+            resourceInputs["exclude"] = undefined /*out*/;
+            resourceInputs["extract"] = undefined /*out*/;
+            resourceInputs["fsync"] = undefined /*out*/;
+            resourceInputs["include"] = undefined /*out*/;
+            resourceInputs["manifest"] = undefined /*out*/;
+            resourceInputs["parallelism"] = undefined /*out*/;
             resourceInputs["remotePath"] = undefined /*out*/;
             resourceInputs["source"] = undefined /*out*/;
+            resourceInputs["symlinks"] = undefined /*out*/;
+            resourceInputs["transport"] = undefined /*out*/;
             resourceInputs["triggers"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
@@ -155,18 +221,54 @@ export class CopyToRemote extends pulumi.CustomResource {
  * The set of arguments for constructing a CopyToRemote resource.
  */
 export interface CopyToRemoteArgs {
+    /**
+     * How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+     */
+    atomic?: pulumi.Input<enums.remote.AtomicMode | undefined>;
+    /**
+     * Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+     */
+    become?: pulumi.Input<boolean | undefined>;
     /**
      * The parameters with which to connect to the remote host.
      */
     connection: pulumi.Input<inputs.remote.ConnectionArgs>;
+    /**
+     * A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+     */
+    exclude?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+     */
+    extract?: pulumi.Input<boolean | undefined>;
+    /**
+     * If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+     */
+    fsync?: pulumi.Input<boolean | undefined>;
+    /**
+     * A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+     */
+    include?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+     */
+    parallelism?: pulumi.Input<number | undefined>;
     /**
      * The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
      */
     remotePath: pulumi.Input<string>;
     /**
-     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+     * An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
      */
     source: pulumi.Input<pulumi.asset.Asset | pulumi.asset.Archive>;
+    /**
+     * How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+     */
+    symlinks?: pulumi.Input<enums.remote.SymlinkPolicy | undefined>;
+    /**
+     * How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+     */
+    transport?: pulumi.Input<enums.remote.Transport | undefined>;
     /**
      * Trigger replacements on changes to this input.
      */
@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as enums from "../types/enums";
+import * as utilities from "../utilities";
+
+/**
+ * Gathers facts about a remote host, like its operating system, architecture and resources.
+ * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+ */
+export function getFacts(args: GetFactsArgs, opts?: pulumi.InvokeOptions): Promise<GetFactsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("command:remote:getFacts", {
+        "connection": args.connection ? inputs.remote.connectionProvideDefaults(args.connection) : undefined,
+    }, opts);
+}
+
+export interface GetFactsArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: inputs.remote.Connection;
+}
+
+export interface GetFactsResult {
+    /**
+     * The machine architecture as reported by `uname -m`, e.g. `x86_64` or `aarch64`.
+     */
+    readonly arch?: string;
+    /**
+     * The number of online CPUs.
+     */
+    readonly cpuCount?: number;
+    /**
+     * The distribution, e.g. `ubuntu`, which is `ID` in `/etc/os-release`, or `macos`.
+     */
+    readonly distro?: string;
+    /**
+     * The version of the distribution, e.g. `24.04`.
+     */
+    readonly distroVersion?: string;
+    /**
+     * The errors of the probes that failed, keyed by the name of the probe: `uname` for `os`, `kernel` and `arch`, `osRelease` for `osFamily`, `distro` and `distroVersion`, and otherwise the name of the fact.
+     */
+    readonly errors: {[key: string]: string};
+    /**
+     * The host name of the host.
+     */
+    readonly hostname?: string;
+    /**
+     * The init system, `systemd`, `openrc`, `launchd` or `sysvinit`.
+     */
+    readonly initSystem?: string;
+    /**
+     * The IP addresses of the host, without loopback and link-local addresses.
+     */
+    readonly ipAddresses?: string[];
+    /**
+     * The kernel release as reported by `uname -r`.
+     */
+    readonly kernel?: string;
+    /**
+     * The total memory in MiB.
+     */
+    readonly memoryMb?: number;
+    /**
+     * The operating system as reported by `uname -s` in lower case, e.g. `linux` or `darwin`.
+     */
+    readonly os?: string;
+    /**
+     * The family of the distribution, e.g. `debian` or `rhel`, which is the first entry of `ID_LIKE` in `/etc/os-release`, or `ID` if it's not set. Outside of Linux, the same as `os`.
+     */
+    readonly osFamily?: string;
+    /**
+     * If the user can run commands with sudo without a password.
+     */
+    readonly passwordlessSudo?: boolean;
+    /**
+     * The login shell of the user.
+     */
+    readonly shell?: string;
+}
+/**
+ * Gathers facts about a remote host, like its operating system, architecture and resources.
+ * Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+ */
+export function getFactsOutput(args: GetFactsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetFactsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("command:remote:getFacts", {
+        "connection": pulumi.output(args.connection).apply(inputs.remote.connectionProvideDefaults),
+    }, opts);
+}
+
+export interface GetFactsOutputArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: pulumi.Input<inputs.remote.ConnectionArgs>;
+}
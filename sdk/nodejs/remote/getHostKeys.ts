@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as enums from "../types/enums";
+import * as utilities from "../utilities";
+
+/**
+ * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+ * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+ */
+export function getHostKeys(args: GetHostKeysArgs, opts?: pulumi.InvokeOptions): Promise<GetHostKeysResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("command:remote:getHostKeys", {
+        "connection": args.connection ? inputs.remote.connectionProvideDefaults(args.connection) : undefined,
+    }, opts);
+}
+
+export interface GetHostKeysArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: inputs.remote.Connection;
+}
+
+export interface GetHostKeysResult {
+    /**
+     * The host keys that the host offers, one for each type.
+     */
+    readonly keys: outputs.remote.HostKey[];
+}
+/**
+ * Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+ * It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+ */
+export function getHostKeysOutput(args: GetHostKeysOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetHostKeysResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("command:remote:getHostKeys", {
+        "connection": pulumi.output(args.connection).apply(inputs.remote.connectionProvideDefaults),
+    }, opts);
+}
+
+export interface GetHostKeysOutputArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: pulumi.Input<inputs.remote.ConnectionArgs>;
+}
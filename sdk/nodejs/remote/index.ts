@@ -20,6 +20,36 @@ export type CopyToRemote = import("./copyToRemote").CopyToRemote;
 export const CopyToRemote: typeof import("./copyToRemote").CopyToRemote = null as any;
 utilities.lazyLoad(exports, ["CopyToRemote"], () => require("./copyToRemote"));
 
+export { GetFactsArgs, GetFactsResult, GetFactsOutputArgs } from "./getFacts";
+export const getFacts: typeof import("./getFacts").getFacts = null as any;
+export const getFactsOutput: typeof import("./getFacts").getFactsOutput = null as any;
+utilities.lazyLoad(exports, ["getFacts","getFactsOutput"], () => require("./getFacts"));
+
+export { GetHostKeysArgs, GetHostKeysResult, GetHostKeysOutputArgs } from "./getHostKeys";
+export const getHostKeys: typeof import("./getHostKeys").getHostKeys = null as any;
+export const getHostKeysOutput: typeof import("./getHostKeys").getHostKeysOutput = null as any;
+utilities.lazyLoad(exports, ["getHostKeys","getHostKeysOutput"], () => require("./getHostKeys"));
+
+export { MultiCommandArgs } from "./multiCommand";
+export type MultiCommand = import("./multiCommand").MultiCommand;
+export const MultiCommand: typeof import("./multiCommand").MultiCommand = null as any;
+utilities.lazyLoad(exports, ["MultiCommand"], () => require("./multiCommand"));
+
+export { TestConnectionArgs, TestConnectionResult, TestConnectionOutputArgs } from "./testConnection";
+export const testConnection: typeof import("./testConnection").testConnection = null as any;
+export const testConnectionOutput: typeof import("./testConnection").testConnectionOutput = null as any;
+utilities.lazyLoad(exports, ["testConnection","testConnectionOutput"], () => require("./testConnection"));
+
+export { TunnelArgs } from "./tunnel";
+export type Tunnel = import("./tunnel").Tunnel;
+export const Tunnel: typeof import("./tunnel").Tunnel = null as any;
+utilities.lazyLoad(exports, ["Tunnel"], () => require("./tunnel"));
+
+export { WaitForReadyArgs } from "./waitForReady";
+export type WaitForReady = import("./waitForReady").WaitForReady;
+export const WaitForReady: typeof import("./waitForReady").WaitForReady = null as any;
+utilities.lazyLoad(exports, ["WaitForReady"], () => require("./waitForReady"));
+
 
 // Export enums:
 export * from "../types/enums/remote";
@@ -34,6 +64,12 @@ const _module = {
                 return new CopyFile(name, <any>undefined, { urn })
             case "command:remote:CopyToRemote":
                 return new CopyToRemote(name, <any>undefined, { urn })
+            case "command:remote:MultiCommand":
+                return new MultiCommand(name, <any>undefined, { urn })
+            case "command:remote:Tunnel":
+                return new Tunnel(name, <any>undefined, { urn })
+            case "command:remote:WaitForReady":
+                return new WaitForReady(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
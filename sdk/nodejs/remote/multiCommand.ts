@@ -0,0 +1,559 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as enums from "../types/enums";
+import * as utilities from "../utilities";
+
+/**
+ * Runs the same command on several remote hosts, e.g. a fleet of identical servers. The
+ * hosts are given either as 'connections', or as 'hosts' that share the settings of 'connection'. The command
+ * is run on 'batchSize' hosts at a time, and stops after more than 'maxFailures' hosts failed. The output and
+ * exit code of each host is available via the 'results' property.
+ *
+ * On update, the 'delete' command is run on the hosts that were removed and the 'create' command on the hosts
+ * that were added or that it didn't succeed on yet. The 'update' command, or 'create' if it's not set, is run on
+ * the other hosts only if the command's inputs or the host's connection changed, or if it failed on the host
+ * before. The output of each host is logged prefixed with the host.
+ */
+export class MultiCommand extends pulumi.CustomResource {
+    /**
+     * Get an existing MultiCommand resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): MultiCommand {
+        return new MultiCommand(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'command:remote:MultiCommand';
+
+    /**
+     * Returns true if the given object is an instance of MultiCommand.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is MultiCommand {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === MultiCommand.__pulumiType;
+    }
+
+    /**
+     * If the previous command's stdout and stderr (as generated by the prior create/update) is
+     * injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+     * Defaults to true.
+     */
+    declare public readonly addPreviousOutputInEnv: pulumi.Output<boolean | undefined>;
+    /**
+     * The number of hosts the command is run on at the same time. The next batch of
+     * hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+     */
+    declare public readonly batchSize: pulumi.Output<number | undefined>;
+    /**
+     * Run the command as another user, root by default, via the escalation tool set in
+     * 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     */
+    declare public readonly become: pulumi.Output<boolean | undefined>;
+    /**
+     * The escalation tool to use with `become`. Defaults to `sudo`.
+     */
+    declare public readonly becomeMethod: pulumi.Output<enums.remote.BecomeMethod | undefined>;
+    /**
+     * The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command's output.
+     */
+    declare public readonly becomePassword: pulumi.Output<string | undefined>;
+    /**
+     * The user to run the command as with `become`. Defaults to `root`.
+     */
+    declare public readonly becomeUser: pulumi.Output<string | undefined>;
+    /**
+     * The connection settings shared by the hosts in 'hosts'.
+     */
+    declare public readonly connection: pulumi.Output<outputs.remote.ConnectionTemplate | undefined>;
+    /**
+     * The connections to the hosts to run the command on. Each host may only be
+     * listed once. Either 'connections' or 'hosts' must be set.
+     */
+    declare public readonly connections: pulumi.Output<outputs.remote.Connection[] | undefined>;
+    /**
+     * The command to run once on resource creation.
+     *
+     * If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+     *
+     * Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+     *
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     */
+    declare public readonly create: pulumi.Output<string | undefined>;
+    /**
+     * The command to run on resource deletion.
+     *
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+     */
+    declare public readonly delete: pulumi.Output<string | undefined>;
+    /**
+     * Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+     * Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+     */
+    declare public readonly detached: pulumi.Output<outputs.remote.Detached | undefined>;
+    /**
+     * The directory on the remote host to run the command in. A leading '~' is the home
+     * directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+     * on the remote host.
+     */
+    declare public readonly dir: pulumi.Output<string | undefined>;
+    /**
+     * Additional environment variables available to the command's process.
+     * How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+     */
+    declare public readonly environment: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+     * them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+     * 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+     */
+    declare public readonly environmentMode: pulumi.Output<enums.remote.EnvironmentMode | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    declare public readonly errorOutputLines: pulumi.Output<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    declare public readonly heartbeatInterval: pulumi.Output<number | undefined>;
+    /**
+     * The addresses of the hosts to run the command on, which are connected to with the
+     * settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+     * which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+     * must be set.
+     */
+    declare public readonly hosts: pulumi.Output<string[] | undefined>;
+    /**
+     * The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     */
+    declare public readonly interpreter: pulumi.Output<string[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    declare public readonly logStreamPrefix: pulumi.Output<boolean | undefined>;
+    /**
+     * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+     * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+     * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+     */
+    declare public readonly logging: pulumi.Output<enums.remote.Logging | undefined>;
+    /**
+     * Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     */
+    declare public readonly loginShell: pulumi.Output<boolean | undefined>;
+    /**
+     * The number of hosts the command may fail on. Once more hosts failed, no further
+     * batches are started and the resource fails. Defaults to 0.
+     */
+    declare public readonly maxFailures: pulumi.Output<number | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     */
+    declare public readonly pty: pulumi.Output<outputs.remote.Pty | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+     * Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    declare public readonly redact: pulumi.Output<string[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    declare public readonly redactOutputs: pulumi.Output<boolean | undefined>;
+    /**
+     * The result of the last command run on each host, keyed by the host's address,
+     * followed by ':' and the port if that's not 22. A host that the command hasn't been run on yet, e.g. because an
+     * earlier batch failed, has no result.
+     */
+    declare public /*out*/ readonly results: pulumi.Output<{[key: string]: outputs.remote.HostResult}>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    declare public readonly stderrSeverity: pulumi.Output<enums.remote.LogSeverity | undefined>;
+    /**
+     * Pass a string to the command's process as standard in
+     */
+    declare public readonly stdin: pulumi.Output<string | undefined>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    declare public readonly stdoutSeverity: pulumi.Output<enums.remote.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    declare public readonly stripWorkflowCommands: pulumi.Output<boolean | undefined>;
+    /**
+     * The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     */
+    declare public readonly timeout: pulumi.Output<number | undefined>;
+    /**
+     * The resource will be updated (or replaced) if any of these values change.
+     *
+     * The trigger values can be of any type.
+     *
+     * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+     *
+     * Please see the resource documentation for examples.
+     */
+    declare public readonly triggers: pulumi.Output<any[] | undefined>;
+    /**
+     * The command to run when the resource is updated.
+     *
+     * If empty, the create command will be executed instead.
+     *
+     * Note that this command will not run if the resource's inputs are unchanged.
+     *
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     *
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+     */
+    declare public readonly update: pulumi.Output<string | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    declare public readonly workflowCommands: pulumi.Output<boolean | undefined>;
+
+    /**
+     * Create a MultiCommand resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: MultiCommandArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["addPreviousOutputInEnv"] = args?.addPreviousOutputInEnv;
+            resourceInputs["batchSize"] = args?.batchSize;
+            resourceInputs["become"] = args?.become;
+            resourceInputs["becomeMethod"] = args?.becomeMethod;
+            resourceInputs["becomePassword"] = args?.becomePassword ? pulumi.secret(args.becomePassword) : undefined;
+            resourceInputs["becomeUser"] = args?.becomeUser;
+            resourceInputs["connection"] = args?.connection ? pulumi.secret(pulumi.output(args.connection).apply(v => v === undefined ? undefined : inputs.remote.connectionTemplateArgsProvideDefaults(v))) : undefined;
+            resourceInputs["connections"] = args?.connections ? pulumi.secret(args.connections) : undefined;
+            resourceInputs["create"] = args?.create;
+            resourceInputs["delete"] = args?.delete;
+            resourceInputs["detached"] = args?.detached;
+            resourceInputs["dir"] = args?.dir;
+            resourceInputs["environment"] = args?.environment;
+            resourceInputs["environmentMode"] = args?.environmentMode;
+            resourceInputs["errorOutputLines"] = args?.errorOutputLines;
+            resourceInputs["heartbeatInterval"] = args?.heartbeatInterval;
+            resourceInputs["hosts"] = args?.hosts;
+            resourceInputs["interpreter"] = args?.interpreter;
+            resourceInputs["logStreamPrefix"] = args?.logStreamPrefix;
+            resourceInputs["logging"] = args?.logging;
+            resourceInputs["loginShell"] = args?.loginShell;
+            resourceInputs["maxFailures"] = args?.maxFailures;
+            resourceInputs["pty"] = args?.pty;
+            resourceInputs["redact"] = args?.redact ? pulumi.secret(args.redact) : undefined;
+            resourceInputs["redactOutputs"] = args?.redactOutputs;
+            resourceInputs["stderrSeverity"] = args?.stderrSeverity;
+            resourceInputs["stdin"] = args?.stdin;
+            resourceInputs["stdoutSeverity"] = args?.stdoutSeverity;
+            resourceInputs["stripWorkflowCommands"] = args?.stripWorkflowCommands;
+            resourceInputs["timeout"] = args?.timeout;
+            resourceInputs["triggers"] = args?.triggers;
+            resourceInputs["update"] = args?.update;
+            resourceInputs["workflowCommands"] = args?.workflowCommands;
+            resourceInputs["results"] = undefined /*out*/;
+        } else {
+            resourceInputs["addPreviousOutputInEnv"] = undefined /*out*/;
+            resourceInputs["batchSize"] = undefined /*out*/;
+            resourceInputs["become"] = undefined /*out*/;
+            resourceInputs["becomeMethod"] = undefined /*out*/;
+            resourceInputs["becomePassword"] = undefined /*out*/;
+            resourceInputs["becomeUser"] = undefined /*out*/;
+            resourceInputs["connection"] = undefined /*out*/;
+            resourceInputs["connections"] = undefined /*out*/;
+            resourceInputs["create"] = undefined /*out*/;
+            resourceInputs["delete"] = undefined /*out*/;
+            resourceInputs["detached"] = undefined /*out*/;
+            resourceInputs["dir"] = undefined /*out*/;
+            resourceInputs["environment"] = undefined /*out*/;
+            resourceInputs["environmentMode"] = undefined /*out*/;
+            resourceInputs["errorOutputLines"] = undefined /*out*/;
+            resourceInputs["heartbeatInterval"] = undefined /*out*/;
+            resourceInputs["hosts"] = undefined /*out*/;
+            resourceInputs["interpreter"] = undefined /*out*/;
+            resourceInputs["logStreamPrefix"] = undefined /*out*/;
+            resourceInputs["logging"] = undefined /*out*/;
+            resourceInputs["loginShell"] = undefined /*out*/;
+            resourceInputs["maxFailures"] = undefined /*out*/;
+            resourceInputs["pty"] = undefined /*out*/;
+            resourceInputs["redact"] = undefined /*out*/;
+            resourceInputs["redactOutputs"] = undefined /*out*/;
+            resourceInputs["results"] = undefined /*out*/;
+            resourceInputs["stderrSeverity"] = undefined /*out*/;
+            resourceInputs["stdin"] = undefined /*out*/;
+            resourceInputs["stdoutSeverity"] = undefined /*out*/;
+            resourceInputs["stripWorkflowCommands"] = undefined /*out*/;
+            resourceInputs["timeout"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+            resourceInputs["update"] = undefined /*out*/;
+            resourceInputs["workflowCommands"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["becomePassword", "connection", "connections", "redact"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        const replaceOnChanges = { replaceOnChanges: ["triggers[*]"] };
+        opts = pulumi.mergeOptions(opts, replaceOnChanges);
+        super(MultiCommand.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a MultiCommand resource.
+ */
+export interface MultiCommandArgs {
+    /**
+     * If the previous command's stdout and stderr (as generated by the prior create/update) is
+     * injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+     * Defaults to true.
+     */
+    addPreviousOutputInEnv?: pulumi.Input<boolean | undefined>;
+    /**
+     * The number of hosts the command is run on at the same time. The next batch of
+     * hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+     */
+    batchSize?: pulumi.Input<number | undefined>;
+    /**
+     * Run the command as another user, root by default, via the escalation tool set in
+     * 'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+     * the quoting of the command intact and passes on the environment variables.
+     */
+    become?: pulumi.Input<boolean | undefined>;
+    /**
+     * The escalation tool to use with `become`. Defaults to `sudo`.
+     */
+    becomeMethod?: pulumi.Input<enums.remote.BecomeMethod | undefined>;
+    /**
+     * The password to answer the prompt of the escalation tool with. Without a
+     * password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+     * the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+     * password is never part of the command's output.
+     */
+    becomePassword?: pulumi.Input<string | undefined>;
+    /**
+     * The user to run the command as with `become`. Defaults to `root`.
+     */
+    becomeUser?: pulumi.Input<string | undefined>;
+    /**
+     * The connection settings shared by the hosts in 'hosts'.
+     */
+    connection?: pulumi.Input<inputs.remote.ConnectionTemplateArgs | undefined>;
+    /**
+     * The connections to the hosts to run the command on. Each host may only be
+     * listed once. Either 'connections' or 'hosts' must be set.
+     */
+    connections?: pulumi.Input<pulumi.Input<inputs.remote.ConnectionArgs>[] | undefined>;
+    /**
+     * The command to run once on resource creation.
+     *
+     * If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+     *
+     * Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+     *
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     */
+    create?: pulumi.Input<string | undefined>;
+    /**
+     * The command to run on resource deletion.
+     *
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+     */
+    delete?: pulumi.Input<string | undefined>;
+    /**
+     * Run the command as a detached job that survives connection drops, e.g. for
+     * jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+     * to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+     * the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+     * polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+     * provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+     * rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+     * Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+     */
+    detached?: pulumi.Input<inputs.remote.DetachedArgs | undefined>;
+    /**
+     * The directory on the remote host to run the command in. A leading '~' is the home
+     * directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+     * on the remote host.
+     */
+    dir?: pulumi.Input<string | undefined>;
+    /**
+     * Additional environment variables available to the command's process.
+     * How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+     * exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+     */
+    environment?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * How the environment variables, including PULUMI_COMMAND_STDOUT and
+     * PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+     * the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+     * them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+     * 'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+     */
+    environmentMode?: pulumi.Input<enums.remote.EnvironmentMode | undefined>;
+    /**
+     * The number of lines at the end of stdout and stderr that the error of a
+     * failed command includes. Defaults to 20.
+     */
+    errorOutputLines?: pulumi.Input<number | undefined>;
+    /**
+     * The number of seconds without output after which the status of the
+     * resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+     * line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+     * Defaults to 60.
+     */
+    heartbeatInterval?: pulumi.Input<number | undefined>;
+    /**
+     * The addresses of the hosts to run the command on, which are connected to with the
+     * settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+     * which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+     * must be set.
+     */
+    hosts?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+     * The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+     */
+    interpreter?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+     */
+    logStreamPrefix?: pulumi.Input<boolean | undefined>;
+    /**
+     * If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+     * stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+     * outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+     */
+    logging?: pulumi.Input<enums.remote.Logging | undefined>;
+    /**
+     * Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+     * ~/.profile are read, e.g. to set the PATH. Defaults to false.
+     */
+    loginShell?: pulumi.Input<boolean | undefined>;
+    /**
+     * The number of hosts the command may fail on. Once more hosts failed, no further
+     * batches are started and the resource fails. Defaults to 0.
+     */
+    maxFailures?: pulumi.Input<number | undefined>;
+    /**
+     * Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+     * terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+     * the terminal, without echo, followed by the end of input.
+     */
+    pty?: pulumi.Input<inputs.remote.PtyArgs | undefined>;
+    /**
+     * Additional values to mask as '[secret]' in the logged output and the error of
+     * the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+     * environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+     * Values shorter than 4 characters aren't masked, and a warning is logged instead.
+     */
+    redact?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+     * to false, in which case outputs that might contain secrets can be marked as secret via
+     * 'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+     */
+    redactOutputs?: pulumi.Input<boolean | undefined>;
+    /**
+     * The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+     */
+    stderrSeverity?: pulumi.Input<enums.remote.LogSeverity | undefined>;
+    /**
+     * Pass a string to the command's process as standard in
+     */
+    stdin?: pulumi.Input<string | undefined>;
+    /**
+     * The severity with which the lines of stdout are logged. Defaults to `info`.
+     */
+    stdoutSeverity?: pulumi.Input<enums.remote.LogSeverity | undefined>;
+    /**
+     * If the workflow commands are removed from the 'stdout' output. Defaults
+     * to false.
+     */
+    stripWorkflowCommands?: pulumi.Input<boolean | undefined>;
+    /**
+     * The maximum number of seconds the command may run. When it elapses, or the
+     * deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+     * doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+     * which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+     * Defaults to no timeout.
+     */
+    timeout?: pulumi.Input<number | undefined>;
+    /**
+     * The resource will be updated (or replaced) if any of these values change.
+     *
+     * The trigger values can be of any type.
+     *
+     * If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+     *
+     * Please see the resource documentation for examples.
+     */
+    triggers?: pulumi.Input<any[] | undefined>;
+    /**
+     * The command to run when the resource is updated.
+     *
+     * If empty, the create command will be executed instead.
+     *
+     * Note that this command will not run if the resource's inputs are unchanged.
+     *
+     * Use `local.runOutput` if you need to run a command on every execution of your program.
+     *
+     * The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+     */
+    update?: pulumi.Input<string | undefined>;
+    /**
+     * Run the workflow commands among the lines of stdout, modeled on those of
+     * GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+     * message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+     * the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+     */
+    workflowCommands?: pulumi.Input<boolean | undefined>;
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as enums from "../types/enums";
+import * as utilities from "../utilities";
+
+/**
+ * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+ */
+export function testConnection(args: TestConnectionArgs, opts?: pulumi.InvokeOptions): Promise<TestConnectionResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("command:remote:testConnection", {
+        "connection": args.connection ? inputs.remote.connectionProvideDefaults(args.connection) : undefined,
+    }, opts);
+}
+
+export interface TestConnectionArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: inputs.remote.Connection;
+}
+
+export interface TestConnectionResult {
+    /**
+     * The stages of the connection in the order they were run. The stages after the first one that failed aren't run, except that all auth methods are tried until one succeeds.
+     */
+    readonly stages: outputs.remote.ConnectionStage[];
+    /**
+     * If the connection to the host succeeded.
+     */
+    readonly success: boolean;
+}
+/**
+ * Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+ */
+export function testConnectionOutput(args: TestConnectionOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<TestConnectionResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("command:remote:testConnection", {
+        "connection": pulumi.output(args.connection).apply(inputs.remote.connectionProvideDefaults),
+    }, opts);
+}
+
+export interface TestConnectionOutputArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: pulumi.Input<inputs.remote.ConnectionArgs>;
+}
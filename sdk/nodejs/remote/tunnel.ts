@@ -0,0 +1,156 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as enums from "../types/enums";
+import * as utilities from "../utilities";
+
+/**
+ * Forwards a port over SSH for the duration of a deployment, e.g. to reach a private
+ * database through a bastion host from other providers.
+ *
+ * A 'local' tunnel listens on 'localHost' and 'localPort' on the machine running Pulumi, and forwards the
+ * connections to 'remoteHost' and 'remotePort' as seen from the remote host. A 'remote' tunnel listens on
+ * 'remoteHost' and 'remotePort' on the remote host, and forwards the connections to 'localHost' and 'localPort'
+ * as seen from the machine running Pulumi. The listening address is available via 'address' and 'port'.
+ *
+ * The tunnel is open while the provider runs, and is reopened on the same address on each deployment when the
+ * Tunnel is checked for changes. Only if that address isn't free anymore, the Tunnel shows as updated, with a
+ * new 'address' for a free port. Set the listening port to keep 'address' the same in any case. Resources that use
+ * the tunnel should depend on it.
+ */
+export class Tunnel extends pulumi.CustomResource {
+    /**
+     * Get an existing Tunnel resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Tunnel {
+        return new Tunnel(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'command:remote:Tunnel';
+
+    /**
+     * Returns true if the given object is an instance of Tunnel.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Tunnel {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Tunnel.__pulumiType;
+    }
+
+    /**
+     * The address that the tunnel listens on as host and port, on the machine running
+     * Pulumi for a 'local' tunnel and on the remote host for a 'remote' one.
+     */
+    declare public /*out*/ readonly address: pulumi.Output<string>;
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    declare public readonly connection: pulumi.Output<outputs.remote.Connection>;
+    /**
+     * The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+     */
+    declare public readonly localHost: pulumi.Output<string | undefined>;
+    /**
+     * The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+     * if it's not set, while a 'remote' tunnel requires it.
+     */
+    declare public readonly localPort: pulumi.Output<number | undefined>;
+    /**
+     * The port that the tunnel listens on.
+     */
+    declare public /*out*/ readonly port: pulumi.Output<number>;
+    /**
+     * The address as seen from the remote host. Defaults to `localhost`.
+     */
+    declare public readonly remoteHost: pulumi.Output<string | undefined>;
+    /**
+     * The port as seen from the remote host. A 'remote' tunnel listens on a port
+     * chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+     */
+    declare public readonly remotePort: pulumi.Output<number | undefined>;
+    /**
+     * The direction of the tunnel. Defaults to `local`.
+     */
+    declare public readonly type: pulumi.Output<enums.remote.TunnelType | undefined>;
+
+    /**
+     * Create a Tunnel resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: TunnelArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.connection === undefined && !opts.urn) {
+                throw new Error("Missing required property 'connection'");
+            }
+            resourceInputs["connection"] = args?.connection ? pulumi.secret(pulumi.output(args.connection).apply(inputs.remote.connectionArgsProvideDefaults)) : undefined;
+            resourceInputs["localHost"] = args?.localHost;
+            resourceInputs["localPort"] = args?.localPort;
+            resourceInputs["remoteHost"] = args?.remoteHost;
+            resourceInputs["remotePort"] = args?.remotePort;
+            resourceInputs["type"] = args?.type;
+            resourceInputs["address"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+        } else {
+            resourceInputs["address"] = undefined /*out*/;
+            resourceInputs["connection"] = undefined /*out*/;
+            resourceInputs["localHost"] = undefined /*out*/;
+            resourceInputs["localPort"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+            resourceInputs["remoteHost"] = undefined /*out*/;
+            resourceInputs["remotePort"] = undefined /*out*/;
+            resourceInputs["type"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["connection"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(Tunnel.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Tunnel resource.
+ */
+export interface TunnelArgs {
+    /**
+     * The parameters with which to connect to the remote host.
+     */
+    connection: pulumi.Input<inputs.remote.ConnectionArgs>;
+    /**
+     * The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+     */
+    localHost?: pulumi.Input<string | undefined>;
+    /**
+     * The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+     * if it's not set, while a 'remote' tunnel requires it.
+     */
+    localPort?: pulumi.Input<number | undefined>;
+    /**
+     * The address as seen from the remote host. Defaults to `localhost`.
+     */
+    remoteHost?: pulumi.Input<string | undefined>;
+    /**
+     * The port as seen from the remote host. A 'remote' tunnel listens on a port
+     * chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+     */
+    remotePort?: pulumi.Input<number | undefined>;
+    /**
+     * The direction of the tunnel. Defaults to `local`.
+     */
+    type?: pulumi.Input<enums.remote.TunnelType | undefined>;
+}
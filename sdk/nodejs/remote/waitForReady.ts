@@ -0,0 +1,143 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as enums from "../types/enums";
+import * as utilities from "../utilities";
+
+/**
+ * Waits until a remote host accepts SSH connections, e.g. after creating a VM, so that the
+ * resources depending on it don't have to retry. Optionally, it also waits until a probe command succeeds on the
+ * host, e.g. 'cloud-init status --wait'.
+ *
+ * The host is waited for on creation, and again when any of the inputs change.
+ */
+export class WaitForReady extends pulumi.CustomResource {
+    /**
+     * Get an existing WaitForReady resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): WaitForReady {
+        return new WaitForReady(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'command:remote:WaitForReady';
+
+    /**
+     * Returns true if the given object is an instance of WaitForReady.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is WaitForReady {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === WaitForReady.__pulumiType;
+    }
+
+    /**
+     * The number of milliseconds that the successful connection took to establish.
+     */
+    declare public /*out*/ readonly connectLatency: pulumi.Output<number>;
+    /**
+     * The parameters with which to connect to the remote host. Each attempt dials
+     * the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+     */
+    declare public readonly connection: pulumi.Output<outputs.remote.Connection>;
+    /**
+     * The host key that the host presented on the successful connection, in the
+     * authorized_keys format, e.g. to pin it as the 'hostKey' of later connections.
+     */
+    declare public /*out*/ readonly hostKey: pulumi.Output<string>;
+    /**
+     * The number of seconds between the attempts to connect and run the probe.
+     * Defaults to 5.
+     */
+    declare public readonly interval: pulumi.Output<number | undefined>;
+    /**
+     * A command that must succeed on the host for it to be ready, e.g.
+     * 'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+     * still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+     */
+    declare public readonly probe: pulumi.Output<string | undefined>;
+    /**
+     * The maximum number of seconds to wait for the host in total. Defaults to 600.
+     */
+    declare public readonly timeout: pulumi.Output<number | undefined>;
+    /**
+     * Wait for the host again on changes to this input.
+     */
+    declare public readonly triggers: pulumi.Output<any[] | undefined>;
+
+    /**
+     * Create a WaitForReady resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: WaitForReadyArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.connection === undefined && !opts.urn) {
+                throw new Error("Missing required property 'connection'");
+            }
+            resourceInputs["connection"] = args?.connection ? pulumi.secret(pulumi.output(args.connection).apply(inputs.remote.connectionArgsProvideDefaults)) : undefined;
+            resourceInputs["interval"] = args?.interval;
+            resourceInputs["probe"] = args?.probe;
+            resourceInputs["timeout"] = args?.timeout;
+            resourceInputs["triggers"] = args?.triggers;
+            resourceInputs["connectLatency"] = undefined /*out*/;
+            resourceInputs["hostKey"] = undefined /*out*/;
+        } else {
+            resourceInputs["connectLatency"] = undefined /*out*/;
+            resourceInputs["connection"] = undefined /*out*/;
+            resourceInputs["hostKey"] = undefined /*out*/;
+            resourceInputs["interval"] = undefined /*out*/;
+            resourceInputs["probe"] = undefined /*out*/;
+            resourceInputs["timeout"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["connection"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(WaitForReady.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a WaitForReady resource.
+ */
+export interface WaitForReadyArgs {
+    /**
+     * The parameters with which to connect to the remote host. Each attempt dials
+     * the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+     */
+    connection: pulumi.Input<inputs.remote.ConnectionArgs>;
+    /**
+     * The number of seconds between the attempts to connect and run the probe.
+     * Defaults to 5.
+     */
+    interval?: pulumi.Input<number | undefined>;
+    /**
+     * A command that must succeed on the host for it to be ready, e.g.
+     * 'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+     * still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+     */
+    probe?: pulumi.Input<string | undefined>;
+    /**
+     * The maximum number of seconds to wait for the host in total. Defaults to 600.
+     */
+    timeout?: pulumi.Input<number | undefined>;
+    /**
+     * Wait for the host again on changes to this input.
+     */
+    triggers?: pulumi.Input<any[] | undefined>;
+}
@@ -2,6 +2,27 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 
+export const LogSeverity = {
+    /**
+     * Log the lines as debug messages
+     */
+    Debug: "debug",
+    /**
+     * Log the lines as info messages
+     */
+    Info: "info",
+    /**
+     * Log the lines as warnings
+     */
+    Warning: "warning",
+    /**
+     * Log the lines as errors
+     */
+    Error: "error",
+} as const;
+
+export type LogSeverity = (typeof LogSeverity)[keyof typeof LogSeverity];
+
 export const Logging = {
     /**
      * Capture stdout in logs but not stderr
@@ -22,3 +43,20 @@ export const Logging = {
 } as const;
 
 export type Logging = (typeof Logging)[keyof typeof Logging];
+
+export const SymlinkPolicy = {
+    /**
+     * Keep symlinks as symlinks
+     */
+    Preserve: "preserve",
+    /**
+     * Treat symlinks as the files or directories they point to
+     */
+    Follow: "follow",
+    /**
+     * Ignore symlinks
+     */
+    Skip: "skip",
+} as const;
+
+export type SymlinkPolicy = (typeof SymlinkPolicy)[keyof typeof SymlinkPolicy];
@@ -2,6 +2,78 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 
+export const AtomicMode = {
+    /**
+     * Write files in place
+     */
+    None: "none",
+    /**
+     * Write each file to a temporary file and rename it into place
+     */
+    File: "file",
+    /**
+     * Stage copied directories in full and swap them in via a symlink
+     */
+    Directory: "directory",
+} as const;
+
+export type AtomicMode = (typeof AtomicMode)[keyof typeof AtomicMode];
+
+export const BecomeMethod = {
+    /**
+     * Use `sudo`
+     */
+    Sudo: "sudo",
+    /**
+     * Use `su`
+     */
+    Su: "su",
+    /**
+     * Use `doas`
+     */
+    Doas: "doas",
+} as const;
+
+export type BecomeMethod = (typeof BecomeMethod)[keyof typeof BecomeMethod];
+
+export const EnvironmentMode = {
+    /**
+     * Set the variables on the SSH session, which requires AcceptEnv on the server
+     */
+    Setenv: "setenv",
+    /**
+     * Export the variables in the command, which requires a POSIX shell on the remote host
+     */
+    Export: "export",
+    /**
+     * Set the variables on the SSH session, and export those that the server rejects
+     */
+    Auto: "auto",
+} as const;
+
+export type EnvironmentMode = (typeof EnvironmentMode)[keyof typeof EnvironmentMode];
+
+export const LogSeverity = {
+    /**
+     * Log the lines as debug messages
+     */
+    Debug: "debug",
+    /**
+     * Log the lines as info messages
+     */
+    Info: "info",
+    /**
+     * Log the lines as warnings
+     */
+    Warning: "warning",
+    /**
+     * Log the lines as errors
+     */
+    Error: "error",
+} as const;
+
+export type LogSeverity = (typeof LogSeverity)[keyof typeof LogSeverity];
+
 export const Logging = {
     /**
      * Capture stdout in logs but not stderr
@@ -22,3 +94,54 @@ export const Logging = {
 } as const;
 
 export type Logging = (typeof Logging)[keyof typeof Logging];
+
+export const SymlinkPolicy = {
+    /**
+     * Keep symlinks as symlinks
+     */
+    Preserve: "preserve",
+    /**
+     * Treat symlinks as the files or directories they point to
+     */
+    Follow: "follow",
+    /**
+     * Ignore symlinks
+     */
+    Skip: "skip",
+} as const;
+
+export type SymlinkPolicy = (typeof SymlinkPolicy)[keyof typeof SymlinkPolicy];
+
+export const Transport = {
+    /**
+     * Use the SFTP subsystem
+     */
+    Sftp: "sftp",
+    /**
+     * Use the SCP protocol via `scp -t`
+     */
+    Scp: "scp",
+    /**
+     * Stream a tar archive to `tar -x` on the remote host
+     */
+    TarOverExec: "tarOverExec",
+    /**
+     * Use SFTP if the host supports it, and fall back to `tarOverExec` or `scp`
+     */
+    Auto: "auto",
+} as const;
+
+export type Transport = (typeof Transport)[keyof typeof Transport];
+
+export const TunnelType = {
+    /**
+     * Forward the connections to a local port to the remote host, like `ssh -L`
+     */
+    Local: "local",
+    /**
+     * Forward the connections to a port on the remote host to the local machine, like `ssh -R`
+     */
+    Remote: "remote",
+} as const;
+
+export type TunnelType = (typeof TunnelType)[keyof typeof TunnelType];
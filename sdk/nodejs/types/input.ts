@@ -8,7 +8,118 @@ import * as enums from "../types/enums";
 
 import * as utilities from "../utilities";
 
+export namespace local {
+    /**
+     * The pseudo-terminal that a command is run in.
+     */
+    export interface Pty {
+        /**
+         * The width of the terminal in characters. Defaults to 80.
+         */
+        cols?: number;
+        /**
+         * The height of the terminal in characters. Defaults to 24.
+         */
+        rows?: number;
+        /**
+         * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         */
+        stripAnsi?: boolean;
+        /**
+         * The terminal type, set as TERM. Defaults to `xterm`.
+         */
+        term?: string;
+    }
+
+    /**
+     * The pseudo-terminal that a command is run in.
+     */
+    export interface PtyArgs {
+        /**
+         * The width of the terminal in characters. Defaults to 80.
+         */
+        cols?: pulumi.Input<number | undefined>;
+        /**
+         * The height of the terminal in characters. Defaults to 24.
+         */
+        rows?: pulumi.Input<number | undefined>;
+        /**
+         * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         */
+        stripAnsi?: pulumi.Input<boolean | undefined>;
+        /**
+         * The terminal type, set as TERM. Defaults to `xterm`.
+         */
+        term?: pulumi.Input<string | undefined>;
+    }
+}
+
 export namespace remote {
+    /**
+     * Instructions for how to connect to a remote endpoint.
+     */
+    export interface Connection {
+        /**
+         * SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+         */
+        agentSocketPath?: string;
+        /**
+         * Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+         */
+        dialErrorLimit?: number;
+        /**
+         * The address of the resource to connect to.
+         */
+        host: string;
+        /**
+         * The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+         */
+        hostKey?: string;
+        /**
+         * The password we should use for the connection.
+         */
+        password?: string;
+        /**
+         * Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+         */
+        perDialTimeout?: number;
+        /**
+         * The port to connect to. Defaults to 22.
+         */
+        port?: number;
+        /**
+         * The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+         */
+        privateKey?: string;
+        /**
+         * The password to use in case the private key is encrypted.
+         */
+        privateKeyPassword?: string;
+        /**
+         * The connection settings for the bastion/proxy host.
+         */
+        proxy?: inputs.remote.ProxyConnection;
+        /**
+         * The user that we should use for the connection.
+         */
+        user?: string;
+    }
+    /**
+     * connectionProvideDefaults sets the appropriate defaults for Connection
+     */
+    export function connectionProvideDefaults(val: Connection): Connection {
+        return {
+            ...val,
+            dialErrorLimit: (val.dialErrorLimit) ?? 10,
+            perDialTimeout: (val.perDialTimeout) ?? 15,
+            port: (val.port) ?? 22,
+            proxy: (val.proxy ? inputs.remote.proxyConnectionProvideDefaults(val.proxy) : undefined),
+            user: (val.user) ?? "root",
+        };
+    }
+
     /**
      * Instructions for how to connect to a remote endpoint.
      */
@@ -72,6 +183,135 @@ export namespace remote {
         };
     }
 
+    /**
+     * Instructions for how to connect to several remote endpoints with the same settings.
+     */
+    export interface ConnectionTemplateArgs {
+        /**
+         * SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+         */
+        agentSocketPath?: pulumi.Input<string | undefined>;
+        /**
+         * Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+         */
+        dialErrorLimit?: pulumi.Input<number | undefined>;
+        /**
+         * The password we should use for the connection.
+         */
+        password?: pulumi.Input<string | undefined>;
+        /**
+         * Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+         */
+        perDialTimeout?: pulumi.Input<number | undefined>;
+        /**
+         * The port to connect to. Defaults to 22.
+         */
+        port?: pulumi.Input<number | undefined>;
+        /**
+         * The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+         */
+        privateKey?: pulumi.Input<string | undefined>;
+        /**
+         * The password to use in case the private key is encrypted.
+         */
+        privateKeyPassword?: pulumi.Input<string | undefined>;
+        /**
+         * The connection settings for the bastion/proxy host.
+         */
+        proxy?: pulumi.Input<inputs.remote.ProxyConnectionArgs | undefined>;
+        /**
+         * The user that we should use for the connection.
+         */
+        user?: pulumi.Input<string | undefined>;
+    }
+    /**
+     * connectionTemplateArgsProvideDefaults sets the appropriate defaults for ConnectionTemplateArgs
+     */
+    export function connectionTemplateArgsProvideDefaults(val: ConnectionTemplateArgs): ConnectionTemplateArgs {
+        return {
+            ...val,
+            dialErrorLimit: (val.dialErrorLimit) ?? 10,
+            perDialTimeout: (val.perDialTimeout) ?? 15,
+            port: (val.port) ?? 22,
+            proxy: pulumi.output(val.proxy).apply(v => v === undefined ? undefined : inputs.remote.proxyConnectionArgsProvideDefaults(v)),
+            user: (val.user) ?? "root",
+        };
+    }
+
+    /**
+     * Settings for running a command as a detached job.
+     */
+    export interface DetachedArgs {
+        /**
+         * The number of seconds between checks whether the job finished. Defaults to 5.
+         */
+        pollInterval?: pulumi.Input<number | undefined>;
+        /**
+         * The directory on the remote host that holds a subdirectory per job, with its pid,
+         * exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+         * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+         */
+        stateDir?: pulumi.Input<string | undefined>;
+    }
+
+    /**
+     * Instructions for how to connect to a remote endpoint via a bastion host.
+     */
+    export interface ProxyConnection {
+        /**
+         * SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+         */
+        agentSocketPath?: string;
+        /**
+         * Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+         */
+        dialErrorLimit?: number;
+        /**
+         * The address of the bastion host to connect to.
+         */
+        host: string;
+        /**
+         * The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+         */
+        hostKey?: string;
+        /**
+         * The password we should use for the connection to the bastion host.
+         */
+        password?: string;
+        /**
+         * Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+         */
+        perDialTimeout?: number;
+        /**
+         * The port of the bastion host to connect to.
+         */
+        port?: number;
+        /**
+         * The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+         */
+        privateKey?: string;
+        /**
+         * The password to use in case the private key is encrypted.
+         */
+        privateKeyPassword?: string;
+        /**
+         * The user that we should use for the connection to the bastion host.
+         */
+        user?: string;
+    }
+    /**
+     * proxyConnectionProvideDefaults sets the appropriate defaults for ProxyConnection
+     */
+    export function proxyConnectionProvideDefaults(val: ProxyConnection): ProxyConnection {
+        return {
+            ...val,
+            dialErrorLimit: (val.dialErrorLimit) ?? 10,
+            perDialTimeout: (val.perDialTimeout) ?? 15,
+            port: (val.port) ?? 22,
+            user: (val.user) ?? "root",
+        };
+    }
+
     /**
      * Instructions for how to connect to a remote endpoint via a bastion host.
      */
@@ -129,4 +369,27 @@ export namespace remote {
             user: (val.user) ?? "root",
         };
     }
+
+    /**
+     * The pseudo-terminal that a command is run in.
+     */
+    export interface PtyArgs {
+        /**
+         * The width of the terminal in characters. Defaults to 80.
+         */
+        cols?: pulumi.Input<number | undefined>;
+        /**
+         * The height of the terminal in characters. Defaults to 24.
+         */
+        rows?: pulumi.Input<number | undefined>;
+        /**
+         * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         */
+        stripAnsi?: pulumi.Input<boolean | undefined>;
+        /**
+         * The terminal type, set as TERM. Defaults to `xterm`.
+         */
+        term?: pulumi.Input<string | undefined>;
+    }
 }
@@ -8,6 +8,32 @@ import * as enums from "../types/enums";
 
 import * as utilities from "../utilities";
 
+export namespace local {
+    /**
+     * The pseudo-terminal that a command is run in.
+     */
+    export interface Pty {
+        /**
+         * The width of the terminal in characters. Defaults to 80.
+         */
+        cols?: number;
+        /**
+         * The height of the terminal in characters. Defaults to 24.
+         */
+        rows?: number;
+        /**
+         * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         */
+        stripAnsi?: boolean;
+        /**
+         * The terminal type, set as TERM. Defaults to `xterm`.
+         */
+        term?: string;
+    }
+
+}
+
 export namespace remote {
     /**
      * Instructions for how to connect to a remote endpoint.
@@ -72,6 +98,158 @@ export namespace remote {
         };
     }
 
+    /**
+     * The result of a step of establishing a connection.
+     */
+    export interface ConnectionStage {
+        /**
+         * What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+         */
+        detail?: string;
+        /**
+         * How long the stage took in milliseconds.
+         */
+        durationMs: number;
+        /**
+         * Why the stage failed.
+         */
+        error?: string;
+        /**
+         * The host that the stage connects to, `proxy` or `host`.
+         */
+        hop: string;
+        /**
+         * The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+         */
+        method?: string;
+        /**
+         * The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+         */
+        stage: string;
+        /**
+         * If the stage succeeded.
+         */
+        success: boolean;
+    }
+
+    /**
+     * Instructions for how to connect to several remote endpoints with the same settings.
+     */
+    export interface ConnectionTemplate {
+        /**
+         * SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+         */
+        agentSocketPath?: string;
+        /**
+         * Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+         */
+        dialErrorLimit?: number;
+        /**
+         * The password we should use for the connection.
+         */
+        password?: string;
+        /**
+         * Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+         */
+        perDialTimeout?: number;
+        /**
+         * The port to connect to. Defaults to 22.
+         */
+        port?: number;
+        /**
+         * The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+         */
+        privateKey?: string;
+        /**
+         * The password to use in case the private key is encrypted.
+         */
+        privateKeyPassword?: string;
+        /**
+         * The connection settings for the bastion/proxy host.
+         */
+        proxy?: outputs.remote.ProxyConnection;
+        /**
+         * The user that we should use for the connection.
+         */
+        user?: string;
+    }
+    /**
+     * connectionTemplateProvideDefaults sets the appropriate defaults for ConnectionTemplate
+     */
+    export function connectionTemplateProvideDefaults(val: ConnectionTemplate): ConnectionTemplate {
+        return {
+            ...val,
+            dialErrorLimit: (val.dialErrorLimit) ?? 10,
+            perDialTimeout: (val.perDialTimeout) ?? 15,
+            port: (val.port) ?? 22,
+            proxy: (val.proxy ? outputs.remote.proxyConnectionProvideDefaults(val.proxy) : undefined),
+            user: (val.user) ?? "root",
+        };
+    }
+
+    /**
+     * Settings for running a command as a detached job.
+     */
+    export interface Detached {
+        /**
+         * The number of seconds between checks whether the job finished. Defaults to 5.
+         */
+        pollInterval?: number;
+        /**
+         * The directory on the remote host that holds a subdirectory per job, with its pid,
+         * exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+         * directory of the user. Defaults to `~/.pulumi-command/jobs`.
+         */
+        stateDir?: string;
+    }
+
+    /**
+     * A public key that a host offers to identify itself.
+     */
+    export interface HostKey {
+        /**
+         * The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+         */
+        fingerprint: string;
+        /**
+         * The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+         */
+        key: string;
+        /**
+         * The type of the key, e.g. `ssh-ed25519`.
+         */
+        type: string;
+    }
+
+    /**
+     * The result of running a command on a host.
+     */
+    export interface HostResult {
+        /**
+         * Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+         * than 'update' is run on the host on update.
+         */
+        created: boolean;
+        /**
+         * Why the command failed on the host, including its output. Unset if the command
+         * succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.
+         */
+        error?: string;
+        /**
+         * The exit code of the command's process, or -1 if the command didn't exit, e.g.
+         * because the host couldn't be reached.
+         */
+        exitCode: number;
+        /**
+         * The standard error of the command's process
+         */
+        stderr: string;
+        /**
+         * The standard output of the command's process
+         */
+        stdout: string;
+    }
+
     /**
      * Instructions for how to connect to a remote endpoint via a bastion host.
      */
@@ -130,4 +308,27 @@ export namespace remote {
         };
     }
 
+    /**
+     * The pseudo-terminal that a command is run in.
+     */
+    export interface Pty {
+        /**
+         * The width of the terminal in characters. Defaults to 80.
+         */
+        cols?: number;
+        /**
+         * The height of the terminal in characters. Defaults to 24.
+         */
+        rows?: number;
+        /**
+         * If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+         * captured stdout. They are always part of the logged output. Defaults to false.
+         */
+        stripAnsi?: boolean;
+        /**
+         * The terminal type, set as TERM. Defaults to `xterm`.
+         */
+        term?: string;
+    }
+
 }
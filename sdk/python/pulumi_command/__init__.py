@@ -36,7 +36,10 @@ _utilities.register(
   "classes": {
    "command:remote:Command": "Command",
    "command:remote:CopyFile": "CopyFile",
-   "command:remote:CopyToRemote": "CopyToRemote"
+   "command:remote:CopyToRemote": "CopyToRemote",
+   "command:remote:MultiCommand": "MultiCommand",
+   "command:remote:Tunnel": "Tunnel",
+   "command:remote:WaitForReady": "WaitForReady"
   }
  }
 ]
@@ -9,3 +9,5 @@ import typing
 from ._enums import *
 from .command import *
 from .run import *
+from ._inputs import *
+from . import outputs
@@ -7,10 +7,32 @@ import pulumi
 from enum import Enum
 
 __all__ = [
+    'LogSeverity',
     'Logging',
+    'SymlinkPolicy',
 ]
 
 
+@pulumi.type_token("command:local:LogSeverity")
+class LogSeverity(_builtins.str, Enum):
+    DEBUG = "debug"
+    """
+    Log the lines as debug messages
+    """
+    INFO = "info"
+    """
+    Log the lines as info messages
+    """
+    WARNING = "warning"
+    """
+    Log the lines as warnings
+    """
+    ERROR = "error"
+    """
+    Log the lines as errors
+    """
+
+
 @pulumi.type_token("command:local:Logging")
 class Logging(_builtins.str, Enum):
     STDOUT = "stdout"
@@ -29,3 +51,19 @@ class Logging(_builtins.str, Enum):
     """
     Capture no logs
     """
+
+
+@pulumi.type_token("command:local:SymlinkPolicy")
+class SymlinkPolicy(_builtins.str, Enum):
+    PRESERVE = "preserve"
+    """
+    Keep symlinks as symlinks
+    """
+    FOLLOW = "follow"
+    """
+    Treat symlinks as the files or directories they point to
+    """
+    SKIP = "skip"
+    """
+    Ignore symlinks
+    """
@@ -0,0 +1,218 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from ._enums import *
+
+__all__ = [
+    'Pty',
+    'PtyDict',
+    'PtyArgs',
+    'PtyArgsDict',
+]
+
+class PtyDict(TypedDict):
+    """
+    The pseudo-terminal that a command is run in.
+    """
+    cols: NotRequired[_builtins.int]
+    """
+    The width of the terminal in characters. Defaults to 80.
+    """
+    rows: NotRequired[_builtins.int]
+    """
+    The height of the terminal in characters. Defaults to 24.
+    """
+    strip_ansi: NotRequired[_builtins.bool]
+    """
+    If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+    captured stdout. They are always part of the logged output. Defaults to false.
+    """
+    term: NotRequired[_builtins.str]
+    """
+    The terminal type, set as TERM. Defaults to `xterm`.
+    """
+
+@pulumi.input_type
+class Pty:
+    def __init__(__self__, *,
+                 cols: Optional[_builtins.int] = None,
+                 rows: Optional[_builtins.int] = None,
+                 strip_ansi: Optional[_builtins.bool] = None,
+                 term: Optional[_builtins.str] = None):
+        """
+        The pseudo-terminal that a command is run in.
+
+        :param _builtins.int cols: The width of the terminal in characters. Defaults to 80.
+        :param _builtins.int rows: The height of the terminal in characters. Defaults to 24.
+        :param _builtins.bool strip_ansi: If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+               captured stdout. They are always part of the logged output. Defaults to false.
+        :param _builtins.str term: The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        if cols is not None:
+            pulumi.set(__self__, "cols", cols)
+        if rows is not None:
+            pulumi.set(__self__, "rows", rows)
+        if strip_ansi is not None:
+            pulumi.set(__self__, "strip_ansi", strip_ansi)
+        if term is not None:
+            pulumi.set(__self__, "term", term)
+
+    @_builtins.property
+    @pulumi.getter
+    def cols(self) -> Optional[_builtins.int]:
+        """
+        The width of the terminal in characters. Defaults to 80.
+        """
+        return pulumi.get(self, "cols")
+
+    @cols.setter
+    def cols(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "cols", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def rows(self) -> Optional[_builtins.int]:
+        """
+        The height of the terminal in characters. Defaults to 24.
+        """
+        return pulumi.get(self, "rows")
+
+    @rows.setter
+    def rows(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "rows", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stripAnsi")
+    def strip_ansi(self) -> Optional[_builtins.bool]:
+        """
+        If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        captured stdout. They are always part of the logged output. Defaults to false.
+        """
+        return pulumi.get(self, "strip_ansi")
+
+    @strip_ansi.setter
+    def strip_ansi(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "strip_ansi", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def term(self) -> Optional[_builtins.str]:
+        """
+        The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        return pulumi.get(self, "term")
+
+    @term.setter
+    def term(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "term", value)
+
+
+class PtyArgsDict(TypedDict):
+    """
+    The pseudo-terminal that a command is run in.
+    """
+    cols: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The width of the terminal in characters. Defaults to 80.
+    """
+    rows: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The height of the terminal in characters. Defaults to 24.
+    """
+    strip_ansi: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+    captured stdout. They are always part of the logged output. Defaults to false.
+    """
+    term: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The terminal type, set as TERM. Defaults to `xterm`.
+    """
+
+@pulumi.input_type
+class PtyArgs:
+    def __init__(__self__, *,
+                 cols: pulumi.Input[Optional[_builtins.int]] = None,
+                 rows: pulumi.Input[Optional[_builtins.int]] = None,
+                 strip_ansi: pulumi.Input[Optional[_builtins.bool]] = None,
+                 term: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        The pseudo-terminal that a command is run in.
+
+        :param pulumi.Input[_builtins.int] cols: The width of the terminal in characters. Defaults to 80.
+        :param pulumi.Input[_builtins.int] rows: The height of the terminal in characters. Defaults to 24.
+        :param pulumi.Input[_builtins.bool] strip_ansi: If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+               captured stdout. They are always part of the logged output. Defaults to false.
+        :param pulumi.Input[_builtins.str] term: The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        if cols is not None:
+            pulumi.set(__self__, "cols", cols)
+        if rows is not None:
+            pulumi.set(__self__, "rows", rows)
+        if strip_ansi is not None:
+            pulumi.set(__self__, "strip_ansi", strip_ansi)
+        if term is not None:
+            pulumi.set(__self__, "term", term)
+
+    @_builtins.property
+    @pulumi.getter
+    def cols(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The width of the terminal in characters. Defaults to 80.
+        """
+        return pulumi.get(self, "cols")
+
+    @cols.setter
+    def cols(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "cols", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def rows(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The height of the terminal in characters. Defaults to 24.
+        """
+        return pulumi.get(self, "rows")
+
+    @rows.setter
+    def rows(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "rows", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stripAnsi")
+    def strip_ansi(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        captured stdout. They are always part of the logged output. Defaults to false.
+        """
+        return pulumi.get(self, "strip_ansi")
+
+    @strip_ansi.setter
+    def strip_ansi(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "strip_ansi", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def term(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        return pulumi.get(self, "term")
+
+    @term.setter
+    def term(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "term", value)
+
+
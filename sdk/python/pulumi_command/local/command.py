@@ -13,7 +13,9 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
+from . import outputs
 from ._enums import *
+from ._inputs import *
 
 __all__ = ['CommandArgs', 'Command']
 
@@ -27,11 +29,22 @@ class CommandArgs:
                  delete: pulumi.Input[Optional[_builtins.str]] = None,
                  dir: pulumi.Input[Optional[_builtins.str]] = None,
                  environment: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 error_output_lines: pulumi.Input[Optional[_builtins.int]] = None,
+                 heartbeat_interval: pulumi.Input[Optional[_builtins.int]] = None,
                  interpreter: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 log_stream_prefix: pulumi.Input[Optional[_builtins.bool]] = None,
                  logging: pulumi.Input[Optional['Logging']] = None,
+                 pty: pulumi.Input[Optional['PtyArgs']] = None,
+                 redact: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 redact_outputs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 stderr_severity: pulumi.Input[Optional['LogSeverity']] = None,
                  stdin: pulumi.Input[Optional[_builtins.str]] = None,
+                 stdout_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 strip_workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 symlinks: pulumi.Input[Optional['SymlinkPolicy']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
-                 update: pulumi.Input[Optional[_builtins.str]] = None):
+                 update: pulumi.Input[Optional[_builtins.str]] = None,
+                 workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None):
         """
         The set of arguments for constructing a Command resource.
 
@@ -127,12 +140,39 @@ class CommandArgs:
         :param pulumi.Input[_builtins.str] dir: The directory from which to run the command from. If `dir` does not exist, then
                `Command` will fail.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] environment: Additional environment variables available to the command's process.
+        :param pulumi.Input[_builtins.int] error_output_lines: The number of lines at the end of stdout and stderr that the error of a
+               failed command includes. Defaults to 20.
+        :param pulumi.Input[_builtins.int] heartbeat_interval: The number of seconds without output after which the status of the
+               resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+               line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+               Defaults to 60.
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] interpreter: The program and arguments to run the command.
                On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
+        :param pulumi.Input[_builtins.bool] log_stream_prefix: If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
         :param pulumi.Input['Logging'] logging: If the command's stdout and stderr should be logged. This doesn't affect the capturing of
                stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
                outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        :param pulumi.Input['PtyArgs'] pty: Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+               terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+               the terminal, without echo, followed by the end of input. Not supported on Windows.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] redact: Additional values to mask as '[secret]' in the logged output and the error of
+               the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+               environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        :param pulumi.Input[_builtins.bool] redact_outputs: If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+               to false, in which case outputs that might contain secrets can be marked as secret via
+               'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        :param pulumi.Input['LogSeverity'] stderr_severity: The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
         :param pulumi.Input[_builtins.str] stdin: Pass a string to the command's process as standard in
+        :param pulumi.Input['LogSeverity'] stdout_severity: The severity with which the lines of stdout are logged. Defaults to `info`.
+        :param pulumi.Input[_builtins.bool] strip_workflow_commands: If the workflow commands are removed from the 'stdout' output. Defaults
+               to false.
+        :param pulumi.Input['SymlinkPolicy'] symlinks: How symlinks are handled when matching `assetPaths` and `archivePaths`.
+               With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+               searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+               Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+               search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+               files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+               a directory isn't searched.
         :param pulumi.Input[Sequence[Any]] triggers: The resource will be updated (or replaced) if any of these values change.
                
                The trigger values can be of any type.
@@ -149,6 +189,10 @@ class CommandArgs:
                Use `local.runOutput` if you need to run a command on every execution of your program.
                
                The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        :param pulumi.Input[_builtins.bool] workflow_commands: Run the workflow commands among the lines of stdout, modeled on those of
+               GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+               message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+               the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
         """
         if add_previous_output_in_env is not None:
             pulumi.set(__self__, "add_previous_output_in_env", add_previous_output_in_env)
@@ -164,16 +208,38 @@ class CommandArgs:
             pulumi.set(__self__, "dir", dir)
         if environment is not None:
             pulumi.set(__self__, "environment", environment)
+        if error_output_lines is not None:
+            pulumi.set(__self__, "error_output_lines", error_output_lines)
+        if heartbeat_interval is not None:
+            pulumi.set(__self__, "heartbeat_interval", heartbeat_interval)
         if interpreter is not None:
             pulumi.set(__self__, "interpreter", interpreter)
+        if log_stream_prefix is not None:
+            pulumi.set(__self__, "log_stream_prefix", log_stream_prefix)
         if logging is not None:
             pulumi.set(__self__, "logging", logging)
+        if pty is not None:
+            pulumi.set(__self__, "pty", pty)
+        if redact is not None:
+            pulumi.set(__self__, "redact", redact)
+        if redact_outputs is not None:
+            pulumi.set(__self__, "redact_outputs", redact_outputs)
+        if stderr_severity is not None:
+            pulumi.set(__self__, "stderr_severity", stderr_severity)
         if stdin is not None:
             pulumi.set(__self__, "stdin", stdin)
+        if stdout_severity is not None:
+            pulumi.set(__self__, "stdout_severity", stdout_severity)
+        if strip_workflow_commands is not None:
+            pulumi.set(__self__, "strip_workflow_commands", strip_workflow_commands)
+        if symlinks is not None:
+            pulumi.set(__self__, "symlinks", symlinks)
         if triggers is not None:
             pulumi.set(__self__, "triggers", triggers)
         if update is not None:
             pulumi.set(__self__, "update", update)
+        if workflow_commands is not None:
+            pulumi.set(__self__, "workflow_commands", workflow_commands)
 
     @_builtins.property
     @pulumi.getter(name="addPreviousOutputInEnv")
@@ -344,6 +410,34 @@ class CommandArgs:
     def environment(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "environment", value)
 
+    @_builtins.property
+    @pulumi.getter(name="errorOutputLines")
+    def error_output_lines(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of lines at the end of stdout and stderr that the error of a
+        failed command includes. Defaults to 20.
+        """
+        return pulumi.get(self, "error_output_lines")
+
+    @error_output_lines.setter
+    def error_output_lines(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "error_output_lines", value)
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatInterval")
+    def heartbeat_interval(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of seconds without output after which the status of the
+        resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        Defaults to 60.
+        """
+        return pulumi.get(self, "heartbeat_interval")
+
+    @heartbeat_interval.setter
+    def heartbeat_interval(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "heartbeat_interval", value)
+
     @_builtins.property
     @pulumi.getter
     def interpreter(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
@@ -357,6 +451,18 @@ class CommandArgs:
     def interpreter(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
         pulumi.set(self, "interpreter", value)
 
+    @_builtins.property
+    @pulumi.getter(name="logStreamPrefix")
+    def log_stream_prefix(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        """
+        return pulumi.get(self, "log_stream_prefix")
+
+    @log_stream_prefix.setter
+    def log_stream_prefix(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "log_stream_prefix", value)
+
     @_builtins.property
     @pulumi.getter
     def logging(self) -> pulumi.Input[Optional['Logging']]:
@@ -371,6 +477,60 @@ class CommandArgs:
     def logging(self, value: pulumi.Input[Optional['Logging']]):
         pulumi.set(self, "logging", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def pty(self) -> pulumi.Input[Optional['PtyArgs']]:
+        """
+        Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        the terminal, without echo, followed by the end of input. Not supported on Windows.
+        """
+        return pulumi.get(self, "pty")
+
+    @pty.setter
+    def pty(self, value: pulumi.Input[Optional['PtyArgs']]):
+        pulumi.set(self, "pty", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def redact(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Additional values to mask as '[secret]' in the logged output and the error of
+        the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        """
+        return pulumi.get(self, "redact")
+
+    @redact.setter
+    def redact(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "redact", value)
+
+    @_builtins.property
+    @pulumi.getter(name="redactOutputs")
+    def redact_outputs(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        to false, in which case outputs that might contain secrets can be marked as secret via
+        'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        """
+        return pulumi.get(self, "redact_outputs")
+
+    @redact_outputs.setter
+    def redact_outputs(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "redact_outputs", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stderrSeverity")
+    def stderr_severity(self) -> pulumi.Input[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        """
+        return pulumi.get(self, "stderr_severity")
+
+    @stderr_severity.setter
+    def stderr_severity(self, value: pulumi.Input[Optional['LogSeverity']]):
+        pulumi.set(self, "stderr_severity", value)
+
     @_builtins.property
     @pulumi.getter
     def stdin(self) -> pulumi.Input[Optional[_builtins.str]]:
@@ -383,6 +543,49 @@ class CommandArgs:
     def stdin(self, value: pulumi.Input[Optional[_builtins.str]]):
         pulumi.set(self, "stdin", value)
 
+    @_builtins.property
+    @pulumi.getter(name="stdoutSeverity")
+    def stdout_severity(self) -> pulumi.Input[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stdout are logged. Defaults to `info`.
+        """
+        return pulumi.get(self, "stdout_severity")
+
+    @stdout_severity.setter
+    def stdout_severity(self, value: pulumi.Input[Optional['LogSeverity']]):
+        pulumi.set(self, "stdout_severity", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stripWorkflowCommands")
+    def strip_workflow_commands(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If the workflow commands are removed from the 'stdout' output. Defaults
+        to false.
+        """
+        return pulumi.get(self, "strip_workflow_commands")
+
+    @strip_workflow_commands.setter
+    def strip_workflow_commands(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "strip_workflow_commands", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def symlinks(self) -> pulumi.Input[Optional['SymlinkPolicy']]:
+        """
+        How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        a directory isn't searched.
+        """
+        return pulumi.get(self, "symlinks")
+
+    @symlinks.setter
+    def symlinks(self, value: pulumi.Input[Optional['SymlinkPolicy']]):
+        pulumi.set(self, "symlinks", value)
+
     @_builtins.property
     @pulumi.getter
     def triggers(self) -> pulumi.Input[Optional[Sequence[Any]]]:
@@ -421,6 +624,21 @@ class CommandArgs:
     def update(self, value: pulumi.Input[Optional[_builtins.str]]):
         pulumi.set(self, "update", value)
 
+    @_builtins.property
+    @pulumi.getter(name="workflowCommands")
+    def workflow_commands(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Run the workflow commands among the lines of stdout, modeled on those of
+        GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        return pulumi.get(self, "workflow_commands")
+
+    @workflow_commands.setter
+    def workflow_commands(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "workflow_commands", value)
+
 
 @pulumi.type_token("command:local:Command")
 class Command(pulumi.CustomResource):
@@ -435,11 +653,22 @@ class Command(pulumi.CustomResource):
                  delete: pulumi.Input[Optional[_builtins.str]] = None,
                  dir: pulumi.Input[Optional[_builtins.str]] = None,
                  environment: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 error_output_lines: pulumi.Input[Optional[_builtins.int]] = None,
+                 heartbeat_interval: pulumi.Input[Optional[_builtins.int]] = None,
                  interpreter: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 log_stream_prefix: pulumi.Input[Optional[_builtins.bool]] = None,
                  logging: pulumi.Input[Optional['Logging']] = None,
+                 pty: pulumi.Input[Optional[Union['PtyArgs', 'PtyArgsDict']]] = None,
+                 redact: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 redact_outputs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 stderr_severity: pulumi.Input[Optional['LogSeverity']] = None,
                  stdin: pulumi.Input[Optional[_builtins.str]] = None,
+                 stdout_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 strip_workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 symlinks: pulumi.Input[Optional['SymlinkPolicy']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
                  update: pulumi.Input[Optional[_builtins.str]] = None,
+                 workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
                  __props__=None):
         """
         A local command to be executed.
@@ -628,12 +857,39 @@ class Command(pulumi.CustomResource):
         :param pulumi.Input[_builtins.str] dir: The directory from which to run the command from. If `dir` does not exist, then
                `Command` will fail.
         :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] environment: Additional environment variables available to the command's process.
+        :param pulumi.Input[_builtins.int] error_output_lines: The number of lines at the end of stdout and stderr that the error of a
+               failed command includes. Defaults to 20.
+        :param pulumi.Input[_builtins.int] heartbeat_interval: The number of seconds without output after which the status of the
+               resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+               line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+               Defaults to 60.
         :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] interpreter: The program and arguments to run the command.
                On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
+        :param pulumi.Input[_builtins.bool] log_stream_prefix: If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
         :param pulumi.Input['Logging'] logging: If the command's stdout and stderr should be logged. This doesn't affect the capturing of
                stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
                outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        :param pulumi.Input[Union['PtyArgs', 'PtyArgsDict']] pty: Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+               terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+               the terminal, without echo, followed by the end of input. Not supported on Windows.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] redact: Additional values to mask as '[secret]' in the logged output and the error of
+               the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+               environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        :param pulumi.Input[_builtins.bool] redact_outputs: If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+               to false, in which case outputs that might contain secrets can be marked as secret via
+               'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        :param pulumi.Input['LogSeverity'] stderr_severity: The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
         :param pulumi.Input[_builtins.str] stdin: Pass a string to the command's process as standard in
+        :param pulumi.Input['LogSeverity'] stdout_severity: The severity with which the lines of stdout are logged. Defaults to `info`.
+        :param pulumi.Input[_builtins.bool] strip_workflow_commands: If the workflow commands are removed from the 'stdout' output. Defaults
+               to false.
+        :param pulumi.Input['SymlinkPolicy'] symlinks: How symlinks are handled when matching `assetPaths` and `archivePaths`.
+               With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+               searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+               Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+               search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+               files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+               a directory isn't searched.
         :param pulumi.Input[Sequence[Any]] triggers: The resource will be updated (or replaced) if any of these values change.
                
                The trigger values can be of any type.
@@ -650,6 +906,10 @@ class Command(pulumi.CustomResource):
                Use `local.runOutput` if you need to run a command on every execution of your program.
                
                The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        :param pulumi.Input[_builtins.bool] workflow_commands: Run the workflow commands among the lines of stdout, modeled on those of
+               GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+               message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+               the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
         """
         ...
     @overload
@@ -772,11 +1032,22 @@ class Command(pulumi.CustomResource):
                  delete: pulumi.Input[Optional[_builtins.str]] = None,
                  dir: pulumi.Input[Optional[_builtins.str]] = None,
                  environment: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 error_output_lines: pulumi.Input[Optional[_builtins.int]] = None,
+                 heartbeat_interval: pulumi.Input[Optional[_builtins.int]] = None,
                  interpreter: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 log_stream_prefix: pulumi.Input[Optional[_builtins.bool]] = None,
                  logging: pulumi.Input[Optional['Logging']] = None,
+                 pty: pulumi.Input[Optional[Union['PtyArgs', 'PtyArgsDict']]] = None,
+                 redact: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 redact_outputs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 stderr_severity: pulumi.Input[Optional['LogSeverity']] = None,
                  stdin: pulumi.Input[Optional[_builtins.str]] = None,
+                 stdout_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 strip_workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 symlinks: pulumi.Input[Optional['SymlinkPolicy']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
                  update: pulumi.Input[Optional[_builtins.str]] = None,
+                 workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -793,15 +1064,28 @@ class Command(pulumi.CustomResource):
             __props__.__dict__["delete"] = delete
             __props__.__dict__["dir"] = dir
             __props__.__dict__["environment"] = environment
+            __props__.__dict__["error_output_lines"] = error_output_lines
+            __props__.__dict__["heartbeat_interval"] = heartbeat_interval
             __props__.__dict__["interpreter"] = interpreter
+            __props__.__dict__["log_stream_prefix"] = log_stream_prefix
             __props__.__dict__["logging"] = logging
+            __props__.__dict__["pty"] = pty
+            __props__.__dict__["redact"] = None if redact is None else pulumi.Output.secret(redact)
+            __props__.__dict__["redact_outputs"] = redact_outputs
+            __props__.__dict__["stderr_severity"] = stderr_severity
             __props__.__dict__["stdin"] = stdin
+            __props__.__dict__["stdout_severity"] = stdout_severity
+            __props__.__dict__["strip_workflow_commands"] = strip_workflow_commands
+            __props__.__dict__["symlinks"] = symlinks
             __props__.__dict__["triggers"] = triggers
             __props__.__dict__["update"] = update
+            __props__.__dict__["workflow_commands"] = workflow_commands
             __props__.__dict__["archive"] = None
             __props__.__dict__["assets"] = None
             __props__.__dict__["stderr"] = None
             __props__.__dict__["stdout"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["redact"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["triggers[*]"])
         opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
         super(Command, __self__).__init__(
@@ -835,13 +1119,24 @@ class Command(pulumi.CustomResource):
         __props__.__dict__["delete"] = None
         __props__.__dict__["dir"] = None
         __props__.__dict__["environment"] = None
+        __props__.__dict__["error_output_lines"] = None
+        __props__.__dict__["heartbeat_interval"] = None
         __props__.__dict__["interpreter"] = None
+        __props__.__dict__["log_stream_prefix"] = None
         __props__.__dict__["logging"] = None
+        __props__.__dict__["pty"] = None
+        __props__.__dict__["redact"] = None
+        __props__.__dict__["redact_outputs"] = None
         __props__.__dict__["stderr"] = None
+        __props__.__dict__["stderr_severity"] = None
         __props__.__dict__["stdin"] = None
         __props__.__dict__["stdout"] = None
+        __props__.__dict__["stdout_severity"] = None
+        __props__.__dict__["strip_workflow_commands"] = None
+        __props__.__dict__["symlinks"] = None
         __props__.__dict__["triggers"] = None
         __props__.__dict__["update"] = None
+        __props__.__dict__["workflow_commands"] = None
         return Command(resource_name, opts=opts, __props__=__props__)
 
     @_builtins.property
@@ -1002,6 +1297,26 @@ class Command(pulumi.CustomResource):
         """
         return pulumi.get(self, "environment")
 
+    @_builtins.property
+    @pulumi.getter(name="errorOutputLines")
+    def error_output_lines(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of lines at the end of stdout and stderr that the error of a
+        failed command includes. Defaults to 20.
+        """
+        return pulumi.get(self, "error_output_lines")
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatInterval")
+    def heartbeat_interval(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of seconds without output after which the status of the
+        resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        Defaults to 60.
+        """
+        return pulumi.get(self, "heartbeat_interval")
+
     @_builtins.property
     @pulumi.getter
     def interpreter(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
@@ -1011,6 +1326,14 @@ class Command(pulumi.CustomResource):
         """
         return pulumi.get(self, "interpreter")
 
+    @_builtins.property
+    @pulumi.getter(name="logStreamPrefix")
+    def log_stream_prefix(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        """
+        return pulumi.get(self, "log_stream_prefix")
+
     @_builtins.property
     @pulumi.getter
     def logging(self) -> pulumi.Output[Optional['Logging']]:
@@ -1021,6 +1344,36 @@ class Command(pulumi.CustomResource):
         """
         return pulumi.get(self, "logging")
 
+    @_builtins.property
+    @pulumi.getter
+    def pty(self) -> pulumi.Output[Optional['outputs.Pty']]:
+        """
+        Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        the terminal, without echo, followed by the end of input. Not supported on Windows.
+        """
+        return pulumi.get(self, "pty")
+
+    @_builtins.property
+    @pulumi.getter
+    def redact(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        Additional values to mask as '[secret]' in the logged output and the error of
+        the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        """
+        return pulumi.get(self, "redact")
+
+    @_builtins.property
+    @pulumi.getter(name="redactOutputs")
+    def redact_outputs(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        to false, in which case outputs that might contain secrets can be marked as secret via
+        'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        """
+        return pulumi.get(self, "redact_outputs")
+
     @_builtins.property
     @pulumi.getter
     def stderr(self) -> pulumi.Output[_builtins.str]:
@@ -1029,6 +1382,14 @@ class Command(pulumi.CustomResource):
         """
         return pulumi.get(self, "stderr")
 
+    @_builtins.property
+    @pulumi.getter(name="stderrSeverity")
+    def stderr_severity(self) -> pulumi.Output[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        """
+        return pulumi.get(self, "stderr_severity")
+
     @_builtins.property
     @pulumi.getter
     def stdin(self) -> pulumi.Output[Optional[_builtins.str]]:
@@ -1045,6 +1406,37 @@ class Command(pulumi.CustomResource):
         """
         return pulumi.get(self, "stdout")
 
+    @_builtins.property
+    @pulumi.getter(name="stdoutSeverity")
+    def stdout_severity(self) -> pulumi.Output[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stdout are logged. Defaults to `info`.
+        """
+        return pulumi.get(self, "stdout_severity")
+
+    @_builtins.property
+    @pulumi.getter(name="stripWorkflowCommands")
+    def strip_workflow_commands(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the workflow commands are removed from the 'stdout' output. Defaults
+        to false.
+        """
+        return pulumi.get(self, "strip_workflow_commands")
+
+    @_builtins.property
+    @pulumi.getter
+    def symlinks(self) -> pulumi.Output[Optional['SymlinkPolicy']]:
+        """
+        How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        a directory isn't searched.
+        """
+        return pulumi.get(self, "symlinks")
+
     @_builtins.property
     @pulumi.getter
     def triggers(self) -> pulumi.Output[Optional[Sequence[Any]]]:
@@ -1075,3 +1467,14 @@ class Command(pulumi.CustomResource):
         """
         return pulumi.get(self, "update")
 
+    @_builtins.property
+    @pulumi.getter(name="workflowCommands")
+    def workflow_commands(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Run the workflow commands among the lines of stdout, modeled on those of
+        GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        return pulumi.get(self, "workflow_commands")
+
@@ -0,0 +1,100 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from ._enums import *
+
+__all__ = [
+    'Pty',
+]
+
+@pulumi.output_type
+class Pty(dict):
+    """
+    The pseudo-terminal that a command is run in.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "stripAnsi":
+            suggest = "strip_ansi"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Pty. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Pty.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Pty.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 cols: Optional[_builtins.int] = None,
+                 rows: Optional[_builtins.int] = None,
+                 strip_ansi: Optional[_builtins.bool] = None,
+                 term: Optional[_builtins.str] = None):
+        """
+        The pseudo-terminal that a command is run in.
+
+        :param _builtins.int cols: The width of the terminal in characters. Defaults to 80.
+        :param _builtins.int rows: The height of the terminal in characters. Defaults to 24.
+        :param _builtins.bool strip_ansi: If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+               captured stdout. They are always part of the logged output. Defaults to false.
+        :param _builtins.str term: The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        if cols is not None:
+            pulumi.set(__self__, "cols", cols)
+        if rows is not None:
+            pulumi.set(__self__, "rows", rows)
+        if strip_ansi is not None:
+            pulumi.set(__self__, "strip_ansi", strip_ansi)
+        if term is not None:
+            pulumi.set(__self__, "term", term)
+
+    @_builtins.property
+    @pulumi.getter
+    def cols(self) -> Optional[_builtins.int]:
+        """
+        The width of the terminal in characters. Defaults to 80.
+        """
+        return pulumi.get(self, "cols")
+
+    @_builtins.property
+    @pulumi.getter
+    def rows(self) -> Optional[_builtins.int]:
+        """
+        The height of the terminal in characters. Defaults to 24.
+        """
+        return pulumi.get(self, "rows")
+
+    @_builtins.property
+    @pulumi.getter(name="stripAnsi")
+    def strip_ansi(self) -> Optional[_builtins.bool]:
+        """
+        If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        captured stdout. They are always part of the logged output. Defaults to false.
+        """
+        return pulumi.get(self, "strip_ansi")
+
+    @_builtins.property
+    @pulumi.getter
+    def term(self) -> Optional[_builtins.str]:
+        """
+        The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        return pulumi.get(self, "term")
+
+
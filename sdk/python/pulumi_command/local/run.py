@@ -13,7 +13,9 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
+from . import outputs
 from ._enums import *
+from ._inputs import *
 
 __all__ = [
     'RunResult',
@@ -24,7 +26,7 @@ __all__ = [
 
 @pulumi.output_type
 class RunResult:
-    def __init__(__self__, add_previous_output_in_env=None, archive=None, archive_paths=None, asset_paths=None, assets=None, command=None, dir=None, environment=None, interpreter=None, logging=None, stderr=None, stdin=None, stdout=None):
+    def __init__(__self__, add_previous_output_in_env=None, archive=None, archive_paths=None, asset_paths=None, assets=None, command=None, dir=None, environment=None, error_output_lines=None, heartbeat_interval=None, interpreter=None, log_stream_prefix=None, logging=None, pty=None, redact=None, redact_outputs=None, stderr=None, stderr_severity=None, stdin=None, stdout=None, stdout_severity=None, strip_workflow_commands=None, symlinks=None, workflow_commands=None):
         if add_previous_output_in_env and not isinstance(add_previous_output_in_env, bool):
             raise TypeError("Expected argument 'add_previous_output_in_env' to be a bool")
         pulumi.set(__self__, "add_previous_output_in_env", add_previous_output_in_env)
@@ -49,21 +51,54 @@ class RunResult:
         if environment and not isinstance(environment, dict):
             raise TypeError("Expected argument 'environment' to be a dict")
         pulumi.set(__self__, "environment", environment)
+        if error_output_lines and not isinstance(error_output_lines, int):
+            raise TypeError("Expected argument 'error_output_lines' to be a int")
+        pulumi.set(__self__, "error_output_lines", error_output_lines)
+        if heartbeat_interval and not isinstance(heartbeat_interval, int):
+            raise TypeError("Expected argument 'heartbeat_interval' to be a int")
+        pulumi.set(__self__, "heartbeat_interval", heartbeat_interval)
         if interpreter and not isinstance(interpreter, list):
             raise TypeError("Expected argument 'interpreter' to be a list")
         pulumi.set(__self__, "interpreter", interpreter)
+        if log_stream_prefix and not isinstance(log_stream_prefix, bool):
+            raise TypeError("Expected argument 'log_stream_prefix' to be a bool")
+        pulumi.set(__self__, "log_stream_prefix", log_stream_prefix)
         if logging and not isinstance(logging, str):
             raise TypeError("Expected argument 'logging' to be a str")
         pulumi.set(__self__, "logging", logging)
+        if pty and not isinstance(pty, dict):
+            raise TypeError("Expected argument 'pty' to be a dict")
+        pulumi.set(__self__, "pty", pty)
+        if redact and not isinstance(redact, list):
+            raise TypeError("Expected argument 'redact' to be a list")
+        pulumi.set(__self__, "redact", redact)
+        if redact_outputs and not isinstance(redact_outputs, bool):
+            raise TypeError("Expected argument 'redact_outputs' to be a bool")
+        pulumi.set(__self__, "redact_outputs", redact_outputs)
         if stderr and not isinstance(stderr, str):
             raise TypeError("Expected argument 'stderr' to be a str")
         pulumi.set(__self__, "stderr", stderr)
+        if stderr_severity and not isinstance(stderr_severity, str):
+            raise TypeError("Expected argument 'stderr_severity' to be a str")
+        pulumi.set(__self__, "stderr_severity", stderr_severity)
         if stdin and not isinstance(stdin, str):
             raise TypeError("Expected argument 'stdin' to be a str")
         pulumi.set(__self__, "stdin", stdin)
         if stdout and not isinstance(stdout, str):
             raise TypeError("Expected argument 'stdout' to be a str")
         pulumi.set(__self__, "stdout", stdout)
+        if stdout_severity and not isinstance(stdout_severity, str):
+            raise TypeError("Expected argument 'stdout_severity' to be a str")
+        pulumi.set(__self__, "stdout_severity", stdout_severity)
+        if strip_workflow_commands and not isinstance(strip_workflow_commands, bool):
+            raise TypeError("Expected argument 'strip_workflow_commands' to be a bool")
+        pulumi.set(__self__, "strip_workflow_commands", strip_workflow_commands)
+        if symlinks and not isinstance(symlinks, str):
+            raise TypeError("Expected argument 'symlinks' to be a str")
+        pulumi.set(__self__, "symlinks", symlinks)
+        if workflow_commands and not isinstance(workflow_commands, bool):
+            raise TypeError("Expected argument 'workflow_commands' to be a bool")
+        pulumi.set(__self__, "workflow_commands", workflow_commands)
 
     @_builtins.property
     @pulumi.getter(name="addPreviousOutputInEnv")
@@ -207,6 +242,26 @@ class RunResult:
         """
         return pulumi.get(self, "environment")
 
+    @_builtins.property
+    @pulumi.getter(name="errorOutputLines")
+    def error_output_lines(self) -> Optional[_builtins.int]:
+        """
+        The number of lines at the end of stdout and stderr that the error of a
+        failed command includes. Defaults to 20.
+        """
+        return pulumi.get(self, "error_output_lines")
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatInterval")
+    def heartbeat_interval(self) -> Optional[_builtins.int]:
+        """
+        The number of seconds without output after which the status of the
+        resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        Defaults to 60.
+        """
+        return pulumi.get(self, "heartbeat_interval")
+
     @_builtins.property
     @pulumi.getter
     def interpreter(self) -> Optional[Sequence[_builtins.str]]:
@@ -216,6 +271,14 @@ class RunResult:
         """
         return pulumi.get(self, "interpreter")
 
+    @_builtins.property
+    @pulumi.getter(name="logStreamPrefix")
+    def log_stream_prefix(self) -> Optional[_builtins.bool]:
+        """
+        If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        """
+        return pulumi.get(self, "log_stream_prefix")
+
     @_builtins.property
     @pulumi.getter
     def logging(self) -> Optional['Logging']:
@@ -226,6 +289,36 @@ class RunResult:
         """
         return pulumi.get(self, "logging")
 
+    @_builtins.property
+    @pulumi.getter
+    def pty(self) -> Optional['outputs.Pty']:
+        """
+        Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        the terminal, without echo, followed by the end of input. Not supported on Windows.
+        """
+        return pulumi.get(self, "pty")
+
+    @_builtins.property
+    @pulumi.getter
+    def redact(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Additional values to mask as '[secret]' in the logged output and the error of
+        the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        """
+        return pulumi.get(self, "redact")
+
+    @_builtins.property
+    @pulumi.getter(name="redactOutputs")
+    def redact_outputs(self) -> Optional[_builtins.bool]:
+        """
+        If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        to false, in which case outputs that might contain secrets can be marked as secret via
+        'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        """
+        return pulumi.get(self, "redact_outputs")
+
     @_builtins.property
     @pulumi.getter
     def stderr(self) -> _builtins.str:
@@ -234,6 +327,14 @@ class RunResult:
         """
         return pulumi.get(self, "stderr")
 
+    @_builtins.property
+    @pulumi.getter(name="stderrSeverity")
+    def stderr_severity(self) -> Optional['LogSeverity']:
+        """
+        The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        """
+        return pulumi.get(self, "stderr_severity")
+
     @_builtins.property
     @pulumi.getter
     def stdin(self) -> Optional[_builtins.str]:
@@ -250,6 +351,48 @@ class RunResult:
         """
         return pulumi.get(self, "stdout")
 
+    @_builtins.property
+    @pulumi.getter(name="stdoutSeverity")
+    def stdout_severity(self) -> Optional['LogSeverity']:
+        """
+        The severity with which the lines of stdout are logged. Defaults to `info`.
+        """
+        return pulumi.get(self, "stdout_severity")
+
+    @_builtins.property
+    @pulumi.getter(name="stripWorkflowCommands")
+    def strip_workflow_commands(self) -> Optional[_builtins.bool]:
+        """
+        If the workflow commands are removed from the 'stdout' output. Defaults
+        to false.
+        """
+        return pulumi.get(self, "strip_workflow_commands")
+
+    @_builtins.property
+    @pulumi.getter
+    def symlinks(self) -> Optional['SymlinkPolicy']:
+        """
+        How symlinks are handled when matching `assetPaths` and `archivePaths`.
+        With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+        searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+        Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+        search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+        files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+        a directory isn't searched.
+        """
+        return pulumi.get(self, "symlinks")
+
+    @_builtins.property
+    @pulumi.getter(name="workflowCommands")
+    def workflow_commands(self) -> Optional[_builtins.bool]:
+        """
+        Run the workflow commands among the lines of stdout, modeled on those of
+        GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        return pulumi.get(self, "workflow_commands")
+
 
 class AwaitableRunResult(RunResult):
     # pylint: disable=using-constant-test
@@ -265,11 +408,22 @@ class AwaitableRunResult(RunResult):
             command=self.command,
             dir=self.dir,
             environment=self.environment,
+            error_output_lines=self.error_output_lines,
+            heartbeat_interval=self.heartbeat_interval,
             interpreter=self.interpreter,
+            log_stream_prefix=self.log_stream_prefix,
             logging=self.logging,
+            pty=self.pty,
+            redact=self.redact,
+            redact_outputs=self.redact_outputs,
             stderr=self.stderr,
+            stderr_severity=self.stderr_severity,
             stdin=self.stdin,
-            stdout=self.stdout)
+            stdout=self.stdout,
+            stdout_severity=self.stdout_severity,
+            strip_workflow_commands=self.strip_workflow_commands,
+            symlinks=self.symlinks,
+            workflow_commands=self.workflow_commands)
 
 
 def run(add_previous_output_in_env: Optional[_builtins.bool] = None,
@@ -278,9 +432,20 @@ def run(add_previous_output_in_env: Optional[_builtins.bool] = None,
         command: Optional[_builtins.str] = None,
         dir: Optional[_builtins.str] = None,
         environment: Optional[Mapping[str, _builtins.str]] = None,
+        error_output_lines: Optional[_builtins.int] = None,
+        heartbeat_interval: Optional[_builtins.int] = None,
         interpreter: Optional[Sequence[_builtins.str]] = None,
+        log_stream_prefix: Optional[_builtins.bool] = None,
         logging: Optional['Logging'] = None,
+        pty: Optional[Union['Pty', 'PtyDict']] = None,
+        redact: Optional[Sequence[_builtins.str]] = None,
+        redact_outputs: Optional[_builtins.bool] = None,
+        stderr_severity: Optional['LogSeverity'] = None,
         stdin: Optional[_builtins.str] = None,
+        stdout_severity: Optional['LogSeverity'] = None,
+        strip_workflow_commands: Optional[_builtins.bool] = None,
+        symlinks: Optional['SymlinkPolicy'] = None,
+        workflow_commands: Optional[_builtins.bool] = None,
         opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableRunResult:
     """
     A local command to be executed unconditionally.
@@ -369,12 +534,43 @@ def run(add_previous_output_in_env: Optional[_builtins.bool] = None,
     :param _builtins.str dir: The directory from which to run the command from. If `dir` does not exist, then
            `Command` will fail.
     :param Mapping[str, _builtins.str] environment: Additional environment variables available to the command's process.
+    :param _builtins.int error_output_lines: The number of lines at the end of stdout and stderr that the error of a
+           failed command includes. Defaults to 20.
+    :param _builtins.int heartbeat_interval: The number of seconds without output after which the status of the
+           resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+           line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+           Defaults to 60.
     :param Sequence[_builtins.str] interpreter: The program and arguments to run the command.
            On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
+    :param _builtins.bool log_stream_prefix: If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
     :param 'Logging' logging: If the command's stdout and stderr should be logged. This doesn't affect the capturing of
            stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
            outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+    :param Union['Pty', 'PtyDict'] pty: Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+           terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+           the terminal, without echo, followed by the end of input. Not supported on Windows.
+    :param Sequence[_builtins.str] redact: Additional values to mask as '[secret]' in the logged output and the error of
+           the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+           environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+    :param _builtins.bool redact_outputs: If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+           to false, in which case outputs that might contain secrets can be marked as secret via
+           'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+    :param 'LogSeverity' stderr_severity: The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
     :param _builtins.str stdin: Pass a string to the command's process as standard in
+    :param 'LogSeverity' stdout_severity: The severity with which the lines of stdout are logged. Defaults to `info`.
+    :param _builtins.bool strip_workflow_commands: If the workflow commands are removed from the 'stdout' output. Defaults
+           to false.
+    :param 'SymlinkPolicy' symlinks: How symlinks are handled when matching `assetPaths` and `archivePaths`.
+           With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+           searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+           Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+           search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+           files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+           a directory isn't searched.
+    :param _builtins.bool workflow_commands: Run the workflow commands among the lines of stdout, modeled on those of
+           GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+           message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+           the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
     """
     __args__ = dict()
     __args__['addPreviousOutputInEnv'] = add_previous_output_in_env
@@ -383,9 +579,20 @@ def run(add_previous_output_in_env: Optional[_builtins.bool] = None,
     __args__['command'] = command
     __args__['dir'] = dir
     __args__['environment'] = environment
+    __args__['errorOutputLines'] = error_output_lines
+    __args__['heartbeatInterval'] = heartbeat_interval
     __args__['interpreter'] = interpreter
+    __args__['logStreamPrefix'] = log_stream_prefix
     __args__['logging'] = logging
+    __args__['pty'] = pty
+    __args__['redact'] = redact
+    __args__['redactOutputs'] = redact_outputs
+    __args__['stderrSeverity'] = stderr_severity
     __args__['stdin'] = stdin
+    __args__['stdoutSeverity'] = stdout_severity
+    __args__['stripWorkflowCommands'] = strip_workflow_commands
+    __args__['symlinks'] = symlinks
+    __args__['workflowCommands'] = workflow_commands
     opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
     __ret__ = pulumi.runtime.invoke('command:local:run', __args__, opts=opts, typ=RunResult).value
 
@@ -398,20 +605,42 @@ def run(add_previous_output_in_env: Optional[_builtins.bool] = None,
         command=pulumi.get(__ret__, 'command'),
         dir=pulumi.get(__ret__, 'dir'),
         environment=pulumi.get(__ret__, 'environment'),
+        error_output_lines=pulumi.get(__ret__, 'error_output_lines'),
+        heartbeat_interval=pulumi.get(__ret__, 'heartbeat_interval'),
         interpreter=pulumi.get(__ret__, 'interpreter'),
+        log_stream_prefix=pulumi.get(__ret__, 'log_stream_prefix'),
         logging=pulumi.get(__ret__, 'logging'),
+        pty=pulumi.get(__ret__, 'pty'),
+        redact=pulumi.get(__ret__, 'redact'),
+        redact_outputs=pulumi.get(__ret__, 'redact_outputs'),
         stderr=pulumi.get(__ret__, 'stderr'),
+        stderr_severity=pulumi.get(__ret__, 'stderr_severity'),
         stdin=pulumi.get(__ret__, 'stdin'),
-        stdout=pulumi.get(__ret__, 'stdout'))
+        stdout=pulumi.get(__ret__, 'stdout'),
+        stdout_severity=pulumi.get(__ret__, 'stdout_severity'),
+        strip_workflow_commands=pulumi.get(__ret__, 'strip_workflow_commands'),
+        symlinks=pulumi.get(__ret__, 'symlinks'),
+        workflow_commands=pulumi.get(__ret__, 'workflow_commands'))
 def run_output(add_previous_output_in_env: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                archive_paths: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
                asset_paths: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
                command: pulumi.Input[Optional[_builtins.str]] = None,
                dir: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                environment: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+               error_output_lines: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+               heartbeat_interval: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
                interpreter: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+               log_stream_prefix: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                logging: pulumi.Input[Optional[Optional['Logging']]] = None,
+               pty: pulumi.Input[Optional[Optional[Union['Pty', 'PtyDict']]]] = None,
+               redact: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+               redact_outputs: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+               stderr_severity: pulumi.Input[Optional[Optional['LogSeverity']]] = None,
                stdin: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+               stdout_severity: pulumi.Input[Optional[Optional['LogSeverity']]] = None,
+               strip_workflow_commands: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+               symlinks: pulumi.Input[Optional[Optional['SymlinkPolicy']]] = None,
+               workflow_commands: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[RunResult]:
     """
     A local command to be executed unconditionally.
@@ -500,12 +729,43 @@ def run_output(add_previous_output_in_env: pulumi.Input[Optional[Optional[_built
     :param _builtins.str dir: The directory from which to run the command from. If `dir` does not exist, then
            `Command` will fail.
     :param Mapping[str, _builtins.str] environment: Additional environment variables available to the command's process.
+    :param _builtins.int error_output_lines: The number of lines at the end of stdout and stderr that the error of a
+           failed command includes. Defaults to 20.
+    :param _builtins.int heartbeat_interval: The number of seconds without output after which the status of the
+           resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+           line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+           Defaults to 60.
     :param Sequence[_builtins.str] interpreter: The program and arguments to run the command.
            On Linux and macOS, defaults to: `["/bin/sh", "-c"]`. On Windows, defaults to: `["cmd", "/C"]`
+    :param _builtins.bool log_stream_prefix: If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
     :param 'Logging' logging: If the command's stdout and stderr should be logged. This doesn't affect the capturing of
            stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
            outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+    :param Union['Pty', 'PtyDict'] pty: Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+           terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+           the terminal, without echo, followed by the end of input. Not supported on Windows.
+    :param Sequence[_builtins.str] redact: Additional values to mask as '[secret]' in the logged output and the error of
+           the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+           environment variables, are masked. Values shorter than 4 characters aren't masked, and a warning is logged instead.
+    :param _builtins.bool redact_outputs: If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+           to false, in which case outputs that might contain secrets can be marked as secret via
+           'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+    :param 'LogSeverity' stderr_severity: The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
     :param _builtins.str stdin: Pass a string to the command's process as standard in
+    :param 'LogSeverity' stdout_severity: The severity with which the lines of stdout are logged. Defaults to `info`.
+    :param _builtins.bool strip_workflow_commands: If the workflow commands are removed from the 'stdout' output. Defaults
+           to false.
+    :param 'SymlinkPolicy' symlinks: How symlinks are handled when matching `assetPaths` and `archivePaths`.
+           With `follow`, symlinks to files are read as the files they point to and symlinks to directories are
+           searched like directories; a symlink pointing to one of its parent directories is reported as an error.
+           Assets can't represent symlinks, so `preserve` reads symlinks to files like `follow` but doesn't
+           search symlinked directories. With `skip`, symlinks are ignored. If not set, symlinks are matched like
+           files, as before this option existed: a symlink to a file is read as the file it points to, and a symlink to
+           a directory isn't searched.
+    :param _builtins.bool workflow_commands: Run the workflow commands among the lines of stdout, modeled on those of
+           GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+           message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+           the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
     """
     __args__ = dict()
     __args__['addPreviousOutputInEnv'] = add_previous_output_in_env
@@ -514,9 +774,20 @@ def run_output(add_previous_output_in_env: pulumi.Input[Optional[Optional[_built
     __args__['command'] = command
     __args__['dir'] = dir
     __args__['environment'] = environment
+    __args__['errorOutputLines'] = error_output_lines
+    __args__['heartbeatInterval'] = heartbeat_interval
     __args__['interpreter'] = interpreter
+    __args__['logStreamPrefix'] = log_stream_prefix
     __args__['logging'] = logging
+    __args__['pty'] = pty
+    __args__['redact'] = redact
+    __args__['redactOutputs'] = redact_outputs
+    __args__['stderrSeverity'] = stderr_severity
     __args__['stdin'] = stdin
+    __args__['stdoutSeverity'] = stdout_severity
+    __args__['stripWorkflowCommands'] = strip_workflow_commands
+    __args__['symlinks'] = symlinks
+    __args__['workflowCommands'] = workflow_commands
     opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
     __ret__ = pulumi.runtime.invoke_output('command:local:run', __args__, opts=opts, typ=RunResult)
     return __ret__.apply(lambda __response__: RunResult(
@@ -528,8 +799,19 @@ def run_output(add_previous_output_in_env: pulumi.Input[Optional[Optional[_built
         command=pulumi.get(__response__, 'command'),
         dir=pulumi.get(__response__, 'dir'),
         environment=pulumi.get(__response__, 'environment'),
+        error_output_lines=pulumi.get(__response__, 'error_output_lines'),
+        heartbeat_interval=pulumi.get(__response__, 'heartbeat_interval'),
         interpreter=pulumi.get(__response__, 'interpreter'),
+        log_stream_prefix=pulumi.get(__response__, 'log_stream_prefix'),
         logging=pulumi.get(__response__, 'logging'),
+        pty=pulumi.get(__response__, 'pty'),
+        redact=pulumi.get(__response__, 'redact'),
+        redact_outputs=pulumi.get(__response__, 'redact_outputs'),
         stderr=pulumi.get(__response__, 'stderr'),
+        stderr_severity=pulumi.get(__response__, 'stderr_severity'),
         stdin=pulumi.get(__response__, 'stdin'),
-        stdout=pulumi.get(__response__, 'stdout')))
+        stdout=pulumi.get(__response__, 'stdout'),
+        stdout_severity=pulumi.get(__response__, 'stdout_severity'),
+        strip_workflow_commands=pulumi.get(__response__, 'strip_workflow_commands'),
+        symlinks=pulumi.get(__response__, 'symlinks'),
+        workflow_commands=pulumi.get(__response__, 'workflow_commands')))
@@ -10,5 +10,11 @@ from ._enums import *
 from .command import *
 from .copy_file import *
 from .copy_to_remote import *
+from .get_facts import *
+from .get_host_keys import *
+from .multi_command import *
+from .test_connection import *
+from .tunnel import *
+from .wait_for_ready import *
 from ._inputs import *
 from . import outputs
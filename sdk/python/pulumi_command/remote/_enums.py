@@ -7,10 +7,85 @@ import pulumi
 from enum import Enum
 
 __all__ = [
+    'AtomicMode',
+    'BecomeMethod',
+    'EnvironmentMode',
+    'LogSeverity',
     'Logging',
+    'SymlinkPolicy',
+    'Transport',
+    'TunnelType',
 ]
 
 
+@pulumi.type_token("command:remote:AtomicMode")
+class AtomicMode(_builtins.str, Enum):
+    NONE = "none"
+    """
+    Write files in place
+    """
+    FILE = "file"
+    """
+    Write each file to a temporary file and rename it into place
+    """
+    DIRECTORY = "directory"
+    """
+    Stage copied directories in full and swap them in via a symlink
+    """
+
+
+@pulumi.type_token("command:remote:BecomeMethod")
+class BecomeMethod(_builtins.str, Enum):
+    SUDO = "sudo"
+    """
+    Use `sudo`
+    """
+    SU = "su"
+    """
+    Use `su`
+    """
+    DOAS = "doas"
+    """
+    Use `doas`
+    """
+
+
+@pulumi.type_token("command:remote:EnvironmentMode")
+class EnvironmentMode(_builtins.str, Enum):
+    SETENV = "setenv"
+    """
+    Set the variables on the SSH session, which requires AcceptEnv on the server
+    """
+    EXPORT = "export"
+    """
+    Export the variables in the command, which requires a POSIX shell on the remote host
+    """
+    AUTO = "auto"
+    """
+    Set the variables on the SSH session, and export those that the server rejects
+    """
+
+
+@pulumi.type_token("command:remote:LogSeverity")
+class LogSeverity(_builtins.str, Enum):
+    DEBUG = "debug"
+    """
+    Log the lines as debug messages
+    """
+    INFO = "info"
+    """
+    Log the lines as info messages
+    """
+    WARNING = "warning"
+    """
+    Log the lines as warnings
+    """
+    ERROR = "error"
+    """
+    Log the lines as errors
+    """
+
+
 @pulumi.type_token("command:remote:Logging")
 class Logging(_builtins.str, Enum):
     STDOUT = "stdout"
@@ -29,3 +104,51 @@ class Logging(_builtins.str, Enum):
     """
     Capture no logs
     """
+
+
+@pulumi.type_token("command:remote:SymlinkPolicy")
+class SymlinkPolicy(_builtins.str, Enum):
+    PRESERVE = "preserve"
+    """
+    Keep symlinks as symlinks
+    """
+    FOLLOW = "follow"
+    """
+    Treat symlinks as the files or directories they point to
+    """
+    SKIP = "skip"
+    """
+    Ignore symlinks
+    """
+
+
+@pulumi.type_token("command:remote:Transport")
+class Transport(_builtins.str, Enum):
+    SFTP = "sftp"
+    """
+    Use the SFTP subsystem
+    """
+    SCP = "scp"
+    """
+    Use the SCP protocol via `scp -t`
+    """
+    TAR_OVER_EXEC = "tarOverExec"
+    """
+    Stream a tar archive to `tar -x` on the remote host
+    """
+    AUTO = "auto"
+    """
+    Use SFTP if the host supports it, and fall back to `tarOverExec` or `scp`
+    """
+
+
+@pulumi.type_token("command:remote:TunnelType")
+class TunnelType(_builtins.str, Enum):
+    LOCAL = "local"
+    """
+    Forward the connections to a local port to the remote host, like `ssh -L`
+    """
+    REMOTE = "remote"
+    """
+    Forward the connections to a port on the remote host to the local machine, like `ssh -R`
+    """
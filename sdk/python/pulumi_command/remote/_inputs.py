@@ -16,12 +16,263 @@ from .. import _utilities
 from ._enums import *
 
 __all__ = [
+    'Connection',
+    'ConnectionDict',
     'ConnectionArgs',
     'ConnectionArgsDict',
+    'ConnectionTemplateArgs',
+    'ConnectionTemplateArgsDict',
+    'DetachedArgs',
+    'DetachedArgsDict',
+    'ProxyConnection',
+    'ProxyConnectionDict',
     'ProxyConnectionArgs',
     'ProxyConnectionArgsDict',
+    'PtyArgs',
+    'PtyArgsDict',
 ]
 
+class ConnectionDict(TypedDict):
+    """
+    Instructions for how to connect to a remote endpoint.
+    """
+    host: _builtins.str
+    """
+    The address of the resource to connect to.
+    """
+    agent_socket_path: NotRequired[_builtins.str]
+    """
+    SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+    """
+    dial_error_limit: NotRequired[_builtins.int]
+    """
+    Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+    """
+    host_key: NotRequired[_builtins.str]
+    """
+    The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+    """
+    password: NotRequired[_builtins.str]
+    """
+    The password we should use for the connection.
+    """
+    per_dial_timeout: NotRequired[_builtins.int]
+    """
+    Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+    """
+    port: NotRequired[_builtins.float]
+    """
+    The port to connect to. Defaults to 22.
+    """
+    private_key: NotRequired[_builtins.str]
+    """
+    The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+    """
+    private_key_password: NotRequired[_builtins.str]
+    """
+    The password to use in case the private key is encrypted.
+    """
+    proxy: NotRequired['ProxyConnectionDict']
+    """
+    The connection settings for the bastion/proxy host.
+    """
+    user: NotRequired[_builtins.str]
+    """
+    The user that we should use for the connection.
+    """
+
+@pulumi.input_type
+class Connection:
+    def __init__(__self__, *,
+                 host: _builtins.str,
+                 agent_socket_path: Optional[_builtins.str] = None,
+                 dial_error_limit: Optional[_builtins.int] = None,
+                 host_key: Optional[_builtins.str] = None,
+                 password: Optional[_builtins.str] = None,
+                 per_dial_timeout: Optional[_builtins.int] = None,
+                 port: Optional[_builtins.float] = None,
+                 private_key: Optional[_builtins.str] = None,
+                 private_key_password: Optional[_builtins.str] = None,
+                 proxy: Optional['ProxyConnection'] = None,
+                 user: Optional[_builtins.str] = None):
+        """
+        Instructions for how to connect to a remote endpoint.
+
+        :param _builtins.str host: The address of the resource to connect to.
+        :param _builtins.str agent_socket_path: SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        :param _builtins.int dial_error_limit: Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        :param _builtins.str host_key: The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        :param _builtins.str password: The password we should use for the connection.
+        :param _builtins.int per_dial_timeout: Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        :param _builtins.float port: The port to connect to. Defaults to 22.
+        :param _builtins.str private_key: The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        :param _builtins.str private_key_password: The password to use in case the private key is encrypted.
+        :param 'ProxyConnection' proxy: The connection settings for the bastion/proxy host.
+        :param _builtins.str user: The user that we should use for the connection.
+        """
+        pulumi.set(__self__, "host", host)
+        if agent_socket_path is not None:
+            pulumi.set(__self__, "agent_socket_path", agent_socket_path)
+        if dial_error_limit is None:
+            dial_error_limit = 10
+        if dial_error_limit is not None:
+            pulumi.set(__self__, "dial_error_limit", dial_error_limit)
+        if host_key is not None:
+            pulumi.set(__self__, "host_key", host_key)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if per_dial_timeout is None:
+            per_dial_timeout = 15
+        if per_dial_timeout is not None:
+            pulumi.set(__self__, "per_dial_timeout", per_dial_timeout)
+        if port is None:
+            port = 22
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if private_key is not None:
+            pulumi.set(__self__, "private_key", private_key)
+        if private_key_password is not None:
+            pulumi.set(__self__, "private_key_password", private_key_password)
+        if proxy is not None:
+            pulumi.set(__self__, "proxy", proxy)
+        if user is None:
+            user = 'root'
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @_builtins.property
+    @pulumi.getter
+    def host(self) -> _builtins.str:
+        """
+        The address of the resource to connect to.
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: _builtins.str):
+        pulumi.set(self, "host", value)
+
+    @_builtins.property
+    @pulumi.getter(name="agentSocketPath")
+    def agent_socket_path(self) -> Optional[_builtins.str]:
+        """
+        SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        """
+        return pulumi.get(self, "agent_socket_path")
+
+    @agent_socket_path.setter
+    def agent_socket_path(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "agent_socket_path", value)
+
+    @_builtins.property
+    @pulumi.getter(name="dialErrorLimit")
+    def dial_error_limit(self) -> Optional[_builtins.int]:
+        """
+        Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        """
+        return pulumi.get(self, "dial_error_limit")
+
+    @dial_error_limit.setter
+    def dial_error_limit(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "dial_error_limit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="hostKey")
+    def host_key(self) -> Optional[_builtins.str]:
+        """
+        The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        """
+        return pulumi.get(self, "host_key")
+
+    @host_key.setter
+    def host_key(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "host_key", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def password(self) -> Optional[_builtins.str]:
+        """
+        The password we should use for the connection.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "password", value)
+
+    @_builtins.property
+    @pulumi.getter(name="perDialTimeout")
+    def per_dial_timeout(self) -> Optional[_builtins.int]:
+        """
+        Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        """
+        return pulumi.get(self, "per_dial_timeout")
+
+    @per_dial_timeout.setter
+    def per_dial_timeout(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "per_dial_timeout", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def port(self) -> Optional[_builtins.float]:
+        """
+        The port to connect to. Defaults to 22.
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: Optional[_builtins.float]):
+        pulumi.set(self, "port", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateKey")
+    def private_key(self) -> Optional[_builtins.str]:
+        """
+        The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        """
+        return pulumi.get(self, "private_key")
+
+    @private_key.setter
+    def private_key(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "private_key", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateKeyPassword")
+    def private_key_password(self) -> Optional[_builtins.str]:
+        """
+        The password to use in case the private key is encrypted.
+        """
+        return pulumi.get(self, "private_key_password")
+
+    @private_key_password.setter
+    def private_key_password(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "private_key_password", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def proxy(self) -> Optional['ProxyConnection']:
+        """
+        The connection settings for the bastion/proxy host.
+        """
+        return pulumi.get(self, "proxy")
+
+    @proxy.setter
+    def proxy(self, value: Optional['ProxyConnection']):
+        pulumi.set(self, "proxy", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def user(self) -> Optional[_builtins.str]:
+        """
+        The user that we should use for the connection.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "user", value)
+
+
 class ConnectionArgsDict(TypedDict):
     """
     Instructions for how to connect to a remote endpoint.
@@ -263,13 +514,9 @@ class ConnectionArgs:
         pulumi.set(self, "user", value)
 
 
-class ProxyConnectionArgsDict(TypedDict):
-    """
-    Instructions for how to connect to a remote endpoint via a bastion host.
-    """
-    host: pulumi.Input[_builtins.str]
+class ConnectionTemplateArgsDict(TypedDict):
     """
-    The address of the bastion host to connect to.
+    Instructions for how to connect to several remote endpoints with the same settings.
     """
     agent_socket_path: NotRequired[pulumi.Input[Optional[_builtins.str]]]
     """
@@ -279,13 +526,9 @@ class ProxyConnectionArgsDict(TypedDict):
     """
     Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
     """
-    host_key: NotRequired[pulumi.Input[Optional[_builtins.str]]]
-    """
-    The expected host key to verify the server's identity. If not provided, the host key will be ignored.
-    """
     password: NotRequired[pulumi.Input[Optional[_builtins.str]]]
     """
-    The password we should use for the connection to the bastion host.
+    The password we should use for the connection.
     """
     per_dial_timeout: NotRequired[pulumi.Input[Optional[_builtins.int]]]
     """
@@ -293,7 +536,7 @@ class ProxyConnectionArgsDict(TypedDict):
     """
     port: NotRequired[pulumi.Input[Optional[_builtins.float]]]
     """
-    The port of the bastion host to connect to.
+    The port to connect to. Defaults to 22.
     """
     private_key: NotRequired[pulumi.Input[Optional[_builtins.str]]]
     """
@@ -303,47 +546,46 @@ class ProxyConnectionArgsDict(TypedDict):
     """
     The password to use in case the private key is encrypted.
     """
+    proxy: NotRequired[pulumi.Input[Optional['ProxyConnectionArgsDict']]]
+    """
+    The connection settings for the bastion/proxy host.
+    """
     user: NotRequired[pulumi.Input[Optional[_builtins.str]]]
     """
-    The user that we should use for the connection to the bastion host.
+    The user that we should use for the connection.
     """
 
 @pulumi.input_type
-class ProxyConnectionArgs:
+class ConnectionTemplateArgs:
     def __init__(__self__, *,
-                 host: pulumi.Input[_builtins.str],
                  agent_socket_path: pulumi.Input[Optional[_builtins.str]] = None,
                  dial_error_limit: pulumi.Input[Optional[_builtins.int]] = None,
-                 host_key: pulumi.Input[Optional[_builtins.str]] = None,
                  password: pulumi.Input[Optional[_builtins.str]] = None,
                  per_dial_timeout: pulumi.Input[Optional[_builtins.int]] = None,
                  port: pulumi.Input[Optional[_builtins.float]] = None,
                  private_key: pulumi.Input[Optional[_builtins.str]] = None,
                  private_key_password: pulumi.Input[Optional[_builtins.str]] = None,
+                 proxy: pulumi.Input[Optional['ProxyConnectionArgs']] = None,
                  user: pulumi.Input[Optional[_builtins.str]] = None):
         """
-        Instructions for how to connect to a remote endpoint via a bastion host.
+        Instructions for how to connect to several remote endpoints with the same settings.
 
-        :param pulumi.Input[_builtins.str] host: The address of the bastion host to connect to.
         :param pulumi.Input[_builtins.str] agent_socket_path: SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
         :param pulumi.Input[_builtins.int] dial_error_limit: Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
-        :param pulumi.Input[_builtins.str] host_key: The expected host key to verify the server's identity. If not provided, the host key will be ignored.
-        :param pulumi.Input[_builtins.str] password: The password we should use for the connection to the bastion host.
+        :param pulumi.Input[_builtins.str] password: The password we should use for the connection.
         :param pulumi.Input[_builtins.int] per_dial_timeout: Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
-        :param pulumi.Input[_builtins.float] port: The port of the bastion host to connect to.
+        :param pulumi.Input[_builtins.float] port: The port to connect to. Defaults to 22.
         :param pulumi.Input[_builtins.str] private_key: The contents of an SSH key to use for the connection. This takes preference over the password if provided.
         :param pulumi.Input[_builtins.str] private_key_password: The password to use in case the private key is encrypted.
-        :param pulumi.Input[_builtins.str] user: The user that we should use for the connection to the bastion host.
+        :param pulumi.Input['ProxyConnectionArgs'] proxy: The connection settings for the bastion/proxy host.
+        :param pulumi.Input[_builtins.str] user: The user that we should use for the connection.
         """
-        pulumi.set(__self__, "host", host)
         if agent_socket_path is not None:
             pulumi.set(__self__, "agent_socket_path", agent_socket_path)
         if dial_error_limit is None:
             dial_error_limit = 10
         if dial_error_limit is not None:
             pulumi.set(__self__, "dial_error_limit", dial_error_limit)
-        if host_key is not None:
-            pulumi.set(__self__, "host_key", host_key)
         if password is not None:
             pulumi.set(__self__, "password", password)
         if per_dial_timeout is None:
@@ -358,23 +600,13 @@ class ProxyConnectionArgs:
             pulumi.set(__self__, "private_key", private_key)
         if private_key_password is not None:
             pulumi.set(__self__, "private_key_password", private_key_password)
+        if proxy is not None:
+            pulumi.set(__self__, "proxy", proxy)
         if user is None:
             user = 'root'
         if user is not None:
             pulumi.set(__self__, "user", user)
 
-    @_builtins.property
-    @pulumi.getter
-    def host(self) -> pulumi.Input[_builtins.str]:
-        """
-        The address of the bastion host to connect to.
-        """
-        return pulumi.get(self, "host")
-
-    @host.setter
-    def host(self, value: pulumi.Input[_builtins.str]):
-        pulumi.set(self, "host", value)
-
     @_builtins.property
     @pulumi.getter(name="agentSocketPath")
     def agent_socket_path(self) -> pulumi.Input[Optional[_builtins.str]]:
@@ -399,23 +631,11 @@ class ProxyConnectionArgs:
     def dial_error_limit(self, value: pulumi.Input[Optional[_builtins.int]]):
         pulumi.set(self, "dial_error_limit", value)
 
-    @_builtins.property
-    @pulumi.getter(name="hostKey")
-    def host_key(self) -> pulumi.Input[Optional[_builtins.str]]:
-        """
-        The expected host key to verify the server's identity. If not provided, the host key will be ignored.
-        """
-        return pulumi.get(self, "host_key")
-
-    @host_key.setter
-    def host_key(self, value: pulumi.Input[Optional[_builtins.str]]):
-        pulumi.set(self, "host_key", value)
-
     @_builtins.property
     @pulumi.getter
     def password(self) -> pulumi.Input[Optional[_builtins.str]]:
         """
-        The password we should use for the connection to the bastion host.
+        The password we should use for the connection.
         """
         return pulumi.get(self, "password")
 
@@ -439,7 +659,7 @@ class ProxyConnectionArgs:
     @pulumi.getter
     def port(self) -> pulumi.Input[Optional[_builtins.float]]:
         """
-        The port of the bastion host to connect to.
+        The port to connect to. Defaults to 22.
         """
         return pulumi.get(self, "port")
 
@@ -473,14 +693,625 @@ class ProxyConnectionArgs:
 
     @_builtins.property
     @pulumi.getter
-    def user(self) -> pulumi.Input[Optional[_builtins.str]]:
+    def proxy(self) -> pulumi.Input[Optional['ProxyConnectionArgs']]:
         """
-        The user that we should use for the connection to the bastion host.
+        The connection settings for the bastion/proxy host.
         """
-        return pulumi.get(self, "user")
+        return pulumi.get(self, "proxy")
+
+    @proxy.setter
+    def proxy(self, value: pulumi.Input[Optional['ProxyConnectionArgs']]):
+        pulumi.set(self, "proxy", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def user(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The user that we should use for the connection.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "user", value)
+
+
+class DetachedArgsDict(TypedDict):
+    """
+    Settings for running a command as a detached job.
+    """
+    poll_interval: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The number of seconds between checks whether the job finished. Defaults to 5.
+    """
+    state_dir: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The directory on the remote host that holds a subdirectory per job, with its pid,
+    exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+    directory of the user. Defaults to `~/.pulumi-command/jobs`.
+    """
+
+@pulumi.input_type
+class DetachedArgs:
+    def __init__(__self__, *,
+                 poll_interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 state_dir: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        Settings for running a command as a detached job.
+
+        :param pulumi.Input[_builtins.int] poll_interval: The number of seconds between checks whether the job finished. Defaults to 5.
+        :param pulumi.Input[_builtins.str] state_dir: The directory on the remote host that holds a subdirectory per job, with its pid,
+               exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+               directory of the user. Defaults to `~/.pulumi-command/jobs`.
+        """
+        if poll_interval is not None:
+            pulumi.set(__self__, "poll_interval", poll_interval)
+        if state_dir is not None:
+            pulumi.set(__self__, "state_dir", state_dir)
+
+    @_builtins.property
+    @pulumi.getter(name="pollInterval")
+    def poll_interval(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of seconds between checks whether the job finished. Defaults to 5.
+        """
+        return pulumi.get(self, "poll_interval")
+
+    @poll_interval.setter
+    def poll_interval(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "poll_interval", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stateDir")
+    def state_dir(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The directory on the remote host that holds a subdirectory per job, with its pid,
+        exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+        directory of the user. Defaults to `~/.pulumi-command/jobs`.
+        """
+        return pulumi.get(self, "state_dir")
+
+    @state_dir.setter
+    def state_dir(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "state_dir", value)
+
+
+class ProxyConnectionDict(TypedDict):
+    """
+    Instructions for how to connect to a remote endpoint via a bastion host.
+    """
+    host: _builtins.str
+    """
+    The address of the bastion host to connect to.
+    """
+    agent_socket_path: NotRequired[_builtins.str]
+    """
+    SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+    """
+    dial_error_limit: NotRequired[_builtins.int]
+    """
+    Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+    """
+    host_key: NotRequired[_builtins.str]
+    """
+    The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+    """
+    password: NotRequired[_builtins.str]
+    """
+    The password we should use for the connection to the bastion host.
+    """
+    per_dial_timeout: NotRequired[_builtins.int]
+    """
+    Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+    """
+    port: NotRequired[_builtins.float]
+    """
+    The port of the bastion host to connect to.
+    """
+    private_key: NotRequired[_builtins.str]
+    """
+    The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+    """
+    private_key_password: NotRequired[_builtins.str]
+    """
+    The password to use in case the private key is encrypted.
+    """
+    user: NotRequired[_builtins.str]
+    """
+    The user that we should use for the connection to the bastion host.
+    """
+
+@pulumi.input_type
+class ProxyConnection:
+    def __init__(__self__, *,
+                 host: _builtins.str,
+                 agent_socket_path: Optional[_builtins.str] = None,
+                 dial_error_limit: Optional[_builtins.int] = None,
+                 host_key: Optional[_builtins.str] = None,
+                 password: Optional[_builtins.str] = None,
+                 per_dial_timeout: Optional[_builtins.int] = None,
+                 port: Optional[_builtins.float] = None,
+                 private_key: Optional[_builtins.str] = None,
+                 private_key_password: Optional[_builtins.str] = None,
+                 user: Optional[_builtins.str] = None):
+        """
+        Instructions for how to connect to a remote endpoint via a bastion host.
+
+        :param _builtins.str host: The address of the bastion host to connect to.
+        :param _builtins.str agent_socket_path: SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        :param _builtins.int dial_error_limit: Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        :param _builtins.str host_key: The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        :param _builtins.str password: The password we should use for the connection to the bastion host.
+        :param _builtins.int per_dial_timeout: Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        :param _builtins.float port: The port of the bastion host to connect to.
+        :param _builtins.str private_key: The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        :param _builtins.str private_key_password: The password to use in case the private key is encrypted.
+        :param _builtins.str user: The user that we should use for the connection to the bastion host.
+        """
+        pulumi.set(__self__, "host", host)
+        if agent_socket_path is not None:
+            pulumi.set(__self__, "agent_socket_path", agent_socket_path)
+        if dial_error_limit is None:
+            dial_error_limit = 10
+        if dial_error_limit is not None:
+            pulumi.set(__self__, "dial_error_limit", dial_error_limit)
+        if host_key is not None:
+            pulumi.set(__self__, "host_key", host_key)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if per_dial_timeout is None:
+            per_dial_timeout = 15
+        if per_dial_timeout is not None:
+            pulumi.set(__self__, "per_dial_timeout", per_dial_timeout)
+        if port is None:
+            port = 22
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if private_key is not None:
+            pulumi.set(__self__, "private_key", private_key)
+        if private_key_password is not None:
+            pulumi.set(__self__, "private_key_password", private_key_password)
+        if user is None:
+            user = 'root'
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @_builtins.property
+    @pulumi.getter
+    def host(self) -> _builtins.str:
+        """
+        The address of the bastion host to connect to.
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: _builtins.str):
+        pulumi.set(self, "host", value)
+
+    @_builtins.property
+    @pulumi.getter(name="agentSocketPath")
+    def agent_socket_path(self) -> Optional[_builtins.str]:
+        """
+        SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        """
+        return pulumi.get(self, "agent_socket_path")
+
+    @agent_socket_path.setter
+    def agent_socket_path(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "agent_socket_path", value)
+
+    @_builtins.property
+    @pulumi.getter(name="dialErrorLimit")
+    def dial_error_limit(self) -> Optional[_builtins.int]:
+        """
+        Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        """
+        return pulumi.get(self, "dial_error_limit")
+
+    @dial_error_limit.setter
+    def dial_error_limit(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "dial_error_limit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="hostKey")
+    def host_key(self) -> Optional[_builtins.str]:
+        """
+        The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        """
+        return pulumi.get(self, "host_key")
+
+    @host_key.setter
+    def host_key(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "host_key", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def password(self) -> Optional[_builtins.str]:
+        """
+        The password we should use for the connection to the bastion host.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "password", value)
+
+    @_builtins.property
+    @pulumi.getter(name="perDialTimeout")
+    def per_dial_timeout(self) -> Optional[_builtins.int]:
+        """
+        Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        """
+        return pulumi.get(self, "per_dial_timeout")
+
+    @per_dial_timeout.setter
+    def per_dial_timeout(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "per_dial_timeout", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def port(self) -> Optional[_builtins.float]:
+        """
+        The port of the bastion host to connect to.
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: Optional[_builtins.float]):
+        pulumi.set(self, "port", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateKey")
+    def private_key(self) -> Optional[_builtins.str]:
+        """
+        The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        """
+        return pulumi.get(self, "private_key")
+
+    @private_key.setter
+    def private_key(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "private_key", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateKeyPassword")
+    def private_key_password(self) -> Optional[_builtins.str]:
+        """
+        The password to use in case the private key is encrypted.
+        """
+        return pulumi.get(self, "private_key_password")
+
+    @private_key_password.setter
+    def private_key_password(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "private_key_password", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def user(self) -> Optional[_builtins.str]:
+        """
+        The user that we should use for the connection to the bastion host.
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "user", value)
+
+
+class ProxyConnectionArgsDict(TypedDict):
+    """
+    Instructions for how to connect to a remote endpoint via a bastion host.
+    """
+    host: pulumi.Input[_builtins.str]
+    """
+    The address of the bastion host to connect to.
+    """
+    agent_socket_path: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+    """
+    dial_error_limit: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+    """
+    host_key: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+    """
+    password: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The password we should use for the connection to the bastion host.
+    """
+    per_dial_timeout: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+    """
+    port: NotRequired[pulumi.Input[Optional[_builtins.float]]]
+    """
+    The port of the bastion host to connect to.
+    """
+    private_key: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+    """
+    private_key_password: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The password to use in case the private key is encrypted.
+    """
+    user: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The user that we should use for the connection to the bastion host.
+    """
+
+@pulumi.input_type
+class ProxyConnectionArgs:
+    def __init__(__self__, *,
+                 host: pulumi.Input[_builtins.str],
+                 agent_socket_path: pulumi.Input[Optional[_builtins.str]] = None,
+                 dial_error_limit: pulumi.Input[Optional[_builtins.int]] = None,
+                 host_key: pulumi.Input[Optional[_builtins.str]] = None,
+                 password: pulumi.Input[Optional[_builtins.str]] = None,
+                 per_dial_timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 port: pulumi.Input[Optional[_builtins.float]] = None,
+                 private_key: pulumi.Input[Optional[_builtins.str]] = None,
+                 private_key_password: pulumi.Input[Optional[_builtins.str]] = None,
+                 user: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        Instructions for how to connect to a remote endpoint via a bastion host.
+
+        :param pulumi.Input[_builtins.str] host: The address of the bastion host to connect to.
+        :param pulumi.Input[_builtins.str] agent_socket_path: SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        :param pulumi.Input[_builtins.int] dial_error_limit: Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        :param pulumi.Input[_builtins.str] host_key: The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        :param pulumi.Input[_builtins.str] password: The password we should use for the connection to the bastion host.
+        :param pulumi.Input[_builtins.int] per_dial_timeout: Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        :param pulumi.Input[_builtins.float] port: The port of the bastion host to connect to.
+        :param pulumi.Input[_builtins.str] private_key: The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        :param pulumi.Input[_builtins.str] private_key_password: The password to use in case the private key is encrypted.
+        :param pulumi.Input[_builtins.str] user: The user that we should use for the connection to the bastion host.
+        """
+        pulumi.set(__self__, "host", host)
+        if agent_socket_path is not None:
+            pulumi.set(__self__, "agent_socket_path", agent_socket_path)
+        if dial_error_limit is None:
+            dial_error_limit = 10
+        if dial_error_limit is not None:
+            pulumi.set(__self__, "dial_error_limit", dial_error_limit)
+        if host_key is not None:
+            pulumi.set(__self__, "host_key", host_key)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if per_dial_timeout is None:
+            per_dial_timeout = 15
+        if per_dial_timeout is not None:
+            pulumi.set(__self__, "per_dial_timeout", per_dial_timeout)
+        if port is None:
+            port = 22
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if private_key is not None:
+            pulumi.set(__self__, "private_key", private_key)
+        if private_key_password is not None:
+            pulumi.set(__self__, "private_key_password", private_key_password)
+        if user is None:
+            user = 'root'
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @_builtins.property
+    @pulumi.getter
+    def host(self) -> pulumi.Input[_builtins.str]:
+        """
+        The address of the bastion host to connect to.
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "host", value)
+
+    @_builtins.property
+    @pulumi.getter(name="agentSocketPath")
+    def agent_socket_path(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        """
+        return pulumi.get(self, "agent_socket_path")
+
+    @agent_socket_path.setter
+    def agent_socket_path(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "agent_socket_path", value)
+
+    @_builtins.property
+    @pulumi.getter(name="dialErrorLimit")
+    def dial_error_limit(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        """
+        return pulumi.get(self, "dial_error_limit")
+
+    @dial_error_limit.setter
+    def dial_error_limit(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "dial_error_limit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="hostKey")
+    def host_key(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The expected host key to verify the server's identity. If not provided, the host key will be ignored.
+        """
+        return pulumi.get(self, "host_key")
+
+    @host_key.setter
+    def host_key(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "host_key", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def password(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The password we should use for the connection to the bastion host.
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "password", value)
+
+    @_builtins.property
+    @pulumi.getter(name="perDialTimeout")
+    def per_dial_timeout(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        """
+        return pulumi.get(self, "per_dial_timeout")
+
+    @per_dial_timeout.setter
+    def per_dial_timeout(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "per_dial_timeout", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def port(self) -> pulumi.Input[Optional[_builtins.float]]:
+        """
+        The port of the bastion host to connect to.
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: pulumi.Input[Optional[_builtins.float]]):
+        pulumi.set(self, "port", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateKey")
+    def private_key(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        """
+        return pulumi.get(self, "private_key")
+
+    @private_key.setter
+    def private_key(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "private_key", value)
+
+    @_builtins.property
+    @pulumi.getter(name="privateKeyPassword")
+    def private_key_password(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The password to use in case the private key is encrypted.
+        """
+        return pulumi.get(self, "private_key_password")
+
+    @private_key_password.setter
+    def private_key_password(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "private_key_password", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def user(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The user that we should use for the connection to the bastion host.
+        """
+        return pulumi.get(self, "user")
 
     @user.setter
     def user(self, value: pulumi.Input[Optional[_builtins.str]]):
         pulumi.set(self, "user", value)
 
 
+class PtyArgsDict(TypedDict):
+    """
+    The pseudo-terminal that a command is run in.
+    """
+    cols: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The width of the terminal in characters. Defaults to 80.
+    """
+    rows: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The height of the terminal in characters. Defaults to 24.
+    """
+    strip_ansi: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+    captured stdout. They are always part of the logged output. Defaults to false.
+    """
+    term: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The terminal type, set as TERM. Defaults to `xterm`.
+    """
+
+@pulumi.input_type
+class PtyArgs:
+    def __init__(__self__, *,
+                 cols: pulumi.Input[Optional[_builtins.int]] = None,
+                 rows: pulumi.Input[Optional[_builtins.int]] = None,
+                 strip_ansi: pulumi.Input[Optional[_builtins.bool]] = None,
+                 term: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        The pseudo-terminal that a command is run in.
+
+        :param pulumi.Input[_builtins.int] cols: The width of the terminal in characters. Defaults to 80.
+        :param pulumi.Input[_builtins.int] rows: The height of the terminal in characters. Defaults to 24.
+        :param pulumi.Input[_builtins.bool] strip_ansi: If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+               captured stdout. They are always part of the logged output. Defaults to false.
+        :param pulumi.Input[_builtins.str] term: The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        if cols is not None:
+            pulumi.set(__self__, "cols", cols)
+        if rows is not None:
+            pulumi.set(__self__, "rows", rows)
+        if strip_ansi is not None:
+            pulumi.set(__self__, "strip_ansi", strip_ansi)
+        if term is not None:
+            pulumi.set(__self__, "term", term)
+
+    @_builtins.property
+    @pulumi.getter
+    def cols(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The width of the terminal in characters. Defaults to 80.
+        """
+        return pulumi.get(self, "cols")
+
+    @cols.setter
+    def cols(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "cols", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def rows(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The height of the terminal in characters. Defaults to 24.
+        """
+        return pulumi.get(self, "rows")
+
+    @rows.setter
+    def rows(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "rows", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stripAnsi")
+    def strip_ansi(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        captured stdout. They are always part of the logged output. Defaults to false.
+        """
+        return pulumi.get(self, "strip_ansi")
+
+    @strip_ansi.setter
+    def strip_ansi(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "strip_ansi", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def term(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        return pulumi.get(self, "term")
+
+    @term.setter
+    def term(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "term", value)
+
+
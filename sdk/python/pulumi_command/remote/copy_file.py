@@ -14,6 +14,7 @@ else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
 from . import outputs
+from ._enums import *
 from ._inputs import *
 
 __all__ = ['CopyFileArgs', 'CopyFile']
@@ -24,6 +25,8 @@ class CopyFileArgs:
                  connection: pulumi.Input['ConnectionArgs'],
                  local_path: pulumi.Input[_builtins.str],
                  remote_path: pulumi.Input[_builtins.str],
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
+                 transport: pulumi.Input[Optional['Transport']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None):
         """
         The set of arguments for constructing a CopyFile resource.
@@ -31,11 +34,17 @@ class CopyFileArgs:
         :param pulumi.Input['ConnectionArgs'] connection: The parameters with which to connect to the remote host.
         :param pulumi.Input[_builtins.str] local_path: The path of the file to be copied.
         :param pulumi.Input[_builtins.str] remote_path: The destination path in the remote host.
+        :param pulumi.Input[_builtins.bool] become: Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+        :param pulumi.Input['Transport'] transport: How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
         :param pulumi.Input[Sequence[Any]] triggers: Trigger replacements on changes to this input.
         """
         pulumi.set(__self__, "connection", connection)
         pulumi.set(__self__, "local_path", local_path)
         pulumi.set(__self__, "remote_path", remote_path)
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if transport is not None:
+            pulumi.set(__self__, "transport", transport)
         if triggers is not None:
             pulumi.set(__self__, "triggers", triggers)
 
@@ -75,6 +84,30 @@ class CopyFileArgs:
     def remote_path(self, value: pulumi.Input[_builtins.str]):
         pulumi.set(self, "remote_path", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def become(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "become", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def transport(self) -> pulumi.Input[Optional['Transport']]:
+        """
+        How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+        """
+        return pulumi.get(self, "transport")
+
+    @transport.setter
+    def transport(self, value: pulumi.Input[Optional['Transport']]):
+        pulumi.set(self, "transport", value)
+
     @_builtins.property
     @pulumi.getter
     def triggers(self) -> pulumi.Input[Optional[Sequence[Any]]]:
@@ -99,9 +132,11 @@ class CopyFile(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
                  connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
                  local_path: pulumi.Input[Optional[_builtins.str]] = None,
                  remote_path: pulumi.Input[Optional[_builtins.str]] = None,
+                 transport: pulumi.Input[Optional['Transport']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
                  __props__=None):
         """
@@ -109,9 +144,11 @@ class CopyFile(pulumi.CustomResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.bool] become: Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
         :param pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']] connection: The parameters with which to connect to the remote host.
         :param pulumi.Input[_builtins.str] local_path: The path of the file to be copied.
         :param pulumi.Input[_builtins.str] remote_path: The destination path in the remote host.
+        :param pulumi.Input['Transport'] transport: How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
         :param pulumi.Input[Sequence[Any]] triggers: Trigger replacements on changes to this input.
         """
         ...
@@ -138,9 +175,11 @@ class CopyFile(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
                  connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
                  local_path: pulumi.Input[Optional[_builtins.str]] = None,
                  remote_path: pulumi.Input[Optional[_builtins.str]] = None,
+                 transport: pulumi.Input[Optional['Transport']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
                  __props__=None):
         pulumi.log.warn("""CopyFile is deprecated: This resource is deprecated and will be removed in a future release. Please use the `CopyToRemote` resource instead.""")
@@ -152,6 +191,7 @@ class CopyFile(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = CopyFileArgs.__new__(CopyFileArgs)
 
+            __props__.__dict__["become"] = become
             if connection is None and not opts.urn:
                 raise TypeError("Missing required property 'connection'")
             __props__.__dict__["connection"] = None if connection is None else pulumi.Output.secret(connection)
@@ -161,6 +201,7 @@ class CopyFile(pulumi.CustomResource):
             if remote_path is None and not opts.urn:
                 raise TypeError("Missing required property 'remote_path'")
             __props__.__dict__["remote_path"] = remote_path
+            __props__.__dict__["transport"] = transport
             __props__.__dict__["triggers"] = triggers
         secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connection"])
         opts = pulumi.ResourceOptions.merge(opts, secret_opts)
@@ -186,12 +227,22 @@ class CopyFile(pulumi.CustomResource):
 
         __props__ = CopyFileArgs.__new__(CopyFileArgs)
 
+        __props__.__dict__["become"] = None
         __props__.__dict__["connection"] = None
         __props__.__dict__["local_path"] = None
         __props__.__dict__["remote_path"] = None
+        __props__.__dict__["transport"] = None
         __props__.__dict__["triggers"] = None
         return CopyFile(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Write the file as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. The copied file is owned by root.
+        """
+        return pulumi.get(self, "become")
+
     @_builtins.property
     @pulumi.getter
     def connection(self) -> pulumi.Output['outputs.Connection']:
@@ -216,6 +267,14 @@ class CopyFile(pulumi.CustomResource):
         """
         return pulumi.get(self, "remote_path")
 
+    @_builtins.property
+    @pulumi.getter
+    def transport(self) -> pulumi.Output[Optional['Transport']]:
+        """
+        How the file is transferred to the remote host: `sftp`, `scp`, `tarOverExec` or `auto`, which uses SFTP when available. Defaults to `sftp`.
+        """
+        return pulumi.get(self, "transport")
+
     @_builtins.property
     @pulumi.getter
     def triggers(self) -> pulumi.Output[Optional[Sequence[Any]]]:
@@ -14,6 +14,7 @@ else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
 from . import outputs
+from ._enums import *
 from ._inputs import *
 
 __all__ = ['CopyToRemoteArgs', 'CopyToRemote']
@@ -24,18 +25,56 @@ class CopyToRemoteArgs:
                  connection: pulumi.Input['ConnectionArgs'],
                  remote_path: pulumi.Input[_builtins.str],
                  source: pulumi.Input[Union[pulumi.Asset, pulumi.Archive]],
+                 atomic: pulumi.Input[Optional['AtomicMode']] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
+                 exclude: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 extract: pulumi.Input[Optional[_builtins.bool]] = None,
+                 fsync: pulumi.Input[Optional[_builtins.bool]] = None,
+                 include: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 parallelism: pulumi.Input[Optional[_builtins.int]] = None,
+                 symlinks: pulumi.Input[Optional['SymlinkPolicy']] = None,
+                 transport: pulumi.Input[Optional['Transport']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None):
         """
         The set of arguments for constructing a CopyToRemote resource.
 
         :param pulumi.Input['ConnectionArgs'] connection: The parameters with which to connect to the remote host.
         :param pulumi.Input[_builtins.str] remote_path: The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
-        :param pulumi.Input[Union[pulumi.Asset, pulumi.Archive]] source: An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+        :param pulumi.Input[Union[pulumi.Asset, pulumi.Archive]] source: An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
+        :param pulumi.Input['AtomicMode'] atomic: How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+        :param pulumi.Input[_builtins.bool] become: Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] exclude: A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+        :param pulumi.Input[_builtins.bool] extract: If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+        :param pulumi.Input[_builtins.bool] fsync: If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] include: A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+        :param pulumi.Input[_builtins.int] parallelism: The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+        :param pulumi.Input['SymlinkPolicy'] symlinks: How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+        :param pulumi.Input['Transport'] transport: How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
         :param pulumi.Input[Sequence[Any]] triggers: Trigger replacements on changes to this input.
         """
         pulumi.set(__self__, "connection", connection)
         pulumi.set(__self__, "remote_path", remote_path)
         pulumi.set(__self__, "source", source)
+        if atomic is not None:
+            pulumi.set(__self__, "atomic", atomic)
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if exclude is not None:
+            pulumi.set(__self__, "exclude", exclude)
+        if extract is not None:
+            pulumi.set(__self__, "extract", extract)
+        if fsync is not None:
+            pulumi.set(__self__, "fsync", fsync)
+        if include is not None:
+            pulumi.set(__self__, "include", include)
+        if parallelism is None:
+            parallelism = 1
+        if parallelism is not None:
+            pulumi.set(__self__, "parallelism", parallelism)
+        if symlinks is not None:
+            pulumi.set(__self__, "symlinks", symlinks)
+        if transport is not None:
+            pulumi.set(__self__, "transport", transport)
         if triggers is not None:
             pulumi.set(__self__, "triggers", triggers)
 
@@ -67,7 +106,7 @@ class CopyToRemoteArgs:
     @pulumi.getter
     def source(self) -> pulumi.Input[Union[pulumi.Asset, pulumi.Archive]]:
         """
-        An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+        An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
         """
         return pulumi.get(self, "source")
 
@@ -75,6 +114,114 @@ class CopyToRemoteArgs:
     def source(self, value: pulumi.Input[Union[pulumi.Asset, pulumi.Archive]]):
         pulumi.set(self, "source", value)
 
+    @_builtins.property
+    @pulumi.getter
+    def atomic(self) -> pulumi.Input[Optional['AtomicMode']]:
+        """
+        How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+        """
+        return pulumi.get(self, "atomic")
+
+    @atomic.setter
+    def atomic(self, value: pulumi.Input[Optional['AtomicMode']]):
+        pulumi.set(self, "atomic", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def become(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "become", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def exclude(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+        """
+        return pulumi.get(self, "exclude")
+
+    @exclude.setter
+    def exclude(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "exclude", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def extract(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+        """
+        return pulumi.get(self, "extract")
+
+    @extract.setter
+    def extract(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "extract", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def fsync(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+        """
+        return pulumi.get(self, "fsync")
+
+    @fsync.setter
+    def fsync(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "fsync", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def include(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+        """
+        return pulumi.get(self, "include")
+
+    @include.setter
+    def include(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "include", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def parallelism(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+        """
+        return pulumi.get(self, "parallelism")
+
+    @parallelism.setter
+    def parallelism(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "parallelism", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def symlinks(self) -> pulumi.Input[Optional['SymlinkPolicy']]:
+        """
+        How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+        """
+        return pulumi.get(self, "symlinks")
+
+    @symlinks.setter
+    def symlinks(self, value: pulumi.Input[Optional['SymlinkPolicy']]):
+        pulumi.set(self, "symlinks", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def transport(self) -> pulumi.Input[Optional['Transport']]:
+        """
+        How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+        """
+        return pulumi.get(self, "transport")
+
+    @transport.setter
+    def transport(self, value: pulumi.Input[Optional['Transport']]):
+        pulumi.set(self, "transport", value)
+
     @_builtins.property
     @pulumi.getter
     def triggers(self) -> pulumi.Input[Optional[Sequence[Any]]]:
@@ -94,9 +241,18 @@ class CopyToRemote(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 atomic: pulumi.Input[Optional['AtomicMode']] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
                  connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
+                 exclude: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 extract: pulumi.Input[Optional[_builtins.bool]] = None,
+                 fsync: pulumi.Input[Optional[_builtins.bool]] = None,
+                 include: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 parallelism: pulumi.Input[Optional[_builtins.int]] = None,
                  remote_path: pulumi.Input[Optional[_builtins.str]] = None,
                  source: pulumi.Input[Optional[Union[pulumi.Asset, pulumi.Archive]]] = None,
+                 symlinks: pulumi.Input[Optional['SymlinkPolicy']] = None,
+                 transport: pulumi.Input[Optional['Transport']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
                  __props__=None):
         """
@@ -148,9 +304,18 @@ class CopyToRemote(pulumi.CustomResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['AtomicMode'] atomic: How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+        :param pulumi.Input[_builtins.bool] become: Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
         :param pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']] connection: The parameters with which to connect to the remote host.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] exclude: A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+        :param pulumi.Input[_builtins.bool] extract: If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+        :param pulumi.Input[_builtins.bool] fsync: If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] include: A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+        :param pulumi.Input[_builtins.int] parallelism: The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
         :param pulumi.Input[_builtins.str] remote_path: The destination path on the remote host. Any necessary parent directories will be created automatically. When the remote path is an existing directory, the source file or directory will be copied into that directory. When the source is a file and the remote path is an existing file, that file will be overwritten. When the source is a directory and the remote path an existing file, the copy will fail.
-        :param pulumi.Input[Union[pulumi.Asset, pulumi.Archive]] source: An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+        :param pulumi.Input[Union[pulumi.Asset, pulumi.Archive]] source: An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
+        :param pulumi.Input['SymlinkPolicy'] symlinks: How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+        :param pulumi.Input['Transport'] transport: How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
         :param pulumi.Input[Sequence[Any]] triggers: Trigger replacements on changes to this input.
         """
         ...
@@ -221,9 +386,18 @@ class CopyToRemote(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 atomic: pulumi.Input[Optional['AtomicMode']] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
                  connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
+                 exclude: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 extract: pulumi.Input[Optional[_builtins.bool]] = None,
+                 fsync: pulumi.Input[Optional[_builtins.bool]] = None,
+                 include: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 parallelism: pulumi.Input[Optional[_builtins.int]] = None,
                  remote_path: pulumi.Input[Optional[_builtins.str]] = None,
                  source: pulumi.Input[Optional[Union[pulumi.Asset, pulumi.Archive]]] = None,
+                 symlinks: pulumi.Input[Optional['SymlinkPolicy']] = None,
+                 transport: pulumi.Input[Optional['Transport']] = None,
                  triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -234,16 +408,29 @@ class CopyToRemote(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = CopyToRemoteArgs.__new__(CopyToRemoteArgs)
 
+            __props__.__dict__["atomic"] = atomic
+            __props__.__dict__["become"] = become
             if connection is None and not opts.urn:
                 raise TypeError("Missing required property 'connection'")
             __props__.__dict__["connection"] = None if connection is None else pulumi.Output.secret(connection)
+            __props__.__dict__["exclude"] = exclude
+            __props__.__dict__["extract"] = extract
+            __props__.__dict__["fsync"] = fsync
+            __props__.__dict__["include"] = include
+            if parallelism is None:
+                parallelism = 1
+            __props__.__dict__["parallelism"] = parallelism
             if remote_path is None and not opts.urn:
                 raise TypeError("Missing required property 'remote_path'")
             __props__.__dict__["remote_path"] = remote_path
             if source is None and not opts.urn:
                 raise TypeError("Missing required property 'source'")
             __props__.__dict__["source"] = source
+            __props__.__dict__["symlinks"] = symlinks
+            __props__.__dict__["transport"] = transport
             __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["drift"] = None
+            __props__.__dict__["manifest"] = None
         secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connection"])
         opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["triggers[*]"])
@@ -270,12 +457,39 @@ class CopyToRemote(pulumi.CustomResource):
 
         __props__ = CopyToRemoteArgs.__new__(CopyToRemoteArgs)
 
+        __props__.__dict__["atomic"] = None
+        __props__.__dict__["become"] = None
         __props__.__dict__["connection"] = None
+        __props__.__dict__["drift"] = None
+        __props__.__dict__["exclude"] = None
+        __props__.__dict__["extract"] = None
+        __props__.__dict__["fsync"] = None
+        __props__.__dict__["include"] = None
+        __props__.__dict__["manifest"] = None
+        __props__.__dict__["parallelism"] = None
         __props__.__dict__["remote_path"] = None
         __props__.__dict__["source"] = None
+        __props__.__dict__["symlinks"] = None
+        __props__.__dict__["transport"] = None
         __props__.__dict__["triggers"] = None
         return CopyToRemote(resource_name, opts=opts, __props__=__props__)
 
+    @_builtins.property
+    @pulumi.getter
+    def atomic(self) -> pulumi.Output[Optional['AtomicMode']]:
+        """
+        How to protect readers on the remote host from partially written files. With `file`, every file is written to a temporary file in the same remote directory and renamed into place once complete. With `directory`, a copied directory is staged in full next to the destination, which is then replaced by a symlink to the staged copy. Defaults to `none`.
+        """
+        return pulumi.get(self, "atomic")
+
+    @_builtins.property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Write the files as root via `sudo -n`, for destinations the login user can't write to, such as `/etc`. The user must be allowed to run sudo without a password. With the `sftp` transport, the SFTP server is started via sudo from one of its usual locations, e.g. `/usr/lib/openssh/sftp-server`, rather than as the SSH server's subsystem. The copied files are owned by root.
+        """
+        return pulumi.get(self, "become")
+
     @_builtins.property
     @pulumi.getter
     def connection(self) -> pulumi.Output['outputs.Connection']:
@@ -284,6 +498,62 @@ class CopyToRemote(pulumi.CustomResource):
         """
         return pulumi.get(self, "connection")
 
+    @_builtins.property
+    @pulumi.getter
+    def drift(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The remote files that were found missing or modified on the last refresh. If any, the next update copies the source again.
+        """
+        return pulumi.get(self, "drift")
+
+    @_builtins.property
+    @pulumi.getter
+    def exclude(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        A list of path globs selecting files to skip when the source is a directory or an archive, e.g. `node_modules/**`, `.git/**` or `**.pem`. Applied after `include`, with the same rules.
+        """
+        return pulumi.get(self, "exclude")
+
+    @_builtins.property
+    @pulumi.getter
+    def extract(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the source is a file or remote archive (.tar, .tgz, .tar.gz, .zip or .jar), unpack it into the directory at `remotePath` instead of copying the archive file itself. File modes, directories and symlinks are preserved from the archive. Entries that would be written outside of `remotePath`, including through a symlink from the archive, cause the copy to fail. The targets of symlinks are created as they are and may point outside of `remotePath`. Defaults to false.
+        """
+        return pulumi.get(self, "extract")
+
+    @_builtins.property
+    @pulumi.getter
+    def fsync(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If each remote file should be flushed to disk before it is closed (and, with `atomic`, before it is renamed into place). Requires the SSH server to support the fsync@openssh.com extension.
+        """
+        return pulumi.get(self, "fsync")
+
+    @_builtins.property
+    @pulumi.getter
+    def include(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        A list of path globs selecting the files to copy when the source is a directory or an archive. Defaults to all files. The globs follow the same rules as `local.Command`'s `assetPaths`: paths are relative to the source directory or archive root and use `/` as separator, `*` matches anything except `/`, `**` matches anything including `/`, and globs starting with `!` exclude files again, with later globs taking precedence. When set, directories are only created on the remote host as needed for the selected files. The list of selected files is part of the source hash, so changing the globs copies the source again if it changes which files are selected.
+        """
+        return pulumi.get(self, "include")
+
+    @_builtins.property
+    @pulumi.getter
+    def manifest(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        The SHA256 hash of every file written to the remote host, keyed by remote path. Used to detect changes to the remote files on refresh.
+        """
+        return pulumi.get(self, "manifest")
+
+    @_builtins.property
+    @pulumi.getter
+    def parallelism(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The maximum number of files to upload concurrently when copying a directory. Directories are always created before any files are written into them. Higher values can considerably speed up copies to hosts with high latency. Must be at least 1. Defaults to 1.
+        """
+        return pulumi.get(self, "parallelism")
+
     @_builtins.property
     @pulumi.getter(name="remotePath")
     def remote_path(self) -> pulumi.Output[_builtins.str]:
@@ -296,10 +566,26 @@ class CopyToRemote(pulumi.CustomResource):
     @pulumi.getter
     def source(self) -> pulumi.Output[Union[pulumi.Asset, pulumi.Archive]]:
         """
-        An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked. Directories are copied recursively, overwriting existing files.
+        An [asset or an archive](https://www.pulumi.com/docs/concepts/assets-archives/) to upload as the source of the copy. The item will be copied as-is; archives like .tgz will not be unpacked unless `extract` is set. Directories are copied recursively, overwriting existing files.
         """
         return pulumi.get(self, "source")
 
+    @_builtins.property
+    @pulumi.getter
+    def symlinks(self) -> pulumi.Output[Optional['SymlinkPolicy']]:
+        """
+        How symlinks are handled when copying a local directory. With `follow`, symlinks are copied as the files or directories they point to, and a symlink pointing to one of its parent directories is reported as an error. With `preserve`, symlinks are recreated as symlinks with the same target on the remote host. With `skip`, symlinks aren't copied. Symlinks in extracted archives are always preserved. If not set, symlinks are copied like files, as before this option existed: a symlink to a file is copied as the file it points to, while a symlink to a directory fails the copy.
+        """
+        return pulumi.get(self, "symlinks")
+
+    @_builtins.property
+    @pulumi.getter
+    def transport(self) -> pulumi.Output[Optional['Transport']]:
+        """
+        How files are transferred to the remote host. `sftp` uses the SFTP subsystem and supports all options. For hosts with the SFTP subsystem disabled, `tarOverExec` streams the files to `tar` on the remote host and `scp` uses the SCP protocol, which can't create symlinks. Both transfer all files in a single stream, which is often faster for large directories, but don't support `atomic` copies, and `parallelism` is ignored. Files copied with them aren't recorded in the `manifest`, so their drift isn't detected. `auto` uses SFTP when available, then `tarOverExec` if `tar` is installed, and `scp` otherwise. Defaults to `sftp`.
+        """
+        return pulumi.get(self, "transport")
+
     @_builtins.property
     @pulumi.getter
     def triggers(self) -> pulumi.Output[Optional[Sequence[Any]]]:
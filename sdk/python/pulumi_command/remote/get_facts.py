@@ -0,0 +1,260 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from ._inputs import *
+
+__all__ = [
+    'GetFactsResult',
+    'AwaitableGetFactsResult',
+    'get_facts',
+    'get_facts_output',
+]
+
+@pulumi.output_type
+class GetFactsResult:
+    def __init__(__self__, arch=None, cpu_count=None, distro=None, distro_version=None, errors=None, hostname=None, init_system=None, ip_addresses=None, kernel=None, memory_mb=None, os=None, os_family=None, passwordless_sudo=None, shell=None):
+        if arch and not isinstance(arch, str):
+            raise TypeError("Expected argument 'arch' to be a str")
+        pulumi.set(__self__, "arch", arch)
+        if cpu_count and not isinstance(cpu_count, int):
+            raise TypeError("Expected argument 'cpu_count' to be a int")
+        pulumi.set(__self__, "cpu_count", cpu_count)
+        if distro and not isinstance(distro, str):
+            raise TypeError("Expected argument 'distro' to be a str")
+        pulumi.set(__self__, "distro", distro)
+        if distro_version and not isinstance(distro_version, str):
+            raise TypeError("Expected argument 'distro_version' to be a str")
+        pulumi.set(__self__, "distro_version", distro_version)
+        if errors and not isinstance(errors, dict):
+            raise TypeError("Expected argument 'errors' to be a dict")
+        pulumi.set(__self__, "errors", errors)
+        if hostname and not isinstance(hostname, str):
+            raise TypeError("Expected argument 'hostname' to be a str")
+        pulumi.set(__self__, "hostname", hostname)
+        if init_system and not isinstance(init_system, str):
+            raise TypeError("Expected argument 'init_system' to be a str")
+        pulumi.set(__self__, "init_system", init_system)
+        if ip_addresses and not isinstance(ip_addresses, list):
+            raise TypeError("Expected argument 'ip_addresses' to be a list")
+        pulumi.set(__self__, "ip_addresses", ip_addresses)
+        if kernel and not isinstance(kernel, str):
+            raise TypeError("Expected argument 'kernel' to be a str")
+        pulumi.set(__self__, "kernel", kernel)
+        if memory_mb and not isinstance(memory_mb, int):
+            raise TypeError("Expected argument 'memory_mb' to be a int")
+        pulumi.set(__self__, "memory_mb", memory_mb)
+        if os and not isinstance(os, str):
+            raise TypeError("Expected argument 'os' to be a str")
+        pulumi.set(__self__, "os", os)
+        if os_family and not isinstance(os_family, str):
+            raise TypeError("Expected argument 'os_family' to be a str")
+        pulumi.set(__self__, "os_family", os_family)
+        if passwordless_sudo and not isinstance(passwordless_sudo, bool):
+            raise TypeError("Expected argument 'passwordless_sudo' to be a bool")
+        pulumi.set(__self__, "passwordless_sudo", passwordless_sudo)
+        if shell and not isinstance(shell, str):
+            raise TypeError("Expected argument 'shell' to be a str")
+        pulumi.set(__self__, "shell", shell)
+
+    @_builtins.property
+    @pulumi.getter
+    def arch(self) -> Optional[_builtins.str]:
+        """
+        The machine architecture as reported by `uname -m`, e.g. `x86_64` or `aarch64`.
+        """
+        return pulumi.get(self, "arch")
+
+    @_builtins.property
+    @pulumi.getter(name="cpuCount")
+    def cpu_count(self) -> Optional[_builtins.int]:
+        """
+        The number of online CPUs.
+        """
+        return pulumi.get(self, "cpu_count")
+
+    @_builtins.property
+    @pulumi.getter
+    def distro(self) -> Optional[_builtins.str]:
+        """
+        The distribution, e.g. `ubuntu`, which is `ID` in `/etc/os-release`, or `macos`.
+        """
+        return pulumi.get(self, "distro")
+
+    @_builtins.property
+    @pulumi.getter(name="distroVersion")
+    def distro_version(self) -> Optional[_builtins.str]:
+        """
+        The version of the distribution, e.g. `24.04`.
+        """
+        return pulumi.get(self, "distro_version")
+
+    @_builtins.property
+    @pulumi.getter
+    def errors(self) -> Mapping[str, _builtins.str]:
+        """
+        The errors of the probes that failed, keyed by the name of the probe: `uname` for `os`, `kernel` and `arch`, `osRelease` for `osFamily`, `distro` and `distroVersion`, and otherwise the name of the fact.
+        """
+        return pulumi.get(self, "errors")
+
+    @_builtins.property
+    @pulumi.getter
+    def hostname(self) -> Optional[_builtins.str]:
+        """
+        The host name of the host.
+        """
+        return pulumi.get(self, "hostname")
+
+    @_builtins.property
+    @pulumi.getter(name="initSystem")
+    def init_system(self) -> Optional[_builtins.str]:
+        """
+        The init system, `systemd`, `openrc`, `launchd` or `sysvinit`.
+        """
+        return pulumi.get(self, "init_system")
+
+    @_builtins.property
+    @pulumi.getter(name="ipAddresses")
+    def ip_addresses(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The IP addresses of the host, without loopback and link-local addresses.
+        """
+        return pulumi.get(self, "ip_addresses")
+
+    @_builtins.property
+    @pulumi.getter
+    def kernel(self) -> Optional[_builtins.str]:
+        """
+        The kernel release as reported by `uname -r`.
+        """
+        return pulumi.get(self, "kernel")
+
+    @_builtins.property
+    @pulumi.getter(name="memoryMb")
+    def memory_mb(self) -> Optional[_builtins.int]:
+        """
+        The total memory in MiB.
+        """
+        return pulumi.get(self, "memory_mb")
+
+    @_builtins.property
+    @pulumi.getter
+    def os(self) -> Optional[_builtins.str]:
+        """
+        The operating system as reported by `uname -s` in lower case, e.g. `linux` or `darwin`.
+        """
+        return pulumi.get(self, "os")
+
+    @_builtins.property
+    @pulumi.getter(name="osFamily")
+    def os_family(self) -> Optional[_builtins.str]:
+        """
+        The family of the distribution, e.g. `debian` or `rhel`, which is the first entry of `ID_LIKE` in `/etc/os-release`, or `ID` if it's not set. Outside of Linux, the same as `os`.
+        """
+        return pulumi.get(self, "os_family")
+
+    @_builtins.property
+    @pulumi.getter(name="passwordlessSudo")
+    def passwordless_sudo(self) -> Optional[_builtins.bool]:
+        """
+        If the user can run commands with sudo without a password.
+        """
+        return pulumi.get(self, "passwordless_sudo")
+
+    @_builtins.property
+    @pulumi.getter
+    def shell(self) -> Optional[_builtins.str]:
+        """
+        The login shell of the user.
+        """
+        return pulumi.get(self, "shell")
+
+
+class AwaitableGetFactsResult(GetFactsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetFactsResult(
+            arch=self.arch,
+            cpu_count=self.cpu_count,
+            distro=self.distro,
+            distro_version=self.distro_version,
+            errors=self.errors,
+            hostname=self.hostname,
+            init_system=self.init_system,
+            ip_addresses=self.ip_addresses,
+            kernel=self.kernel,
+            memory_mb=self.memory_mb,
+            os=self.os,
+            os_family=self.os_family,
+            passwordless_sudo=self.passwordless_sudo,
+            shell=self.shell)
+
+
+def get_facts(connection: Optional[Union['Connection', 'ConnectionDict']] = None,
+              opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetFactsResult:
+    """
+    Gathers facts about a remote host, like its operating system, architecture and resources.
+    Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+
+    :param Union['Connection', 'ConnectionDict'] connection: The parameters with which to connect to the remote host.
+    """
+    __args__ = dict()
+    __args__['connection'] = connection
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('command:remote:getFacts', __args__, opts=opts, typ=GetFactsResult).value
+
+    return AwaitableGetFactsResult(
+        arch=pulumi.get(__ret__, 'arch'),
+        cpu_count=pulumi.get(__ret__, 'cpu_count'),
+        distro=pulumi.get(__ret__, 'distro'),
+        distro_version=pulumi.get(__ret__, 'distro_version'),
+        errors=pulumi.get(__ret__, 'errors'),
+        hostname=pulumi.get(__ret__, 'hostname'),
+        init_system=pulumi.get(__ret__, 'init_system'),
+        ip_addresses=pulumi.get(__ret__, 'ip_addresses'),
+        kernel=pulumi.get(__ret__, 'kernel'),
+        memory_mb=pulumi.get(__ret__, 'memory_mb'),
+        os=pulumi.get(__ret__, 'os'),
+        os_family=pulumi.get(__ret__, 'os_family'),
+        passwordless_sudo=pulumi.get(__ret__, 'passwordless_sudo'),
+        shell=pulumi.get(__ret__, 'shell'))
+def get_facts_output(connection: pulumi.Input[Optional[Union['Connection', 'ConnectionDict']]] = None,
+                     opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetFactsResult]:
+    """
+    Gathers facts about a remote host, like its operating system, architecture and resources.
+    Each fact is gathered by a separate probe using a POSIX shell on the host. Facts whose probe failed are unset, and the reason is in `errors`, so that a partial result is returned rather than an error.
+
+    :param Union['Connection', 'ConnectionDict'] connection: The parameters with which to connect to the remote host.
+    """
+    __args__ = dict()
+    __args__['connection'] = connection
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('command:remote:getFacts', __args__, opts=opts, typ=GetFactsResult)
+    return __ret__.apply(lambda __response__: GetFactsResult(
+        arch=pulumi.get(__response__, 'arch'),
+        cpu_count=pulumi.get(__response__, 'cpu_count'),
+        distro=pulumi.get(__response__, 'distro'),
+        distro_version=pulumi.get(__response__, 'distro_version'),
+        errors=pulumi.get(__response__, 'errors'),
+        hostname=pulumi.get(__response__, 'hostname'),
+        init_system=pulumi.get(__response__, 'init_system'),
+        ip_addresses=pulumi.get(__response__, 'ip_addresses'),
+        kernel=pulumi.get(__response__, 'kernel'),
+        memory_mb=pulumi.get(__response__, 'memory_mb'),
+        os=pulumi.get(__response__, 'os'),
+        os_family=pulumi.get(__response__, 'os_family'),
+        passwordless_sudo=pulumi.get(__response__, 'passwordless_sudo'),
+        shell=pulumi.get(__response__, 'shell')))
@@ -0,0 +1,79 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetHostKeysResult',
+    'AwaitableGetHostKeysResult',
+    'get_host_keys',
+    'get_host_keys_output',
+]
+
+@pulumi.output_type
+class GetHostKeysResult:
+    def __init__(__self__, keys=None):
+        if keys and not isinstance(keys, list):
+            raise TypeError("Expected argument 'keys' to be a list")
+        pulumi.set(__self__, "keys", keys)
+
+    @_builtins.property
+    @pulumi.getter
+    def keys(self) -> Sequence['outputs.HostKey']:
+        """
+        The host keys that the host offers, one for each type.
+        """
+        return pulumi.get(self, "keys")
+
+
+class AwaitableGetHostKeysResult(GetHostKeysResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetHostKeysResult(
+            keys=self.keys)
+
+
+def get_host_keys(connection: Optional[Union['Connection', 'ConnectionDict']] = None,
+                  opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetHostKeysResult:
+    """
+    Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+    It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+
+    :param Union['Connection', 'ConnectionDict'] connection: The parameters with which to connect to the remote host.
+    """
+    __args__ = dict()
+    __args__['connection'] = connection
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('command:remote:getHostKeys', __args__, opts=opts, typ=GetHostKeysResult).value
+
+    return AwaitableGetHostKeysResult(
+        keys=pulumi.get(__ret__, 'keys'))
+def get_host_keys_output(connection: pulumi.Input[Optional[Union['Connection', 'ConnectionDict']]] = None,
+                         opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetHostKeysResult]:
+    """
+    Gets the host keys of a remote host, like `ssh-keyscan`, to pin them with the `hostKey` of a connection.
+    It only performs SSH handshakes, one for each type of key, so no credentials for the host are needed, and the `hostKey` of the connection is ignored. The proxy of the connection is used if set.
+
+    :param Union['Connection', 'ConnectionDict'] connection: The parameters with which to connect to the remote host.
+    """
+    __args__ = dict()
+    __args__['connection'] = connection
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('command:remote:getHostKeys', __args__, opts=opts, typ=GetHostKeysResult)
+    return __ret__.apply(lambda __response__: GetHostKeysResult(
+        keys=pulumi.get(__response__, 'keys')))
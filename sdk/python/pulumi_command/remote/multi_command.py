@@ -0,0 +1,1381 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['MultiCommandArgs', 'MultiCommand']
+
+@pulumi.input_type
+class MultiCommandArgs:
+    def __init__(__self__, *,
+                 add_previous_output_in_env: pulumi.Input[Optional[_builtins.bool]] = None,
+                 batch_size: pulumi.Input[Optional[_builtins.int]] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
+                 become_method: pulumi.Input[Optional['BecomeMethod']] = None,
+                 become_password: pulumi.Input[Optional[_builtins.str]] = None,
+                 become_user: pulumi.Input[Optional[_builtins.str]] = None,
+                 connection: pulumi.Input[Optional['ConnectionTemplateArgs']] = None,
+                 connections: pulumi.Input[Optional[Sequence[pulumi.Input['ConnectionArgs']]]] = None,
+                 create: pulumi.Input[Optional[_builtins.str]] = None,
+                 delete: pulumi.Input[Optional[_builtins.str]] = None,
+                 detached: pulumi.Input[Optional['DetachedArgs']] = None,
+                 dir: pulumi.Input[Optional[_builtins.str]] = None,
+                 environment: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 environment_mode: pulumi.Input[Optional['EnvironmentMode']] = None,
+                 error_output_lines: pulumi.Input[Optional[_builtins.int]] = None,
+                 heartbeat_interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 hosts: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 interpreter: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 log_stream_prefix: pulumi.Input[Optional[_builtins.bool]] = None,
+                 logging: pulumi.Input[Optional['Logging']] = None,
+                 login_shell: pulumi.Input[Optional[_builtins.bool]] = None,
+                 max_failures: pulumi.Input[Optional[_builtins.int]] = None,
+                 pty: pulumi.Input[Optional['PtyArgs']] = None,
+                 redact: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 redact_outputs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 stderr_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 stdin: pulumi.Input[Optional[_builtins.str]] = None,
+                 stdout_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 strip_workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
+                 update: pulumi.Input[Optional[_builtins.str]] = None,
+                 workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a MultiCommand resource.
+
+        :param pulumi.Input[_builtins.bool] add_previous_output_in_env: If the previous command's stdout and stderr (as generated by the prior create/update) is
+               injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+               Defaults to true.
+        :param pulumi.Input[_builtins.int] batch_size: The number of hosts the command is run on at the same time. The next batch of
+               hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+        :param pulumi.Input[_builtins.bool] become: Run the command as another user, root by default, via the escalation tool set in
+               'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+               the quoting of the command intact and passes on the environment variables.
+        :param pulumi.Input['BecomeMethod'] become_method: The escalation tool to use with `become`. Defaults to `sudo`.
+        :param pulumi.Input[_builtins.str] become_password: The password to answer the prompt of the escalation tool with. Without a
+               password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+               the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+               password is never part of the command's output.
+        :param pulumi.Input[_builtins.str] become_user: The user to run the command as with `become`. Defaults to `root`.
+        :param pulumi.Input['ConnectionTemplateArgs'] connection: The connection settings shared by the hosts in 'hosts'.
+        :param pulumi.Input[Sequence[pulumi.Input['ConnectionArgs']]] connections: The connections to the hosts to run the command on. Each host may only be
+               listed once. Either 'connections' or 'hosts' must be set.
+        :param pulumi.Input[_builtins.str] create: The command to run once on resource creation.
+               
+               If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+               
+               Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+               
+               Use `local.runOutput` if you need to run a command on every execution of your program.
+        :param pulumi.Input[_builtins.str] delete: The command to run on resource deletion.
+               
+               The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+        :param pulumi.Input['DetachedArgs'] detached: Run the command as a detached job that survives connection drops, e.g. for
+               jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+               to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+               the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+               polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+               provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+               rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+               Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        :param pulumi.Input[_builtins.str] dir: The directory on the remote host to run the command in. A leading '~' is the home
+               directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+               on the remote host.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] environment: Additional environment variables available to the command's process.
+               How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+               exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+        :param pulumi.Input['EnvironmentMode'] environment_mode: How the environment variables, including PULUMI_COMMAND_STDOUT and
+               PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+               the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+               them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+               'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        :param pulumi.Input[_builtins.int] error_output_lines: The number of lines at the end of stdout and stderr that the error of a
+               failed command includes. Defaults to 20.
+        :param pulumi.Input[_builtins.int] heartbeat_interval: The number of seconds without output after which the status of the
+               resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+               line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+               Defaults to 60.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] hosts: The addresses of the hosts to run the command on, which are connected to with the
+               settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+               which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+               must be set.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] interpreter: The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+               The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        :param pulumi.Input[_builtins.bool] log_stream_prefix: If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        :param pulumi.Input['Logging'] logging: If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+               stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+               outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        :param pulumi.Input[_builtins.bool] login_shell: Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+               ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        :param pulumi.Input[_builtins.int] max_failures: The number of hosts the command may fail on. Once more hosts failed, no further
+               batches are started and the resource fails. Defaults to 0.
+        :param pulumi.Input['PtyArgs'] pty: Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+               terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+               the terminal, without echo, followed by the end of input.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] redact: Additional values to mask as '[secret]' in the logged output and the error of
+               the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+               environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+               Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        :param pulumi.Input[_builtins.bool] redact_outputs: If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+               to false, in which case outputs that might contain secrets can be marked as secret via
+               'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        :param pulumi.Input['LogSeverity'] stderr_severity: The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        :param pulumi.Input[_builtins.str] stdin: Pass a string to the command's process as standard in
+        :param pulumi.Input['LogSeverity'] stdout_severity: The severity with which the lines of stdout are logged. Defaults to `info`.
+        :param pulumi.Input[_builtins.bool] strip_workflow_commands: If the workflow commands are removed from the 'stdout' output. Defaults
+               to false.
+        :param pulumi.Input[_builtins.int] timeout: The maximum number of seconds the command may run. When it elapses, or the
+               deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+               doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+               which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+               Defaults to no timeout.
+        :param pulumi.Input[Sequence[Any]] triggers: The resource will be updated (or replaced) if any of these values change.
+               
+               The trigger values can be of any type.
+               
+               If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+               
+               Please see the resource documentation for examples.
+        :param pulumi.Input[_builtins.str] update: The command to run when the resource is updated.
+               
+               If empty, the create command will be executed instead.
+               
+               Note that this command will not run if the resource's inputs are unchanged.
+               
+               Use `local.runOutput` if you need to run a command on every execution of your program.
+               
+               The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        :param pulumi.Input[_builtins.bool] workflow_commands: Run the workflow commands among the lines of stdout, modeled on those of
+               GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+               message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+               the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        if add_previous_output_in_env is not None:
+            pulumi.set(__self__, "add_previous_output_in_env", add_previous_output_in_env)
+        if batch_size is not None:
+            pulumi.set(__self__, "batch_size", batch_size)
+        if become is not None:
+            pulumi.set(__self__, "become", become)
+        if become_method is not None:
+            pulumi.set(__self__, "become_method", become_method)
+        if become_password is not None:
+            pulumi.set(__self__, "become_password", become_password)
+        if become_user is not None:
+            pulumi.set(__self__, "become_user", become_user)
+        if connection is not None:
+            pulumi.set(__self__, "connection", connection)
+        if connections is not None:
+            pulumi.set(__self__, "connections", connections)
+        if create is not None:
+            pulumi.set(__self__, "create", create)
+        if delete is not None:
+            pulumi.set(__self__, "delete", delete)
+        if detached is not None:
+            pulumi.set(__self__, "detached", detached)
+        if dir is not None:
+            pulumi.set(__self__, "dir", dir)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if environment_mode is not None:
+            pulumi.set(__self__, "environment_mode", environment_mode)
+        if error_output_lines is not None:
+            pulumi.set(__self__, "error_output_lines", error_output_lines)
+        if heartbeat_interval is not None:
+            pulumi.set(__self__, "heartbeat_interval", heartbeat_interval)
+        if hosts is not None:
+            pulumi.set(__self__, "hosts", hosts)
+        if interpreter is not None:
+            pulumi.set(__self__, "interpreter", interpreter)
+        if log_stream_prefix is not None:
+            pulumi.set(__self__, "log_stream_prefix", log_stream_prefix)
+        if logging is not None:
+            pulumi.set(__self__, "logging", logging)
+        if login_shell is not None:
+            pulumi.set(__self__, "login_shell", login_shell)
+        if max_failures is not None:
+            pulumi.set(__self__, "max_failures", max_failures)
+        if pty is not None:
+            pulumi.set(__self__, "pty", pty)
+        if redact is not None:
+            pulumi.set(__self__, "redact", redact)
+        if redact_outputs is not None:
+            pulumi.set(__self__, "redact_outputs", redact_outputs)
+        if stderr_severity is not None:
+            pulumi.set(__self__, "stderr_severity", stderr_severity)
+        if stdin is not None:
+            pulumi.set(__self__, "stdin", stdin)
+        if stdout_severity is not None:
+            pulumi.set(__self__, "stdout_severity", stdout_severity)
+        if strip_workflow_commands is not None:
+            pulumi.set(__self__, "strip_workflow_commands", strip_workflow_commands)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+        if update is not None:
+            pulumi.set(__self__, "update", update)
+        if workflow_commands is not None:
+            pulumi.set(__self__, "workflow_commands", workflow_commands)
+
+    @_builtins.property
+    @pulumi.getter(name="addPreviousOutputInEnv")
+    def add_previous_output_in_env(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If the previous command's stdout and stderr (as generated by the prior create/update) is
+        injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+        Defaults to true.
+        """
+        return pulumi.get(self, "add_previous_output_in_env")
+
+    @add_previous_output_in_env.setter
+    def add_previous_output_in_env(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "add_previous_output_in_env", value)
+
+    @_builtins.property
+    @pulumi.getter(name="batchSize")
+    def batch_size(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of hosts the command is run on at the same time. The next batch of
+        hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+        """
+        return pulumi.get(self, "batch_size")
+
+    @batch_size.setter
+    def batch_size(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "batch_size", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def become(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Run the command as another user, root by default, via the escalation tool set in
+        'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+        the quoting of the command intact and passes on the environment variables.
+        """
+        return pulumi.get(self, "become")
+
+    @become.setter
+    def become(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "become", value)
+
+    @_builtins.property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> pulumi.Input[Optional['BecomeMethod']]:
+        """
+        The escalation tool to use with `become`. Defaults to `sudo`.
+        """
+        return pulumi.get(self, "become_method")
+
+    @become_method.setter
+    def become_method(self, value: pulumi.Input[Optional['BecomeMethod']]):
+        pulumi.set(self, "become_method", value)
+
+    @_builtins.property
+    @pulumi.getter(name="becomePassword")
+    def become_password(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The password to answer the prompt of the escalation tool with. Without a
+        password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+        the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+        password is never part of the command's output.
+        """
+        return pulumi.get(self, "become_password")
+
+    @become_password.setter
+    def become_password(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "become_password", value)
+
+    @_builtins.property
+    @pulumi.getter(name="becomeUser")
+    def become_user(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The user to run the command as with `become`. Defaults to `root`.
+        """
+        return pulumi.get(self, "become_user")
+
+    @become_user.setter
+    def become_user(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "become_user", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def connection(self) -> pulumi.Input[Optional['ConnectionTemplateArgs']]:
+        """
+        The connection settings shared by the hosts in 'hosts'.
+        """
+        return pulumi.get(self, "connection")
+
+    @connection.setter
+    def connection(self, value: pulumi.Input[Optional['ConnectionTemplateArgs']]):
+        pulumi.set(self, "connection", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def connections(self) -> pulumi.Input[Optional[Sequence[pulumi.Input['ConnectionArgs']]]]:
+        """
+        The connections to the hosts to run the command on. Each host may only be
+        listed once. Either 'connections' or 'hosts' must be set.
+        """
+        return pulumi.get(self, "connections")
+
+    @connections.setter
+    def connections(self, value: pulumi.Input[Optional[Sequence[pulumi.Input['ConnectionArgs']]]]):
+        pulumi.set(self, "connections", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def create(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The command to run once on resource creation.
+
+        If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+
+        Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+
+        Use `local.runOutput` if you need to run a command on every execution of your program.
+        """
+        return pulumi.get(self, "create")
+
+    @create.setter
+    def create(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "create", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def delete(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The command to run on resource deletion.
+
+        The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+        """
+        return pulumi.get(self, "delete")
+
+    @delete.setter
+    def delete(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "delete", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def detached(self) -> pulumi.Input[Optional['DetachedArgs']]:
+        """
+        Run the command as a detached job that survives connection drops, e.g. for
+        jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+        to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+        the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+        polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+        provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+        rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+        Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        """
+        return pulumi.get(self, "detached")
+
+    @detached.setter
+    def detached(self, value: pulumi.Input[Optional['DetachedArgs']]):
+        pulumi.set(self, "detached", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def dir(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The directory on the remote host to run the command in. A leading '~' is the home
+        directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+        on the remote host.
+        """
+        return pulumi.get(self, "dir")
+
+    @dir.setter
+    def dir(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "dir", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def environment(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Additional environment variables available to the command's process.
+        How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+        exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "environment", value)
+
+    @_builtins.property
+    @pulumi.getter(name="environmentMode")
+    def environment_mode(self) -> pulumi.Input[Optional['EnvironmentMode']]:
+        """
+        How the environment variables, including PULUMI_COMMAND_STDOUT and
+        PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+        the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+        them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+        'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        """
+        return pulumi.get(self, "environment_mode")
+
+    @environment_mode.setter
+    def environment_mode(self, value: pulumi.Input[Optional['EnvironmentMode']]):
+        pulumi.set(self, "environment_mode", value)
+
+    @_builtins.property
+    @pulumi.getter(name="errorOutputLines")
+    def error_output_lines(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of lines at the end of stdout and stderr that the error of a
+        failed command includes. Defaults to 20.
+        """
+        return pulumi.get(self, "error_output_lines")
+
+    @error_output_lines.setter
+    def error_output_lines(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "error_output_lines", value)
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatInterval")
+    def heartbeat_interval(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of seconds without output after which the status of the
+        resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        Defaults to 60.
+        """
+        return pulumi.get(self, "heartbeat_interval")
+
+    @heartbeat_interval.setter
+    def heartbeat_interval(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "heartbeat_interval", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def hosts(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The addresses of the hosts to run the command on, which are connected to with the
+        settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+        which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+        must be set.
+        """
+        return pulumi.get(self, "hosts")
+
+    @hosts.setter
+    def hosts(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "hosts", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def interpreter(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+        The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        """
+        return pulumi.get(self, "interpreter")
+
+    @interpreter.setter
+    def interpreter(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "interpreter", value)
+
+    @_builtins.property
+    @pulumi.getter(name="logStreamPrefix")
+    def log_stream_prefix(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        """
+        return pulumi.get(self, "log_stream_prefix")
+
+    @log_stream_prefix.setter
+    def log_stream_prefix(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "log_stream_prefix", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def logging(self) -> pulumi.Input[Optional['Logging']]:
+        """
+        If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+        stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+        outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        """
+        return pulumi.get(self, "logging")
+
+    @logging.setter
+    def logging(self, value: pulumi.Input[Optional['Logging']]):
+        pulumi.set(self, "logging", value)
+
+    @_builtins.property
+    @pulumi.getter(name="loginShell")
+    def login_shell(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+        ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        """
+        return pulumi.get(self, "login_shell")
+
+    @login_shell.setter
+    def login_shell(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "login_shell", value)
+
+    @_builtins.property
+    @pulumi.getter(name="maxFailures")
+    def max_failures(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of hosts the command may fail on. Once more hosts failed, no further
+        batches are started and the resource fails. Defaults to 0.
+        """
+        return pulumi.get(self, "max_failures")
+
+    @max_failures.setter
+    def max_failures(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "max_failures", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def pty(self) -> pulumi.Input[Optional['PtyArgs']]:
+        """
+        Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        the terminal, without echo, followed by the end of input.
+        """
+        return pulumi.get(self, "pty")
+
+    @pty.setter
+    def pty(self, value: pulumi.Input[Optional['PtyArgs']]):
+        pulumi.set(self, "pty", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def redact(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Additional values to mask as '[secret]' in the logged output and the error of
+        the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+        Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        """
+        return pulumi.get(self, "redact")
+
+    @redact.setter
+    def redact(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "redact", value)
+
+    @_builtins.property
+    @pulumi.getter(name="redactOutputs")
+    def redact_outputs(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        to false, in which case outputs that might contain secrets can be marked as secret via
+        'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        """
+        return pulumi.get(self, "redact_outputs")
+
+    @redact_outputs.setter
+    def redact_outputs(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "redact_outputs", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stderrSeverity")
+    def stderr_severity(self) -> pulumi.Input[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        """
+        return pulumi.get(self, "stderr_severity")
+
+    @stderr_severity.setter
+    def stderr_severity(self, value: pulumi.Input[Optional['LogSeverity']]):
+        pulumi.set(self, "stderr_severity", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def stdin(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        Pass a string to the command's process as standard in
+        """
+        return pulumi.get(self, "stdin")
+
+    @stdin.setter
+    def stdin(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "stdin", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stdoutSeverity")
+    def stdout_severity(self) -> pulumi.Input[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stdout are logged. Defaults to `info`.
+        """
+        return pulumi.get(self, "stdout_severity")
+
+    @stdout_severity.setter
+    def stdout_severity(self, value: pulumi.Input[Optional['LogSeverity']]):
+        pulumi.set(self, "stdout_severity", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stripWorkflowCommands")
+    def strip_workflow_commands(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        If the workflow commands are removed from the 'stdout' output. Defaults
+        to false.
+        """
+        return pulumi.get(self, "strip_workflow_commands")
+
+    @strip_workflow_commands.setter
+    def strip_workflow_commands(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "strip_workflow_commands", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The maximum number of seconds the command may run. When it elapses, or the
+        deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+        doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+        which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+        Defaults to no timeout.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "timeout", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Input[Optional[Sequence[Any]]]:
+        """
+        The resource will be updated (or replaced) if any of these values change.
+
+        The trigger values can be of any type.
+
+        If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+
+        Please see the resource documentation for examples.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: pulumi.Input[Optional[Sequence[Any]]]):
+        pulumi.set(self, "triggers", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def update(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The command to run when the resource is updated.
+
+        If empty, the create command will be executed instead.
+
+        Note that this command will not run if the resource's inputs are unchanged.
+
+        Use `local.runOutput` if you need to run a command on every execution of your program.
+
+        The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        """
+        return pulumi.get(self, "update")
+
+    @update.setter
+    def update(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "update", value)
+
+    @_builtins.property
+    @pulumi.getter(name="workflowCommands")
+    def workflow_commands(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Run the workflow commands among the lines of stdout, modeled on those of
+        GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        return pulumi.get(self, "workflow_commands")
+
+    @workflow_commands.setter
+    def workflow_commands(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "workflow_commands", value)
+
+
+@pulumi.type_token("command:remote:MultiCommand")
+class MultiCommand(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 add_previous_output_in_env: pulumi.Input[Optional[_builtins.bool]] = None,
+                 batch_size: pulumi.Input[Optional[_builtins.int]] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
+                 become_method: pulumi.Input[Optional['BecomeMethod']] = None,
+                 become_password: pulumi.Input[Optional[_builtins.str]] = None,
+                 become_user: pulumi.Input[Optional[_builtins.str]] = None,
+                 connection: pulumi.Input[Optional[Union['ConnectionTemplateArgs', 'ConnectionTemplateArgsDict']]] = None,
+                 connections: pulumi.Input[Optional[Sequence[pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']]]]] = None,
+                 create: pulumi.Input[Optional[_builtins.str]] = None,
+                 delete: pulumi.Input[Optional[_builtins.str]] = None,
+                 detached: pulumi.Input[Optional[Union['DetachedArgs', 'DetachedArgsDict']]] = None,
+                 dir: pulumi.Input[Optional[_builtins.str]] = None,
+                 environment: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 environment_mode: pulumi.Input[Optional['EnvironmentMode']] = None,
+                 error_output_lines: pulumi.Input[Optional[_builtins.int]] = None,
+                 heartbeat_interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 hosts: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 interpreter: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 log_stream_prefix: pulumi.Input[Optional[_builtins.bool]] = None,
+                 logging: pulumi.Input[Optional['Logging']] = None,
+                 login_shell: pulumi.Input[Optional[_builtins.bool]] = None,
+                 max_failures: pulumi.Input[Optional[_builtins.int]] = None,
+                 pty: pulumi.Input[Optional[Union['PtyArgs', 'PtyArgsDict']]] = None,
+                 redact: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 redact_outputs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 stderr_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 stdin: pulumi.Input[Optional[_builtins.str]] = None,
+                 stdout_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 strip_workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
+                 update: pulumi.Input[Optional[_builtins.str]] = None,
+                 workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 __props__=None):
+        """
+        Runs the same command on several remote hosts, e.g. a fleet of identical servers. The
+        hosts are given either as 'connections', or as 'hosts' that share the settings of 'connection'. The command
+        is run on 'batchSize' hosts at a time, and stops after more than 'maxFailures' hosts failed. The output and
+        exit code of each host is available via the 'results' property.
+
+        On update, the 'delete' command is run on the hosts that were removed and the 'create' command on the hosts
+        that were added or that it didn't succeed on yet. The 'update' command, or 'create' if it's not set, is run on
+        the other hosts only if the command's inputs or the host's connection changed, or if it failed on the host
+        before. The output of each host is logged prefixed with the host.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.bool] add_previous_output_in_env: If the previous command's stdout and stderr (as generated by the prior create/update) is
+               injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+               Defaults to true.
+        :param pulumi.Input[_builtins.int] batch_size: The number of hosts the command is run on at the same time. The next batch of
+               hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+        :param pulumi.Input[_builtins.bool] become: Run the command as another user, root by default, via the escalation tool set in
+               'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+               the quoting of the command intact and passes on the environment variables.
+        :param pulumi.Input['BecomeMethod'] become_method: The escalation tool to use with `become`. Defaults to `sudo`.
+        :param pulumi.Input[_builtins.str] become_password: The password to answer the prompt of the escalation tool with. Without a
+               password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+               the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+               password is never part of the command's output.
+        :param pulumi.Input[_builtins.str] become_user: The user to run the command as with `become`. Defaults to `root`.
+        :param pulumi.Input[Union['ConnectionTemplateArgs', 'ConnectionTemplateArgsDict']] connection: The connection settings shared by the hosts in 'hosts'.
+        :param pulumi.Input[Sequence[pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']]]] connections: The connections to the hosts to run the command on. Each host may only be
+               listed once. Either 'connections' or 'hosts' must be set.
+        :param pulumi.Input[_builtins.str] create: The command to run once on resource creation.
+               
+               If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+               
+               Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+               
+               Use `local.runOutput` if you need to run a command on every execution of your program.
+        :param pulumi.Input[_builtins.str] delete: The command to run on resource deletion.
+               
+               The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+        :param pulumi.Input[Union['DetachedArgs', 'DetachedArgsDict']] detached: Run the command as a detached job that survives connection drops, e.g. for
+               jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+               to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+               the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+               polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+               provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+               rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+               Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        :param pulumi.Input[_builtins.str] dir: The directory on the remote host to run the command in. A leading '~' is the home
+               directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+               on the remote host.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] environment: Additional environment variables available to the command's process.
+               How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+               exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+        :param pulumi.Input['EnvironmentMode'] environment_mode: How the environment variables, including PULUMI_COMMAND_STDOUT and
+               PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+               the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+               them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+               'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        :param pulumi.Input[_builtins.int] error_output_lines: The number of lines at the end of stdout and stderr that the error of a
+               failed command includes. Defaults to 20.
+        :param pulumi.Input[_builtins.int] heartbeat_interval: The number of seconds without output after which the status of the
+               resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+               line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+               Defaults to 60.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] hosts: The addresses of the hosts to run the command on, which are connected to with the
+               settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+               which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+               must be set.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] interpreter: The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+               The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        :param pulumi.Input[_builtins.bool] log_stream_prefix: If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        :param pulumi.Input['Logging'] logging: If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+               stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+               outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        :param pulumi.Input[_builtins.bool] login_shell: Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+               ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        :param pulumi.Input[_builtins.int] max_failures: The number of hosts the command may fail on. Once more hosts failed, no further
+               batches are started and the resource fails. Defaults to 0.
+        :param pulumi.Input[Union['PtyArgs', 'PtyArgsDict']] pty: Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+               terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+               the terminal, without echo, followed by the end of input.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] redact: Additional values to mask as '[secret]' in the logged output and the error of
+               the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+               environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+               Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        :param pulumi.Input[_builtins.bool] redact_outputs: If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+               to false, in which case outputs that might contain secrets can be marked as secret via
+               'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        :param pulumi.Input['LogSeverity'] stderr_severity: The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        :param pulumi.Input[_builtins.str] stdin: Pass a string to the command's process as standard in
+        :param pulumi.Input['LogSeverity'] stdout_severity: The severity with which the lines of stdout are logged. Defaults to `info`.
+        :param pulumi.Input[_builtins.bool] strip_workflow_commands: If the workflow commands are removed from the 'stdout' output. Defaults
+               to false.
+        :param pulumi.Input[_builtins.int] timeout: The maximum number of seconds the command may run. When it elapses, or the
+               deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+               doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+               which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+               Defaults to no timeout.
+        :param pulumi.Input[Sequence[Any]] triggers: The resource will be updated (or replaced) if any of these values change.
+               
+               The trigger values can be of any type.
+               
+               If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+               
+               Please see the resource documentation for examples.
+        :param pulumi.Input[_builtins.str] update: The command to run when the resource is updated.
+               
+               If empty, the create command will be executed instead.
+               
+               Note that this command will not run if the resource's inputs are unchanged.
+               
+               Use `local.runOutput` if you need to run a command on every execution of your program.
+               
+               The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        :param pulumi.Input[_builtins.bool] workflow_commands: Run the workflow commands among the lines of stdout, modeled on those of
+               GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+               message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+               the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[MultiCommandArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Runs the same command on several remote hosts, e.g. a fleet of identical servers. The
+        hosts are given either as 'connections', or as 'hosts' that share the settings of 'connection'. The command
+        is run on 'batchSize' hosts at a time, and stops after more than 'maxFailures' hosts failed. The output and
+        exit code of each host is available via the 'results' property.
+
+        On update, the 'delete' command is run on the hosts that were removed and the 'create' command on the hosts
+        that were added or that it didn't succeed on yet. The 'update' command, or 'create' if it's not set, is run on
+        the other hosts only if the command's inputs or the host's connection changed, or if it failed on the host
+        before. The output of each host is logged prefixed with the host.
+
+        :param str resource_name: The name of the resource.
+        :param MultiCommandArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(MultiCommandArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 add_previous_output_in_env: pulumi.Input[Optional[_builtins.bool]] = None,
+                 batch_size: pulumi.Input[Optional[_builtins.int]] = None,
+                 become: pulumi.Input[Optional[_builtins.bool]] = None,
+                 become_method: pulumi.Input[Optional['BecomeMethod']] = None,
+                 become_password: pulumi.Input[Optional[_builtins.str]] = None,
+                 become_user: pulumi.Input[Optional[_builtins.str]] = None,
+                 connection: pulumi.Input[Optional[Union['ConnectionTemplateArgs', 'ConnectionTemplateArgsDict']]] = None,
+                 connections: pulumi.Input[Optional[Sequence[pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']]]]] = None,
+                 create: pulumi.Input[Optional[_builtins.str]] = None,
+                 delete: pulumi.Input[Optional[_builtins.str]] = None,
+                 detached: pulumi.Input[Optional[Union['DetachedArgs', 'DetachedArgsDict']]] = None,
+                 dir: pulumi.Input[Optional[_builtins.str]] = None,
+                 environment: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 environment_mode: pulumi.Input[Optional['EnvironmentMode']] = None,
+                 error_output_lines: pulumi.Input[Optional[_builtins.int]] = None,
+                 heartbeat_interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 hosts: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 interpreter: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 log_stream_prefix: pulumi.Input[Optional[_builtins.bool]] = None,
+                 logging: pulumi.Input[Optional['Logging']] = None,
+                 login_shell: pulumi.Input[Optional[_builtins.bool]] = None,
+                 max_failures: pulumi.Input[Optional[_builtins.int]] = None,
+                 pty: pulumi.Input[Optional[Union['PtyArgs', 'PtyArgsDict']]] = None,
+                 redact: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 redact_outputs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 stderr_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 stdin: pulumi.Input[Optional[_builtins.str]] = None,
+                 stdout_severity: pulumi.Input[Optional['LogSeverity']] = None,
+                 strip_workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
+                 update: pulumi.Input[Optional[_builtins.str]] = None,
+                 workflow_commands: pulumi.Input[Optional[_builtins.bool]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = MultiCommandArgs.__new__(MultiCommandArgs)
+
+            __props__.__dict__["add_previous_output_in_env"] = add_previous_output_in_env
+            __props__.__dict__["batch_size"] = batch_size
+            __props__.__dict__["become"] = become
+            __props__.__dict__["become_method"] = become_method
+            __props__.__dict__["become_password"] = None if become_password is None else pulumi.Output.secret(become_password)
+            __props__.__dict__["become_user"] = become_user
+            __props__.__dict__["connection"] = None if connection is None else pulumi.Output.secret(connection)
+            __props__.__dict__["connections"] = None if connections is None else pulumi.Output.secret(connections)
+            __props__.__dict__["create"] = create
+            __props__.__dict__["delete"] = delete
+            __props__.__dict__["detached"] = detached
+            __props__.__dict__["dir"] = dir
+            __props__.__dict__["environment"] = environment
+            __props__.__dict__["environment_mode"] = environment_mode
+            __props__.__dict__["error_output_lines"] = error_output_lines
+            __props__.__dict__["heartbeat_interval"] = heartbeat_interval
+            __props__.__dict__["hosts"] = hosts
+            __props__.__dict__["interpreter"] = interpreter
+            __props__.__dict__["log_stream_prefix"] = log_stream_prefix
+            __props__.__dict__["logging"] = logging
+            __props__.__dict__["login_shell"] = login_shell
+            __props__.__dict__["max_failures"] = max_failures
+            __props__.__dict__["pty"] = pty
+            __props__.__dict__["redact"] = None if redact is None else pulumi.Output.secret(redact)
+            __props__.__dict__["redact_outputs"] = redact_outputs
+            __props__.__dict__["stderr_severity"] = stderr_severity
+            __props__.__dict__["stdin"] = stdin
+            __props__.__dict__["stdout_severity"] = stdout_severity
+            __props__.__dict__["strip_workflow_commands"] = strip_workflow_commands
+            __props__.__dict__["timeout"] = timeout
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["update"] = update
+            __props__.__dict__["workflow_commands"] = workflow_commands
+            __props__.__dict__["results"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["becomePassword", "connection", "connections", "redact"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        replace_on_changes = pulumi.ResourceOptions(replace_on_changes=["triggers[*]"])
+        opts = pulumi.ResourceOptions.merge(opts, replace_on_changes)
+        super(MultiCommand, __self__).__init__(
+            'command:remote:MultiCommand',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'MultiCommand':
+        """
+        Get an existing MultiCommand resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = MultiCommandArgs.__new__(MultiCommandArgs)
+
+        __props__.__dict__["add_previous_output_in_env"] = None
+        __props__.__dict__["batch_size"] = None
+        __props__.__dict__["become"] = None
+        __props__.__dict__["become_method"] = None
+        __props__.__dict__["become_password"] = None
+        __props__.__dict__["become_user"] = None
+        __props__.__dict__["connection"] = None
+        __props__.__dict__["connections"] = None
+        __props__.__dict__["create"] = None
+        __props__.__dict__["delete"] = None
+        __props__.__dict__["detached"] = None
+        __props__.__dict__["dir"] = None
+        __props__.__dict__["environment"] = None
+        __props__.__dict__["environment_mode"] = None
+        __props__.__dict__["error_output_lines"] = None
+        __props__.__dict__["heartbeat_interval"] = None
+        __props__.__dict__["hosts"] = None
+        __props__.__dict__["interpreter"] = None
+        __props__.__dict__["log_stream_prefix"] = None
+        __props__.__dict__["logging"] = None
+        __props__.__dict__["login_shell"] = None
+        __props__.__dict__["max_failures"] = None
+        __props__.__dict__["pty"] = None
+        __props__.__dict__["redact"] = None
+        __props__.__dict__["redact_outputs"] = None
+        __props__.__dict__["results"] = None
+        __props__.__dict__["stderr_severity"] = None
+        __props__.__dict__["stdin"] = None
+        __props__.__dict__["stdout_severity"] = None
+        __props__.__dict__["strip_workflow_commands"] = None
+        __props__.__dict__["timeout"] = None
+        __props__.__dict__["triggers"] = None
+        __props__.__dict__["update"] = None
+        __props__.__dict__["workflow_commands"] = None
+        return MultiCommand(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="addPreviousOutputInEnv")
+    def add_previous_output_in_env(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the previous command's stdout and stderr (as generated by the prior create/update) is
+        injected into the environment of the next run as PULUMI_COMMAND_STDOUT and PULUMI_COMMAND_STDERR.
+        Defaults to true.
+        """
+        return pulumi.get(self, "add_previous_output_in_env")
+
+    @_builtins.property
+    @pulumi.getter(name="batchSize")
+    def batch_size(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of hosts the command is run on at the same time. The next batch of
+        hosts starts once all hosts of the batch finished. Defaults to all hosts at once.
+        """
+        return pulumi.get(self, "batch_size")
+
+    @_builtins.property
+    @pulumi.getter
+    def become(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Run the command as another user, root by default, via the escalation tool set in
+        'becomeMethod'. The command is run by 'sh' as that user. Unlike prefixing the command with 'sudo', this keeps
+        the quoting of the command intact and passes on the environment variables.
+        """
+        return pulumi.get(self, "become")
+
+    @_builtins.property
+    @pulumi.getter(name="becomeMethod")
+    def become_method(self) -> pulumi.Output[Optional['BecomeMethod']]:
+        """
+        The escalation tool to use with `become`. Defaults to `sudo`.
+        """
+        return pulumi.get(self, "become_method")
+
+    @_builtins.property
+    @pulumi.getter(name="becomePassword")
+    def become_password(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The password to answer the prompt of the escalation tool with. Without a
+        password, the tool must not prompt, e.g. because sudo is configured with NOPASSWD. sudo reads the password from
+        the standard input, while su and doas require a pseudo-terminal, which merges stderr into stdout. The
+        password is never part of the command's output.
+        """
+        return pulumi.get(self, "become_password")
+
+    @_builtins.property
+    @pulumi.getter(name="becomeUser")
+    def become_user(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The user to run the command as with `become`. Defaults to `root`.
+        """
+        return pulumi.get(self, "become_user")
+
+    @_builtins.property
+    @pulumi.getter
+    def connection(self) -> pulumi.Output[Optional['outputs.ConnectionTemplate']]:
+        """
+        The connection settings shared by the hosts in 'hosts'.
+        """
+        return pulumi.get(self, "connection")
+
+    @_builtins.property
+    @pulumi.getter
+    def connections(self) -> pulumi.Output[Optional[Sequence['outputs.Connection']]]:
+        """
+        The connections to the hosts to run the command on. Each host may only be
+        listed once. Either 'connections' or 'hosts' must be set.
+        """
+        return pulumi.get(self, "connections")
+
+    @_builtins.property
+    @pulumi.getter
+    def create(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The command to run once on resource creation.
+
+        If an `update` command isn't provided, then `create` will also be run when the resource's inputs are modified.
+
+        Note that this command will not be executed if the resource has already been created and its inputs are unchanged.
+
+        Use `local.runOutput` if you need to run a command on every execution of your program.
+        """
+        return pulumi.get(self, "create")
+
+    @_builtins.property
+    @pulumi.getter
+    def delete(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The command to run on resource deletion.
+
+        The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the stdout and stderr properties of the Command resource from previous create or update steps.
+        """
+        return pulumi.get(self, "delete")
+
+    @_builtins.property
+    @pulumi.getter
+    def detached(self) -> pulumi.Output[Optional['outputs.Detached']]:
+        """
+        Run the command as a detached job that survives connection drops, e.g. for
+        jobs that take hours. The command is started with nohup and setsid, and writes its pid, exit code and output
+        to a state directory on the remote host. The provider then polls the job until it finishes, and reconnects if
+        the connection drops, within the limits of 'dialErrorLimit' and 'perDialTimeout'. The output is logged as it's
+        polled. The job is named after the resource, host and command, so that if the deployment is canceled or the
+        provider stops while waiting, the next run resumes waiting for the job, or uses its result if it finished,
+        rather than starting it again. A canceled job therefore keeps running, while a 'timeout' still stops it.
+        Requires a POSIX shell on the remote host, and can't be combined with 'pty' or 'becomePassword'.
+        """
+        return pulumi.get(self, "detached")
+
+    @_builtins.property
+    @pulumi.getter
+    def dir(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The directory on the remote host to run the command in. A leading '~' is the home
+        directory of the user. If 'dir' doesn't exist, the command isn't run and 'Command' fails. Requires a POSIX shell
+        on the remote host.
+        """
+        return pulumi.get(self, "dir")
+
+    @_builtins.property
+    @pulumi.getter
+    def environment(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        Additional environment variables available to the command's process.
+        How they are passed to the command is set by 'environmentMode'. With 'become', the variables are
+        exported by the shell running as the become user instead, so AcceptEnv isn't needed.
+        """
+        return pulumi.get(self, "environment")
+
+    @_builtins.property
+    @pulumi.getter(name="environmentMode")
+    def environment_mode(self) -> pulumi.Output[Optional['EnvironmentMode']]:
+        """
+        How the environment variables, including PULUMI_COMMAND_STDOUT and
+        PULUMI_COMMAND_STDERR, are passed to the command. 'setenv' sets them on the SSH session, which only works if
+        the SSH server is configured to accept these variables via AcceptEnv. 'export' quotes the variables and prepends
+        them to the command as 'export' statements, which requires a POSIX shell on the remote host. 'auto' tries
+        'setenv' and exports the variables that the server rejects. Defaults to 'setenv'.
+        """
+        return pulumi.get(self, "environment_mode")
+
+    @_builtins.property
+    @pulumi.getter(name="errorOutputLines")
+    def error_output_lines(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of lines at the end of stdout and stderr that the error of a
+        failed command includes. Defaults to 20.
+        """
+        return pulumi.get(self, "error_output_lines")
+
+    @_builtins.property
+    @pulumi.getter(name="heartbeatInterval")
+    def heartbeat_interval(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of seconds without output after which the status of the
+        resource is set to 'still running' with the elapsed time, the time since the last output and the last logged
+        line, and again after each such interval, so that a long-running command doesn't look stuck. 0 disables it.
+        Defaults to 60.
+        """
+        return pulumi.get(self, "heartbeat_interval")
+
+    @_builtins.property
+    @pulumi.getter
+    def hosts(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The addresses of the hosts to run the command on, which are connected to with the
+        settings of 'connection'. An address may be followed by ':' and a port, e.g. '10.0.0.5:2222' or '[::1]:2222',
+        which overrides the port of 'connection'. Each host may only be listed once. Either 'connections' or 'hosts'
+        must be set.
+        """
+        return pulumi.get(self, "hosts")
+
+    @_builtins.property
+    @pulumi.getter
+    def interpreter(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The program and arguments to run the command with, e.g. `["bash", "-euo", "pipefail", "-c"]`.
+        The command is passed as the last argument. Defaults to running the command with the login shell of the user.
+        """
+        return pulumi.get(self, "interpreter")
+
+    @_builtins.property
+    @pulumi.getter(name="logStreamPrefix")
+    def log_stream_prefix(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If each logged line is prefixed with the name of its stream, `[stdout]` or `[stderr]`. Either way, the lines are logged in the order they arrive. Defaults to false.
+        """
+        return pulumi.get(self, "log_stream_prefix")
+
+    @_builtins.property
+    @pulumi.getter
+    def logging(self) -> pulumi.Output[Optional['Logging']]:
+        """
+        If the command's stdout and stderr should be logged. This doesn't affect the capturing of
+        stdout and stderr as outputs. If there might be secrets in the output, you can disable logging here and mark the
+        outputs as secret via 'additionalSecretOutputs'. Defaults to logging both stdout and stderr.
+        """
+        return pulumi.get(self, "logging")
+
+    @_builtins.property
+    @pulumi.getter(name="loginShell")
+    def login_shell(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Run the command in a login shell, i.e. '$SHELL -l -c', so that profile files like
+        ~/.profile are read, e.g. to set the PATH. Defaults to false.
+        """
+        return pulumi.get(self, "login_shell")
+
+    @_builtins.property
+    @pulumi.getter(name="maxFailures")
+    def max_failures(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of hosts the command may fail on. Once more hosts failed, no further
+        batches are started and the resource fails. Defaults to 0.
+        """
+        return pulumi.get(self, "max_failures")
+
+    @_builtins.property
+    @pulumi.getter
+    def pty(self) -> pulumi.Output[Optional['outputs.Pty']]:
+        """
+        Run the command in a pseudo-terminal, for programs that require a TTY. As on a real
+        terminal, stdout and stderr are merged into stdout, and stderr is empty. The standard input is typed into
+        the terminal, without echo, followed by the end of input.
+        """
+        return pulumi.get(self, "pty")
+
+    @_builtins.property
+    @pulumi.getter
+    def redact(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        Additional values to mask as '[secret]' in the logged output and the error of
+        the command, like the secret inputs. Besides these, the secret strings among the inputs, e.g. secret
+        environment variables, and the passwords and private keys of the connection and 'becomePassword' are masked.
+        Values shorter than 4 characters aren't masked, and a warning is logged instead.
+        """
+        return pulumi.get(self, "redact")
+
+    @_builtins.property
+    @pulumi.getter(name="redactOutputs")
+    def redact_outputs(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the secrets are also masked in the 'stdout' and 'stderr' outputs. Defaults
+        to false, in which case outputs that might contain secrets can be marked as secret via
+        'additionalSecretOutputs'. As in the logs, values shorter than 4 characters aren't masked.
+        """
+        return pulumi.get(self, "redact_outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def results(self) -> pulumi.Output[Mapping[str, 'outputs.HostResult']]:
+        """
+        The result of the last command run on each host, keyed by the host's address,
+        followed by ':' and the port if that's not 22. A host that the command hasn't been run on yet, e.g. because an
+        earlier batch failed, has no result.
+        """
+        return pulumi.get(self, "results")
+
+    @_builtins.property
+    @pulumi.getter(name="stderrSeverity")
+    def stderr_severity(self) -> pulumi.Output[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stderr are logged, e.g. `warning` to make errors stand out. Defaults to `info`.
+        """
+        return pulumi.get(self, "stderr_severity")
+
+    @_builtins.property
+    @pulumi.getter
+    def stdin(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        Pass a string to the command's process as standard in
+        """
+        return pulumi.get(self, "stdin")
+
+    @_builtins.property
+    @pulumi.getter(name="stdoutSeverity")
+    def stdout_severity(self) -> pulumi.Output[Optional['LogSeverity']]:
+        """
+        The severity with which the lines of stdout are logged. Defaults to `info`.
+        """
+        return pulumi.get(self, "stdout_severity")
+
+    @_builtins.property
+    @pulumi.getter(name="stripWorkflowCommands")
+    def strip_workflow_commands(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the workflow commands are removed from the 'stdout' output. Defaults
+        to false.
+        """
+        return pulumi.get(self, "strip_workflow_commands")
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The maximum number of seconds the command may run. When it elapses, or the
+        deployment is canceled, the command is sent SIGTERM and then SIGKILL, and the session is closed if it still
+        doesn't exit. The error then includes the output captured so far. Signals require support by the SSH server,
+        which recent versions of OpenSSH have. A 'detached' command keeps running when the deployment is canceled.
+        Defaults to no timeout.
+        """
+        return pulumi.get(self, "timeout")
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Sequence[Any]]]:
+        """
+        The resource will be updated (or replaced) if any of these values change.
+
+        The trigger values can be of any type.
+
+        If the `update` command was provided the resource will be updated, otherwise it will be replaced using the `create` command.
+
+        Please see the resource documentation for examples.
+        """
+        return pulumi.get(self, "triggers")
+
+    @_builtins.property
+    @pulumi.getter
+    def update(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The command to run when the resource is updated.
+
+        If empty, the create command will be executed instead.
+
+        Note that this command will not run if the resource's inputs are unchanged.
+
+        Use `local.runOutput` if you need to run a command on every execution of your program.
+
+        The environment variables `PULUMI_COMMAND_STDOUT` and `PULUMI_COMMAND_STDERR` are set to the `stdout` and `stderr` properties of the Command resource from previous create or update steps.
+        """
+        return pulumi.get(self, "update")
+
+    @_builtins.property
+    @pulumi.getter(name="workflowCommands")
+    def workflow_commands(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        Run the workflow commands among the lines of stdout, modeled on those of
+        GitHub Actions: '::debug::message', '::notice::message', '::warning::message' and '::error::message' log the
+        message as a diagnostic with the severity, and '::add-mask::value' masks the value like the secret inputs in
+        the later output. The commands aren't logged, even if the logging of stdout is disabled. Defaults to false.
+        """
+        return pulumi.get(self, "workflow_commands")
+
@@ -18,7 +18,13 @@ from ._enums import *
 
 __all__ = [
     'Connection',
+    'ConnectionStage',
+    'ConnectionTemplate',
+    'Detached',
+    'HostKey',
+    'HostResult',
     'ProxyConnection',
+    'Pty',
 ]
 
 @pulumi.output_type
@@ -199,6 +205,446 @@ class Connection(dict):
         return pulumi.get(self, "user")
 
 
+@pulumi.output_type
+class ConnectionStage(dict):
+    """
+    The result of a step of establishing a connection.
+    """
+    def __init__(__self__, *,
+                 duration_ms: _builtins.float,
+                 hop: _builtins.str,
+                 stage: _builtins.str,
+                 success: _builtins.bool,
+                 detail: Optional[_builtins.str] = None,
+                 error: Optional[_builtins.str] = None,
+                 method: Optional[_builtins.str] = None):
+        """
+        The result of a step of establishing a connection.
+
+        :param _builtins.float duration_ms: How long the stage took in milliseconds.
+        :param _builtins.str hop: The host that the stage connects to, `proxy` or `host`.
+        :param _builtins.str stage: The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+        :param _builtins.bool success: If the stage succeeded.
+        :param _builtins.str detail: What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+        :param _builtins.str error: Why the stage failed.
+        :param _builtins.str method: The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+        """
+        pulumi.set(__self__, "duration_ms", duration_ms)
+        pulumi.set(__self__, "hop", hop)
+        pulumi.set(__self__, "stage", stage)
+        pulumi.set(__self__, "success", success)
+        if detail is not None:
+            pulumi.set(__self__, "detail", detail)
+        if error is not None:
+            pulumi.set(__self__, "error", error)
+        if method is not None:
+            pulumi.set(__self__, "method", method)
+
+    @_builtins.property
+    @pulumi.getter(name="durationMs")
+    def duration_ms(self) -> _builtins.float:
+        """
+        How long the stage took in milliseconds.
+        """
+        return pulumi.get(self, "duration_ms")
+
+    @_builtins.property
+    @pulumi.getter
+    def hop(self) -> _builtins.str:
+        """
+        The host that the stage connects to, `proxy` or `host`.
+        """
+        return pulumi.get(self, "hop")
+
+    @_builtins.property
+    @pulumi.getter
+    def stage(self) -> _builtins.str:
+        """
+        The step: `resolve` the address, the `tcp` connection, the SSH `handshake`, the `hostKey` check or `auth`.
+        """
+        return pulumi.get(self, "stage")
+
+    @_builtins.property
+    @pulumi.getter
+    def success(self) -> _builtins.bool:
+        """
+        If the stage succeeded.
+        """
+        return pulumi.get(self, "success")
+
+    @_builtins.property
+    @pulumi.getter
+    def detail(self) -> Optional[_builtins.str]:
+        """
+        What the stage found, e.g. the resolved addresses or the fingerprint of the host key.
+        """
+        return pulumi.get(self, "detail")
+
+    @_builtins.property
+    @pulumi.getter
+    def error(self) -> Optional[_builtins.str]:
+        """
+        Why the stage failed.
+        """
+        return pulumi.get(self, "error")
+
+    @_builtins.property
+    @pulumi.getter
+    def method(self) -> Optional[_builtins.str]:
+        """
+        The auth method of an `auth` stage, e.g. `publickey`, `password` or `agent`.
+        """
+        return pulumi.get(self, "method")
+
+
+@pulumi.output_type
+class ConnectionTemplate(dict):
+    """
+    Instructions for how to connect to several remote endpoints with the same settings.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "agentSocketPath":
+            suggest = "agent_socket_path"
+        elif key == "dialErrorLimit":
+            suggest = "dial_error_limit"
+        elif key == "perDialTimeout":
+            suggest = "per_dial_timeout"
+        elif key == "privateKey":
+            suggest = "private_key"
+        elif key == "privateKeyPassword":
+            suggest = "private_key_password"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ConnectionTemplate. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ConnectionTemplate.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ConnectionTemplate.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 agent_socket_path: Optional[_builtins.str] = None,
+                 dial_error_limit: Optional[_builtins.int] = None,
+                 password: Optional[_builtins.str] = None,
+                 per_dial_timeout: Optional[_builtins.int] = None,
+                 port: Optional[_builtins.float] = None,
+                 private_key: Optional[_builtins.str] = None,
+                 private_key_password: Optional[_builtins.str] = None,
+                 proxy: Optional['outputs.ProxyConnection'] = None,
+                 user: Optional[_builtins.str] = None):
+        """
+        Instructions for how to connect to several remote endpoints with the same settings.
+
+        :param _builtins.str agent_socket_path: SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        :param _builtins.int dial_error_limit: Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        :param _builtins.str password: The password we should use for the connection.
+        :param _builtins.int per_dial_timeout: Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        :param _builtins.float port: The port to connect to. Defaults to 22.
+        :param _builtins.str private_key: The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        :param _builtins.str private_key_password: The password to use in case the private key is encrypted.
+        :param 'ProxyConnection' proxy: The connection settings for the bastion/proxy host.
+        :param _builtins.str user: The user that we should use for the connection.
+        """
+        if agent_socket_path is not None:
+            pulumi.set(__self__, "agent_socket_path", agent_socket_path)
+        if dial_error_limit is None:
+            dial_error_limit = 10
+        if dial_error_limit is not None:
+            pulumi.set(__self__, "dial_error_limit", dial_error_limit)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if per_dial_timeout is None:
+            per_dial_timeout = 15
+        if per_dial_timeout is not None:
+            pulumi.set(__self__, "per_dial_timeout", per_dial_timeout)
+        if port is None:
+            port = 22
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if private_key is not None:
+            pulumi.set(__self__, "private_key", private_key)
+        if private_key_password is not None:
+            pulumi.set(__self__, "private_key_password", private_key_password)
+        if proxy is not None:
+            pulumi.set(__self__, "proxy", proxy)
+        if user is None:
+            user = 'root'
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @_builtins.property
+    @pulumi.getter(name="agentSocketPath")
+    def agent_socket_path(self) -> Optional[_builtins.str]:
+        """
+        SSH Agent socket path. Default to environment variable SSH_AUTH_SOCK if present.
+        """
+        return pulumi.get(self, "agent_socket_path")
+
+    @_builtins.property
+    @pulumi.getter(name="dialErrorLimit")
+    def dial_error_limit(self) -> Optional[_builtins.int]:
+        """
+        Max allowed errors on trying to dial the remote host. -1 set count to unlimited. Default value is 10.
+        """
+        return pulumi.get(self, "dial_error_limit")
+
+    @_builtins.property
+    @pulumi.getter
+    def password(self) -> Optional[_builtins.str]:
+        """
+        The password we should use for the connection.
+        """
+        return pulumi.get(self, "password")
+
+    @_builtins.property
+    @pulumi.getter(name="perDialTimeout")
+    def per_dial_timeout(self) -> Optional[_builtins.int]:
+        """
+        Max number of seconds for each dial attempt. 0 implies no maximum. Default value is 15 seconds.
+        """
+        return pulumi.get(self, "per_dial_timeout")
+
+    @_builtins.property
+    @pulumi.getter
+    def port(self) -> Optional[_builtins.float]:
+        """
+        The port to connect to. Defaults to 22.
+        """
+        return pulumi.get(self, "port")
+
+    @_builtins.property
+    @pulumi.getter(name="privateKey")
+    def private_key(self) -> Optional[_builtins.str]:
+        """
+        The contents of an SSH key to use for the connection. This takes preference over the password if provided.
+        """
+        return pulumi.get(self, "private_key")
+
+    @_builtins.property
+    @pulumi.getter(name="privateKeyPassword")
+    def private_key_password(self) -> Optional[_builtins.str]:
+        """
+        The password to use in case the private key is encrypted.
+        """
+        return pulumi.get(self, "private_key_password")
+
+    @_builtins.property
+    @pulumi.getter
+    def proxy(self) -> Optional['outputs.ProxyConnection']:
+        """
+        The connection settings for the bastion/proxy host.
+        """
+        return pulumi.get(self, "proxy")
+
+    @_builtins.property
+    @pulumi.getter
+    def user(self) -> Optional[_builtins.str]:
+        """
+        The user that we should use for the connection.
+        """
+        return pulumi.get(self, "user")
+
+
+@pulumi.output_type
+class Detached(dict):
+    """
+    Settings for running a command as a detached job.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "pollInterval":
+            suggest = "poll_interval"
+        elif key == "stateDir":
+            suggest = "state_dir"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Detached. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Detached.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Detached.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 poll_interval: Optional[_builtins.int] = None,
+                 state_dir: Optional[_builtins.str] = None):
+        """
+        Settings for running a command as a detached job.
+
+        :param _builtins.int poll_interval: The number of seconds between checks whether the job finished. Defaults to 5.
+        :param _builtins.str state_dir: The directory on the remote host that holds a subdirectory per job, with its pid,
+               exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+               directory of the user. Defaults to `~/.pulumi-command/jobs`.
+        """
+        if poll_interval is not None:
+            pulumi.set(__self__, "poll_interval", poll_interval)
+        if state_dir is not None:
+            pulumi.set(__self__, "state_dir", state_dir)
+
+    @_builtins.property
+    @pulumi.getter(name="pollInterval")
+    def poll_interval(self) -> Optional[_builtins.int]:
+        """
+        The number of seconds between checks whether the job finished. Defaults to 5.
+        """
+        return pulumi.get(self, "poll_interval")
+
+    @_builtins.property
+    @pulumi.getter(name="stateDir")
+    def state_dir(self) -> Optional[_builtins.str]:
+        """
+        The directory on the remote host that holds a subdirectory per job, with its pid,
+        exit code, standard input and output. A job's directory is removed once it finished. A leading '~' is the home
+        directory of the user. Defaults to `~/.pulumi-command/jobs`.
+        """
+        return pulumi.get(self, "state_dir")
+
+
+@pulumi.output_type
+class HostKey(dict):
+    """
+    A public key that a host offers to identify itself.
+    """
+    def __init__(__self__, *,
+                 fingerprint: _builtins.str,
+                 key: _builtins.str,
+                 type: _builtins.str):
+        """
+        A public key that a host offers to identify itself.
+
+        :param _builtins.str fingerprint: The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+        :param _builtins.str key: The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+        :param _builtins.str type: The type of the key, e.g. `ssh-ed25519`.
+        """
+        pulumi.set(__self__, "fingerprint", fingerprint)
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "type", type)
+
+    @_builtins.property
+    @pulumi.getter
+    def fingerprint(self) -> _builtins.str:
+        """
+        The SHA256 fingerprint of the key, e.g. `SHA256:...`.
+        """
+        return pulumi.get(self, "fingerprint")
+
+    @_builtins.property
+    @pulumi.getter
+    def key(self) -> _builtins.str:
+        """
+        The key in the authorized_keys format, e.g. `ssh-ed25519 AAAA...`, as expected by the `hostKey` of a connection.
+        """
+        return pulumi.get(self, "key")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> _builtins.str:
+        """
+        The type of the key, e.g. `ssh-ed25519`.
+        """
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class HostResult(dict):
+    """
+    The result of running a command on a host.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "exitCode":
+            suggest = "exit_code"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in HostResult. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        HostResult.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        HostResult.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 created: _builtins.bool,
+                 exit_code: _builtins.int,
+                 stderr: _builtins.str,
+                 stdout: _builtins.str,
+                 error: Optional[_builtins.str] = None):
+        """
+        The result of running a command on a host.
+
+        :param _builtins.bool created: Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+               than 'update' is run on the host on update.
+        :param _builtins.int exit_code: The exit code of the command's process, or -1 if the command didn't exit, e.g.
+               because the host couldn't be reached.
+        :param _builtins.str stderr: The standard error of the command's process
+        :param _builtins.str stdout: The standard output of the command's process
+        :param _builtins.str error: Why the command failed on the host, including its output. Unset if the command
+               succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.
+        """
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "exit_code", exit_code)
+        pulumi.set(__self__, "stderr", stderr)
+        pulumi.set(__self__, "stdout", stdout)
+        if error is not None:
+            pulumi.set(__self__, "error", error)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.bool:
+        """
+        Whether the 'create' command succeeded on the host. Until it did, 'create' rather
+        than 'update' is run on the host on update.
+        """
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter(name="exitCode")
+    def exit_code(self) -> _builtins.int:
+        """
+        The exit code of the command's process, or -1 if the command didn't exit, e.g.
+        because the host couldn't be reached.
+        """
+        return pulumi.get(self, "exit_code")
+
+    @_builtins.property
+    @pulumi.getter
+    def stderr(self) -> _builtins.str:
+        """
+        The standard error of the command's process
+        """
+        return pulumi.get(self, "stderr")
+
+    @_builtins.property
+    @pulumi.getter
+    def stdout(self) -> _builtins.str:
+        """
+        The standard output of the command's process
+        """
+        return pulumi.get(self, "stdout")
+
+    @_builtins.property
+    @pulumi.getter
+    def error(self) -> Optional[_builtins.str]:
+        """
+        Why the command failed on the host, including its output. Unset if the command
+        succeeded. If it failed, 'stdout' and 'stderr' hold the last 'errorOutputLines' lines of its output.
+        """
+        return pulumi.get(self, "error")
+
+
 @pulumi.output_type
 class ProxyConnection(dict):
     """
@@ -365,3 +811,82 @@ class ProxyConnection(dict):
         return pulumi.get(self, "user")
 
 
+@pulumi.output_type
+class Pty(dict):
+    """
+    The pseudo-terminal that a command is run in.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "stripAnsi":
+            suggest = "strip_ansi"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Pty. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Pty.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Pty.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 cols: Optional[_builtins.int] = None,
+                 rows: Optional[_builtins.int] = None,
+                 strip_ansi: Optional[_builtins.bool] = None,
+                 term: Optional[_builtins.str] = None):
+        """
+        The pseudo-terminal that a command is run in.
+
+        :param _builtins.int cols: The width of the terminal in characters. Defaults to 80.
+        :param _builtins.int rows: The height of the terminal in characters. Defaults to 24.
+        :param _builtins.bool strip_ansi: If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+               captured stdout. They are always part of the logged output. Defaults to false.
+        :param _builtins.str term: The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        if cols is not None:
+            pulumi.set(__self__, "cols", cols)
+        if rows is not None:
+            pulumi.set(__self__, "rows", rows)
+        if strip_ansi is not None:
+            pulumi.set(__self__, "strip_ansi", strip_ansi)
+        if term is not None:
+            pulumi.set(__self__, "term", term)
+
+    @_builtins.property
+    @pulumi.getter
+    def cols(self) -> Optional[_builtins.int]:
+        """
+        The width of the terminal in characters. Defaults to 80.
+        """
+        return pulumi.get(self, "cols")
+
+    @_builtins.property
+    @pulumi.getter
+    def rows(self) -> Optional[_builtins.int]:
+        """
+        The height of the terminal in characters. Defaults to 24.
+        """
+        return pulumi.get(self, "rows")
+
+    @_builtins.property
+    @pulumi.getter(name="stripAnsi")
+    def strip_ansi(self) -> Optional[_builtins.bool]:
+        """
+        If ANSI escape codes, e.g. colors and cursor movements, are removed from the
+        captured stdout. They are always part of the logged output. Defaults to false.
+        """
+        return pulumi.get(self, "strip_ansi")
+
+    @_builtins.property
+    @pulumi.getter
+    def term(self) -> Optional[_builtins.str]:
+        """
+        The terminal type, set as TERM. Defaults to `xterm`.
+        """
+        return pulumi.get(self, "term")
+
+
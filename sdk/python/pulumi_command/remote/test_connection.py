@@ -0,0 +1,91 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'TestConnectionResult',
+    'AwaitableTestConnectionResult',
+    'test_connection',
+    'test_connection_output',
+]
+
+@pulumi.output_type
+class TestConnectionResult:
+    def __init__(__self__, stages=None, success=None):
+        if stages and not isinstance(stages, list):
+            raise TypeError("Expected argument 'stages' to be a list")
+        pulumi.set(__self__, "stages", stages)
+        if success and not isinstance(success, bool):
+            raise TypeError("Expected argument 'success' to be a bool")
+        pulumi.set(__self__, "success", success)
+
+    @_builtins.property
+    @pulumi.getter
+    def stages(self) -> Sequence['outputs.ConnectionStage']:
+        """
+        The stages of the connection in the order they were run. The stages after the first one that failed aren't run, except that all auth methods are tried until one succeeds.
+        """
+        return pulumi.get(self, "stages")
+
+    @_builtins.property
+    @pulumi.getter
+    def success(self) -> _builtins.bool:
+        """
+        If the connection to the host succeeded.
+        """
+        return pulumi.get(self, "success")
+
+
+class AwaitableTestConnectionResult(TestConnectionResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return TestConnectionResult(
+            stages=self.stages,
+            success=self.success)
+
+
+def test_connection(connection: Optional[Union['Connection', 'ConnectionDict']] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableTestConnectionResult:
+    """
+    Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+
+    :param Union['Connection', 'ConnectionDict'] connection: The parameters with which to connect to the remote host.
+    """
+    __args__ = dict()
+    __args__['connection'] = connection
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('command:remote:testConnection', __args__, opts=opts, typ=TestConnectionResult).value
+
+    return AwaitableTestConnectionResult(
+        stages=pulumi.get(__ret__, 'stages'),
+        success=pulumi.get(__ret__, 'success'))
+def test_connection_output(connection: pulumi.Input[Optional[Union['Connection', 'ConnectionDict']]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[TestConnectionResult]:
+    """
+    Tests a connection step by step to diagnose why it fails: resolving the address, the TCP connection, the SSH handshake, the host key check and each auth method, first to the proxy if set and then to the host. Unlike the resources, it makes a single attempt, and reports a failed connection in its result rather than as an error.
+
+    :param Union['Connection', 'ConnectionDict'] connection: The parameters with which to connect to the remote host.
+    """
+    __args__ = dict()
+    __args__['connection'] = connection
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('command:remote:testConnection', __args__, opts=opts, typ=TestConnectionResult)
+    return __ret__.apply(lambda __response__: TestConnectionResult(
+        stages=pulumi.get(__response__, 'stages'),
+        success=pulumi.get(__response__, 'success')))
@@ -0,0 +1,328 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['TunnelArgs', 'Tunnel']
+
+@pulumi.input_type
+class TunnelArgs:
+    def __init__(__self__, *,
+                 connection: pulumi.Input['ConnectionArgs'],
+                 local_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 local_port: pulumi.Input[Optional[_builtins.int]] = None,
+                 remote_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 remote_port: pulumi.Input[Optional[_builtins.int]] = None,
+                 type: pulumi.Input[Optional['TunnelType']] = None):
+        """
+        The set of arguments for constructing a Tunnel resource.
+
+        :param pulumi.Input['ConnectionArgs'] connection: The parameters with which to connect to the remote host.
+        :param pulumi.Input[_builtins.str] local_host: The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+        :param pulumi.Input[_builtins.int] local_port: The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+               if it's not set, while a 'remote' tunnel requires it.
+        :param pulumi.Input[_builtins.str] remote_host: The address as seen from the remote host. Defaults to `localhost`.
+        :param pulumi.Input[_builtins.int] remote_port: The port as seen from the remote host. A 'remote' tunnel listens on a port
+               chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+        :param pulumi.Input['TunnelType'] type: The direction of the tunnel. Defaults to `local`.
+        """
+        pulumi.set(__self__, "connection", connection)
+        if local_host is not None:
+            pulumi.set(__self__, "local_host", local_host)
+        if local_port is not None:
+            pulumi.set(__self__, "local_port", local_port)
+        if remote_host is not None:
+            pulumi.set(__self__, "remote_host", remote_host)
+        if remote_port is not None:
+            pulumi.set(__self__, "remote_port", remote_port)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @_builtins.property
+    @pulumi.getter
+    def connection(self) -> pulumi.Input['ConnectionArgs']:
+        """
+        The parameters with which to connect to the remote host.
+        """
+        return pulumi.get(self, "connection")
+
+    @connection.setter
+    def connection(self, value: pulumi.Input['ConnectionArgs']):
+        pulumi.set(self, "connection", value)
+
+    @_builtins.property
+    @pulumi.getter(name="localHost")
+    def local_host(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+        """
+        return pulumi.get(self, "local_host")
+
+    @local_host.setter
+    def local_host(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "local_host", value)
+
+    @_builtins.property
+    @pulumi.getter(name="localPort")
+    def local_port(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+        if it's not set, while a 'remote' tunnel requires it.
+        """
+        return pulumi.get(self, "local_port")
+
+    @local_port.setter
+    def local_port(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "local_port", value)
+
+    @_builtins.property
+    @pulumi.getter(name="remoteHost")
+    def remote_host(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The address as seen from the remote host. Defaults to `localhost`.
+        """
+        return pulumi.get(self, "remote_host")
+
+    @remote_host.setter
+    def remote_host(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "remote_host", value)
+
+    @_builtins.property
+    @pulumi.getter(name="remotePort")
+    def remote_port(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The port as seen from the remote host. A 'remote' tunnel listens on a port
+        chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+        """
+        return pulumi.get(self, "remote_port")
+
+    @remote_port.setter
+    def remote_port(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "remote_port", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[Optional['TunnelType']]:
+        """
+        The direction of the tunnel. Defaults to `local`.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[Optional['TunnelType']]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.type_token("command:remote:Tunnel")
+class Tunnel(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
+                 local_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 local_port: pulumi.Input[Optional[_builtins.int]] = None,
+                 remote_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 remote_port: pulumi.Input[Optional[_builtins.int]] = None,
+                 type: pulumi.Input[Optional['TunnelType']] = None,
+                 __props__=None):
+        """
+        Forwards a port over SSH for the duration of a deployment, e.g. to reach a private
+        database through a bastion host from other providers.
+
+        A 'local' tunnel listens on 'localHost' and 'localPort' on the machine running Pulumi, and forwards the
+        connections to 'remoteHost' and 'remotePort' as seen from the remote host. A 'remote' tunnel listens on
+        'remoteHost' and 'remotePort' on the remote host, and forwards the connections to 'localHost' and 'localPort'
+        as seen from the machine running Pulumi. The listening address is available via 'address' and 'port'.
+
+        The tunnel is open while the provider runs, and is reopened on the same address on each deployment when the
+        Tunnel is checked for changes. Only if that address isn't free anymore, the Tunnel shows as updated, with a
+        new 'address' for a free port. Set the listening port to keep 'address' the same in any case. Resources that use
+        the tunnel should depend on it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']] connection: The parameters with which to connect to the remote host.
+        :param pulumi.Input[_builtins.str] local_host: The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+        :param pulumi.Input[_builtins.int] local_port: The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+               if it's not set, while a 'remote' tunnel requires it.
+        :param pulumi.Input[_builtins.str] remote_host: The address as seen from the remote host. Defaults to `localhost`.
+        :param pulumi.Input[_builtins.int] remote_port: The port as seen from the remote host. A 'remote' tunnel listens on a port
+               chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+        :param pulumi.Input['TunnelType'] type: The direction of the tunnel. Defaults to `local`.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: TunnelArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Forwards a port over SSH for the duration of a deployment, e.g. to reach a private
+        database through a bastion host from other providers.
+
+        A 'local' tunnel listens on 'localHost' and 'localPort' on the machine running Pulumi, and forwards the
+        connections to 'remoteHost' and 'remotePort' as seen from the remote host. A 'remote' tunnel listens on
+        'remoteHost' and 'remotePort' on the remote host, and forwards the connections to 'localHost' and 'localPort'
+        as seen from the machine running Pulumi. The listening address is available via 'address' and 'port'.
+
+        The tunnel is open while the provider runs, and is reopened on the same address on each deployment when the
+        Tunnel is checked for changes. Only if that address isn't free anymore, the Tunnel shows as updated, with a
+        new 'address' for a free port. Set the listening port to keep 'address' the same in any case. Resources that use
+        the tunnel should depend on it.
+
+        :param str resource_name: The name of the resource.
+        :param TunnelArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(TunnelArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
+                 local_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 local_port: pulumi.Input[Optional[_builtins.int]] = None,
+                 remote_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 remote_port: pulumi.Input[Optional[_builtins.int]] = None,
+                 type: pulumi.Input[Optional['TunnelType']] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = TunnelArgs.__new__(TunnelArgs)
+
+            if connection is None and not opts.urn:
+                raise TypeError("Missing required property 'connection'")
+            __props__.__dict__["connection"] = None if connection is None else pulumi.Output.secret(connection)
+            __props__.__dict__["local_host"] = local_host
+            __props__.__dict__["local_port"] = local_port
+            __props__.__dict__["remote_host"] = remote_host
+            __props__.__dict__["remote_port"] = remote_port
+            __props__.__dict__["type"] = type
+            __props__.__dict__["address"] = None
+            __props__.__dict__["port"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connection"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Tunnel, __self__).__init__(
+            'command:remote:Tunnel',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Tunnel':
+        """
+        Get an existing Tunnel resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = TunnelArgs.__new__(TunnelArgs)
+
+        __props__.__dict__["address"] = None
+        __props__.__dict__["connection"] = None
+        __props__.__dict__["local_host"] = None
+        __props__.__dict__["local_port"] = None
+        __props__.__dict__["port"] = None
+        __props__.__dict__["remote_host"] = None
+        __props__.__dict__["remote_port"] = None
+        __props__.__dict__["type"] = None
+        return Tunnel(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def address(self) -> pulumi.Output[_builtins.str]:
+        """
+        The address that the tunnel listens on as host and port, on the machine running
+        Pulumi for a 'local' tunnel and on the remote host for a 'remote' one.
+        """
+        return pulumi.get(self, "address")
+
+    @_builtins.property
+    @pulumi.getter
+    def connection(self) -> pulumi.Output['outputs.Connection']:
+        """
+        The parameters with which to connect to the remote host.
+        """
+        return pulumi.get(self, "connection")
+
+    @_builtins.property
+    @pulumi.getter(name="localHost")
+    def local_host(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The address on the machine running Pulumi. Defaults to `127.0.0.1`.
+        """
+        return pulumi.get(self, "local_host")
+
+    @_builtins.property
+    @pulumi.getter(name="localPort")
+    def local_port(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The port on the machine running Pulumi. A 'local' tunnel listens on a free port
+        if it's not set, while a 'remote' tunnel requires it.
+        """
+        return pulumi.get(self, "local_port")
+
+    @_builtins.property
+    @pulumi.getter
+    def port(self) -> pulumi.Output[_builtins.int]:
+        """
+        The port that the tunnel listens on.
+        """
+        return pulumi.get(self, "port")
+
+    @_builtins.property
+    @pulumi.getter(name="remoteHost")
+    def remote_host(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The address as seen from the remote host. Defaults to `localhost`.
+        """
+        return pulumi.get(self, "remote_host")
+
+    @_builtins.property
+    @pulumi.getter(name="remotePort")
+    def remote_port(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The port as seen from the remote host. A 'remote' tunnel listens on a port
+        chosen by the SSH server if it's not set, while a 'local' tunnel requires it.
+        """
+        return pulumi.get(self, "remote_port")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> pulumi.Output[Optional['TunnelType']]:
+        """
+        The direction of the tunnel. Defaults to `local`.
+        """
+        return pulumi.get(self, "type")
+
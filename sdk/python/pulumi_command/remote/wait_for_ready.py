@@ -0,0 +1,292 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['WaitForReadyArgs', 'WaitForReady']
+
+@pulumi.input_type
+class WaitForReadyArgs:
+    def __init__(__self__, *,
+                 connection: pulumi.Input['ConnectionArgs'],
+                 interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 probe: pulumi.Input[Optional[_builtins.str]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 triggers: pulumi.Input[Optional[Sequence[Any]]] = None):
+        """
+        The set of arguments for constructing a WaitForReady resource.
+
+        :param pulumi.Input['ConnectionArgs'] connection: The parameters with which to connect to the remote host. Each attempt dials
+               the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+        :param pulumi.Input[_builtins.int] interval: The number of seconds between the attempts to connect and run the probe.
+               Defaults to 5.
+        :param pulumi.Input[_builtins.str] probe: A command that must succeed on the host for it to be ready, e.g.
+               'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+               still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+        :param pulumi.Input[_builtins.int] timeout: The maximum number of seconds to wait for the host in total. Defaults to 600.
+        :param pulumi.Input[Sequence[Any]] triggers: Wait for the host again on changes to this input.
+        """
+        pulumi.set(__self__, "connection", connection)
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if probe is not None:
+            pulumi.set(__self__, "probe", probe)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @_builtins.property
+    @pulumi.getter
+    def connection(self) -> pulumi.Input['ConnectionArgs']:
+        """
+        The parameters with which to connect to the remote host. Each attempt dials
+        the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+        """
+        return pulumi.get(self, "connection")
+
+    @connection.setter
+    def connection(self, value: pulumi.Input['ConnectionArgs']):
+        pulumi.set(self, "connection", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def interval(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The number of seconds between the attempts to connect and run the probe.
+        Defaults to 5.
+        """
+        return pulumi.get(self, "interval")
+
+    @interval.setter
+    def interval(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "interval", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def probe(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        A command that must succeed on the host for it to be ready, e.g.
+        'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+        still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+        """
+        return pulumi.get(self, "probe")
+
+    @probe.setter
+    def probe(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "probe", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The maximum number of seconds to wait for the host in total. Defaults to 600.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "timeout", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Input[Optional[Sequence[Any]]]:
+        """
+        Wait for the host again on changes to this input.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: pulumi.Input[Optional[Sequence[Any]]]):
+        pulumi.set(self, "triggers", value)
+
+
+@pulumi.type_token("command:remote:WaitForReady")
+class WaitForReady(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
+                 interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 probe: pulumi.Input[Optional[_builtins.str]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
+                 __props__=None):
+        """
+        Waits until a remote host accepts SSH connections, e.g. after creating a VM, so that the
+        resources depending on it don't have to retry. Optionally, it also waits until a probe command succeeds on the
+        host, e.g. 'cloud-init status --wait'.
+
+        The host is waited for on creation, and again when any of the inputs change.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Union['ConnectionArgs', 'ConnectionArgsDict']] connection: The parameters with which to connect to the remote host. Each attempt dials
+               the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+        :param pulumi.Input[_builtins.int] interval: The number of seconds between the attempts to connect and run the probe.
+               Defaults to 5.
+        :param pulumi.Input[_builtins.str] probe: A command that must succeed on the host for it to be ready, e.g.
+               'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+               still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+        :param pulumi.Input[_builtins.int] timeout: The maximum number of seconds to wait for the host in total. Defaults to 600.
+        :param pulumi.Input[Sequence[Any]] triggers: Wait for the host again on changes to this input.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: WaitForReadyArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Waits until a remote host accepts SSH connections, e.g. after creating a VM, so that the
+        resources depending on it don't have to retry. Optionally, it also waits until a probe command succeeds on the
+        host, e.g. 'cloud-init status --wait'.
+
+        The host is waited for on creation, and again when any of the inputs change.
+
+        :param str resource_name: The name of the resource.
+        :param WaitForReadyArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(WaitForReadyArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 connection: pulumi.Input[Optional[Union['ConnectionArgs', 'ConnectionArgsDict']]] = None,
+                 interval: pulumi.Input[Optional[_builtins.int]] = None,
+                 probe: pulumi.Input[Optional[_builtins.str]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.int]] = None,
+                 triggers: pulumi.Input[Optional[Sequence[Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = WaitForReadyArgs.__new__(WaitForReadyArgs)
+
+            if connection is None and not opts.urn:
+                raise TypeError("Missing required property 'connection'")
+            __props__.__dict__["connection"] = None if connection is None else pulumi.Output.secret(connection)
+            __props__.__dict__["interval"] = interval
+            __props__.__dict__["probe"] = probe
+            __props__.__dict__["timeout"] = timeout
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["connect_latency"] = None
+            __props__.__dict__["host_key"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connection"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(WaitForReady, __self__).__init__(
+            'command:remote:WaitForReady',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'WaitForReady':
+        """
+        Get an existing WaitForReady resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = WaitForReadyArgs.__new__(WaitForReadyArgs)
+
+        __props__.__dict__["connect_latency"] = None
+        __props__.__dict__["connection"] = None
+        __props__.__dict__["host_key"] = None
+        __props__.__dict__["interval"] = None
+        __props__.__dict__["probe"] = None
+        __props__.__dict__["timeout"] = None
+        __props__.__dict__["triggers"] = None
+        return WaitForReady(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="connectLatency")
+    def connect_latency(self) -> pulumi.Output[_builtins.int]:
+        """
+        The number of milliseconds that the successful connection took to establish.
+        """
+        return pulumi.get(self, "connect_latency")
+
+    @_builtins.property
+    @pulumi.getter
+    def connection(self) -> pulumi.Output['outputs.Connection']:
+        """
+        The parameters with which to connect to the remote host. Each attempt dials
+        the host once, within 'perDialTimeout', and 'dialErrorLimit' doesn't apply.
+        """
+        return pulumi.get(self, "connection")
+
+    @_builtins.property
+    @pulumi.getter(name="hostKey")
+    def host_key(self) -> pulumi.Output[_builtins.str]:
+        """
+        The host key that the host presented on the successful connection, in the
+        authorized_keys format, e.g. to pin it as the 'hostKey' of later connections.
+        """
+        return pulumi.get(self, "host_key")
+
+    @_builtins.property
+    @pulumi.getter
+    def interval(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The number of seconds between the attempts to connect and run the probe.
+        Defaults to 5.
+        """
+        return pulumi.get(self, "interval")
+
+    @_builtins.property
+    @pulumi.getter
+    def probe(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A command that must succeed on the host for it to be ready, e.g.
+        'cloud-init status --wait'. It's run on each attempt once the host accepts the connection. A probe that's
+        still running when 'timeout' elapses is sent SIGTERM and then SIGKILL, like a command that times out.
+        """
+        return pulumi.get(self, "probe")
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The maximum number of seconds to wait for the host in total. Defaults to 600.
+        """
+        return pulumi.get(self, "timeout")
+
+    @_builtins.property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Sequence[Any]]]:
+        """
+        Wait for the host again on changes to this input.
+        """
+        return pulumi.get(self, "triggers")
+